@@ -0,0 +1,300 @@
+package networkcontracts
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// binding ties a generated contract to the artifact it was generated from.
+type binding struct {
+	artifact   string
+	metaData   *bind.MetaData
+	caller     any
+	transactor any
+	filterer   any
+}
+
+var bindings = []binding{
+	{"IBaseDelegator", IBaseDelegatorMetaData, &IBaseDelegatorCaller{}, &IBaseDelegatorTransactor{}, &IBaseDelegatorFilterer{}},
+	{"IERC20Metadata", IERC20MetadataMetaData, &IERC20MetadataCaller{}, &IERC20MetadataTransactor{}, &IERC20MetadataFilterer{}},
+	{"INetwork", INetworkMetaData, &INetworkCaller{}, &INetworkTransactor{}, &INetworkFilterer{}},
+	{"INetworkMiddlewareService", INetworkMiddlewareServiceMetaData, &INetworkMiddlewareServiceCaller{}, &INetworkMiddlewareServiceTransactor{}, &INetworkMiddlewareServiceFilterer{}},
+	{"INetworkRegistry", INetworkRegistryMetaData, &INetworkRegistryCaller{}, &INetworkRegistryTransactor{}, &INetworkRegistryFilterer{}},
+	{"INetworkRestakeDelegator", INetworkRestakeDelegatorMetaData, &INetworkRestakeDelegatorCaller{}, &INetworkRestakeDelegatorTransactor{}, &INetworkRestakeDelegatorFilterer{}},
+	{"ISetMaxNetworkLimitHook", ISetMaxNetworkLimitHookMetaData, &ISetMaxNetworkLimitHookCaller{}, &ISetMaxNetworkLimitHookTransactor{}, &ISetMaxNetworkLimitHookFilterer{}},
+	{"IVault", IVaultMetaData, &IVaultCaller{}, &IVaultTransactor{}, &IVaultFilterer{}},
+	{"IVetoSlasher", IVetoSlasherMetaData, &IVetoSlasherCaller{}, &IVetoSlasherTransactor{}, &IVetoSlasherFilterer{}},
+	{"ProxyAdmin", ProxyAdminMetaData, &ProxyAdminCaller{}, &ProxyAdminTransactor{}, &ProxyAdminFilterer{}},
+	{"TimelockControllerUpgradeable", TimelockControllerUpgradeableMetaData, &TimelockControllerUpgradeableCaller{}, &TimelockControllerUpgradeableTransactor{}, &TimelockControllerUpgradeableFilterer{}},
+}
+
+func artifactPath(name string) string {
+	return filepath.Join("..", "..", "abis", name+".abi.json")
+}
+
+func TestEveryArtifactHasBinding(t *testing.T) {
+	paths, err := filepath.Glob(artifactPath("*"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	bound := make(map[string]bool, len(bindings))
+	for _, b := range bindings {
+		bound[artifactPath(b.artifact)] = true
+	}
+	for _, path := range paths {
+		if !bound[path] {
+			t.Errorf("%s has no binding", path)
+		}
+	}
+}
+
+// TestEmbeddedABI checks that the ABI embedded in each binding is the one in
+// ./abis, so the bindings were regenerated after the last ABI change.
+func TestEmbeddedABI(t *testing.T) {
+	for _, b := range bindings {
+		t.Run(b.artifact, func(t *testing.T) {
+			data, err := os.ReadFile(artifactPath(b.artifact))
+			if err != nil {
+				t.Fatal(err)
+			}
+			var artifact, embedded any
+			if err := json.Unmarshal(data, &artifact); err != nil {
+				t.Fatalf("artifact: %v", err)
+			}
+			if err := json.Unmarshal([]byte(b.metaData.ABI), &embedded); err != nil {
+				t.Fatalf("embedded: %v", err)
+			}
+			if !reflect.DeepEqual(stripInternalTypes(artifact), embedded) {
+				t.Errorf("embedded ABI differs from %s; regenerate the bindings", artifactPath(b.artifact))
+			}
+		})
+	}
+}
+
+// stripInternalTypes drops the spaces of every internalType, as abigen does
+// when embedding the ABI ("struct INetwork.DelayParams" becomes
+// "structINetwork.DelayParams").
+func stripInternalTypes(v any) any {
+	switch v := v.(type) {
+	case []any:
+		for i := range v {
+			v[i] = stripInternalTypes(v[i])
+		}
+	case map[string]any:
+		for key, value := range v {
+			if s, ok := value.(string); ok && key == "internalType" {
+				v[key] = strings.ReplaceAll(s, " ", "")
+			} else {
+				v[key] = stripInternalTypes(value)
+			}
+		}
+	}
+	return v
+}
+
+// TestWrappers checks that every method and event of the artifact has its
+// generated wrapper. abigen exposes custom errors through the parsed ABI only,
+// so errors are checked to be decodable from the embedded ABI by selector.
+func TestWrappers(t *testing.T) {
+	for _, b := range bindings {
+		t.Run(b.artifact, func(t *testing.T) {
+			data, err := os.ReadFile(artifactPath(b.artifact))
+			if err != nil {
+				t.Fatal(err)
+			}
+			artifact, err := abi.JSON(bytes.NewReader(data))
+			if err != nil {
+				t.Fatal(err)
+			}
+			parsed, err := b.metaData.GetAbi()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, method := range artifact.Methods {
+				wrapper, name := b.transactor, abi.ToCamelCase(method.Name)
+				if method.IsConstant() {
+					wrapper = b.caller
+				}
+				if !hasMethod(wrapper, name) {
+					t.Errorf("method %s: %T has no %s", method.Sig, wrapper, name)
+				}
+			}
+			for _, event := range artifact.Events {
+				name := abi.ToCamelCase(event.Name)
+				for _, prefix := range []string{"Filter", "Watch", "Parse"} {
+					if !hasMethod(b.filterer, prefix+name) {
+						t.Errorf("event %s: %T has no %s%s", event.Sig, b.filterer, prefix, name)
+					}
+				}
+			}
+			for _, e := range artifact.Errors {
+				got, err := parsed.ErrorByID([4]byte(e.ID[:4]))
+				if err != nil {
+					t.Errorf("error %s: %v", e.Sig, err)
+					continue
+				}
+				if got.Sig != e.Sig {
+					t.Errorf("error %s: selector decodes to %s", e.Sig, got.Sig)
+				}
+			}
+		})
+	}
+}
+
+func hasMethod(v any, name string) bool {
+	_, ok := reflect.TypeOf(v).MethodByName(name)
+	return ok
+}
+
+// TestNetworkInitParamsRoundTrip encodes INetworkNetworkInitParams as the
+// initialize argument and decodes it back, catching a Go struct that no longer
+// matches the Solidity tuple.
+func TestNetworkInitParamsRoundTrip(t *testing.T) {
+	parsed, err := INetworkMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	params := INetworkNetworkInitParams{
+		GlobalMinDelay: big.NewInt(3 * 24 * 60 * 60),
+		DelayParams: []INetworkDelayParams{
+			{
+				Target:   common.HexToAddress("0x00000000000000000000000000000000000000a1"),
+				Selector: [4]byte{0x23, 0xf7, 0x52, 0xd5},
+				Delay:    big.NewInt(14 * 24 * 60 * 60),
+			},
+			{
+				Target:   common.HexToAddress("0x00000000000000000000000000000000000000a2"),
+				Selector: [4]byte{0xff, 0xff, 0xff, 0xff},
+				Delay:    big.NewInt(0),
+			},
+		},
+		Proposers:                   []common.Address{common.HexToAddress("0x00000000000000000000000000000000000000b1")},
+		Executors:                   []common.Address{common.HexToAddress("0x00000000000000000000000000000000000000c1"), common.HexToAddress("0x00000000000000000000000000000000000000c2")},
+		Name:                        "Network",
+		MetadataURI:                 "https://example.com/network.json",
+		DefaultAdminRoleHolder:      common.HexToAddress("0x00000000000000000000000000000000000000d1"),
+		NameUpdateRoleHolder:        common.HexToAddress("0x00000000000000000000000000000000000000d2"),
+		MetadataURIUpdateRoleHolder: common.HexToAddress("0x00000000000000000000000000000000000000d3"),
+	}
+
+	data, err := parsed.Pack("initialize", params)
+	if err != nil {
+		t.Fatal(err)
+	}
+	method := parsed.Methods["initialize"]
+	if !bytes.Equal(data[:4], method.ID) {
+		t.Fatalf("selector %x, want %x", data[:4], method.ID)
+	}
+	args, err := method.Inputs.Unpack(data[4:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded := *abi.ConvertType(args[0], new(INetworkNetworkInitParams)).(*INetworkNetworkInitParams)
+	if fmt.Sprintf("%+v", decoded) != fmt.Sprintf("%+v", params) {
+		t.Errorf("round trip:\n got %+v\nwant %+v", decoded, params)
+	}
+	again, err := parsed.Pack("initialize", decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(again, data) {
+		t.Errorf("re-encoding differs:\n got %x\nwant %x", again, data)
+	}
+}
+
+// rustBindings maps the artifacts that also have forge-generated Rust
+// bindings to their source file.
+var rustBindings = map[string]string{
+	"INetwork":                  "i_network.rs",
+	"INetworkMiddlewareService": "i_network_middleware_service.rs",
+	"INetworkRegistry":          "i_network_registry.rs",
+	"INetworkRestakeDelegator":  "i_network_restake_delegator.rs",
+	"ISetMaxNetworkLimitHook":   "i_set_max_network_limit_hook.rs",
+}
+
+// rustABI returns the JSON ABI quoted in the doc comment of a Rust binding,
+// the one forge generated it from.
+func rustABI(t *testing.T, file string) []byte {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("..", "rust-alloy", "src", file))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, rest, ok := strings.Cut(string(data), "...which was generated by the following JSON ABI:\n```json\n")
+	if !ok {
+		t.Fatalf("%s quotes no JSON ABI", file)
+	}
+	quoted, _, ok := strings.Cut(rest, "```")
+	if !ok {
+		t.Fatalf("%s: unterminated JSON ABI", file)
+	}
+	return []byte(quoted)
+}
+
+// TestRustABI checks that the artifacts in ./abis are the ones the Rust
+// bindings were generated from, so both languages bind the same interfaces.
+func TestRustABI(t *testing.T) {
+	for artifact, file := range rustBindings {
+		t.Run(artifact, func(t *testing.T) {
+			data, err := os.ReadFile(artifactPath(artifact))
+			if err != nil {
+				t.Fatal(err)
+			}
+			var want, got any
+			if err := json.Unmarshal(data, &want); err != nil {
+				t.Fatalf("artifact: %v", err)
+			}
+			if err := json.Unmarshal(rustABI(t, file), &got); err != nil {
+				t.Fatalf("%s: %v", file, err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("%s was generated from a different ABI than %s", file, artifactPath(artifact))
+			}
+		})
+	}
+}
+
+// TestBaseDelegatorSubset checks that IBaseDelegator, which has no Rust
+// binding, declares nothing that INetworkRestakeDelegator declares otherwise:
+// the restake delegator inherits it in lib/core.
+func TestBaseDelegatorSubset(t *testing.T) {
+	load := func(name string) abi.ABI {
+		data, err := os.ReadFile(artifactPath(name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		parsed, err := abi.JSON(bytes.NewReader(data))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return parsed
+	}
+	base, restake := load("IBaseDelegator"), load("INetworkRestakeDelegator")
+	for name, method := range base.Methods {
+		if got, ok := restake.Methods[name]; !ok || got.String() != method.String() {
+			t.Errorf("method %s is not in INetworkRestakeDelegator", method)
+		}
+	}
+	for name, event := range base.Events {
+		if got, ok := restake.Events[name]; !ok || got.String() != event.String() {
+			t.Errorf("event %s is not in INetworkRestakeDelegator", event)
+		}
+	}
+	for name, e := range base.Errors {
+		if got, ok := restake.Errors[name]; !ok || got.String() != e.String() {
+			t.Errorf("error %s is not in INetworkRestakeDelegator", e)
+		}
+	}
+}
@@ -0,0 +1,1174 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package networkcontracts
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// IBaseDelegatorMetaData contains all meta data concerning the IBaseDelegator contract.
+var IBaseDelegatorMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"FACTORY\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"HOOK_GAS_LIMIT\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"HOOK_RESERVE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"HOOK_SET_ROLE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"NETWORK_REGISTRY\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"OPERATOR_NETWORK_OPT_IN_SERVICE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"OPERATOR_VAULT_OPT_IN_SERVICE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"TYPE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint64\",\"internalType\":\"uint64\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"VAULT_FACTORY\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"VERSION\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint64\",\"internalType\":\"uint64\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hook\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"initialize\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"maxNetworkLimit\",\"inputs\":[{\"name\":\"subnetwork\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"onSlash\",\"inputs\":[{\"name\":\"subnetwork\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"operator\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"captureTimestamp\",\"type\":\"uint48\",\"internalType\":\"uint48\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setHook\",\"inputs\":[{\"name\":\"hook\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setMaxNetworkLimit\",\"inputs\":[{\"name\":\"identifier\",\"type\":\"uint96\",\"internalType\":\"uint96\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"stake\",\"inputs\":[{\"name\":\"subnetwork\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"operator\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"stakeAt\",\"inputs\":[{\"name\":\"subnetwork\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"operator\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"timestamp\",\"type\":\"uint48\",\"internalType\":\"uint48\"},{\"name\":\"hints\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"vault\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"event\",\"name\":\"OnSlash\",\"inputs\":[{\"name\":\"subnetwork\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"operator\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"captureTimestamp\",\"type\":\"uint48\",\"indexed\":false,\"internalType\":\"uint48\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"SetHook\",\"inputs\":[{\"name\":\"hook\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"SetMaxNetworkLimit\",\"inputs\":[{\"name\":\"subnetwork\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"AlreadySet\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InsufficientHookGas\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NotInitialized\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NotNetwork\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NotSlasher\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NotVault\",\"inputs\":[]}]",
+}
+
+// IBaseDelegatorABI is the input ABI used to generate the binding from.
+// Deprecated: Use IBaseDelegatorMetaData.ABI instead.
+var IBaseDelegatorABI = IBaseDelegatorMetaData.ABI
+
+// IBaseDelegator is an auto generated Go binding around an Ethereum contract.
+type IBaseDelegator struct {
+	IBaseDelegatorCaller     // Read-only binding to the contract
+	IBaseDelegatorTransactor // Write-only binding to the contract
+	IBaseDelegatorFilterer   // Log filterer for contract events
+}
+
+// IBaseDelegatorCaller is an auto generated read-only Go binding around an Ethereum contract.
+type IBaseDelegatorCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IBaseDelegatorTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type IBaseDelegatorTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IBaseDelegatorFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type IBaseDelegatorFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IBaseDelegatorSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type IBaseDelegatorSession struct {
+	Contract     *IBaseDelegator   // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// IBaseDelegatorCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type IBaseDelegatorCallerSession struct {
+	Contract *IBaseDelegatorCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts         // Call options to use throughout this session
+}
+
+// IBaseDelegatorTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type IBaseDelegatorTransactorSession struct {
+	Contract     *IBaseDelegatorTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts         // Transaction auth options to use throughout this session
+}
+
+// IBaseDelegatorRaw is an auto generated low-level Go binding around an Ethereum contract.
+type IBaseDelegatorRaw struct {
+	Contract *IBaseDelegator // Generic contract binding to access the raw methods on
+}
+
+// IBaseDelegatorCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type IBaseDelegatorCallerRaw struct {
+	Contract *IBaseDelegatorCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// IBaseDelegatorTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type IBaseDelegatorTransactorRaw struct {
+	Contract *IBaseDelegatorTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewIBaseDelegator creates a new instance of IBaseDelegator, bound to a specific deployed contract.
+func NewIBaseDelegator(address common.Address, backend bind.ContractBackend) (*IBaseDelegator, error) {
+	contract, err := bindIBaseDelegator(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &IBaseDelegator{IBaseDelegatorCaller: IBaseDelegatorCaller{contract: contract}, IBaseDelegatorTransactor: IBaseDelegatorTransactor{contract: contract}, IBaseDelegatorFilterer: IBaseDelegatorFilterer{contract: contract}}, nil
+}
+
+// NewIBaseDelegatorCaller creates a new read-only instance of IBaseDelegator, bound to a specific deployed contract.
+func NewIBaseDelegatorCaller(address common.Address, caller bind.ContractCaller) (*IBaseDelegatorCaller, error) {
+	contract, err := bindIBaseDelegator(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IBaseDelegatorCaller{contract: contract}, nil
+}
+
+// NewIBaseDelegatorTransactor creates a new write-only instance of IBaseDelegator, bound to a specific deployed contract.
+func NewIBaseDelegatorTransactor(address common.Address, transactor bind.ContractTransactor) (*IBaseDelegatorTransactor, error) {
+	contract, err := bindIBaseDelegator(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IBaseDelegatorTransactor{contract: contract}, nil
+}
+
+// NewIBaseDelegatorFilterer creates a new log filterer instance of IBaseDelegator, bound to a specific deployed contract.
+func NewIBaseDelegatorFilterer(address common.Address, filterer bind.ContractFilterer) (*IBaseDelegatorFilterer, error) {
+	contract, err := bindIBaseDelegator(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &IBaseDelegatorFilterer{contract: contract}, nil
+}
+
+// bindIBaseDelegator binds a generic wrapper to an already deployed contract.
+func bindIBaseDelegator(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := IBaseDelegatorMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_IBaseDelegator *IBaseDelegatorRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _IBaseDelegator.Contract.IBaseDelegatorCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_IBaseDelegator *IBaseDelegatorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IBaseDelegator.Contract.IBaseDelegatorTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_IBaseDelegator *IBaseDelegatorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _IBaseDelegator.Contract.IBaseDelegatorTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_IBaseDelegator *IBaseDelegatorCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _IBaseDelegator.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_IBaseDelegator *IBaseDelegatorTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IBaseDelegator.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_IBaseDelegator *IBaseDelegatorTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _IBaseDelegator.Contract.contract.Transact(opts, method, params...)
+}
+
+// FACTORY is a free data retrieval call binding the contract method 0x2dd31000.
+//
+// Solidity: function FACTORY() view returns(address)
+func (_IBaseDelegator *IBaseDelegatorCaller) FACTORY(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _IBaseDelegator.contract.Call(opts, &out, "FACTORY")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// FACTORY is a free data retrieval call binding the contract method 0x2dd31000.
+//
+// Solidity: function FACTORY() view returns(address)
+func (_IBaseDelegator *IBaseDelegatorSession) FACTORY() (common.Address, error) {
+	return _IBaseDelegator.Contract.FACTORY(&_IBaseDelegator.CallOpts)
+}
+
+// FACTORY is a free data retrieval call binding the contract method 0x2dd31000.
+//
+// Solidity: function FACTORY() view returns(address)
+func (_IBaseDelegator *IBaseDelegatorCallerSession) FACTORY() (common.Address, error) {
+	return _IBaseDelegator.Contract.FACTORY(&_IBaseDelegator.CallOpts)
+}
+
+// HOOKGASLIMIT is a free data retrieval call binding the contract method 0xff54740f.
+//
+// Solidity: function HOOK_GAS_LIMIT() view returns(uint256)
+func (_IBaseDelegator *IBaseDelegatorCaller) HOOKGASLIMIT(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _IBaseDelegator.contract.Call(opts, &out, "HOOK_GAS_LIMIT")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// HOOKGASLIMIT is a free data retrieval call binding the contract method 0xff54740f.
+//
+// Solidity: function HOOK_GAS_LIMIT() view returns(uint256)
+func (_IBaseDelegator *IBaseDelegatorSession) HOOKGASLIMIT() (*big.Int, error) {
+	return _IBaseDelegator.Contract.HOOKGASLIMIT(&_IBaseDelegator.CallOpts)
+}
+
+// HOOKGASLIMIT is a free data retrieval call binding the contract method 0xff54740f.
+//
+// Solidity: function HOOK_GAS_LIMIT() view returns(uint256)
+func (_IBaseDelegator *IBaseDelegatorCallerSession) HOOKGASLIMIT() (*big.Int, error) {
+	return _IBaseDelegator.Contract.HOOKGASLIMIT(&_IBaseDelegator.CallOpts)
+}
+
+// HOOKRESERVE is a free data retrieval call binding the contract method 0x557cab44.
+//
+// Solidity: function HOOK_RESERVE() view returns(uint256)
+func (_IBaseDelegator *IBaseDelegatorCaller) HOOKRESERVE(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _IBaseDelegator.contract.Call(opts, &out, "HOOK_RESERVE")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// HOOKRESERVE is a free data retrieval call binding the contract method 0x557cab44.
+//
+// Solidity: function HOOK_RESERVE() view returns(uint256)
+func (_IBaseDelegator *IBaseDelegatorSession) HOOKRESERVE() (*big.Int, error) {
+	return _IBaseDelegator.Contract.HOOKRESERVE(&_IBaseDelegator.CallOpts)
+}
+
+// HOOKRESERVE is a free data retrieval call binding the contract method 0x557cab44.
+//
+// Solidity: function HOOK_RESERVE() view returns(uint256)
+func (_IBaseDelegator *IBaseDelegatorCallerSession) HOOKRESERVE() (*big.Int, error) {
+	return _IBaseDelegator.Contract.HOOKRESERVE(&_IBaseDelegator.CallOpts)
+}
+
+// HOOKSETROLE is a free data retrieval call binding the contract method 0x6679191e.
+//
+// Solidity: function HOOK_SET_ROLE() view returns(bytes32)
+func (_IBaseDelegator *IBaseDelegatorCaller) HOOKSETROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _IBaseDelegator.contract.Call(opts, &out, "HOOK_SET_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// HOOKSETROLE is a free data retrieval call binding the contract method 0x6679191e.
+//
+// Solidity: function HOOK_SET_ROLE() view returns(bytes32)
+func (_IBaseDelegator *IBaseDelegatorSession) HOOKSETROLE() ([32]byte, error) {
+	return _IBaseDelegator.Contract.HOOKSETROLE(&_IBaseDelegator.CallOpts)
+}
+
+// HOOKSETROLE is a free data retrieval call binding the contract method 0x6679191e.
+//
+// Solidity: function HOOK_SET_ROLE() view returns(bytes32)
+func (_IBaseDelegator *IBaseDelegatorCallerSession) HOOKSETROLE() ([32]byte, error) {
+	return _IBaseDelegator.Contract.HOOKSETROLE(&_IBaseDelegator.CallOpts)
+}
+
+// NETWORKREGISTRY is a free data retrieval call binding the contract method 0xc0cd7c3e.
+//
+// Solidity: function NETWORK_REGISTRY() view returns(address)
+func (_IBaseDelegator *IBaseDelegatorCaller) NETWORKREGISTRY(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _IBaseDelegator.contract.Call(opts, &out, "NETWORK_REGISTRY")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// NETWORKREGISTRY is a free data retrieval call binding the contract method 0xc0cd7c3e.
+//
+// Solidity: function NETWORK_REGISTRY() view returns(address)
+func (_IBaseDelegator *IBaseDelegatorSession) NETWORKREGISTRY() (common.Address, error) {
+	return _IBaseDelegator.Contract.NETWORKREGISTRY(&_IBaseDelegator.CallOpts)
+}
+
+// NETWORKREGISTRY is a free data retrieval call binding the contract method 0xc0cd7c3e.
+//
+// Solidity: function NETWORK_REGISTRY() view returns(address)
+func (_IBaseDelegator *IBaseDelegatorCallerSession) NETWORKREGISTRY() (common.Address, error) {
+	return _IBaseDelegator.Contract.NETWORKREGISTRY(&_IBaseDelegator.CallOpts)
+}
+
+// OPERATORNETWORKOPTINSERVICE is a free data retrieval call binding the contract method 0x1a80e500.
+//
+// Solidity: function OPERATOR_NETWORK_OPT_IN_SERVICE() view returns(address)
+func (_IBaseDelegator *IBaseDelegatorCaller) OPERATORNETWORKOPTINSERVICE(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _IBaseDelegator.contract.Call(opts, &out, "OPERATOR_NETWORK_OPT_IN_SERVICE")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// OPERATORNETWORKOPTINSERVICE is a free data retrieval call binding the contract method 0x1a80e500.
+//
+// Solidity: function OPERATOR_NETWORK_OPT_IN_SERVICE() view returns(address)
+func (_IBaseDelegator *IBaseDelegatorSession) OPERATORNETWORKOPTINSERVICE() (common.Address, error) {
+	return _IBaseDelegator.Contract.OPERATORNETWORKOPTINSERVICE(&_IBaseDelegator.CallOpts)
+}
+
+// OPERATORNETWORKOPTINSERVICE is a free data retrieval call binding the contract method 0x1a80e500.
+//
+// Solidity: function OPERATOR_NETWORK_OPT_IN_SERVICE() view returns(address)
+func (_IBaseDelegator *IBaseDelegatorCallerSession) OPERATORNETWORKOPTINSERVICE() (common.Address, error) {
+	return _IBaseDelegator.Contract.OPERATORNETWORKOPTINSERVICE(&_IBaseDelegator.CallOpts)
+}
+
+// OPERATORVAULTOPTINSERVICE is a free data retrieval call binding the contract method 0x128e5d82.
+//
+// Solidity: function OPERATOR_VAULT_OPT_IN_SERVICE() view returns(address)
+func (_IBaseDelegator *IBaseDelegatorCaller) OPERATORVAULTOPTINSERVICE(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _IBaseDelegator.contract.Call(opts, &out, "OPERATOR_VAULT_OPT_IN_SERVICE")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// OPERATORVAULTOPTINSERVICE is a free data retrieval call binding the contract method 0x128e5d82.
+//
+// Solidity: function OPERATOR_VAULT_OPT_IN_SERVICE() view returns(address)
+func (_IBaseDelegator *IBaseDelegatorSession) OPERATORVAULTOPTINSERVICE() (common.Address, error) {
+	return _IBaseDelegator.Contract.OPERATORVAULTOPTINSERVICE(&_IBaseDelegator.CallOpts)
+}
+
+// OPERATORVAULTOPTINSERVICE is a free data retrieval call binding the contract method 0x128e5d82.
+//
+// Solidity: function OPERATOR_VAULT_OPT_IN_SERVICE() view returns(address)
+func (_IBaseDelegator *IBaseDelegatorCallerSession) OPERATORVAULTOPTINSERVICE() (common.Address, error) {
+	return _IBaseDelegator.Contract.OPERATORVAULTOPTINSERVICE(&_IBaseDelegator.CallOpts)
+}
+
+// TYPE is a free data retrieval call binding the contract method 0xbb24fe8a.
+//
+// Solidity: function TYPE() view returns(uint64)
+func (_IBaseDelegator *IBaseDelegatorCaller) TYPE(opts *bind.CallOpts) (uint64, error) {
+	var out []interface{}
+	err := _IBaseDelegator.contract.Call(opts, &out, "TYPE")
+
+	if err != nil {
+		return *new(uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint64)).(*uint64)
+
+	return out0, err
+
+}
+
+// TYPE is a free data retrieval call binding the contract method 0xbb24fe8a.
+//
+// Solidity: function TYPE() view returns(uint64)
+func (_IBaseDelegator *IBaseDelegatorSession) TYPE() (uint64, error) {
+	return _IBaseDelegator.Contract.TYPE(&_IBaseDelegator.CallOpts)
+}
+
+// TYPE is a free data retrieval call binding the contract method 0xbb24fe8a.
+//
+// Solidity: function TYPE() view returns(uint64)
+func (_IBaseDelegator *IBaseDelegatorCallerSession) TYPE() (uint64, error) {
+	return _IBaseDelegator.Contract.TYPE(&_IBaseDelegator.CallOpts)
+}
+
+// VAULTFACTORY is a free data retrieval call binding the contract method 0x103f2907.
+//
+// Solidity: function VAULT_FACTORY() view returns(address)
+func (_IBaseDelegator *IBaseDelegatorCaller) VAULTFACTORY(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _IBaseDelegator.contract.Call(opts, &out, "VAULT_FACTORY")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// VAULTFACTORY is a free data retrieval call binding the contract method 0x103f2907.
+//
+// Solidity: function VAULT_FACTORY() view returns(address)
+func (_IBaseDelegator *IBaseDelegatorSession) VAULTFACTORY() (common.Address, error) {
+	return _IBaseDelegator.Contract.VAULTFACTORY(&_IBaseDelegator.CallOpts)
+}
+
+// VAULTFACTORY is a free data retrieval call binding the contract method 0x103f2907.
+//
+// Solidity: function VAULT_FACTORY() view returns(address)
+func (_IBaseDelegator *IBaseDelegatorCallerSession) VAULTFACTORY() (common.Address, error) {
+	return _IBaseDelegator.Contract.VAULTFACTORY(&_IBaseDelegator.CallOpts)
+}
+
+// VERSION is a free data retrieval call binding the contract method 0xffa1ad74.
+//
+// Solidity: function VERSION() view returns(uint64)
+func (_IBaseDelegator *IBaseDelegatorCaller) VERSION(opts *bind.CallOpts) (uint64, error) {
+	var out []interface{}
+	err := _IBaseDelegator.contract.Call(opts, &out, "VERSION")
+
+	if err != nil {
+		return *new(uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint64)).(*uint64)
+
+	return out0, err
+
+}
+
+// VERSION is a free data retrieval call binding the contract method 0xffa1ad74.
+//
+// Solidity: function VERSION() view returns(uint64)
+func (_IBaseDelegator *IBaseDelegatorSession) VERSION() (uint64, error) {
+	return _IBaseDelegator.Contract.VERSION(&_IBaseDelegator.CallOpts)
+}
+
+// VERSION is a free data retrieval call binding the contract method 0xffa1ad74.
+//
+// Solidity: function VERSION() view returns(uint64)
+func (_IBaseDelegator *IBaseDelegatorCallerSession) VERSION() (uint64, error) {
+	return _IBaseDelegator.Contract.VERSION(&_IBaseDelegator.CallOpts)
+}
+
+// Hook is a free data retrieval call binding the contract method 0x7f5a7c7b.
+//
+// Solidity: function hook() view returns(address)
+func (_IBaseDelegator *IBaseDelegatorCaller) Hook(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _IBaseDelegator.contract.Call(opts, &out, "hook")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Hook is a free data retrieval call binding the contract method 0x7f5a7c7b.
+//
+// Solidity: function hook() view returns(address)
+func (_IBaseDelegator *IBaseDelegatorSession) Hook() (common.Address, error) {
+	return _IBaseDelegator.Contract.Hook(&_IBaseDelegator.CallOpts)
+}
+
+// Hook is a free data retrieval call binding the contract method 0x7f5a7c7b.
+//
+// Solidity: function hook() view returns(address)
+func (_IBaseDelegator *IBaseDelegatorCallerSession) Hook() (common.Address, error) {
+	return _IBaseDelegator.Contract.Hook(&_IBaseDelegator.CallOpts)
+}
+
+// MaxNetworkLimit is a free data retrieval call binding the contract method 0xd15b740e.
+//
+// Solidity: function maxNetworkLimit(bytes32 subnetwork) view returns(uint256)
+func (_IBaseDelegator *IBaseDelegatorCaller) MaxNetworkLimit(opts *bind.CallOpts, subnetwork [32]byte) (*big.Int, error) {
+	var out []interface{}
+	err := _IBaseDelegator.contract.Call(opts, &out, "maxNetworkLimit", subnetwork)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MaxNetworkLimit is a free data retrieval call binding the contract method 0xd15b740e.
+//
+// Solidity: function maxNetworkLimit(bytes32 subnetwork) view returns(uint256)
+func (_IBaseDelegator *IBaseDelegatorSession) MaxNetworkLimit(subnetwork [32]byte) (*big.Int, error) {
+	return _IBaseDelegator.Contract.MaxNetworkLimit(&_IBaseDelegator.CallOpts, subnetwork)
+}
+
+// MaxNetworkLimit is a free data retrieval call binding the contract method 0xd15b740e.
+//
+// Solidity: function maxNetworkLimit(bytes32 subnetwork) view returns(uint256)
+func (_IBaseDelegator *IBaseDelegatorCallerSession) MaxNetworkLimit(subnetwork [32]byte) (*big.Int, error) {
+	return _IBaseDelegator.Contract.MaxNetworkLimit(&_IBaseDelegator.CallOpts, subnetwork)
+}
+
+// Stake is a free data retrieval call binding the contract method 0xfd4d447c.
+//
+// Solidity: function stake(bytes32 subnetwork, address operator) view returns(uint256)
+func (_IBaseDelegator *IBaseDelegatorCaller) Stake(opts *bind.CallOpts, subnetwork [32]byte, operator common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _IBaseDelegator.contract.Call(opts, &out, "stake", subnetwork, operator)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Stake is a free data retrieval call binding the contract method 0xfd4d447c.
+//
+// Solidity: function stake(bytes32 subnetwork, address operator) view returns(uint256)
+func (_IBaseDelegator *IBaseDelegatorSession) Stake(subnetwork [32]byte, operator common.Address) (*big.Int, error) {
+	return _IBaseDelegator.Contract.Stake(&_IBaseDelegator.CallOpts, subnetwork, operator)
+}
+
+// Stake is a free data retrieval call binding the contract method 0xfd4d447c.
+//
+// Solidity: function stake(bytes32 subnetwork, address operator) view returns(uint256)
+func (_IBaseDelegator *IBaseDelegatorCallerSession) Stake(subnetwork [32]byte, operator common.Address) (*big.Int, error) {
+	return _IBaseDelegator.Contract.Stake(&_IBaseDelegator.CallOpts, subnetwork, operator)
+}
+
+// StakeAt is a free data retrieval call binding the contract method 0xe02f6937.
+//
+// Solidity: function stakeAt(bytes32 subnetwork, address operator, uint48 timestamp, bytes hints) view returns(uint256)
+func (_IBaseDelegator *IBaseDelegatorCaller) StakeAt(opts *bind.CallOpts, subnetwork [32]byte, operator common.Address, timestamp *big.Int, hints []byte) (*big.Int, error) {
+	var out []interface{}
+	err := _IBaseDelegator.contract.Call(opts, &out, "stakeAt", subnetwork, operator, timestamp, hints)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// StakeAt is a free data retrieval call binding the contract method 0xe02f6937.
+//
+// Solidity: function stakeAt(bytes32 subnetwork, address operator, uint48 timestamp, bytes hints) view returns(uint256)
+func (_IBaseDelegator *IBaseDelegatorSession) StakeAt(subnetwork [32]byte, operator common.Address, timestamp *big.Int, hints []byte) (*big.Int, error) {
+	return _IBaseDelegator.Contract.StakeAt(&_IBaseDelegator.CallOpts, subnetwork, operator, timestamp, hints)
+}
+
+// StakeAt is a free data retrieval call binding the contract method 0xe02f6937.
+//
+// Solidity: function stakeAt(bytes32 subnetwork, address operator, uint48 timestamp, bytes hints) view returns(uint256)
+func (_IBaseDelegator *IBaseDelegatorCallerSession) StakeAt(subnetwork [32]byte, operator common.Address, timestamp *big.Int, hints []byte) (*big.Int, error) {
+	return _IBaseDelegator.Contract.StakeAt(&_IBaseDelegator.CallOpts, subnetwork, operator, timestamp, hints)
+}
+
+// Vault is a free data retrieval call binding the contract method 0xfbfa77cf.
+//
+// Solidity: function vault() view returns(address)
+func (_IBaseDelegator *IBaseDelegatorCaller) Vault(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _IBaseDelegator.contract.Call(opts, &out, "vault")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Vault is a free data retrieval call binding the contract method 0xfbfa77cf.
+//
+// Solidity: function vault() view returns(address)
+func (_IBaseDelegator *IBaseDelegatorSession) Vault() (common.Address, error) {
+	return _IBaseDelegator.Contract.Vault(&_IBaseDelegator.CallOpts)
+}
+
+// Vault is a free data retrieval call binding the contract method 0xfbfa77cf.
+//
+// Solidity: function vault() view returns(address)
+func (_IBaseDelegator *IBaseDelegatorCallerSession) Vault() (common.Address, error) {
+	return _IBaseDelegator.Contract.Vault(&_IBaseDelegator.CallOpts)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x439fab91.
+//
+// Solidity: function initialize(bytes data) returns()
+func (_IBaseDelegator *IBaseDelegatorTransactor) Initialize(opts *bind.TransactOpts, data []byte) (*types.Transaction, error) {
+	return _IBaseDelegator.contract.Transact(opts, "initialize", data)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x439fab91.
+//
+// Solidity: function initialize(bytes data) returns()
+func (_IBaseDelegator *IBaseDelegatorSession) Initialize(data []byte) (*types.Transaction, error) {
+	return _IBaseDelegator.Contract.Initialize(&_IBaseDelegator.TransactOpts, data)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x439fab91.
+//
+// Solidity: function initialize(bytes data) returns()
+func (_IBaseDelegator *IBaseDelegatorTransactorSession) Initialize(data []byte) (*types.Transaction, error) {
+	return _IBaseDelegator.Contract.Initialize(&_IBaseDelegator.TransactOpts, data)
+}
+
+// OnSlash is a paid mutator transaction binding the contract method 0xe49561ee.
+//
+// Solidity: function onSlash(bytes32 subnetwork, address operator, uint256 amount, uint48 captureTimestamp, bytes data) returns()
+func (_IBaseDelegator *IBaseDelegatorTransactor) OnSlash(opts *bind.TransactOpts, subnetwork [32]byte, operator common.Address, amount *big.Int, captureTimestamp *big.Int, data []byte) (*types.Transaction, error) {
+	return _IBaseDelegator.contract.Transact(opts, "onSlash", subnetwork, operator, amount, captureTimestamp, data)
+}
+
+// OnSlash is a paid mutator transaction binding the contract method 0xe49561ee.
+//
+// Solidity: function onSlash(bytes32 subnetwork, address operator, uint256 amount, uint48 captureTimestamp, bytes data) returns()
+func (_IBaseDelegator *IBaseDelegatorSession) OnSlash(subnetwork [32]byte, operator common.Address, amount *big.Int, captureTimestamp *big.Int, data []byte) (*types.Transaction, error) {
+	return _IBaseDelegator.Contract.OnSlash(&_IBaseDelegator.TransactOpts, subnetwork, operator, amount, captureTimestamp, data)
+}
+
+// OnSlash is a paid mutator transaction binding the contract method 0xe49561ee.
+//
+// Solidity: function onSlash(bytes32 subnetwork, address operator, uint256 amount, uint48 captureTimestamp, bytes data) returns()
+func (_IBaseDelegator *IBaseDelegatorTransactorSession) OnSlash(subnetwork [32]byte, operator common.Address, amount *big.Int, captureTimestamp *big.Int, data []byte) (*types.Transaction, error) {
+	return _IBaseDelegator.Contract.OnSlash(&_IBaseDelegator.TransactOpts, subnetwork, operator, amount, captureTimestamp, data)
+}
+
+// SetHook is a paid mutator transaction binding the contract method 0x3dfd3873.
+//
+// Solidity: function setHook(address hook) returns()
+func (_IBaseDelegator *IBaseDelegatorTransactor) SetHook(opts *bind.TransactOpts, hook common.Address) (*types.Transaction, error) {
+	return _IBaseDelegator.contract.Transact(opts, "setHook", hook)
+}
+
+// SetHook is a paid mutator transaction binding the contract method 0x3dfd3873.
+//
+// Solidity: function setHook(address hook) returns()
+func (_IBaseDelegator *IBaseDelegatorSession) SetHook(hook common.Address) (*types.Transaction, error) {
+	return _IBaseDelegator.Contract.SetHook(&_IBaseDelegator.TransactOpts, hook)
+}
+
+// SetHook is a paid mutator transaction binding the contract method 0x3dfd3873.
+//
+// Solidity: function setHook(address hook) returns()
+func (_IBaseDelegator *IBaseDelegatorTransactorSession) SetHook(hook common.Address) (*types.Transaction, error) {
+	return _IBaseDelegator.Contract.SetHook(&_IBaseDelegator.TransactOpts, hook)
+}
+
+// SetMaxNetworkLimit is a paid mutator transaction binding the contract method 0x23f752d5.
+//
+// Solidity: function setMaxNetworkLimit(uint96 identifier, uint256 amount) returns()
+func (_IBaseDelegator *IBaseDelegatorTransactor) SetMaxNetworkLimit(opts *bind.TransactOpts, identifier *big.Int, amount *big.Int) (*types.Transaction, error) {
+	return _IBaseDelegator.contract.Transact(opts, "setMaxNetworkLimit", identifier, amount)
+}
+
+// SetMaxNetworkLimit is a paid mutator transaction binding the contract method 0x23f752d5.
+//
+// Solidity: function setMaxNetworkLimit(uint96 identifier, uint256 amount) returns()
+func (_IBaseDelegator *IBaseDelegatorSession) SetMaxNetworkLimit(identifier *big.Int, amount *big.Int) (*types.Transaction, error) {
+	return _IBaseDelegator.Contract.SetMaxNetworkLimit(&_IBaseDelegator.TransactOpts, identifier, amount)
+}
+
+// SetMaxNetworkLimit is a paid mutator transaction binding the contract method 0x23f752d5.
+//
+// Solidity: function setMaxNetworkLimit(uint96 identifier, uint256 amount) returns()
+func (_IBaseDelegator *IBaseDelegatorTransactorSession) SetMaxNetworkLimit(identifier *big.Int, amount *big.Int) (*types.Transaction, error) {
+	return _IBaseDelegator.Contract.SetMaxNetworkLimit(&_IBaseDelegator.TransactOpts, identifier, amount)
+}
+
+// IBaseDelegatorOnSlashIterator is returned from FilterOnSlash and is used to iterate over the raw logs and unpacked data for OnSlash events raised by the IBaseDelegator contract.
+type IBaseDelegatorOnSlashIterator struct {
+	Event *IBaseDelegatorOnSlash // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IBaseDelegatorOnSlashIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IBaseDelegatorOnSlash)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IBaseDelegatorOnSlash)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IBaseDelegatorOnSlashIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IBaseDelegatorOnSlashIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IBaseDelegatorOnSlash represents a OnSlash event raised by the IBaseDelegator contract.
+type IBaseDelegatorOnSlash struct {
+	Subnetwork       [32]byte
+	Operator         common.Address
+	Amount           *big.Int
+	CaptureTimestamp *big.Int
+	Raw              types.Log // Blockchain specific contextual infos
+}
+
+// FilterOnSlash is a free log retrieval operation binding the contract event 0x741a5de99085c0d660f3e4192217b0ffb0ea4e35a0480de48e857a4bc3ee36ed.
+//
+// Solidity: event OnSlash(bytes32 indexed subnetwork, address indexed operator, uint256 amount, uint48 captureTimestamp)
+func (_IBaseDelegator *IBaseDelegatorFilterer) FilterOnSlash(opts *bind.FilterOpts, subnetwork [][32]byte, operator []common.Address) (*IBaseDelegatorOnSlashIterator, error) {
+
+	var subnetworkRule []interface{}
+	for _, subnetworkItem := range subnetwork {
+		subnetworkRule = append(subnetworkRule, subnetworkItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _IBaseDelegator.contract.FilterLogs(opts, "OnSlash", subnetworkRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IBaseDelegatorOnSlashIterator{contract: _IBaseDelegator.contract, event: "OnSlash", logs: logs, sub: sub}, nil
+}
+
+// WatchOnSlash is a free log subscription operation binding the contract event 0x741a5de99085c0d660f3e4192217b0ffb0ea4e35a0480de48e857a4bc3ee36ed.
+//
+// Solidity: event OnSlash(bytes32 indexed subnetwork, address indexed operator, uint256 amount, uint48 captureTimestamp)
+func (_IBaseDelegator *IBaseDelegatorFilterer) WatchOnSlash(opts *bind.WatchOpts, sink chan<- *IBaseDelegatorOnSlash, subnetwork [][32]byte, operator []common.Address) (event.Subscription, error) {
+
+	var subnetworkRule []interface{}
+	for _, subnetworkItem := range subnetwork {
+		subnetworkRule = append(subnetworkRule, subnetworkItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _IBaseDelegator.contract.WatchLogs(opts, "OnSlash", subnetworkRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IBaseDelegatorOnSlash)
+				if err := _IBaseDelegator.contract.UnpackLog(event, "OnSlash", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOnSlash is a log parse operation binding the contract event 0x741a5de99085c0d660f3e4192217b0ffb0ea4e35a0480de48e857a4bc3ee36ed.
+//
+// Solidity: event OnSlash(bytes32 indexed subnetwork, address indexed operator, uint256 amount, uint48 captureTimestamp)
+func (_IBaseDelegator *IBaseDelegatorFilterer) ParseOnSlash(log types.Log) (*IBaseDelegatorOnSlash, error) {
+	event := new(IBaseDelegatorOnSlash)
+	if err := _IBaseDelegator.contract.UnpackLog(event, "OnSlash", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IBaseDelegatorSetHookIterator is returned from FilterSetHook and is used to iterate over the raw logs and unpacked data for SetHook events raised by the IBaseDelegator contract.
+type IBaseDelegatorSetHookIterator struct {
+	Event *IBaseDelegatorSetHook // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IBaseDelegatorSetHookIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IBaseDelegatorSetHook)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IBaseDelegatorSetHook)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IBaseDelegatorSetHookIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IBaseDelegatorSetHookIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IBaseDelegatorSetHook represents a SetHook event raised by the IBaseDelegator contract.
+type IBaseDelegatorSetHook struct {
+	Hook common.Address
+	Raw  types.Log // Blockchain specific contextual infos
+}
+
+// FilterSetHook is a free log retrieval operation binding the contract event 0x5bbb1d3ebb6a3ad2a0f17ff35e579a83af60604d1d3c2a4c83c62adecadf666d.
+//
+// Solidity: event SetHook(address indexed hook)
+func (_IBaseDelegator *IBaseDelegatorFilterer) FilterSetHook(opts *bind.FilterOpts, hook []common.Address) (*IBaseDelegatorSetHookIterator, error) {
+
+	var hookRule []interface{}
+	for _, hookItem := range hook {
+		hookRule = append(hookRule, hookItem)
+	}
+
+	logs, sub, err := _IBaseDelegator.contract.FilterLogs(opts, "SetHook", hookRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IBaseDelegatorSetHookIterator{contract: _IBaseDelegator.contract, event: "SetHook", logs: logs, sub: sub}, nil
+}
+
+// WatchSetHook is a free log subscription operation binding the contract event 0x5bbb1d3ebb6a3ad2a0f17ff35e579a83af60604d1d3c2a4c83c62adecadf666d.
+//
+// Solidity: event SetHook(address indexed hook)
+func (_IBaseDelegator *IBaseDelegatorFilterer) WatchSetHook(opts *bind.WatchOpts, sink chan<- *IBaseDelegatorSetHook, hook []common.Address) (event.Subscription, error) {
+
+	var hookRule []interface{}
+	for _, hookItem := range hook {
+		hookRule = append(hookRule, hookItem)
+	}
+
+	logs, sub, err := _IBaseDelegator.contract.WatchLogs(opts, "SetHook", hookRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IBaseDelegatorSetHook)
+				if err := _IBaseDelegator.contract.UnpackLog(event, "SetHook", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSetHook is a log parse operation binding the contract event 0x5bbb1d3ebb6a3ad2a0f17ff35e579a83af60604d1d3c2a4c83c62adecadf666d.
+//
+// Solidity: event SetHook(address indexed hook)
+func (_IBaseDelegator *IBaseDelegatorFilterer) ParseSetHook(log types.Log) (*IBaseDelegatorSetHook, error) {
+	event := new(IBaseDelegatorSetHook)
+	if err := _IBaseDelegator.contract.UnpackLog(event, "SetHook", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IBaseDelegatorSetMaxNetworkLimitIterator is returned from FilterSetMaxNetworkLimit and is used to iterate over the raw logs and unpacked data for SetMaxNetworkLimit events raised by the IBaseDelegator contract.
+type IBaseDelegatorSetMaxNetworkLimitIterator struct {
+	Event *IBaseDelegatorSetMaxNetworkLimit // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IBaseDelegatorSetMaxNetworkLimitIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IBaseDelegatorSetMaxNetworkLimit)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IBaseDelegatorSetMaxNetworkLimit)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IBaseDelegatorSetMaxNetworkLimitIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IBaseDelegatorSetMaxNetworkLimitIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IBaseDelegatorSetMaxNetworkLimit represents a SetMaxNetworkLimit event raised by the IBaseDelegator contract.
+type IBaseDelegatorSetMaxNetworkLimit struct {
+	Subnetwork [32]byte
+	Amount     *big.Int
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterSetMaxNetworkLimit is a free log retrieval operation binding the contract event 0xc67e7929681aa1bccd63f52b3799bf5805f3009f197db6fdf584b14f7fbf608c.
+//
+// Solidity: event SetMaxNetworkLimit(bytes32 indexed subnetwork, uint256 amount)
+func (_IBaseDelegator *IBaseDelegatorFilterer) FilterSetMaxNetworkLimit(opts *bind.FilterOpts, subnetwork [][32]byte) (*IBaseDelegatorSetMaxNetworkLimitIterator, error) {
+
+	var subnetworkRule []interface{}
+	for _, subnetworkItem := range subnetwork {
+		subnetworkRule = append(subnetworkRule, subnetworkItem)
+	}
+
+	logs, sub, err := _IBaseDelegator.contract.FilterLogs(opts, "SetMaxNetworkLimit", subnetworkRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IBaseDelegatorSetMaxNetworkLimitIterator{contract: _IBaseDelegator.contract, event: "SetMaxNetworkLimit", logs: logs, sub: sub}, nil
+}
+
+// WatchSetMaxNetworkLimit is a free log subscription operation binding the contract event 0xc67e7929681aa1bccd63f52b3799bf5805f3009f197db6fdf584b14f7fbf608c.
+//
+// Solidity: event SetMaxNetworkLimit(bytes32 indexed subnetwork, uint256 amount)
+func (_IBaseDelegator *IBaseDelegatorFilterer) WatchSetMaxNetworkLimit(opts *bind.WatchOpts, sink chan<- *IBaseDelegatorSetMaxNetworkLimit, subnetwork [][32]byte) (event.Subscription, error) {
+
+	var subnetworkRule []interface{}
+	for _, subnetworkItem := range subnetwork {
+		subnetworkRule = append(subnetworkRule, subnetworkItem)
+	}
+
+	logs, sub, err := _IBaseDelegator.contract.WatchLogs(opts, "SetMaxNetworkLimit", subnetworkRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IBaseDelegatorSetMaxNetworkLimit)
+				if err := _IBaseDelegator.contract.UnpackLog(event, "SetMaxNetworkLimit", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSetMaxNetworkLimit is a log parse operation binding the contract event 0xc67e7929681aa1bccd63f52b3799bf5805f3009f197db6fdf584b14f7fbf608c.
+//
+// Solidity: event SetMaxNetworkLimit(bytes32 indexed subnetwork, uint256 amount)
+func (_IBaseDelegator *IBaseDelegatorFilterer) ParseSetMaxNetworkLimit(log types.Log) (*IBaseDelegatorSetMaxNetworkLimit, error) {
+	event := new(IBaseDelegatorSetMaxNetworkLimit)
+	if err := _IBaseDelegator.contract.UnpackLog(event, "SetMaxNetworkLimit", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
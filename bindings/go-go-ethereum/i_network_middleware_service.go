@@ -0,0 +1,409 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package networkcontracts
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// INetworkMiddlewareServiceMetaData contains all meta data concerning the INetworkMiddlewareService contract.
+var INetworkMiddlewareServiceMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"NETWORK_REGISTRY\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"middleware\",\"inputs\":[{\"name\":\"network\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"setMiddleware\",\"inputs\":[{\"name\":\"middleware\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"SetMiddleware\",\"inputs\":[{\"name\":\"network\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"middleware\",\"type\":\"address\",\"indexed\":false,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"AlreadySet\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NotNetwork\",\"inputs\":[]}]",
+}
+
+// INetworkMiddlewareServiceABI is the input ABI used to generate the binding from.
+// Deprecated: Use INetworkMiddlewareServiceMetaData.ABI instead.
+var INetworkMiddlewareServiceABI = INetworkMiddlewareServiceMetaData.ABI
+
+// INetworkMiddlewareService is an auto generated Go binding around an Ethereum contract.
+type INetworkMiddlewareService struct {
+	INetworkMiddlewareServiceCaller     // Read-only binding to the contract
+	INetworkMiddlewareServiceTransactor // Write-only binding to the contract
+	INetworkMiddlewareServiceFilterer   // Log filterer for contract events
+}
+
+// INetworkMiddlewareServiceCaller is an auto generated read-only Go binding around an Ethereum contract.
+type INetworkMiddlewareServiceCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// INetworkMiddlewareServiceTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type INetworkMiddlewareServiceTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// INetworkMiddlewareServiceFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type INetworkMiddlewareServiceFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// INetworkMiddlewareServiceSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type INetworkMiddlewareServiceSession struct {
+	Contract     *INetworkMiddlewareService // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts              // Call options to use throughout this session
+	TransactOpts bind.TransactOpts          // Transaction auth options to use throughout this session
+}
+
+// INetworkMiddlewareServiceCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type INetworkMiddlewareServiceCallerSession struct {
+	Contract *INetworkMiddlewareServiceCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                    // Call options to use throughout this session
+}
+
+// INetworkMiddlewareServiceTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type INetworkMiddlewareServiceTransactorSession struct {
+	Contract     *INetworkMiddlewareServiceTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                    // Transaction auth options to use throughout this session
+}
+
+// INetworkMiddlewareServiceRaw is an auto generated low-level Go binding around an Ethereum contract.
+type INetworkMiddlewareServiceRaw struct {
+	Contract *INetworkMiddlewareService // Generic contract binding to access the raw methods on
+}
+
+// INetworkMiddlewareServiceCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type INetworkMiddlewareServiceCallerRaw struct {
+	Contract *INetworkMiddlewareServiceCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// INetworkMiddlewareServiceTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type INetworkMiddlewareServiceTransactorRaw struct {
+	Contract *INetworkMiddlewareServiceTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewINetworkMiddlewareService creates a new instance of INetworkMiddlewareService, bound to a specific deployed contract.
+func NewINetworkMiddlewareService(address common.Address, backend bind.ContractBackend) (*INetworkMiddlewareService, error) {
+	contract, err := bindINetworkMiddlewareService(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &INetworkMiddlewareService{INetworkMiddlewareServiceCaller: INetworkMiddlewareServiceCaller{contract: contract}, INetworkMiddlewareServiceTransactor: INetworkMiddlewareServiceTransactor{contract: contract}, INetworkMiddlewareServiceFilterer: INetworkMiddlewareServiceFilterer{contract: contract}}, nil
+}
+
+// NewINetworkMiddlewareServiceCaller creates a new read-only instance of INetworkMiddlewareService, bound to a specific deployed contract.
+func NewINetworkMiddlewareServiceCaller(address common.Address, caller bind.ContractCaller) (*INetworkMiddlewareServiceCaller, error) {
+	contract, err := bindINetworkMiddlewareService(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &INetworkMiddlewareServiceCaller{contract: contract}, nil
+}
+
+// NewINetworkMiddlewareServiceTransactor creates a new write-only instance of INetworkMiddlewareService, bound to a specific deployed contract.
+func NewINetworkMiddlewareServiceTransactor(address common.Address, transactor bind.ContractTransactor) (*INetworkMiddlewareServiceTransactor, error) {
+	contract, err := bindINetworkMiddlewareService(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &INetworkMiddlewareServiceTransactor{contract: contract}, nil
+}
+
+// NewINetworkMiddlewareServiceFilterer creates a new log filterer instance of INetworkMiddlewareService, bound to a specific deployed contract.
+func NewINetworkMiddlewareServiceFilterer(address common.Address, filterer bind.ContractFilterer) (*INetworkMiddlewareServiceFilterer, error) {
+	contract, err := bindINetworkMiddlewareService(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &INetworkMiddlewareServiceFilterer{contract: contract}, nil
+}
+
+// bindINetworkMiddlewareService binds a generic wrapper to an already deployed contract.
+func bindINetworkMiddlewareService(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := INetworkMiddlewareServiceMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_INetworkMiddlewareService *INetworkMiddlewareServiceRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _INetworkMiddlewareService.Contract.INetworkMiddlewareServiceCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_INetworkMiddlewareService *INetworkMiddlewareServiceRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _INetworkMiddlewareService.Contract.INetworkMiddlewareServiceTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_INetworkMiddlewareService *INetworkMiddlewareServiceRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _INetworkMiddlewareService.Contract.INetworkMiddlewareServiceTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_INetworkMiddlewareService *INetworkMiddlewareServiceCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _INetworkMiddlewareService.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_INetworkMiddlewareService *INetworkMiddlewareServiceTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _INetworkMiddlewareService.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_INetworkMiddlewareService *INetworkMiddlewareServiceTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _INetworkMiddlewareService.Contract.contract.Transact(opts, method, params...)
+}
+
+// NETWORKREGISTRY is a free data retrieval call binding the contract method 0xc0cd7c3e.
+//
+// Solidity: function NETWORK_REGISTRY() view returns(address)
+func (_INetworkMiddlewareService *INetworkMiddlewareServiceCaller) NETWORKREGISTRY(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _INetworkMiddlewareService.contract.Call(opts, &out, "NETWORK_REGISTRY")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// NETWORKREGISTRY is a free data retrieval call binding the contract method 0xc0cd7c3e.
+//
+// Solidity: function NETWORK_REGISTRY() view returns(address)
+func (_INetworkMiddlewareService *INetworkMiddlewareServiceSession) NETWORKREGISTRY() (common.Address, error) {
+	return _INetworkMiddlewareService.Contract.NETWORKREGISTRY(&_INetworkMiddlewareService.CallOpts)
+}
+
+// NETWORKREGISTRY is a free data retrieval call binding the contract method 0xc0cd7c3e.
+//
+// Solidity: function NETWORK_REGISTRY() view returns(address)
+func (_INetworkMiddlewareService *INetworkMiddlewareServiceCallerSession) NETWORKREGISTRY() (common.Address, error) {
+	return _INetworkMiddlewareService.Contract.NETWORKREGISTRY(&_INetworkMiddlewareService.CallOpts)
+}
+
+// Middleware is a free data retrieval call binding the contract method 0xbb5ed032.
+//
+// Solidity: function middleware(address network) view returns(address)
+func (_INetworkMiddlewareService *INetworkMiddlewareServiceCaller) Middleware(opts *bind.CallOpts, network common.Address) (common.Address, error) {
+	var out []interface{}
+	err := _INetworkMiddlewareService.contract.Call(opts, &out, "middleware", network)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Middleware is a free data retrieval call binding the contract method 0xbb5ed032.
+//
+// Solidity: function middleware(address network) view returns(address)
+func (_INetworkMiddlewareService *INetworkMiddlewareServiceSession) Middleware(network common.Address) (common.Address, error) {
+	return _INetworkMiddlewareService.Contract.Middleware(&_INetworkMiddlewareService.CallOpts, network)
+}
+
+// Middleware is a free data retrieval call binding the contract method 0xbb5ed032.
+//
+// Solidity: function middleware(address network) view returns(address)
+func (_INetworkMiddlewareService *INetworkMiddlewareServiceCallerSession) Middleware(network common.Address) (common.Address, error) {
+	return _INetworkMiddlewareService.Contract.Middleware(&_INetworkMiddlewareService.CallOpts, network)
+}
+
+// SetMiddleware is a paid mutator transaction binding the contract method 0xb7d8e1a9.
+//
+// Solidity: function setMiddleware(address middleware) returns()
+func (_INetworkMiddlewareService *INetworkMiddlewareServiceTransactor) SetMiddleware(opts *bind.TransactOpts, middleware common.Address) (*types.Transaction, error) {
+	return _INetworkMiddlewareService.contract.Transact(opts, "setMiddleware", middleware)
+}
+
+// SetMiddleware is a paid mutator transaction binding the contract method 0xb7d8e1a9.
+//
+// Solidity: function setMiddleware(address middleware) returns()
+func (_INetworkMiddlewareService *INetworkMiddlewareServiceSession) SetMiddleware(middleware common.Address) (*types.Transaction, error) {
+	return _INetworkMiddlewareService.Contract.SetMiddleware(&_INetworkMiddlewareService.TransactOpts, middleware)
+}
+
+// SetMiddleware is a paid mutator transaction binding the contract method 0xb7d8e1a9.
+//
+// Solidity: function setMiddleware(address middleware) returns()
+func (_INetworkMiddlewareService *INetworkMiddlewareServiceTransactorSession) SetMiddleware(middleware common.Address) (*types.Transaction, error) {
+	return _INetworkMiddlewareService.Contract.SetMiddleware(&_INetworkMiddlewareService.TransactOpts, middleware)
+}
+
+// INetworkMiddlewareServiceSetMiddlewareIterator is returned from FilterSetMiddleware and is used to iterate over the raw logs and unpacked data for SetMiddleware events raised by the INetworkMiddlewareService contract.
+type INetworkMiddlewareServiceSetMiddlewareIterator struct {
+	Event *INetworkMiddlewareServiceSetMiddleware // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *INetworkMiddlewareServiceSetMiddlewareIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(INetworkMiddlewareServiceSetMiddleware)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(INetworkMiddlewareServiceSetMiddleware)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *INetworkMiddlewareServiceSetMiddlewareIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *INetworkMiddlewareServiceSetMiddlewareIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// INetworkMiddlewareServiceSetMiddleware represents a SetMiddleware event raised by the INetworkMiddlewareService contract.
+type INetworkMiddlewareServiceSetMiddleware struct {
+	Network    common.Address
+	Middleware common.Address
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterSetMiddleware is a free log retrieval operation binding the contract event 0xf64e2a8734392e221de13f5e56deb22d308e292cad394052affa97dbaf41ec98.
+//
+// Solidity: event SetMiddleware(address indexed network, address middleware)
+func (_INetworkMiddlewareService *INetworkMiddlewareServiceFilterer) FilterSetMiddleware(opts *bind.FilterOpts, network []common.Address) (*INetworkMiddlewareServiceSetMiddlewareIterator, error) {
+
+	var networkRule []interface{}
+	for _, networkItem := range network {
+		networkRule = append(networkRule, networkItem)
+	}
+
+	logs, sub, err := _INetworkMiddlewareService.contract.FilterLogs(opts, "SetMiddleware", networkRule)
+	if err != nil {
+		return nil, err
+	}
+	return &INetworkMiddlewareServiceSetMiddlewareIterator{contract: _INetworkMiddlewareService.contract, event: "SetMiddleware", logs: logs, sub: sub}, nil
+}
+
+// WatchSetMiddleware is a free log subscription operation binding the contract event 0xf64e2a8734392e221de13f5e56deb22d308e292cad394052affa97dbaf41ec98.
+//
+// Solidity: event SetMiddleware(address indexed network, address middleware)
+func (_INetworkMiddlewareService *INetworkMiddlewareServiceFilterer) WatchSetMiddleware(opts *bind.WatchOpts, sink chan<- *INetworkMiddlewareServiceSetMiddleware, network []common.Address) (event.Subscription, error) {
+
+	var networkRule []interface{}
+	for _, networkItem := range network {
+		networkRule = append(networkRule, networkItem)
+	}
+
+	logs, sub, err := _INetworkMiddlewareService.contract.WatchLogs(opts, "SetMiddleware", networkRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(INetworkMiddlewareServiceSetMiddleware)
+				if err := _INetworkMiddlewareService.contract.UnpackLog(event, "SetMiddleware", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSetMiddleware is a log parse operation binding the contract event 0xf64e2a8734392e221de13f5e56deb22d308e292cad394052affa97dbaf41ec98.
+//
+// Solidity: event SetMiddleware(address indexed network, address middleware)
+func (_INetworkMiddlewareService *INetworkMiddlewareServiceFilterer) ParseSetMiddleware(log types.Log) (*INetworkMiddlewareServiceSetMiddleware, error) {
+	event := new(INetworkMiddlewareServiceSetMiddleware)
+	if err := _INetworkMiddlewareService.contract.UnpackLog(event, "SetMiddleware", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
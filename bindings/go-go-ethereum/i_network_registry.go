@@ -0,0 +1,439 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package networkcontracts
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// INetworkRegistryMetaData contains all meta data concerning the INetworkRegistry contract.
+var INetworkRegistryMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"entity\",\"inputs\":[{\"name\":\"index\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"isEntity\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"registerNetwork\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"totalEntities\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"event\",\"name\":\"AddEntity\",\"inputs\":[{\"name\":\"entity\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"EntityNotExist\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NetworkAlreadyRegistered\",\"inputs\":[]}]",
+}
+
+// INetworkRegistryABI is the input ABI used to generate the binding from.
+// Deprecated: Use INetworkRegistryMetaData.ABI instead.
+var INetworkRegistryABI = INetworkRegistryMetaData.ABI
+
+// INetworkRegistry is an auto generated Go binding around an Ethereum contract.
+type INetworkRegistry struct {
+	INetworkRegistryCaller     // Read-only binding to the contract
+	INetworkRegistryTransactor // Write-only binding to the contract
+	INetworkRegistryFilterer   // Log filterer for contract events
+}
+
+// INetworkRegistryCaller is an auto generated read-only Go binding around an Ethereum contract.
+type INetworkRegistryCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// INetworkRegistryTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type INetworkRegistryTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// INetworkRegistryFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type INetworkRegistryFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// INetworkRegistrySession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type INetworkRegistrySession struct {
+	Contract     *INetworkRegistry // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// INetworkRegistryCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type INetworkRegistryCallerSession struct {
+	Contract *INetworkRegistryCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts           // Call options to use throughout this session
+}
+
+// INetworkRegistryTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type INetworkRegistryTransactorSession struct {
+	Contract     *INetworkRegistryTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts           // Transaction auth options to use throughout this session
+}
+
+// INetworkRegistryRaw is an auto generated low-level Go binding around an Ethereum contract.
+type INetworkRegistryRaw struct {
+	Contract *INetworkRegistry // Generic contract binding to access the raw methods on
+}
+
+// INetworkRegistryCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type INetworkRegistryCallerRaw struct {
+	Contract *INetworkRegistryCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// INetworkRegistryTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type INetworkRegistryTransactorRaw struct {
+	Contract *INetworkRegistryTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewINetworkRegistry creates a new instance of INetworkRegistry, bound to a specific deployed contract.
+func NewINetworkRegistry(address common.Address, backend bind.ContractBackend) (*INetworkRegistry, error) {
+	contract, err := bindINetworkRegistry(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &INetworkRegistry{INetworkRegistryCaller: INetworkRegistryCaller{contract: contract}, INetworkRegistryTransactor: INetworkRegistryTransactor{contract: contract}, INetworkRegistryFilterer: INetworkRegistryFilterer{contract: contract}}, nil
+}
+
+// NewINetworkRegistryCaller creates a new read-only instance of INetworkRegistry, bound to a specific deployed contract.
+func NewINetworkRegistryCaller(address common.Address, caller bind.ContractCaller) (*INetworkRegistryCaller, error) {
+	contract, err := bindINetworkRegistry(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &INetworkRegistryCaller{contract: contract}, nil
+}
+
+// NewINetworkRegistryTransactor creates a new write-only instance of INetworkRegistry, bound to a specific deployed contract.
+func NewINetworkRegistryTransactor(address common.Address, transactor bind.ContractTransactor) (*INetworkRegistryTransactor, error) {
+	contract, err := bindINetworkRegistry(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &INetworkRegistryTransactor{contract: contract}, nil
+}
+
+// NewINetworkRegistryFilterer creates a new log filterer instance of INetworkRegistry, bound to a specific deployed contract.
+func NewINetworkRegistryFilterer(address common.Address, filterer bind.ContractFilterer) (*INetworkRegistryFilterer, error) {
+	contract, err := bindINetworkRegistry(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &INetworkRegistryFilterer{contract: contract}, nil
+}
+
+// bindINetworkRegistry binds a generic wrapper to an already deployed contract.
+func bindINetworkRegistry(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := INetworkRegistryMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_INetworkRegistry *INetworkRegistryRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _INetworkRegistry.Contract.INetworkRegistryCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_INetworkRegistry *INetworkRegistryRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _INetworkRegistry.Contract.INetworkRegistryTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_INetworkRegistry *INetworkRegistryRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _INetworkRegistry.Contract.INetworkRegistryTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_INetworkRegistry *INetworkRegistryCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _INetworkRegistry.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_INetworkRegistry *INetworkRegistryTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _INetworkRegistry.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_INetworkRegistry *INetworkRegistryTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _INetworkRegistry.Contract.contract.Transact(opts, method, params...)
+}
+
+// Entity is a free data retrieval call binding the contract method 0xb42ba2a2.
+//
+// Solidity: function entity(uint256 index) view returns(address)
+func (_INetworkRegistry *INetworkRegistryCaller) Entity(opts *bind.CallOpts, index *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _INetworkRegistry.contract.Call(opts, &out, "entity", index)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Entity is a free data retrieval call binding the contract method 0xb42ba2a2.
+//
+// Solidity: function entity(uint256 index) view returns(address)
+func (_INetworkRegistry *INetworkRegistrySession) Entity(index *big.Int) (common.Address, error) {
+	return _INetworkRegistry.Contract.Entity(&_INetworkRegistry.CallOpts, index)
+}
+
+// Entity is a free data retrieval call binding the contract method 0xb42ba2a2.
+//
+// Solidity: function entity(uint256 index) view returns(address)
+func (_INetworkRegistry *INetworkRegistryCallerSession) Entity(index *big.Int) (common.Address, error) {
+	return _INetworkRegistry.Contract.Entity(&_INetworkRegistry.CallOpts, index)
+}
+
+// IsEntity is a free data retrieval call binding the contract method 0x14887c58.
+//
+// Solidity: function isEntity(address account) view returns(bool)
+func (_INetworkRegistry *INetworkRegistryCaller) IsEntity(opts *bind.CallOpts, account common.Address) (bool, error) {
+	var out []interface{}
+	err := _INetworkRegistry.contract.Call(opts, &out, "isEntity", account)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsEntity is a free data retrieval call binding the contract method 0x14887c58.
+//
+// Solidity: function isEntity(address account) view returns(bool)
+func (_INetworkRegistry *INetworkRegistrySession) IsEntity(account common.Address) (bool, error) {
+	return _INetworkRegistry.Contract.IsEntity(&_INetworkRegistry.CallOpts, account)
+}
+
+// IsEntity is a free data retrieval call binding the contract method 0x14887c58.
+//
+// Solidity: function isEntity(address account) view returns(bool)
+func (_INetworkRegistry *INetworkRegistryCallerSession) IsEntity(account common.Address) (bool, error) {
+	return _INetworkRegistry.Contract.IsEntity(&_INetworkRegistry.CallOpts, account)
+}
+
+// TotalEntities is a free data retrieval call binding the contract method 0x5cd8b15e.
+//
+// Solidity: function totalEntities() view returns(uint256)
+func (_INetworkRegistry *INetworkRegistryCaller) TotalEntities(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _INetworkRegistry.contract.Call(opts, &out, "totalEntities")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalEntities is a free data retrieval call binding the contract method 0x5cd8b15e.
+//
+// Solidity: function totalEntities() view returns(uint256)
+func (_INetworkRegistry *INetworkRegistrySession) TotalEntities() (*big.Int, error) {
+	return _INetworkRegistry.Contract.TotalEntities(&_INetworkRegistry.CallOpts)
+}
+
+// TotalEntities is a free data retrieval call binding the contract method 0x5cd8b15e.
+//
+// Solidity: function totalEntities() view returns(uint256)
+func (_INetworkRegistry *INetworkRegistryCallerSession) TotalEntities() (*big.Int, error) {
+	return _INetworkRegistry.Contract.TotalEntities(&_INetworkRegistry.CallOpts)
+}
+
+// RegisterNetwork is a paid mutator transaction binding the contract method 0x87140b5b.
+//
+// Solidity: function registerNetwork() returns()
+func (_INetworkRegistry *INetworkRegistryTransactor) RegisterNetwork(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _INetworkRegistry.contract.Transact(opts, "registerNetwork")
+}
+
+// RegisterNetwork is a paid mutator transaction binding the contract method 0x87140b5b.
+//
+// Solidity: function registerNetwork() returns()
+func (_INetworkRegistry *INetworkRegistrySession) RegisterNetwork() (*types.Transaction, error) {
+	return _INetworkRegistry.Contract.RegisterNetwork(&_INetworkRegistry.TransactOpts)
+}
+
+// RegisterNetwork is a paid mutator transaction binding the contract method 0x87140b5b.
+//
+// Solidity: function registerNetwork() returns()
+func (_INetworkRegistry *INetworkRegistryTransactorSession) RegisterNetwork() (*types.Transaction, error) {
+	return _INetworkRegistry.Contract.RegisterNetwork(&_INetworkRegistry.TransactOpts)
+}
+
+// INetworkRegistryAddEntityIterator is returned from FilterAddEntity and is used to iterate over the raw logs and unpacked data for AddEntity events raised by the INetworkRegistry contract.
+type INetworkRegistryAddEntityIterator struct {
+	Event *INetworkRegistryAddEntity // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *INetworkRegistryAddEntityIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(INetworkRegistryAddEntity)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(INetworkRegistryAddEntity)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *INetworkRegistryAddEntityIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *INetworkRegistryAddEntityIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// INetworkRegistryAddEntity represents a AddEntity event raised by the INetworkRegistry contract.
+type INetworkRegistryAddEntity struct {
+	Entity common.Address
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterAddEntity is a free log retrieval operation binding the contract event 0xb919910dcefbf753bfd926ab3b1d3f85d877190c3d01ba1bd585047b99b99f0b.
+//
+// Solidity: event AddEntity(address indexed entity)
+func (_INetworkRegistry *INetworkRegistryFilterer) FilterAddEntity(opts *bind.FilterOpts, entity []common.Address) (*INetworkRegistryAddEntityIterator, error) {
+
+	var entityRule []interface{}
+	for _, entityItem := range entity {
+		entityRule = append(entityRule, entityItem)
+	}
+
+	logs, sub, err := _INetworkRegistry.contract.FilterLogs(opts, "AddEntity", entityRule)
+	if err != nil {
+		return nil, err
+	}
+	return &INetworkRegistryAddEntityIterator{contract: _INetworkRegistry.contract, event: "AddEntity", logs: logs, sub: sub}, nil
+}
+
+// WatchAddEntity is a free log subscription operation binding the contract event 0xb919910dcefbf753bfd926ab3b1d3f85d877190c3d01ba1bd585047b99b99f0b.
+//
+// Solidity: event AddEntity(address indexed entity)
+func (_INetworkRegistry *INetworkRegistryFilterer) WatchAddEntity(opts *bind.WatchOpts, sink chan<- *INetworkRegistryAddEntity, entity []common.Address) (event.Subscription, error) {
+
+	var entityRule []interface{}
+	for _, entityItem := range entity {
+		entityRule = append(entityRule, entityItem)
+	}
+
+	logs, sub, err := _INetworkRegistry.contract.WatchLogs(opts, "AddEntity", entityRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(INetworkRegistryAddEntity)
+				if err := _INetworkRegistry.contract.UnpackLog(event, "AddEntity", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseAddEntity is a log parse operation binding the contract event 0xb919910dcefbf753bfd926ab3b1d3f85d877190c3d01ba1bd585047b99b99f0b.
+//
+// Solidity: event AddEntity(address indexed entity)
+func (_INetworkRegistry *INetworkRegistryFilterer) ParseAddEntity(log types.Log) (*INetworkRegistryAddEntity, error) {
+	event := new(INetworkRegistryAddEntity)
+	if err := _INetworkRegistry.contract.UnpackLog(event, "AddEntity", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,1763 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package networkcontracts
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// INetworkRestakeDelegatorMetaData contains all meta data concerning the INetworkRestakeDelegator contract.
+var INetworkRestakeDelegatorMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"FACTORY\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"HOOK_GAS_LIMIT\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"HOOK_RESERVE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"HOOK_SET_ROLE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"NETWORK_LIMIT_SET_ROLE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"NETWORK_REGISTRY\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"OPERATOR_NETWORK_OPT_IN_SERVICE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"OPERATOR_NETWORK_SHARES_SET_ROLE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"OPERATOR_VAULT_OPT_IN_SERVICE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"TYPE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint64\",\"internalType\":\"uint64\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"VAULT_FACTORY\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"VERSION\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint64\",\"internalType\":\"uint64\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hook\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"initialize\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"maxNetworkLimit\",\"inputs\":[{\"name\":\"subnetwork\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"networkLimit\",\"inputs\":[{\"name\":\"subnetwork\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"networkLimitAt\",\"inputs\":[{\"name\":\"subnetwork\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"timestamp\",\"type\":\"uint48\",\"internalType\":\"uint48\"},{\"name\":\"hint\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"onSlash\",\"inputs\":[{\"name\":\"subnetwork\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"operator\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"captureTimestamp\",\"type\":\"uint48\",\"internalType\":\"uint48\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"operatorNetworkShares\",\"inputs\":[{\"name\":\"subnetwork\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"operator\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"operatorNetworkSharesAt\",\"inputs\":[{\"name\":\"subnetwork\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"operator\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"timestamp\",\"type\":\"uint48\",\"internalType\":\"uint48\"},{\"name\":\"hint\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"setHook\",\"inputs\":[{\"name\":\"hook\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setMaxNetworkLimit\",\"inputs\":[{\"name\":\"identifier\",\"type\":\"uint96\",\"internalType\":\"uint96\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setNetworkLimit\",\"inputs\":[{\"name\":\"subnetwork\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOperatorNetworkShares\",\"inputs\":[{\"name\":\"subnetwork\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"operator\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"shares\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"stake\",\"inputs\":[{\"name\":\"subnetwork\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"operator\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"stakeAt\",\"inputs\":[{\"name\":\"subnetwork\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"operator\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"timestamp\",\"type\":\"uint48\",\"internalType\":\"uint48\"},{\"name\":\"hints\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"totalOperatorNetworkShares\",\"inputs\":[{\"name\":\"subnetwork\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"totalOperatorNetworkSharesAt\",\"inputs\":[{\"name\":\"subnetwork\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"timestamp\",\"type\":\"uint48\",\"internalType\":\"uint48\"},{\"name\":\"hint\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"vault\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"event\",\"name\":\"OnSlash\",\"inputs\":[{\"name\":\"subnetwork\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"operator\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"captureTimestamp\",\"type\":\"uint48\",\"indexed\":false,\"internalType\":\"uint48\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"SetHook\",\"inputs\":[{\"name\":\"hook\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"SetMaxNetworkLimit\",\"inputs\":[{\"name\":\"subnetwork\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"SetNetworkLimit\",\"inputs\":[{\"name\":\"subnetwork\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"SetOperatorNetworkShares\",\"inputs\":[{\"name\":\"subnetwork\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"operator\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"shares\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"AlreadySet\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"DuplicateRoleHolder\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ExceedsMaxNetworkLimit\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InsufficientHookGas\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"MissingRoleHolders\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NotInitialized\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NotNetwork\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NotSlasher\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NotVault\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ZeroAddressRoleHolder\",\"inputs\":[]}]",
+}
+
+// INetworkRestakeDelegatorABI is the input ABI used to generate the binding from.
+// Deprecated: Use INetworkRestakeDelegatorMetaData.ABI instead.
+var INetworkRestakeDelegatorABI = INetworkRestakeDelegatorMetaData.ABI
+
+// INetworkRestakeDelegator is an auto generated Go binding around an Ethereum contract.
+type INetworkRestakeDelegator struct {
+	INetworkRestakeDelegatorCaller     // Read-only binding to the contract
+	INetworkRestakeDelegatorTransactor // Write-only binding to the contract
+	INetworkRestakeDelegatorFilterer   // Log filterer for contract events
+}
+
+// INetworkRestakeDelegatorCaller is an auto generated read-only Go binding around an Ethereum contract.
+type INetworkRestakeDelegatorCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// INetworkRestakeDelegatorTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type INetworkRestakeDelegatorTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// INetworkRestakeDelegatorFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type INetworkRestakeDelegatorFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// INetworkRestakeDelegatorSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type INetworkRestakeDelegatorSession struct {
+	Contract     *INetworkRestakeDelegator // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts             // Call options to use throughout this session
+	TransactOpts bind.TransactOpts         // Transaction auth options to use throughout this session
+}
+
+// INetworkRestakeDelegatorCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type INetworkRestakeDelegatorCallerSession struct {
+	Contract *INetworkRestakeDelegatorCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                   // Call options to use throughout this session
+}
+
+// INetworkRestakeDelegatorTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type INetworkRestakeDelegatorTransactorSession struct {
+	Contract     *INetworkRestakeDelegatorTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                   // Transaction auth options to use throughout this session
+}
+
+// INetworkRestakeDelegatorRaw is an auto generated low-level Go binding around an Ethereum contract.
+type INetworkRestakeDelegatorRaw struct {
+	Contract *INetworkRestakeDelegator // Generic contract binding to access the raw methods on
+}
+
+// INetworkRestakeDelegatorCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type INetworkRestakeDelegatorCallerRaw struct {
+	Contract *INetworkRestakeDelegatorCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// INetworkRestakeDelegatorTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type INetworkRestakeDelegatorTransactorRaw struct {
+	Contract *INetworkRestakeDelegatorTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewINetworkRestakeDelegator creates a new instance of INetworkRestakeDelegator, bound to a specific deployed contract.
+func NewINetworkRestakeDelegator(address common.Address, backend bind.ContractBackend) (*INetworkRestakeDelegator, error) {
+	contract, err := bindINetworkRestakeDelegator(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &INetworkRestakeDelegator{INetworkRestakeDelegatorCaller: INetworkRestakeDelegatorCaller{contract: contract}, INetworkRestakeDelegatorTransactor: INetworkRestakeDelegatorTransactor{contract: contract}, INetworkRestakeDelegatorFilterer: INetworkRestakeDelegatorFilterer{contract: contract}}, nil
+}
+
+// NewINetworkRestakeDelegatorCaller creates a new read-only instance of INetworkRestakeDelegator, bound to a specific deployed contract.
+func NewINetworkRestakeDelegatorCaller(address common.Address, caller bind.ContractCaller) (*INetworkRestakeDelegatorCaller, error) {
+	contract, err := bindINetworkRestakeDelegator(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &INetworkRestakeDelegatorCaller{contract: contract}, nil
+}
+
+// NewINetworkRestakeDelegatorTransactor creates a new write-only instance of INetworkRestakeDelegator, bound to a specific deployed contract.
+func NewINetworkRestakeDelegatorTransactor(address common.Address, transactor bind.ContractTransactor) (*INetworkRestakeDelegatorTransactor, error) {
+	contract, err := bindINetworkRestakeDelegator(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &INetworkRestakeDelegatorTransactor{contract: contract}, nil
+}
+
+// NewINetworkRestakeDelegatorFilterer creates a new log filterer instance of INetworkRestakeDelegator, bound to a specific deployed contract.
+func NewINetworkRestakeDelegatorFilterer(address common.Address, filterer bind.ContractFilterer) (*INetworkRestakeDelegatorFilterer, error) {
+	contract, err := bindINetworkRestakeDelegator(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &INetworkRestakeDelegatorFilterer{contract: contract}, nil
+}
+
+// bindINetworkRestakeDelegator binds a generic wrapper to an already deployed contract.
+func bindINetworkRestakeDelegator(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := INetworkRestakeDelegatorMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _INetworkRestakeDelegator.Contract.INetworkRestakeDelegatorCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _INetworkRestakeDelegator.Contract.INetworkRestakeDelegatorTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _INetworkRestakeDelegator.Contract.INetworkRestakeDelegatorTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _INetworkRestakeDelegator.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _INetworkRestakeDelegator.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _INetworkRestakeDelegator.Contract.contract.Transact(opts, method, params...)
+}
+
+// FACTORY is a free data retrieval call binding the contract method 0x2dd31000.
+//
+// Solidity: function FACTORY() view returns(address)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorCaller) FACTORY(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _INetworkRestakeDelegator.contract.Call(opts, &out, "FACTORY")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// FACTORY is a free data retrieval call binding the contract method 0x2dd31000.
+//
+// Solidity: function FACTORY() view returns(address)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorSession) FACTORY() (common.Address, error) {
+	return _INetworkRestakeDelegator.Contract.FACTORY(&_INetworkRestakeDelegator.CallOpts)
+}
+
+// FACTORY is a free data retrieval call binding the contract method 0x2dd31000.
+//
+// Solidity: function FACTORY() view returns(address)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorCallerSession) FACTORY() (common.Address, error) {
+	return _INetworkRestakeDelegator.Contract.FACTORY(&_INetworkRestakeDelegator.CallOpts)
+}
+
+// HOOKGASLIMIT is a free data retrieval call binding the contract method 0xff54740f.
+//
+// Solidity: function HOOK_GAS_LIMIT() view returns(uint256)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorCaller) HOOKGASLIMIT(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _INetworkRestakeDelegator.contract.Call(opts, &out, "HOOK_GAS_LIMIT")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// HOOKGASLIMIT is a free data retrieval call binding the contract method 0xff54740f.
+//
+// Solidity: function HOOK_GAS_LIMIT() view returns(uint256)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorSession) HOOKGASLIMIT() (*big.Int, error) {
+	return _INetworkRestakeDelegator.Contract.HOOKGASLIMIT(&_INetworkRestakeDelegator.CallOpts)
+}
+
+// HOOKGASLIMIT is a free data retrieval call binding the contract method 0xff54740f.
+//
+// Solidity: function HOOK_GAS_LIMIT() view returns(uint256)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorCallerSession) HOOKGASLIMIT() (*big.Int, error) {
+	return _INetworkRestakeDelegator.Contract.HOOKGASLIMIT(&_INetworkRestakeDelegator.CallOpts)
+}
+
+// HOOKRESERVE is a free data retrieval call binding the contract method 0x557cab44.
+//
+// Solidity: function HOOK_RESERVE() view returns(uint256)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorCaller) HOOKRESERVE(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _INetworkRestakeDelegator.contract.Call(opts, &out, "HOOK_RESERVE")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// HOOKRESERVE is a free data retrieval call binding the contract method 0x557cab44.
+//
+// Solidity: function HOOK_RESERVE() view returns(uint256)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorSession) HOOKRESERVE() (*big.Int, error) {
+	return _INetworkRestakeDelegator.Contract.HOOKRESERVE(&_INetworkRestakeDelegator.CallOpts)
+}
+
+// HOOKRESERVE is a free data retrieval call binding the contract method 0x557cab44.
+//
+// Solidity: function HOOK_RESERVE() view returns(uint256)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorCallerSession) HOOKRESERVE() (*big.Int, error) {
+	return _INetworkRestakeDelegator.Contract.HOOKRESERVE(&_INetworkRestakeDelegator.CallOpts)
+}
+
+// HOOKSETROLE is a free data retrieval call binding the contract method 0x6679191e.
+//
+// Solidity: function HOOK_SET_ROLE() view returns(bytes32)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorCaller) HOOKSETROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _INetworkRestakeDelegator.contract.Call(opts, &out, "HOOK_SET_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// HOOKSETROLE is a free data retrieval call binding the contract method 0x6679191e.
+//
+// Solidity: function HOOK_SET_ROLE() view returns(bytes32)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorSession) HOOKSETROLE() ([32]byte, error) {
+	return _INetworkRestakeDelegator.Contract.HOOKSETROLE(&_INetworkRestakeDelegator.CallOpts)
+}
+
+// HOOKSETROLE is a free data retrieval call binding the contract method 0x6679191e.
+//
+// Solidity: function HOOK_SET_ROLE() view returns(bytes32)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorCallerSession) HOOKSETROLE() ([32]byte, error) {
+	return _INetworkRestakeDelegator.Contract.HOOKSETROLE(&_INetworkRestakeDelegator.CallOpts)
+}
+
+// NETWORKLIMITSETROLE is a free data retrieval call binding the contract method 0x7d24bb27.
+//
+// Solidity: function NETWORK_LIMIT_SET_ROLE() view returns(bytes32)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorCaller) NETWORKLIMITSETROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _INetworkRestakeDelegator.contract.Call(opts, &out, "NETWORK_LIMIT_SET_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// NETWORKLIMITSETROLE is a free data retrieval call binding the contract method 0x7d24bb27.
+//
+// Solidity: function NETWORK_LIMIT_SET_ROLE() view returns(bytes32)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorSession) NETWORKLIMITSETROLE() ([32]byte, error) {
+	return _INetworkRestakeDelegator.Contract.NETWORKLIMITSETROLE(&_INetworkRestakeDelegator.CallOpts)
+}
+
+// NETWORKLIMITSETROLE is a free data retrieval call binding the contract method 0x7d24bb27.
+//
+// Solidity: function NETWORK_LIMIT_SET_ROLE() view returns(bytes32)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorCallerSession) NETWORKLIMITSETROLE() ([32]byte, error) {
+	return _INetworkRestakeDelegator.Contract.NETWORKLIMITSETROLE(&_INetworkRestakeDelegator.CallOpts)
+}
+
+// NETWORKREGISTRY is a free data retrieval call binding the contract method 0xc0cd7c3e.
+//
+// Solidity: function NETWORK_REGISTRY() view returns(address)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorCaller) NETWORKREGISTRY(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _INetworkRestakeDelegator.contract.Call(opts, &out, "NETWORK_REGISTRY")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// NETWORKREGISTRY is a free data retrieval call binding the contract method 0xc0cd7c3e.
+//
+// Solidity: function NETWORK_REGISTRY() view returns(address)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorSession) NETWORKREGISTRY() (common.Address, error) {
+	return _INetworkRestakeDelegator.Contract.NETWORKREGISTRY(&_INetworkRestakeDelegator.CallOpts)
+}
+
+// NETWORKREGISTRY is a free data retrieval call binding the contract method 0xc0cd7c3e.
+//
+// Solidity: function NETWORK_REGISTRY() view returns(address)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorCallerSession) NETWORKREGISTRY() (common.Address, error) {
+	return _INetworkRestakeDelegator.Contract.NETWORKREGISTRY(&_INetworkRestakeDelegator.CallOpts)
+}
+
+// OPERATORNETWORKOPTINSERVICE is a free data retrieval call binding the contract method 0x1a80e500.
+//
+// Solidity: function OPERATOR_NETWORK_OPT_IN_SERVICE() view returns(address)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorCaller) OPERATORNETWORKOPTINSERVICE(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _INetworkRestakeDelegator.contract.Call(opts, &out, "OPERATOR_NETWORK_OPT_IN_SERVICE")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// OPERATORNETWORKOPTINSERVICE is a free data retrieval call binding the contract method 0x1a80e500.
+//
+// Solidity: function OPERATOR_NETWORK_OPT_IN_SERVICE() view returns(address)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorSession) OPERATORNETWORKOPTINSERVICE() (common.Address, error) {
+	return _INetworkRestakeDelegator.Contract.OPERATORNETWORKOPTINSERVICE(&_INetworkRestakeDelegator.CallOpts)
+}
+
+// OPERATORNETWORKOPTINSERVICE is a free data retrieval call binding the contract method 0x1a80e500.
+//
+// Solidity: function OPERATOR_NETWORK_OPT_IN_SERVICE() view returns(address)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorCallerSession) OPERATORNETWORKOPTINSERVICE() (common.Address, error) {
+	return _INetworkRestakeDelegator.Contract.OPERATORNETWORKOPTINSERVICE(&_INetworkRestakeDelegator.CallOpts)
+}
+
+// OPERATORNETWORKSHARESSETROLE is a free data retrieval call binding the contract method 0xe78eb6ae.
+//
+// Solidity: function OPERATOR_NETWORK_SHARES_SET_ROLE() view returns(bytes32)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorCaller) OPERATORNETWORKSHARESSETROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _INetworkRestakeDelegator.contract.Call(opts, &out, "OPERATOR_NETWORK_SHARES_SET_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// OPERATORNETWORKSHARESSETROLE is a free data retrieval call binding the contract method 0xe78eb6ae.
+//
+// Solidity: function OPERATOR_NETWORK_SHARES_SET_ROLE() view returns(bytes32)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorSession) OPERATORNETWORKSHARESSETROLE() ([32]byte, error) {
+	return _INetworkRestakeDelegator.Contract.OPERATORNETWORKSHARESSETROLE(&_INetworkRestakeDelegator.CallOpts)
+}
+
+// OPERATORNETWORKSHARESSETROLE is a free data retrieval call binding the contract method 0xe78eb6ae.
+//
+// Solidity: function OPERATOR_NETWORK_SHARES_SET_ROLE() view returns(bytes32)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorCallerSession) OPERATORNETWORKSHARESSETROLE() ([32]byte, error) {
+	return _INetworkRestakeDelegator.Contract.OPERATORNETWORKSHARESSETROLE(&_INetworkRestakeDelegator.CallOpts)
+}
+
+// OPERATORVAULTOPTINSERVICE is a free data retrieval call binding the contract method 0x128e5d82.
+//
+// Solidity: function OPERATOR_VAULT_OPT_IN_SERVICE() view returns(address)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorCaller) OPERATORVAULTOPTINSERVICE(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _INetworkRestakeDelegator.contract.Call(opts, &out, "OPERATOR_VAULT_OPT_IN_SERVICE")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// OPERATORVAULTOPTINSERVICE is a free data retrieval call binding the contract method 0x128e5d82.
+//
+// Solidity: function OPERATOR_VAULT_OPT_IN_SERVICE() view returns(address)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorSession) OPERATORVAULTOPTINSERVICE() (common.Address, error) {
+	return _INetworkRestakeDelegator.Contract.OPERATORVAULTOPTINSERVICE(&_INetworkRestakeDelegator.CallOpts)
+}
+
+// OPERATORVAULTOPTINSERVICE is a free data retrieval call binding the contract method 0x128e5d82.
+//
+// Solidity: function OPERATOR_VAULT_OPT_IN_SERVICE() view returns(address)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorCallerSession) OPERATORVAULTOPTINSERVICE() (common.Address, error) {
+	return _INetworkRestakeDelegator.Contract.OPERATORVAULTOPTINSERVICE(&_INetworkRestakeDelegator.CallOpts)
+}
+
+// TYPE is a free data retrieval call binding the contract method 0xbb24fe8a.
+//
+// Solidity: function TYPE() view returns(uint64)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorCaller) TYPE(opts *bind.CallOpts) (uint64, error) {
+	var out []interface{}
+	err := _INetworkRestakeDelegator.contract.Call(opts, &out, "TYPE")
+
+	if err != nil {
+		return *new(uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint64)).(*uint64)
+
+	return out0, err
+
+}
+
+// TYPE is a free data retrieval call binding the contract method 0xbb24fe8a.
+//
+// Solidity: function TYPE() view returns(uint64)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorSession) TYPE() (uint64, error) {
+	return _INetworkRestakeDelegator.Contract.TYPE(&_INetworkRestakeDelegator.CallOpts)
+}
+
+// TYPE is a free data retrieval call binding the contract method 0xbb24fe8a.
+//
+// Solidity: function TYPE() view returns(uint64)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorCallerSession) TYPE() (uint64, error) {
+	return _INetworkRestakeDelegator.Contract.TYPE(&_INetworkRestakeDelegator.CallOpts)
+}
+
+// VAULTFACTORY is a free data retrieval call binding the contract method 0x103f2907.
+//
+// Solidity: function VAULT_FACTORY() view returns(address)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorCaller) VAULTFACTORY(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _INetworkRestakeDelegator.contract.Call(opts, &out, "VAULT_FACTORY")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// VAULTFACTORY is a free data retrieval call binding the contract method 0x103f2907.
+//
+// Solidity: function VAULT_FACTORY() view returns(address)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorSession) VAULTFACTORY() (common.Address, error) {
+	return _INetworkRestakeDelegator.Contract.VAULTFACTORY(&_INetworkRestakeDelegator.CallOpts)
+}
+
+// VAULTFACTORY is a free data retrieval call binding the contract method 0x103f2907.
+//
+// Solidity: function VAULT_FACTORY() view returns(address)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorCallerSession) VAULTFACTORY() (common.Address, error) {
+	return _INetworkRestakeDelegator.Contract.VAULTFACTORY(&_INetworkRestakeDelegator.CallOpts)
+}
+
+// VERSION is a free data retrieval call binding the contract method 0xffa1ad74.
+//
+// Solidity: function VERSION() view returns(uint64)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorCaller) VERSION(opts *bind.CallOpts) (uint64, error) {
+	var out []interface{}
+	err := _INetworkRestakeDelegator.contract.Call(opts, &out, "VERSION")
+
+	if err != nil {
+		return *new(uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint64)).(*uint64)
+
+	return out0, err
+
+}
+
+// VERSION is a free data retrieval call binding the contract method 0xffa1ad74.
+//
+// Solidity: function VERSION() view returns(uint64)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorSession) VERSION() (uint64, error) {
+	return _INetworkRestakeDelegator.Contract.VERSION(&_INetworkRestakeDelegator.CallOpts)
+}
+
+// VERSION is a free data retrieval call binding the contract method 0xffa1ad74.
+//
+// Solidity: function VERSION() view returns(uint64)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorCallerSession) VERSION() (uint64, error) {
+	return _INetworkRestakeDelegator.Contract.VERSION(&_INetworkRestakeDelegator.CallOpts)
+}
+
+// Hook is a free data retrieval call binding the contract method 0x7f5a7c7b.
+//
+// Solidity: function hook() view returns(address)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorCaller) Hook(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _INetworkRestakeDelegator.contract.Call(opts, &out, "hook")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Hook is a free data retrieval call binding the contract method 0x7f5a7c7b.
+//
+// Solidity: function hook() view returns(address)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorSession) Hook() (common.Address, error) {
+	return _INetworkRestakeDelegator.Contract.Hook(&_INetworkRestakeDelegator.CallOpts)
+}
+
+// Hook is a free data retrieval call binding the contract method 0x7f5a7c7b.
+//
+// Solidity: function hook() view returns(address)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorCallerSession) Hook() (common.Address, error) {
+	return _INetworkRestakeDelegator.Contract.Hook(&_INetworkRestakeDelegator.CallOpts)
+}
+
+// MaxNetworkLimit is a free data retrieval call binding the contract method 0xd15b740e.
+//
+// Solidity: function maxNetworkLimit(bytes32 subnetwork) view returns(uint256)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorCaller) MaxNetworkLimit(opts *bind.CallOpts, subnetwork [32]byte) (*big.Int, error) {
+	var out []interface{}
+	err := _INetworkRestakeDelegator.contract.Call(opts, &out, "maxNetworkLimit", subnetwork)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MaxNetworkLimit is a free data retrieval call binding the contract method 0xd15b740e.
+//
+// Solidity: function maxNetworkLimit(bytes32 subnetwork) view returns(uint256)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorSession) MaxNetworkLimit(subnetwork [32]byte) (*big.Int, error) {
+	return _INetworkRestakeDelegator.Contract.MaxNetworkLimit(&_INetworkRestakeDelegator.CallOpts, subnetwork)
+}
+
+// MaxNetworkLimit is a free data retrieval call binding the contract method 0xd15b740e.
+//
+// Solidity: function maxNetworkLimit(bytes32 subnetwork) view returns(uint256)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorCallerSession) MaxNetworkLimit(subnetwork [32]byte) (*big.Int, error) {
+	return _INetworkRestakeDelegator.Contract.MaxNetworkLimit(&_INetworkRestakeDelegator.CallOpts, subnetwork)
+}
+
+// NetworkLimit is a free data retrieval call binding the contract method 0x3eb22c0f.
+//
+// Solidity: function networkLimit(bytes32 subnetwork) view returns(uint256)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorCaller) NetworkLimit(opts *bind.CallOpts, subnetwork [32]byte) (*big.Int, error) {
+	var out []interface{}
+	err := _INetworkRestakeDelegator.contract.Call(opts, &out, "networkLimit", subnetwork)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// NetworkLimit is a free data retrieval call binding the contract method 0x3eb22c0f.
+//
+// Solidity: function networkLimit(bytes32 subnetwork) view returns(uint256)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorSession) NetworkLimit(subnetwork [32]byte) (*big.Int, error) {
+	return _INetworkRestakeDelegator.Contract.NetworkLimit(&_INetworkRestakeDelegator.CallOpts, subnetwork)
+}
+
+// NetworkLimit is a free data retrieval call binding the contract method 0x3eb22c0f.
+//
+// Solidity: function networkLimit(bytes32 subnetwork) view returns(uint256)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorCallerSession) NetworkLimit(subnetwork [32]byte) (*big.Int, error) {
+	return _INetworkRestakeDelegator.Contract.NetworkLimit(&_INetworkRestakeDelegator.CallOpts, subnetwork)
+}
+
+// NetworkLimitAt is a free data retrieval call binding the contract method 0x5d32a1c9.
+//
+// Solidity: function networkLimitAt(bytes32 subnetwork, uint48 timestamp, bytes hint) view returns(uint256)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorCaller) NetworkLimitAt(opts *bind.CallOpts, subnetwork [32]byte, timestamp *big.Int, hint []byte) (*big.Int, error) {
+	var out []interface{}
+	err := _INetworkRestakeDelegator.contract.Call(opts, &out, "networkLimitAt", subnetwork, timestamp, hint)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// NetworkLimitAt is a free data retrieval call binding the contract method 0x5d32a1c9.
+//
+// Solidity: function networkLimitAt(bytes32 subnetwork, uint48 timestamp, bytes hint) view returns(uint256)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorSession) NetworkLimitAt(subnetwork [32]byte, timestamp *big.Int, hint []byte) (*big.Int, error) {
+	return _INetworkRestakeDelegator.Contract.NetworkLimitAt(&_INetworkRestakeDelegator.CallOpts, subnetwork, timestamp, hint)
+}
+
+// NetworkLimitAt is a free data retrieval call binding the contract method 0x5d32a1c9.
+//
+// Solidity: function networkLimitAt(bytes32 subnetwork, uint48 timestamp, bytes hint) view returns(uint256)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorCallerSession) NetworkLimitAt(subnetwork [32]byte, timestamp *big.Int, hint []byte) (*big.Int, error) {
+	return _INetworkRestakeDelegator.Contract.NetworkLimitAt(&_INetworkRestakeDelegator.CallOpts, subnetwork, timestamp, hint)
+}
+
+// OperatorNetworkShares is a free data retrieval call binding the contract method 0x42c53e33.
+//
+// Solidity: function operatorNetworkShares(bytes32 subnetwork, address operator) view returns(uint256)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorCaller) OperatorNetworkShares(opts *bind.CallOpts, subnetwork [32]byte, operator common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _INetworkRestakeDelegator.contract.Call(opts, &out, "operatorNetworkShares", subnetwork, operator)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// OperatorNetworkShares is a free data retrieval call binding the contract method 0x42c53e33.
+//
+// Solidity: function operatorNetworkShares(bytes32 subnetwork, address operator) view returns(uint256)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorSession) OperatorNetworkShares(subnetwork [32]byte, operator common.Address) (*big.Int, error) {
+	return _INetworkRestakeDelegator.Contract.OperatorNetworkShares(&_INetworkRestakeDelegator.CallOpts, subnetwork, operator)
+}
+
+// OperatorNetworkShares is a free data retrieval call binding the contract method 0x42c53e33.
+//
+// Solidity: function operatorNetworkShares(bytes32 subnetwork, address operator) view returns(uint256)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorCallerSession) OperatorNetworkShares(subnetwork [32]byte, operator common.Address) (*big.Int, error) {
+	return _INetworkRestakeDelegator.Contract.OperatorNetworkShares(&_INetworkRestakeDelegator.CallOpts, subnetwork, operator)
+}
+
+// OperatorNetworkSharesAt is a free data retrieval call binding the contract method 0x1a7a7044.
+//
+// Solidity: function operatorNetworkSharesAt(bytes32 subnetwork, address operator, uint48 timestamp, bytes hint) view returns(uint256)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorCaller) OperatorNetworkSharesAt(opts *bind.CallOpts, subnetwork [32]byte, operator common.Address, timestamp *big.Int, hint []byte) (*big.Int, error) {
+	var out []interface{}
+	err := _INetworkRestakeDelegator.contract.Call(opts, &out, "operatorNetworkSharesAt", subnetwork, operator, timestamp, hint)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// OperatorNetworkSharesAt is a free data retrieval call binding the contract method 0x1a7a7044.
+//
+// Solidity: function operatorNetworkSharesAt(bytes32 subnetwork, address operator, uint48 timestamp, bytes hint) view returns(uint256)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorSession) OperatorNetworkSharesAt(subnetwork [32]byte, operator common.Address, timestamp *big.Int, hint []byte) (*big.Int, error) {
+	return _INetworkRestakeDelegator.Contract.OperatorNetworkSharesAt(&_INetworkRestakeDelegator.CallOpts, subnetwork, operator, timestamp, hint)
+}
+
+// OperatorNetworkSharesAt is a free data retrieval call binding the contract method 0x1a7a7044.
+//
+// Solidity: function operatorNetworkSharesAt(bytes32 subnetwork, address operator, uint48 timestamp, bytes hint) view returns(uint256)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorCallerSession) OperatorNetworkSharesAt(subnetwork [32]byte, operator common.Address, timestamp *big.Int, hint []byte) (*big.Int, error) {
+	return _INetworkRestakeDelegator.Contract.OperatorNetworkSharesAt(&_INetworkRestakeDelegator.CallOpts, subnetwork, operator, timestamp, hint)
+}
+
+// Stake is a free data retrieval call binding the contract method 0xfd4d447c.
+//
+// Solidity: function stake(bytes32 subnetwork, address operator) view returns(uint256)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorCaller) Stake(opts *bind.CallOpts, subnetwork [32]byte, operator common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _INetworkRestakeDelegator.contract.Call(opts, &out, "stake", subnetwork, operator)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Stake is a free data retrieval call binding the contract method 0xfd4d447c.
+//
+// Solidity: function stake(bytes32 subnetwork, address operator) view returns(uint256)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorSession) Stake(subnetwork [32]byte, operator common.Address) (*big.Int, error) {
+	return _INetworkRestakeDelegator.Contract.Stake(&_INetworkRestakeDelegator.CallOpts, subnetwork, operator)
+}
+
+// Stake is a free data retrieval call binding the contract method 0xfd4d447c.
+//
+// Solidity: function stake(bytes32 subnetwork, address operator) view returns(uint256)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorCallerSession) Stake(subnetwork [32]byte, operator common.Address) (*big.Int, error) {
+	return _INetworkRestakeDelegator.Contract.Stake(&_INetworkRestakeDelegator.CallOpts, subnetwork, operator)
+}
+
+// StakeAt is a free data retrieval call binding the contract method 0xe02f6937.
+//
+// Solidity: function stakeAt(bytes32 subnetwork, address operator, uint48 timestamp, bytes hints) view returns(uint256)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorCaller) StakeAt(opts *bind.CallOpts, subnetwork [32]byte, operator common.Address, timestamp *big.Int, hints []byte) (*big.Int, error) {
+	var out []interface{}
+	err := _INetworkRestakeDelegator.contract.Call(opts, &out, "stakeAt", subnetwork, operator, timestamp, hints)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// StakeAt is a free data retrieval call binding the contract method 0xe02f6937.
+//
+// Solidity: function stakeAt(bytes32 subnetwork, address operator, uint48 timestamp, bytes hints) view returns(uint256)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorSession) StakeAt(subnetwork [32]byte, operator common.Address, timestamp *big.Int, hints []byte) (*big.Int, error) {
+	return _INetworkRestakeDelegator.Contract.StakeAt(&_INetworkRestakeDelegator.CallOpts, subnetwork, operator, timestamp, hints)
+}
+
+// StakeAt is a free data retrieval call binding the contract method 0xe02f6937.
+//
+// Solidity: function stakeAt(bytes32 subnetwork, address operator, uint48 timestamp, bytes hints) view returns(uint256)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorCallerSession) StakeAt(subnetwork [32]byte, operator common.Address, timestamp *big.Int, hints []byte) (*big.Int, error) {
+	return _INetworkRestakeDelegator.Contract.StakeAt(&_INetworkRestakeDelegator.CallOpts, subnetwork, operator, timestamp, hints)
+}
+
+// TotalOperatorNetworkShares is a free data retrieval call binding the contract method 0xc43dc03f.
+//
+// Solidity: function totalOperatorNetworkShares(bytes32 subnetwork) view returns(uint256)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorCaller) TotalOperatorNetworkShares(opts *bind.CallOpts, subnetwork [32]byte) (*big.Int, error) {
+	var out []interface{}
+	err := _INetworkRestakeDelegator.contract.Call(opts, &out, "totalOperatorNetworkShares", subnetwork)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalOperatorNetworkShares is a free data retrieval call binding the contract method 0xc43dc03f.
+//
+// Solidity: function totalOperatorNetworkShares(bytes32 subnetwork) view returns(uint256)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorSession) TotalOperatorNetworkShares(subnetwork [32]byte) (*big.Int, error) {
+	return _INetworkRestakeDelegator.Contract.TotalOperatorNetworkShares(&_INetworkRestakeDelegator.CallOpts, subnetwork)
+}
+
+// TotalOperatorNetworkShares is a free data retrieval call binding the contract method 0xc43dc03f.
+//
+// Solidity: function totalOperatorNetworkShares(bytes32 subnetwork) view returns(uint256)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorCallerSession) TotalOperatorNetworkShares(subnetwork [32]byte) (*big.Int, error) {
+	return _INetworkRestakeDelegator.Contract.TotalOperatorNetworkShares(&_INetworkRestakeDelegator.CallOpts, subnetwork)
+}
+
+// TotalOperatorNetworkSharesAt is a free data retrieval call binding the contract method 0x8b3f10b0.
+//
+// Solidity: function totalOperatorNetworkSharesAt(bytes32 subnetwork, uint48 timestamp, bytes hint) view returns(uint256)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorCaller) TotalOperatorNetworkSharesAt(opts *bind.CallOpts, subnetwork [32]byte, timestamp *big.Int, hint []byte) (*big.Int, error) {
+	var out []interface{}
+	err := _INetworkRestakeDelegator.contract.Call(opts, &out, "totalOperatorNetworkSharesAt", subnetwork, timestamp, hint)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalOperatorNetworkSharesAt is a free data retrieval call binding the contract method 0x8b3f10b0.
+//
+// Solidity: function totalOperatorNetworkSharesAt(bytes32 subnetwork, uint48 timestamp, bytes hint) view returns(uint256)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorSession) TotalOperatorNetworkSharesAt(subnetwork [32]byte, timestamp *big.Int, hint []byte) (*big.Int, error) {
+	return _INetworkRestakeDelegator.Contract.TotalOperatorNetworkSharesAt(&_INetworkRestakeDelegator.CallOpts, subnetwork, timestamp, hint)
+}
+
+// TotalOperatorNetworkSharesAt is a free data retrieval call binding the contract method 0x8b3f10b0.
+//
+// Solidity: function totalOperatorNetworkSharesAt(bytes32 subnetwork, uint48 timestamp, bytes hint) view returns(uint256)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorCallerSession) TotalOperatorNetworkSharesAt(subnetwork [32]byte, timestamp *big.Int, hint []byte) (*big.Int, error) {
+	return _INetworkRestakeDelegator.Contract.TotalOperatorNetworkSharesAt(&_INetworkRestakeDelegator.CallOpts, subnetwork, timestamp, hint)
+}
+
+// Vault is a free data retrieval call binding the contract method 0xfbfa77cf.
+//
+// Solidity: function vault() view returns(address)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorCaller) Vault(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _INetworkRestakeDelegator.contract.Call(opts, &out, "vault")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Vault is a free data retrieval call binding the contract method 0xfbfa77cf.
+//
+// Solidity: function vault() view returns(address)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorSession) Vault() (common.Address, error) {
+	return _INetworkRestakeDelegator.Contract.Vault(&_INetworkRestakeDelegator.CallOpts)
+}
+
+// Vault is a free data retrieval call binding the contract method 0xfbfa77cf.
+//
+// Solidity: function vault() view returns(address)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorCallerSession) Vault() (common.Address, error) {
+	return _INetworkRestakeDelegator.Contract.Vault(&_INetworkRestakeDelegator.CallOpts)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x439fab91.
+//
+// Solidity: function initialize(bytes data) returns()
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorTransactor) Initialize(opts *bind.TransactOpts, data []byte) (*types.Transaction, error) {
+	return _INetworkRestakeDelegator.contract.Transact(opts, "initialize", data)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x439fab91.
+//
+// Solidity: function initialize(bytes data) returns()
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorSession) Initialize(data []byte) (*types.Transaction, error) {
+	return _INetworkRestakeDelegator.Contract.Initialize(&_INetworkRestakeDelegator.TransactOpts, data)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x439fab91.
+//
+// Solidity: function initialize(bytes data) returns()
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorTransactorSession) Initialize(data []byte) (*types.Transaction, error) {
+	return _INetworkRestakeDelegator.Contract.Initialize(&_INetworkRestakeDelegator.TransactOpts, data)
+}
+
+// OnSlash is a paid mutator transaction binding the contract method 0xe49561ee.
+//
+// Solidity: function onSlash(bytes32 subnetwork, address operator, uint256 amount, uint48 captureTimestamp, bytes data) returns()
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorTransactor) OnSlash(opts *bind.TransactOpts, subnetwork [32]byte, operator common.Address, amount *big.Int, captureTimestamp *big.Int, data []byte) (*types.Transaction, error) {
+	return _INetworkRestakeDelegator.contract.Transact(opts, "onSlash", subnetwork, operator, amount, captureTimestamp, data)
+}
+
+// OnSlash is a paid mutator transaction binding the contract method 0xe49561ee.
+//
+// Solidity: function onSlash(bytes32 subnetwork, address operator, uint256 amount, uint48 captureTimestamp, bytes data) returns()
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorSession) OnSlash(subnetwork [32]byte, operator common.Address, amount *big.Int, captureTimestamp *big.Int, data []byte) (*types.Transaction, error) {
+	return _INetworkRestakeDelegator.Contract.OnSlash(&_INetworkRestakeDelegator.TransactOpts, subnetwork, operator, amount, captureTimestamp, data)
+}
+
+// OnSlash is a paid mutator transaction binding the contract method 0xe49561ee.
+//
+// Solidity: function onSlash(bytes32 subnetwork, address operator, uint256 amount, uint48 captureTimestamp, bytes data) returns()
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorTransactorSession) OnSlash(subnetwork [32]byte, operator common.Address, amount *big.Int, captureTimestamp *big.Int, data []byte) (*types.Transaction, error) {
+	return _INetworkRestakeDelegator.Contract.OnSlash(&_INetworkRestakeDelegator.TransactOpts, subnetwork, operator, amount, captureTimestamp, data)
+}
+
+// SetHook is a paid mutator transaction binding the contract method 0x3dfd3873.
+//
+// Solidity: function setHook(address hook) returns()
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorTransactor) SetHook(opts *bind.TransactOpts, hook common.Address) (*types.Transaction, error) {
+	return _INetworkRestakeDelegator.contract.Transact(opts, "setHook", hook)
+}
+
+// SetHook is a paid mutator transaction binding the contract method 0x3dfd3873.
+//
+// Solidity: function setHook(address hook) returns()
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorSession) SetHook(hook common.Address) (*types.Transaction, error) {
+	return _INetworkRestakeDelegator.Contract.SetHook(&_INetworkRestakeDelegator.TransactOpts, hook)
+}
+
+// SetHook is a paid mutator transaction binding the contract method 0x3dfd3873.
+//
+// Solidity: function setHook(address hook) returns()
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorTransactorSession) SetHook(hook common.Address) (*types.Transaction, error) {
+	return _INetworkRestakeDelegator.Contract.SetHook(&_INetworkRestakeDelegator.TransactOpts, hook)
+}
+
+// SetMaxNetworkLimit is a paid mutator transaction binding the contract method 0x23f752d5.
+//
+// Solidity: function setMaxNetworkLimit(uint96 identifier, uint256 amount) returns()
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorTransactor) SetMaxNetworkLimit(opts *bind.TransactOpts, identifier *big.Int, amount *big.Int) (*types.Transaction, error) {
+	return _INetworkRestakeDelegator.contract.Transact(opts, "setMaxNetworkLimit", identifier, amount)
+}
+
+// SetMaxNetworkLimit is a paid mutator transaction binding the contract method 0x23f752d5.
+//
+// Solidity: function setMaxNetworkLimit(uint96 identifier, uint256 amount) returns()
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorSession) SetMaxNetworkLimit(identifier *big.Int, amount *big.Int) (*types.Transaction, error) {
+	return _INetworkRestakeDelegator.Contract.SetMaxNetworkLimit(&_INetworkRestakeDelegator.TransactOpts, identifier, amount)
+}
+
+// SetMaxNetworkLimit is a paid mutator transaction binding the contract method 0x23f752d5.
+//
+// Solidity: function setMaxNetworkLimit(uint96 identifier, uint256 amount) returns()
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorTransactorSession) SetMaxNetworkLimit(identifier *big.Int, amount *big.Int) (*types.Transaction, error) {
+	return _INetworkRestakeDelegator.Contract.SetMaxNetworkLimit(&_INetworkRestakeDelegator.TransactOpts, identifier, amount)
+}
+
+// SetNetworkLimit is a paid mutator transaction binding the contract method 0x02145348.
+//
+// Solidity: function setNetworkLimit(bytes32 subnetwork, uint256 amount) returns()
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorTransactor) SetNetworkLimit(opts *bind.TransactOpts, subnetwork [32]byte, amount *big.Int) (*types.Transaction, error) {
+	return _INetworkRestakeDelegator.contract.Transact(opts, "setNetworkLimit", subnetwork, amount)
+}
+
+// SetNetworkLimit is a paid mutator transaction binding the contract method 0x02145348.
+//
+// Solidity: function setNetworkLimit(bytes32 subnetwork, uint256 amount) returns()
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorSession) SetNetworkLimit(subnetwork [32]byte, amount *big.Int) (*types.Transaction, error) {
+	return _INetworkRestakeDelegator.Contract.SetNetworkLimit(&_INetworkRestakeDelegator.TransactOpts, subnetwork, amount)
+}
+
+// SetNetworkLimit is a paid mutator transaction binding the contract method 0x02145348.
+//
+// Solidity: function setNetworkLimit(bytes32 subnetwork, uint256 amount) returns()
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorTransactorSession) SetNetworkLimit(subnetwork [32]byte, amount *big.Int) (*types.Transaction, error) {
+	return _INetworkRestakeDelegator.Contract.SetNetworkLimit(&_INetworkRestakeDelegator.TransactOpts, subnetwork, amount)
+}
+
+// SetOperatorNetworkShares is a paid mutator transaction binding the contract method 0xa33bc287.
+//
+// Solidity: function setOperatorNetworkShares(bytes32 subnetwork, address operator, uint256 shares) returns()
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorTransactor) SetOperatorNetworkShares(opts *bind.TransactOpts, subnetwork [32]byte, operator common.Address, shares *big.Int) (*types.Transaction, error) {
+	return _INetworkRestakeDelegator.contract.Transact(opts, "setOperatorNetworkShares", subnetwork, operator, shares)
+}
+
+// SetOperatorNetworkShares is a paid mutator transaction binding the contract method 0xa33bc287.
+//
+// Solidity: function setOperatorNetworkShares(bytes32 subnetwork, address operator, uint256 shares) returns()
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorSession) SetOperatorNetworkShares(subnetwork [32]byte, operator common.Address, shares *big.Int) (*types.Transaction, error) {
+	return _INetworkRestakeDelegator.Contract.SetOperatorNetworkShares(&_INetworkRestakeDelegator.TransactOpts, subnetwork, operator, shares)
+}
+
+// SetOperatorNetworkShares is a paid mutator transaction binding the contract method 0xa33bc287.
+//
+// Solidity: function setOperatorNetworkShares(bytes32 subnetwork, address operator, uint256 shares) returns()
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorTransactorSession) SetOperatorNetworkShares(subnetwork [32]byte, operator common.Address, shares *big.Int) (*types.Transaction, error) {
+	return _INetworkRestakeDelegator.Contract.SetOperatorNetworkShares(&_INetworkRestakeDelegator.TransactOpts, subnetwork, operator, shares)
+}
+
+// INetworkRestakeDelegatorOnSlashIterator is returned from FilterOnSlash and is used to iterate over the raw logs and unpacked data for OnSlash events raised by the INetworkRestakeDelegator contract.
+type INetworkRestakeDelegatorOnSlashIterator struct {
+	Event *INetworkRestakeDelegatorOnSlash // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *INetworkRestakeDelegatorOnSlashIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(INetworkRestakeDelegatorOnSlash)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(INetworkRestakeDelegatorOnSlash)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *INetworkRestakeDelegatorOnSlashIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *INetworkRestakeDelegatorOnSlashIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// INetworkRestakeDelegatorOnSlash represents a OnSlash event raised by the INetworkRestakeDelegator contract.
+type INetworkRestakeDelegatorOnSlash struct {
+	Subnetwork       [32]byte
+	Operator         common.Address
+	Amount           *big.Int
+	CaptureTimestamp *big.Int
+	Raw              types.Log // Blockchain specific contextual infos
+}
+
+// FilterOnSlash is a free log retrieval operation binding the contract event 0x741a5de99085c0d660f3e4192217b0ffb0ea4e35a0480de48e857a4bc3ee36ed.
+//
+// Solidity: event OnSlash(bytes32 indexed subnetwork, address indexed operator, uint256 amount, uint48 captureTimestamp)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorFilterer) FilterOnSlash(opts *bind.FilterOpts, subnetwork [][32]byte, operator []common.Address) (*INetworkRestakeDelegatorOnSlashIterator, error) {
+
+	var subnetworkRule []interface{}
+	for _, subnetworkItem := range subnetwork {
+		subnetworkRule = append(subnetworkRule, subnetworkItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _INetworkRestakeDelegator.contract.FilterLogs(opts, "OnSlash", subnetworkRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &INetworkRestakeDelegatorOnSlashIterator{contract: _INetworkRestakeDelegator.contract, event: "OnSlash", logs: logs, sub: sub}, nil
+}
+
+// WatchOnSlash is a free log subscription operation binding the contract event 0x741a5de99085c0d660f3e4192217b0ffb0ea4e35a0480de48e857a4bc3ee36ed.
+//
+// Solidity: event OnSlash(bytes32 indexed subnetwork, address indexed operator, uint256 amount, uint48 captureTimestamp)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorFilterer) WatchOnSlash(opts *bind.WatchOpts, sink chan<- *INetworkRestakeDelegatorOnSlash, subnetwork [][32]byte, operator []common.Address) (event.Subscription, error) {
+
+	var subnetworkRule []interface{}
+	for _, subnetworkItem := range subnetwork {
+		subnetworkRule = append(subnetworkRule, subnetworkItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _INetworkRestakeDelegator.contract.WatchLogs(opts, "OnSlash", subnetworkRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(INetworkRestakeDelegatorOnSlash)
+				if err := _INetworkRestakeDelegator.contract.UnpackLog(event, "OnSlash", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOnSlash is a log parse operation binding the contract event 0x741a5de99085c0d660f3e4192217b0ffb0ea4e35a0480de48e857a4bc3ee36ed.
+//
+// Solidity: event OnSlash(bytes32 indexed subnetwork, address indexed operator, uint256 amount, uint48 captureTimestamp)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorFilterer) ParseOnSlash(log types.Log) (*INetworkRestakeDelegatorOnSlash, error) {
+	event := new(INetworkRestakeDelegatorOnSlash)
+	if err := _INetworkRestakeDelegator.contract.UnpackLog(event, "OnSlash", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// INetworkRestakeDelegatorSetHookIterator is returned from FilterSetHook and is used to iterate over the raw logs and unpacked data for SetHook events raised by the INetworkRestakeDelegator contract.
+type INetworkRestakeDelegatorSetHookIterator struct {
+	Event *INetworkRestakeDelegatorSetHook // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *INetworkRestakeDelegatorSetHookIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(INetworkRestakeDelegatorSetHook)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(INetworkRestakeDelegatorSetHook)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *INetworkRestakeDelegatorSetHookIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *INetworkRestakeDelegatorSetHookIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// INetworkRestakeDelegatorSetHook represents a SetHook event raised by the INetworkRestakeDelegator contract.
+type INetworkRestakeDelegatorSetHook struct {
+	Hook common.Address
+	Raw  types.Log // Blockchain specific contextual infos
+}
+
+// FilterSetHook is a free log retrieval operation binding the contract event 0x5bbb1d3ebb6a3ad2a0f17ff35e579a83af60604d1d3c2a4c83c62adecadf666d.
+//
+// Solidity: event SetHook(address indexed hook)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorFilterer) FilterSetHook(opts *bind.FilterOpts, hook []common.Address) (*INetworkRestakeDelegatorSetHookIterator, error) {
+
+	var hookRule []interface{}
+	for _, hookItem := range hook {
+		hookRule = append(hookRule, hookItem)
+	}
+
+	logs, sub, err := _INetworkRestakeDelegator.contract.FilterLogs(opts, "SetHook", hookRule)
+	if err != nil {
+		return nil, err
+	}
+	return &INetworkRestakeDelegatorSetHookIterator{contract: _INetworkRestakeDelegator.contract, event: "SetHook", logs: logs, sub: sub}, nil
+}
+
+// WatchSetHook is a free log subscription operation binding the contract event 0x5bbb1d3ebb6a3ad2a0f17ff35e579a83af60604d1d3c2a4c83c62adecadf666d.
+//
+// Solidity: event SetHook(address indexed hook)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorFilterer) WatchSetHook(opts *bind.WatchOpts, sink chan<- *INetworkRestakeDelegatorSetHook, hook []common.Address) (event.Subscription, error) {
+
+	var hookRule []interface{}
+	for _, hookItem := range hook {
+		hookRule = append(hookRule, hookItem)
+	}
+
+	logs, sub, err := _INetworkRestakeDelegator.contract.WatchLogs(opts, "SetHook", hookRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(INetworkRestakeDelegatorSetHook)
+				if err := _INetworkRestakeDelegator.contract.UnpackLog(event, "SetHook", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSetHook is a log parse operation binding the contract event 0x5bbb1d3ebb6a3ad2a0f17ff35e579a83af60604d1d3c2a4c83c62adecadf666d.
+//
+// Solidity: event SetHook(address indexed hook)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorFilterer) ParseSetHook(log types.Log) (*INetworkRestakeDelegatorSetHook, error) {
+	event := new(INetworkRestakeDelegatorSetHook)
+	if err := _INetworkRestakeDelegator.contract.UnpackLog(event, "SetHook", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// INetworkRestakeDelegatorSetMaxNetworkLimitIterator is returned from FilterSetMaxNetworkLimit and is used to iterate over the raw logs and unpacked data for SetMaxNetworkLimit events raised by the INetworkRestakeDelegator contract.
+type INetworkRestakeDelegatorSetMaxNetworkLimitIterator struct {
+	Event *INetworkRestakeDelegatorSetMaxNetworkLimit // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *INetworkRestakeDelegatorSetMaxNetworkLimitIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(INetworkRestakeDelegatorSetMaxNetworkLimit)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(INetworkRestakeDelegatorSetMaxNetworkLimit)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *INetworkRestakeDelegatorSetMaxNetworkLimitIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *INetworkRestakeDelegatorSetMaxNetworkLimitIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// INetworkRestakeDelegatorSetMaxNetworkLimit represents a SetMaxNetworkLimit event raised by the INetworkRestakeDelegator contract.
+type INetworkRestakeDelegatorSetMaxNetworkLimit struct {
+	Subnetwork [32]byte
+	Amount     *big.Int
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterSetMaxNetworkLimit is a free log retrieval operation binding the contract event 0xc67e7929681aa1bccd63f52b3799bf5805f3009f197db6fdf584b14f7fbf608c.
+//
+// Solidity: event SetMaxNetworkLimit(bytes32 indexed subnetwork, uint256 amount)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorFilterer) FilterSetMaxNetworkLimit(opts *bind.FilterOpts, subnetwork [][32]byte) (*INetworkRestakeDelegatorSetMaxNetworkLimitIterator, error) {
+
+	var subnetworkRule []interface{}
+	for _, subnetworkItem := range subnetwork {
+		subnetworkRule = append(subnetworkRule, subnetworkItem)
+	}
+
+	logs, sub, err := _INetworkRestakeDelegator.contract.FilterLogs(opts, "SetMaxNetworkLimit", subnetworkRule)
+	if err != nil {
+		return nil, err
+	}
+	return &INetworkRestakeDelegatorSetMaxNetworkLimitIterator{contract: _INetworkRestakeDelegator.contract, event: "SetMaxNetworkLimit", logs: logs, sub: sub}, nil
+}
+
+// WatchSetMaxNetworkLimit is a free log subscription operation binding the contract event 0xc67e7929681aa1bccd63f52b3799bf5805f3009f197db6fdf584b14f7fbf608c.
+//
+// Solidity: event SetMaxNetworkLimit(bytes32 indexed subnetwork, uint256 amount)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorFilterer) WatchSetMaxNetworkLimit(opts *bind.WatchOpts, sink chan<- *INetworkRestakeDelegatorSetMaxNetworkLimit, subnetwork [][32]byte) (event.Subscription, error) {
+
+	var subnetworkRule []interface{}
+	for _, subnetworkItem := range subnetwork {
+		subnetworkRule = append(subnetworkRule, subnetworkItem)
+	}
+
+	logs, sub, err := _INetworkRestakeDelegator.contract.WatchLogs(opts, "SetMaxNetworkLimit", subnetworkRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(INetworkRestakeDelegatorSetMaxNetworkLimit)
+				if err := _INetworkRestakeDelegator.contract.UnpackLog(event, "SetMaxNetworkLimit", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSetMaxNetworkLimit is a log parse operation binding the contract event 0xc67e7929681aa1bccd63f52b3799bf5805f3009f197db6fdf584b14f7fbf608c.
+//
+// Solidity: event SetMaxNetworkLimit(bytes32 indexed subnetwork, uint256 amount)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorFilterer) ParseSetMaxNetworkLimit(log types.Log) (*INetworkRestakeDelegatorSetMaxNetworkLimit, error) {
+	event := new(INetworkRestakeDelegatorSetMaxNetworkLimit)
+	if err := _INetworkRestakeDelegator.contract.UnpackLog(event, "SetMaxNetworkLimit", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// INetworkRestakeDelegatorSetNetworkLimitIterator is returned from FilterSetNetworkLimit and is used to iterate over the raw logs and unpacked data for SetNetworkLimit events raised by the INetworkRestakeDelegator contract.
+type INetworkRestakeDelegatorSetNetworkLimitIterator struct {
+	Event *INetworkRestakeDelegatorSetNetworkLimit // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *INetworkRestakeDelegatorSetNetworkLimitIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(INetworkRestakeDelegatorSetNetworkLimit)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(INetworkRestakeDelegatorSetNetworkLimit)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *INetworkRestakeDelegatorSetNetworkLimitIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *INetworkRestakeDelegatorSetNetworkLimitIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// INetworkRestakeDelegatorSetNetworkLimit represents a SetNetworkLimit event raised by the INetworkRestakeDelegator contract.
+type INetworkRestakeDelegatorSetNetworkLimit struct {
+	Subnetwork [32]byte
+	Amount     *big.Int
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterSetNetworkLimit is a free log retrieval operation binding the contract event 0x00899d104fc3d8820bd96540612bcc5c448c8837b13b9f7faa43ad0728f0c14f.
+//
+// Solidity: event SetNetworkLimit(bytes32 indexed subnetwork, uint256 amount)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorFilterer) FilterSetNetworkLimit(opts *bind.FilterOpts, subnetwork [][32]byte) (*INetworkRestakeDelegatorSetNetworkLimitIterator, error) {
+
+	var subnetworkRule []interface{}
+	for _, subnetworkItem := range subnetwork {
+		subnetworkRule = append(subnetworkRule, subnetworkItem)
+	}
+
+	logs, sub, err := _INetworkRestakeDelegator.contract.FilterLogs(opts, "SetNetworkLimit", subnetworkRule)
+	if err != nil {
+		return nil, err
+	}
+	return &INetworkRestakeDelegatorSetNetworkLimitIterator{contract: _INetworkRestakeDelegator.contract, event: "SetNetworkLimit", logs: logs, sub: sub}, nil
+}
+
+// WatchSetNetworkLimit is a free log subscription operation binding the contract event 0x00899d104fc3d8820bd96540612bcc5c448c8837b13b9f7faa43ad0728f0c14f.
+//
+// Solidity: event SetNetworkLimit(bytes32 indexed subnetwork, uint256 amount)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorFilterer) WatchSetNetworkLimit(opts *bind.WatchOpts, sink chan<- *INetworkRestakeDelegatorSetNetworkLimit, subnetwork [][32]byte) (event.Subscription, error) {
+
+	var subnetworkRule []interface{}
+	for _, subnetworkItem := range subnetwork {
+		subnetworkRule = append(subnetworkRule, subnetworkItem)
+	}
+
+	logs, sub, err := _INetworkRestakeDelegator.contract.WatchLogs(opts, "SetNetworkLimit", subnetworkRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(INetworkRestakeDelegatorSetNetworkLimit)
+				if err := _INetworkRestakeDelegator.contract.UnpackLog(event, "SetNetworkLimit", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSetNetworkLimit is a log parse operation binding the contract event 0x00899d104fc3d8820bd96540612bcc5c448c8837b13b9f7faa43ad0728f0c14f.
+//
+// Solidity: event SetNetworkLimit(bytes32 indexed subnetwork, uint256 amount)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorFilterer) ParseSetNetworkLimit(log types.Log) (*INetworkRestakeDelegatorSetNetworkLimit, error) {
+	event := new(INetworkRestakeDelegatorSetNetworkLimit)
+	if err := _INetworkRestakeDelegator.contract.UnpackLog(event, "SetNetworkLimit", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// INetworkRestakeDelegatorSetOperatorNetworkSharesIterator is returned from FilterSetOperatorNetworkShares and is used to iterate over the raw logs and unpacked data for SetOperatorNetworkShares events raised by the INetworkRestakeDelegator contract.
+type INetworkRestakeDelegatorSetOperatorNetworkSharesIterator struct {
+	Event *INetworkRestakeDelegatorSetOperatorNetworkShares // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *INetworkRestakeDelegatorSetOperatorNetworkSharesIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(INetworkRestakeDelegatorSetOperatorNetworkShares)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(INetworkRestakeDelegatorSetOperatorNetworkShares)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *INetworkRestakeDelegatorSetOperatorNetworkSharesIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *INetworkRestakeDelegatorSetOperatorNetworkSharesIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// INetworkRestakeDelegatorSetOperatorNetworkShares represents a SetOperatorNetworkShares event raised by the INetworkRestakeDelegator contract.
+type INetworkRestakeDelegatorSetOperatorNetworkShares struct {
+	Subnetwork [32]byte
+	Operator   common.Address
+	Shares     *big.Int
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterSetOperatorNetworkShares is a free log retrieval operation binding the contract event 0x739a5a3ec0ff71e2386d0013deac5f44e0935a98def2e2a5ddf9a709518c8294.
+//
+// Solidity: event SetOperatorNetworkShares(bytes32 indexed subnetwork, address indexed operator, uint256 shares)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorFilterer) FilterSetOperatorNetworkShares(opts *bind.FilterOpts, subnetwork [][32]byte, operator []common.Address) (*INetworkRestakeDelegatorSetOperatorNetworkSharesIterator, error) {
+
+	var subnetworkRule []interface{}
+	for _, subnetworkItem := range subnetwork {
+		subnetworkRule = append(subnetworkRule, subnetworkItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _INetworkRestakeDelegator.contract.FilterLogs(opts, "SetOperatorNetworkShares", subnetworkRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &INetworkRestakeDelegatorSetOperatorNetworkSharesIterator{contract: _INetworkRestakeDelegator.contract, event: "SetOperatorNetworkShares", logs: logs, sub: sub}, nil
+}
+
+// WatchSetOperatorNetworkShares is a free log subscription operation binding the contract event 0x739a5a3ec0ff71e2386d0013deac5f44e0935a98def2e2a5ddf9a709518c8294.
+//
+// Solidity: event SetOperatorNetworkShares(bytes32 indexed subnetwork, address indexed operator, uint256 shares)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorFilterer) WatchSetOperatorNetworkShares(opts *bind.WatchOpts, sink chan<- *INetworkRestakeDelegatorSetOperatorNetworkShares, subnetwork [][32]byte, operator []common.Address) (event.Subscription, error) {
+
+	var subnetworkRule []interface{}
+	for _, subnetworkItem := range subnetwork {
+		subnetworkRule = append(subnetworkRule, subnetworkItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _INetworkRestakeDelegator.contract.WatchLogs(opts, "SetOperatorNetworkShares", subnetworkRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(INetworkRestakeDelegatorSetOperatorNetworkShares)
+				if err := _INetworkRestakeDelegator.contract.UnpackLog(event, "SetOperatorNetworkShares", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSetOperatorNetworkShares is a log parse operation binding the contract event 0x739a5a3ec0ff71e2386d0013deac5f44e0935a98def2e2a5ddf9a709518c8294.
+//
+// Solidity: event SetOperatorNetworkShares(bytes32 indexed subnetwork, address indexed operator, uint256 shares)
+func (_INetworkRestakeDelegator *INetworkRestakeDelegatorFilterer) ParseSetOperatorNetworkShares(log types.Log) (*INetworkRestakeDelegatorSetOperatorNetworkShares, error) {
+	event := new(INetworkRestakeDelegatorSetOperatorNetworkShares)
+	if err := _INetworkRestakeDelegator.contract.UnpackLog(event, "SetOperatorNetworkShares", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,3338 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package networkcontracts
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// IVaultMetaData contains all meta data concerning the IVault contract.
+var IVaultMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"DELEGATOR_FACTORY\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"DEPOSITOR_WHITELIST_ROLE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"DEPOSIT_LIMIT_SET_ROLE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"DEPOSIT_WHITELIST_SET_ROLE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"FACTORY\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"IS_DEPOSIT_LIMIT_SET_ROLE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"SLASHER_FACTORY\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"activeBalanceOf\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"activeBalanceOfAt\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"timestamp\",\"type\":\"uint48\",\"internalType\":\"uint48\"},{\"name\":\"hints\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"activeShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"activeSharesAt\",\"inputs\":[{\"name\":\"timestamp\",\"type\":\"uint48\",\"internalType\":\"uint48\"},{\"name\":\"hint\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"activeSharesOf\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"activeSharesOfAt\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"timestamp\",\"type\":\"uint48\",\"internalType\":\"uint48\"},{\"name\":\"hint\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"activeStake\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"activeStakeAt\",\"inputs\":[{\"name\":\"timestamp\",\"type\":\"uint48\",\"internalType\":\"uint48\"},{\"name\":\"hint\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"burner\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"claim\",\"inputs\":[{\"name\":\"recipient\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"epoch\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"claimBatch\",\"inputs\":[{\"name\":\"recipient\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"epochs\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"outputs\":[{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"collateral\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"currentEpoch\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"currentEpochStart\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint48\",\"internalType\":\"uint48\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"delegator\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"deposit\",\"inputs\":[{\"name\":\"onBehalfOf\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"depositedAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"mintedShares\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"depositLimit\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"depositWhitelist\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"epochAt\",\"inputs\":[{\"name\":\"timestamp\",\"type\":\"uint48\",\"internalType\":\"uint48\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"epochDuration\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint48\",\"internalType\":\"uint48\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"epochDurationInit\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint48\",\"internalType\":\"uint48\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"initialize\",\"inputs\":[{\"name\":\"initialVersion\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"isDelegatorInitialized\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"isDepositLimit\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"isDepositorWhitelisted\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"isInitialized\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"isSlasherInitialized\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"isWithdrawalsClaimed\",\"inputs\":[{\"name\":\"epoch\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"migrate\",\"inputs\":[{\"name\":\"newVersion\",\"type\":\"uint64\",\"internalType\":\"uint64\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"nextEpochStart\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint48\",\"internalType\":\"uint48\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"onSlash\",\"inputs\":[{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"captureTimestamp\",\"type\":\"uint48\",\"internalType\":\"uint48\"}],\"outputs\":[{\"name\":\"slashedAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"previousEpochStart\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint48\",\"internalType\":\"uint48\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"redeem\",\"inputs\":[{\"name\":\"claimer\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"shares\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"withdrawnAssets\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"mintedShares\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setDelegator\",\"inputs\":[{\"name\":\"delegator\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setDepositLimit\",\"inputs\":[{\"name\":\"limit\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setDepositWhitelist\",\"inputs\":[{\"name\":\"status\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setDepositorWhitelistStatus\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"status\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setIsDepositLimit\",\"inputs\":[{\"name\":\"status\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setSlasher\",\"inputs\":[{\"name\":\"slasher\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"slashableBalanceOf\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"slasher\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"totalStake\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"version\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint64\",\"internalType\":\"uint64\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"withdraw\",\"inputs\":[{\"name\":\"claimer\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"burnedShares\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"mintedShares\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"withdrawalShares\",\"inputs\":[{\"name\":\"epoch\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"withdrawalSharesOf\",\"inputs\":[{\"name\":\"epoch\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"withdrawals\",\"inputs\":[{\"name\":\"epoch\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"withdrawalsOf\",\"inputs\":[{\"name\":\"epoch\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"event\",\"name\":\"Claim\",\"inputs\":[{\"name\":\"claimer\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"recipient\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"epoch\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"ClaimBatch\",\"inputs\":[{\"name\":\"claimer\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"recipient\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"epochs\",\"type\":\"uint256[]\",\"indexed\":false,\"internalType\":\"uint256[]\"},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Deposit\",\"inputs\":[{\"name\":\"depositor\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"onBehalfOf\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"shares\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"OnSlash\",\"inputs\":[{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"captureTimestamp\",\"type\":\"uint48\",\"indexed\":false,\"internalType\":\"uint48\"},{\"name\":\"slashedAmount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"SetDelegator\",\"inputs\":[{\"name\":\"delegator\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"SetDepositLimit\",\"inputs\":[{\"name\":\"limit\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"SetDepositWhitelist\",\"inputs\":[{\"name\":\"status\",\"type\":\"bool\",\"indexed\":false,\"internalType\":\"bool\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"SetDepositorWhitelistStatus\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"status\",\"type\":\"bool\",\"indexed\":false,\"internalType\":\"bool\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"SetIsDepositLimit\",\"inputs\":[{\"name\":\"status\",\"type\":\"bool\",\"indexed\":false,\"internalType\":\"bool\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"SetSlasher\",\"inputs\":[{\"name\":\"slasher\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Withdraw\",\"inputs\":[{\"name\":\"withdrawer\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"claimer\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"burnedShares\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"mintedShares\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"AlreadyClaimed\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AlreadyInitialized\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AlreadySet\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"DelegatorAlreadyInitialized\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"DepositLimitReached\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InsufficientClaim\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InsufficientDeposit\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InsufficientRedemption\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InsufficientWithdrawal\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidAccount\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidCaptureEpoch\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidClaimer\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidCollateral\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidDelegator\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidEpoch\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidEpochDuration\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidLengthEpochs\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidOnBehalfOf\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidRecipient\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidSlasher\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidTimestamp\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"MissingRoles\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NoPreviousEpoch\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NotDelegator\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NotFactory\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NotInitialized\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NotSlasher\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NotWhitelistedDepositor\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"SlasherAlreadyInitialized\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"TooMuchRedeem\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"TooMuchWithdraw\",\"inputs\":[]}]",
+}
+
+// IVaultABI is the input ABI used to generate the binding from.
+// Deprecated: Use IVaultMetaData.ABI instead.
+var IVaultABI = IVaultMetaData.ABI
+
+// IVault is an auto generated Go binding around an Ethereum contract.
+type IVault struct {
+	IVaultCaller     // Read-only binding to the contract
+	IVaultTransactor // Write-only binding to the contract
+	IVaultFilterer   // Log filterer for contract events
+}
+
+// IVaultCaller is an auto generated read-only Go binding around an Ethereum contract.
+type IVaultCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IVaultTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type IVaultTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IVaultFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type IVaultFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IVaultSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type IVaultSession struct {
+	Contract     *IVault           // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// IVaultCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type IVaultCallerSession struct {
+	Contract *IVaultCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts // Call options to use throughout this session
+}
+
+// IVaultTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type IVaultTransactorSession struct {
+	Contract     *IVaultTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// IVaultRaw is an auto generated low-level Go binding around an Ethereum contract.
+type IVaultRaw struct {
+	Contract *IVault // Generic contract binding to access the raw methods on
+}
+
+// IVaultCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type IVaultCallerRaw struct {
+	Contract *IVaultCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// IVaultTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type IVaultTransactorRaw struct {
+	Contract *IVaultTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewIVault creates a new instance of IVault, bound to a specific deployed contract.
+func NewIVault(address common.Address, backend bind.ContractBackend) (*IVault, error) {
+	contract, err := bindIVault(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &IVault{IVaultCaller: IVaultCaller{contract: contract}, IVaultTransactor: IVaultTransactor{contract: contract}, IVaultFilterer: IVaultFilterer{contract: contract}}, nil
+}
+
+// NewIVaultCaller creates a new read-only instance of IVault, bound to a specific deployed contract.
+func NewIVaultCaller(address common.Address, caller bind.ContractCaller) (*IVaultCaller, error) {
+	contract, err := bindIVault(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IVaultCaller{contract: contract}, nil
+}
+
+// NewIVaultTransactor creates a new write-only instance of IVault, bound to a specific deployed contract.
+func NewIVaultTransactor(address common.Address, transactor bind.ContractTransactor) (*IVaultTransactor, error) {
+	contract, err := bindIVault(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IVaultTransactor{contract: contract}, nil
+}
+
+// NewIVaultFilterer creates a new log filterer instance of IVault, bound to a specific deployed contract.
+func NewIVaultFilterer(address common.Address, filterer bind.ContractFilterer) (*IVaultFilterer, error) {
+	contract, err := bindIVault(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &IVaultFilterer{contract: contract}, nil
+}
+
+// bindIVault binds a generic wrapper to an already deployed contract.
+func bindIVault(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := IVaultMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_IVault *IVaultRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _IVault.Contract.IVaultCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_IVault *IVaultRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IVault.Contract.IVaultTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_IVault *IVaultRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _IVault.Contract.IVaultTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_IVault *IVaultCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _IVault.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_IVault *IVaultTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IVault.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_IVault *IVaultTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _IVault.Contract.contract.Transact(opts, method, params...)
+}
+
+// DELEGATORFACTORY is a free data retrieval call binding the contract method 0x6da3e06d.
+//
+// Solidity: function DELEGATOR_FACTORY() view returns(address)
+func (_IVault *IVaultCaller) DELEGATORFACTORY(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _IVault.contract.Call(opts, &out, "DELEGATOR_FACTORY")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// DELEGATORFACTORY is a free data retrieval call binding the contract method 0x6da3e06d.
+//
+// Solidity: function DELEGATOR_FACTORY() view returns(address)
+func (_IVault *IVaultSession) DELEGATORFACTORY() (common.Address, error) {
+	return _IVault.Contract.DELEGATORFACTORY(&_IVault.CallOpts)
+}
+
+// DELEGATORFACTORY is a free data retrieval call binding the contract method 0x6da3e06d.
+//
+// Solidity: function DELEGATOR_FACTORY() view returns(address)
+func (_IVault *IVaultCallerSession) DELEGATORFACTORY() (common.Address, error) {
+	return _IVault.Contract.DELEGATORFACTORY(&_IVault.CallOpts)
+}
+
+// DEPOSITORWHITELISTROLE is a free data retrieval call binding the contract method 0x1b66c9e1.
+//
+// Solidity: function DEPOSITOR_WHITELIST_ROLE() view returns(bytes32)
+func (_IVault *IVaultCaller) DEPOSITORWHITELISTROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _IVault.contract.Call(opts, &out, "DEPOSITOR_WHITELIST_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// DEPOSITORWHITELISTROLE is a free data retrieval call binding the contract method 0x1b66c9e1.
+//
+// Solidity: function DEPOSITOR_WHITELIST_ROLE() view returns(bytes32)
+func (_IVault *IVaultSession) DEPOSITORWHITELISTROLE() ([32]byte, error) {
+	return _IVault.Contract.DEPOSITORWHITELISTROLE(&_IVault.CallOpts)
+}
+
+// DEPOSITORWHITELISTROLE is a free data retrieval call binding the contract method 0x1b66c9e1.
+//
+// Solidity: function DEPOSITOR_WHITELIST_ROLE() view returns(bytes32)
+func (_IVault *IVaultCallerSession) DEPOSITORWHITELISTROLE() ([32]byte, error) {
+	return _IVault.Contract.DEPOSITORWHITELISTROLE(&_IVault.CallOpts)
+}
+
+// DEPOSITLIMITSETROLE is a free data retrieval call binding the contract method 0xa21a1df9.
+//
+// Solidity: function DEPOSIT_LIMIT_SET_ROLE() view returns(bytes32)
+func (_IVault *IVaultCaller) DEPOSITLIMITSETROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _IVault.contract.Call(opts, &out, "DEPOSIT_LIMIT_SET_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// DEPOSITLIMITSETROLE is a free data retrieval call binding the contract method 0xa21a1df9.
+//
+// Solidity: function DEPOSIT_LIMIT_SET_ROLE() view returns(bytes32)
+func (_IVault *IVaultSession) DEPOSITLIMITSETROLE() ([32]byte, error) {
+	return _IVault.Contract.DEPOSITLIMITSETROLE(&_IVault.CallOpts)
+}
+
+// DEPOSITLIMITSETROLE is a free data retrieval call binding the contract method 0xa21a1df9.
+//
+// Solidity: function DEPOSIT_LIMIT_SET_ROLE() view returns(bytes32)
+func (_IVault *IVaultCallerSession) DEPOSITLIMITSETROLE() ([32]byte, error) {
+	return _IVault.Contract.DEPOSITLIMITSETROLE(&_IVault.CallOpts)
+}
+
+// DEPOSITWHITELISTSETROLE is a free data retrieval call binding the contract method 0xdb388715.
+//
+// Solidity: function DEPOSIT_WHITELIST_SET_ROLE() view returns(bytes32)
+func (_IVault *IVaultCaller) DEPOSITWHITELISTSETROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _IVault.contract.Call(opts, &out, "DEPOSIT_WHITELIST_SET_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// DEPOSITWHITELISTSETROLE is a free data retrieval call binding the contract method 0xdb388715.
+//
+// Solidity: function DEPOSIT_WHITELIST_SET_ROLE() view returns(bytes32)
+func (_IVault *IVaultSession) DEPOSITWHITELISTSETROLE() ([32]byte, error) {
+	return _IVault.Contract.DEPOSITWHITELISTSETROLE(&_IVault.CallOpts)
+}
+
+// DEPOSITWHITELISTSETROLE is a free data retrieval call binding the contract method 0xdb388715.
+//
+// Solidity: function DEPOSIT_WHITELIST_SET_ROLE() view returns(bytes32)
+func (_IVault *IVaultCallerSession) DEPOSITWHITELISTSETROLE() ([32]byte, error) {
+	return _IVault.Contract.DEPOSITWHITELISTSETROLE(&_IVault.CallOpts)
+}
+
+// FACTORY is a free data retrieval call binding the contract method 0x2dd31000.
+//
+// Solidity: function FACTORY() view returns(address)
+func (_IVault *IVaultCaller) FACTORY(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _IVault.contract.Call(opts, &out, "FACTORY")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// FACTORY is a free data retrieval call binding the contract method 0x2dd31000.
+//
+// Solidity: function FACTORY() view returns(address)
+func (_IVault *IVaultSession) FACTORY() (common.Address, error) {
+	return _IVault.Contract.FACTORY(&_IVault.CallOpts)
+}
+
+// FACTORY is a free data retrieval call binding the contract method 0x2dd31000.
+//
+// Solidity: function FACTORY() view returns(address)
+func (_IVault *IVaultCallerSession) FACTORY() (common.Address, error) {
+	return _IVault.Contract.FACTORY(&_IVault.CallOpts)
+}
+
+// ISDEPOSITLIMITSETROLE is a free data retrieval call binding the contract method 0x1415519b.
+//
+// Solidity: function IS_DEPOSIT_LIMIT_SET_ROLE() view returns(bytes32)
+func (_IVault *IVaultCaller) ISDEPOSITLIMITSETROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _IVault.contract.Call(opts, &out, "IS_DEPOSIT_LIMIT_SET_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// ISDEPOSITLIMITSETROLE is a free data retrieval call binding the contract method 0x1415519b.
+//
+// Solidity: function IS_DEPOSIT_LIMIT_SET_ROLE() view returns(bytes32)
+func (_IVault *IVaultSession) ISDEPOSITLIMITSETROLE() ([32]byte, error) {
+	return _IVault.Contract.ISDEPOSITLIMITSETROLE(&_IVault.CallOpts)
+}
+
+// ISDEPOSITLIMITSETROLE is a free data retrieval call binding the contract method 0x1415519b.
+//
+// Solidity: function IS_DEPOSIT_LIMIT_SET_ROLE() view returns(bytes32)
+func (_IVault *IVaultCallerSession) ISDEPOSITLIMITSETROLE() ([32]byte, error) {
+	return _IVault.Contract.ISDEPOSITLIMITSETROLE(&_IVault.CallOpts)
+}
+
+// SLASHERFACTORY is a free data retrieval call binding the contract method 0x87df0788.
+//
+// Solidity: function SLASHER_FACTORY() view returns(address)
+func (_IVault *IVaultCaller) SLASHERFACTORY(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _IVault.contract.Call(opts, &out, "SLASHER_FACTORY")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SLASHERFACTORY is a free data retrieval call binding the contract method 0x87df0788.
+//
+// Solidity: function SLASHER_FACTORY() view returns(address)
+func (_IVault *IVaultSession) SLASHERFACTORY() (common.Address, error) {
+	return _IVault.Contract.SLASHERFACTORY(&_IVault.CallOpts)
+}
+
+// SLASHERFACTORY is a free data retrieval call binding the contract method 0x87df0788.
+//
+// Solidity: function SLASHER_FACTORY() view returns(address)
+func (_IVault *IVaultCallerSession) SLASHERFACTORY() (common.Address, error) {
+	return _IVault.Contract.SLASHERFACTORY(&_IVault.CallOpts)
+}
+
+// ActiveBalanceOf is a free data retrieval call binding the contract method 0x59f769a9.
+//
+// Solidity: function activeBalanceOf(address account) view returns(uint256)
+func (_IVault *IVaultCaller) ActiveBalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _IVault.contract.Call(opts, &out, "activeBalanceOf", account)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ActiveBalanceOf is a free data retrieval call binding the contract method 0x59f769a9.
+//
+// Solidity: function activeBalanceOf(address account) view returns(uint256)
+func (_IVault *IVaultSession) ActiveBalanceOf(account common.Address) (*big.Int, error) {
+	return _IVault.Contract.ActiveBalanceOf(&_IVault.CallOpts, account)
+}
+
+// ActiveBalanceOf is a free data retrieval call binding the contract method 0x59f769a9.
+//
+// Solidity: function activeBalanceOf(address account) view returns(uint256)
+func (_IVault *IVaultCallerSession) ActiveBalanceOf(account common.Address) (*big.Int, error) {
+	return _IVault.Contract.ActiveBalanceOf(&_IVault.CallOpts, account)
+}
+
+// ActiveBalanceOfAt is a free data retrieval call binding the contract method 0xefb559d6.
+//
+// Solidity: function activeBalanceOfAt(address account, uint48 timestamp, bytes hints) view returns(uint256)
+func (_IVault *IVaultCaller) ActiveBalanceOfAt(opts *bind.CallOpts, account common.Address, timestamp *big.Int, hints []byte) (*big.Int, error) {
+	var out []interface{}
+	err := _IVault.contract.Call(opts, &out, "activeBalanceOfAt", account, timestamp, hints)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ActiveBalanceOfAt is a free data retrieval call binding the contract method 0xefb559d6.
+//
+// Solidity: function activeBalanceOfAt(address account, uint48 timestamp, bytes hints) view returns(uint256)
+func (_IVault *IVaultSession) ActiveBalanceOfAt(account common.Address, timestamp *big.Int, hints []byte) (*big.Int, error) {
+	return _IVault.Contract.ActiveBalanceOfAt(&_IVault.CallOpts, account, timestamp, hints)
+}
+
+// ActiveBalanceOfAt is a free data retrieval call binding the contract method 0xefb559d6.
+//
+// Solidity: function activeBalanceOfAt(address account, uint48 timestamp, bytes hints) view returns(uint256)
+func (_IVault *IVaultCallerSession) ActiveBalanceOfAt(account common.Address, timestamp *big.Int, hints []byte) (*big.Int, error) {
+	return _IVault.Contract.ActiveBalanceOfAt(&_IVault.CallOpts, account, timestamp, hints)
+}
+
+// ActiveShares is a free data retrieval call binding the contract method 0xbfefcd7b.
+//
+// Solidity: function activeShares() view returns(uint256)
+func (_IVault *IVaultCaller) ActiveShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _IVault.contract.Call(opts, &out, "activeShares")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ActiveShares is a free data retrieval call binding the contract method 0xbfefcd7b.
+//
+// Solidity: function activeShares() view returns(uint256)
+func (_IVault *IVaultSession) ActiveShares() (*big.Int, error) {
+	return _IVault.Contract.ActiveShares(&_IVault.CallOpts)
+}
+
+// ActiveShares is a free data retrieval call binding the contract method 0xbfefcd7b.
+//
+// Solidity: function activeShares() view returns(uint256)
+func (_IVault *IVaultCallerSession) ActiveShares() (*big.Int, error) {
+	return _IVault.Contract.ActiveShares(&_IVault.CallOpts)
+}
+
+// ActiveSharesAt is a free data retrieval call binding the contract method 0x50f22068.
+//
+// Solidity: function activeSharesAt(uint48 timestamp, bytes hint) view returns(uint256)
+func (_IVault *IVaultCaller) ActiveSharesAt(opts *bind.CallOpts, timestamp *big.Int, hint []byte) (*big.Int, error) {
+	var out []interface{}
+	err := _IVault.contract.Call(opts, &out, "activeSharesAt", timestamp, hint)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ActiveSharesAt is a free data retrieval call binding the contract method 0x50f22068.
+//
+// Solidity: function activeSharesAt(uint48 timestamp, bytes hint) view returns(uint256)
+func (_IVault *IVaultSession) ActiveSharesAt(timestamp *big.Int, hint []byte) (*big.Int, error) {
+	return _IVault.Contract.ActiveSharesAt(&_IVault.CallOpts, timestamp, hint)
+}
+
+// ActiveSharesAt is a free data retrieval call binding the contract method 0x50f22068.
+//
+// Solidity: function activeSharesAt(uint48 timestamp, bytes hint) view returns(uint256)
+func (_IVault *IVaultCallerSession) ActiveSharesAt(timestamp *big.Int, hint []byte) (*big.Int, error) {
+	return _IVault.Contract.ActiveSharesAt(&_IVault.CallOpts, timestamp, hint)
+}
+
+// ActiveSharesOf is a free data retrieval call binding the contract method 0x9d66201b.
+//
+// Solidity: function activeSharesOf(address account) view returns(uint256)
+func (_IVault *IVaultCaller) ActiveSharesOf(opts *bind.CallOpts, account common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _IVault.contract.Call(opts, &out, "activeSharesOf", account)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ActiveSharesOf is a free data retrieval call binding the contract method 0x9d66201b.
+//
+// Solidity: function activeSharesOf(address account) view returns(uint256)
+func (_IVault *IVaultSession) ActiveSharesOf(account common.Address) (*big.Int, error) {
+	return _IVault.Contract.ActiveSharesOf(&_IVault.CallOpts, account)
+}
+
+// ActiveSharesOf is a free data retrieval call binding the contract method 0x9d66201b.
+//
+// Solidity: function activeSharesOf(address account) view returns(uint256)
+func (_IVault *IVaultCallerSession) ActiveSharesOf(account common.Address) (*big.Int, error) {
+	return _IVault.Contract.ActiveSharesOf(&_IVault.CallOpts, account)
+}
+
+// ActiveSharesOfAt is a free data retrieval call binding the contract method 0x2d73c69c.
+//
+// Solidity: function activeSharesOfAt(address account, uint48 timestamp, bytes hint) view returns(uint256)
+func (_IVault *IVaultCaller) ActiveSharesOfAt(opts *bind.CallOpts, account common.Address, timestamp *big.Int, hint []byte) (*big.Int, error) {
+	var out []interface{}
+	err := _IVault.contract.Call(opts, &out, "activeSharesOfAt", account, timestamp, hint)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ActiveSharesOfAt is a free data retrieval call binding the contract method 0x2d73c69c.
+//
+// Solidity: function activeSharesOfAt(address account, uint48 timestamp, bytes hint) view returns(uint256)
+func (_IVault *IVaultSession) ActiveSharesOfAt(account common.Address, timestamp *big.Int, hint []byte) (*big.Int, error) {
+	return _IVault.Contract.ActiveSharesOfAt(&_IVault.CallOpts, account, timestamp, hint)
+}
+
+// ActiveSharesOfAt is a free data retrieval call binding the contract method 0x2d73c69c.
+//
+// Solidity: function activeSharesOfAt(address account, uint48 timestamp, bytes hint) view returns(uint256)
+func (_IVault *IVaultCallerSession) ActiveSharesOfAt(account common.Address, timestamp *big.Int, hint []byte) (*big.Int, error) {
+	return _IVault.Contract.ActiveSharesOfAt(&_IVault.CallOpts, account, timestamp, hint)
+}
+
+// ActiveStake is a free data retrieval call binding the contract method 0xbd49c35f.
+//
+// Solidity: function activeStake() view returns(uint256)
+func (_IVault *IVaultCaller) ActiveStake(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _IVault.contract.Call(opts, &out, "activeStake")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ActiveStake is a free data retrieval call binding the contract method 0xbd49c35f.
+//
+// Solidity: function activeStake() view returns(uint256)
+func (_IVault *IVaultSession) ActiveStake() (*big.Int, error) {
+	return _IVault.Contract.ActiveStake(&_IVault.CallOpts)
+}
+
+// ActiveStake is a free data retrieval call binding the contract method 0xbd49c35f.
+//
+// Solidity: function activeStake() view returns(uint256)
+func (_IVault *IVaultCallerSession) ActiveStake() (*big.Int, error) {
+	return _IVault.Contract.ActiveStake(&_IVault.CallOpts)
+}
+
+// ActiveStakeAt is a free data retrieval call binding the contract method 0x810da75d.
+//
+// Solidity: function activeStakeAt(uint48 timestamp, bytes hint) view returns(uint256)
+func (_IVault *IVaultCaller) ActiveStakeAt(opts *bind.CallOpts, timestamp *big.Int, hint []byte) (*big.Int, error) {
+	var out []interface{}
+	err := _IVault.contract.Call(opts, &out, "activeStakeAt", timestamp, hint)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ActiveStakeAt is a free data retrieval call binding the contract method 0x810da75d.
+//
+// Solidity: function activeStakeAt(uint48 timestamp, bytes hint) view returns(uint256)
+func (_IVault *IVaultSession) ActiveStakeAt(timestamp *big.Int, hint []byte) (*big.Int, error) {
+	return _IVault.Contract.ActiveStakeAt(&_IVault.CallOpts, timestamp, hint)
+}
+
+// ActiveStakeAt is a free data retrieval call binding the contract method 0x810da75d.
+//
+// Solidity: function activeStakeAt(uint48 timestamp, bytes hint) view returns(uint256)
+func (_IVault *IVaultCallerSession) ActiveStakeAt(timestamp *big.Int, hint []byte) (*big.Int, error) {
+	return _IVault.Contract.ActiveStakeAt(&_IVault.CallOpts, timestamp, hint)
+}
+
+// Burner is a free data retrieval call binding the contract method 0x27810b6e.
+//
+// Solidity: function burner() view returns(address)
+func (_IVault *IVaultCaller) Burner(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _IVault.contract.Call(opts, &out, "burner")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Burner is a free data retrieval call binding the contract method 0x27810b6e.
+//
+// Solidity: function burner() view returns(address)
+func (_IVault *IVaultSession) Burner() (common.Address, error) {
+	return _IVault.Contract.Burner(&_IVault.CallOpts)
+}
+
+// Burner is a free data retrieval call binding the contract method 0x27810b6e.
+//
+// Solidity: function burner() view returns(address)
+func (_IVault *IVaultCallerSession) Burner() (common.Address, error) {
+	return _IVault.Contract.Burner(&_IVault.CallOpts)
+}
+
+// Collateral is a free data retrieval call binding the contract method 0xd8dfeb45.
+//
+// Solidity: function collateral() view returns(address)
+func (_IVault *IVaultCaller) Collateral(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _IVault.contract.Call(opts, &out, "collateral")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Collateral is a free data retrieval call binding the contract method 0xd8dfeb45.
+//
+// Solidity: function collateral() view returns(address)
+func (_IVault *IVaultSession) Collateral() (common.Address, error) {
+	return _IVault.Contract.Collateral(&_IVault.CallOpts)
+}
+
+// Collateral is a free data retrieval call binding the contract method 0xd8dfeb45.
+//
+// Solidity: function collateral() view returns(address)
+func (_IVault *IVaultCallerSession) Collateral() (common.Address, error) {
+	return _IVault.Contract.Collateral(&_IVault.CallOpts)
+}
+
+// CurrentEpoch is a free data retrieval call binding the contract method 0x76671808.
+//
+// Solidity: function currentEpoch() view returns(uint256)
+func (_IVault *IVaultCaller) CurrentEpoch(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _IVault.contract.Call(opts, &out, "currentEpoch")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// CurrentEpoch is a free data retrieval call binding the contract method 0x76671808.
+//
+// Solidity: function currentEpoch() view returns(uint256)
+func (_IVault *IVaultSession) CurrentEpoch() (*big.Int, error) {
+	return _IVault.Contract.CurrentEpoch(&_IVault.CallOpts)
+}
+
+// CurrentEpoch is a free data retrieval call binding the contract method 0x76671808.
+//
+// Solidity: function currentEpoch() view returns(uint256)
+func (_IVault *IVaultCallerSession) CurrentEpoch() (*big.Int, error) {
+	return _IVault.Contract.CurrentEpoch(&_IVault.CallOpts)
+}
+
+// CurrentEpochStart is a free data retrieval call binding the contract method 0x61a8c8c4.
+//
+// Solidity: function currentEpochStart() view returns(uint48)
+func (_IVault *IVaultCaller) CurrentEpochStart(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _IVault.contract.Call(opts, &out, "currentEpochStart")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// CurrentEpochStart is a free data retrieval call binding the contract method 0x61a8c8c4.
+//
+// Solidity: function currentEpochStart() view returns(uint48)
+func (_IVault *IVaultSession) CurrentEpochStart() (*big.Int, error) {
+	return _IVault.Contract.CurrentEpochStart(&_IVault.CallOpts)
+}
+
+// CurrentEpochStart is a free data retrieval call binding the contract method 0x61a8c8c4.
+//
+// Solidity: function currentEpochStart() view returns(uint48)
+func (_IVault *IVaultCallerSession) CurrentEpochStart() (*big.Int, error) {
+	return _IVault.Contract.CurrentEpochStart(&_IVault.CallOpts)
+}
+
+// Delegator is a free data retrieval call binding the contract method 0xce9b7930.
+//
+// Solidity: function delegator() view returns(address)
+func (_IVault *IVaultCaller) Delegator(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _IVault.contract.Call(opts, &out, "delegator")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Delegator is a free data retrieval call binding the contract method 0xce9b7930.
+//
+// Solidity: function delegator() view returns(address)
+func (_IVault *IVaultSession) Delegator() (common.Address, error) {
+	return _IVault.Contract.Delegator(&_IVault.CallOpts)
+}
+
+// Delegator is a free data retrieval call binding the contract method 0xce9b7930.
+//
+// Solidity: function delegator() view returns(address)
+func (_IVault *IVaultCallerSession) Delegator() (common.Address, error) {
+	return _IVault.Contract.Delegator(&_IVault.CallOpts)
+}
+
+// DepositLimit is a free data retrieval call binding the contract method 0xecf70858.
+//
+// Solidity: function depositLimit() view returns(uint256)
+func (_IVault *IVaultCaller) DepositLimit(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _IVault.contract.Call(opts, &out, "depositLimit")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// DepositLimit is a free data retrieval call binding the contract method 0xecf70858.
+//
+// Solidity: function depositLimit() view returns(uint256)
+func (_IVault *IVaultSession) DepositLimit() (*big.Int, error) {
+	return _IVault.Contract.DepositLimit(&_IVault.CallOpts)
+}
+
+// DepositLimit is a free data retrieval call binding the contract method 0xecf70858.
+//
+// Solidity: function depositLimit() view returns(uint256)
+func (_IVault *IVaultCallerSession) DepositLimit() (*big.Int, error) {
+	return _IVault.Contract.DepositLimit(&_IVault.CallOpts)
+}
+
+// DepositWhitelist is a free data retrieval call binding the contract method 0x48d3b775.
+//
+// Solidity: function depositWhitelist() view returns(bool)
+func (_IVault *IVaultCaller) DepositWhitelist(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _IVault.contract.Call(opts, &out, "depositWhitelist")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// DepositWhitelist is a free data retrieval call binding the contract method 0x48d3b775.
+//
+// Solidity: function depositWhitelist() view returns(bool)
+func (_IVault *IVaultSession) DepositWhitelist() (bool, error) {
+	return _IVault.Contract.DepositWhitelist(&_IVault.CallOpts)
+}
+
+// DepositWhitelist is a free data retrieval call binding the contract method 0x48d3b775.
+//
+// Solidity: function depositWhitelist() view returns(bool)
+func (_IVault *IVaultCallerSession) DepositWhitelist() (bool, error) {
+	return _IVault.Contract.DepositWhitelist(&_IVault.CallOpts)
+}
+
+// EpochAt is a free data retrieval call binding the contract method 0x7953b33b.
+//
+// Solidity: function epochAt(uint48 timestamp) view returns(uint256)
+func (_IVault *IVaultCaller) EpochAt(opts *bind.CallOpts, timestamp *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _IVault.contract.Call(opts, &out, "epochAt", timestamp)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// EpochAt is a free data retrieval call binding the contract method 0x7953b33b.
+//
+// Solidity: function epochAt(uint48 timestamp) view returns(uint256)
+func (_IVault *IVaultSession) EpochAt(timestamp *big.Int) (*big.Int, error) {
+	return _IVault.Contract.EpochAt(&_IVault.CallOpts, timestamp)
+}
+
+// EpochAt is a free data retrieval call binding the contract method 0x7953b33b.
+//
+// Solidity: function epochAt(uint48 timestamp) view returns(uint256)
+func (_IVault *IVaultCallerSession) EpochAt(timestamp *big.Int) (*big.Int, error) {
+	return _IVault.Contract.EpochAt(&_IVault.CallOpts, timestamp)
+}
+
+// EpochDuration is a free data retrieval call binding the contract method 0x4ff0876a.
+//
+// Solidity: function epochDuration() view returns(uint48)
+func (_IVault *IVaultCaller) EpochDuration(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _IVault.contract.Call(opts, &out, "epochDuration")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// EpochDuration is a free data retrieval call binding the contract method 0x4ff0876a.
+//
+// Solidity: function epochDuration() view returns(uint48)
+func (_IVault *IVaultSession) EpochDuration() (*big.Int, error) {
+	return _IVault.Contract.EpochDuration(&_IVault.CallOpts)
+}
+
+// EpochDuration is a free data retrieval call binding the contract method 0x4ff0876a.
+//
+// Solidity: function epochDuration() view returns(uint48)
+func (_IVault *IVaultCallerSession) EpochDuration() (*big.Int, error) {
+	return _IVault.Contract.EpochDuration(&_IVault.CallOpts)
+}
+
+// EpochDurationInit is a free data retrieval call binding the contract method 0x46361671.
+//
+// Solidity: function epochDurationInit() view returns(uint48)
+func (_IVault *IVaultCaller) EpochDurationInit(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _IVault.contract.Call(opts, &out, "epochDurationInit")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// EpochDurationInit is a free data retrieval call binding the contract method 0x46361671.
+//
+// Solidity: function epochDurationInit() view returns(uint48)
+func (_IVault *IVaultSession) EpochDurationInit() (*big.Int, error) {
+	return _IVault.Contract.EpochDurationInit(&_IVault.CallOpts)
+}
+
+// EpochDurationInit is a free data retrieval call binding the contract method 0x46361671.
+//
+// Solidity: function epochDurationInit() view returns(uint48)
+func (_IVault *IVaultCallerSession) EpochDurationInit() (*big.Int, error) {
+	return _IVault.Contract.EpochDurationInit(&_IVault.CallOpts)
+}
+
+// IsDelegatorInitialized is a free data retrieval call binding the contract method 0x50861adc.
+//
+// Solidity: function isDelegatorInitialized() view returns(bool)
+func (_IVault *IVaultCaller) IsDelegatorInitialized(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _IVault.contract.Call(opts, &out, "isDelegatorInitialized")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsDelegatorInitialized is a free data retrieval call binding the contract method 0x50861adc.
+//
+// Solidity: function isDelegatorInitialized() view returns(bool)
+func (_IVault *IVaultSession) IsDelegatorInitialized() (bool, error) {
+	return _IVault.Contract.IsDelegatorInitialized(&_IVault.CallOpts)
+}
+
+// IsDelegatorInitialized is a free data retrieval call binding the contract method 0x50861adc.
+//
+// Solidity: function isDelegatorInitialized() view returns(bool)
+func (_IVault *IVaultCallerSession) IsDelegatorInitialized() (bool, error) {
+	return _IVault.Contract.IsDelegatorInitialized(&_IVault.CallOpts)
+}
+
+// IsDepositLimit is a free data retrieval call binding the contract method 0xa1b12202.
+//
+// Solidity: function isDepositLimit() view returns(bool)
+func (_IVault *IVaultCaller) IsDepositLimit(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _IVault.contract.Call(opts, &out, "isDepositLimit")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsDepositLimit is a free data retrieval call binding the contract method 0xa1b12202.
+//
+// Solidity: function isDepositLimit() view returns(bool)
+func (_IVault *IVaultSession) IsDepositLimit() (bool, error) {
+	return _IVault.Contract.IsDepositLimit(&_IVault.CallOpts)
+}
+
+// IsDepositLimit is a free data retrieval call binding the contract method 0xa1b12202.
+//
+// Solidity: function isDepositLimit() view returns(bool)
+func (_IVault *IVaultCallerSession) IsDepositLimit() (bool, error) {
+	return _IVault.Contract.IsDepositLimit(&_IVault.CallOpts)
+}
+
+// IsDepositorWhitelisted is a free data retrieval call binding the contract method 0x794b15b7.
+//
+// Solidity: function isDepositorWhitelisted(address account) view returns(bool)
+func (_IVault *IVaultCaller) IsDepositorWhitelisted(opts *bind.CallOpts, account common.Address) (bool, error) {
+	var out []interface{}
+	err := _IVault.contract.Call(opts, &out, "isDepositorWhitelisted", account)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsDepositorWhitelisted is a free data retrieval call binding the contract method 0x794b15b7.
+//
+// Solidity: function isDepositorWhitelisted(address account) view returns(bool)
+func (_IVault *IVaultSession) IsDepositorWhitelisted(account common.Address) (bool, error) {
+	return _IVault.Contract.IsDepositorWhitelisted(&_IVault.CallOpts, account)
+}
+
+// IsDepositorWhitelisted is a free data retrieval call binding the contract method 0x794b15b7.
+//
+// Solidity: function isDepositorWhitelisted(address account) view returns(bool)
+func (_IVault *IVaultCallerSession) IsDepositorWhitelisted(account common.Address) (bool, error) {
+	return _IVault.Contract.IsDepositorWhitelisted(&_IVault.CallOpts, account)
+}
+
+// IsInitialized is a free data retrieval call binding the contract method 0x392e53cd.
+//
+// Solidity: function isInitialized() view returns(bool)
+func (_IVault *IVaultCaller) IsInitialized(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _IVault.contract.Call(opts, &out, "isInitialized")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsInitialized is a free data retrieval call binding the contract method 0x392e53cd.
+//
+// Solidity: function isInitialized() view returns(bool)
+func (_IVault *IVaultSession) IsInitialized() (bool, error) {
+	return _IVault.Contract.IsInitialized(&_IVault.CallOpts)
+}
+
+// IsInitialized is a free data retrieval call binding the contract method 0x392e53cd.
+//
+// Solidity: function isInitialized() view returns(bool)
+func (_IVault *IVaultCallerSession) IsInitialized() (bool, error) {
+	return _IVault.Contract.IsInitialized(&_IVault.CallOpts)
+}
+
+// IsSlasherInitialized is a free data retrieval call binding the contract method 0x6ec1e3f8.
+//
+// Solidity: function isSlasherInitialized() view returns(bool)
+func (_IVault *IVaultCaller) IsSlasherInitialized(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _IVault.contract.Call(opts, &out, "isSlasherInitialized")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsSlasherInitialized is a free data retrieval call binding the contract method 0x6ec1e3f8.
+//
+// Solidity: function isSlasherInitialized() view returns(bool)
+func (_IVault *IVaultSession) IsSlasherInitialized() (bool, error) {
+	return _IVault.Contract.IsSlasherInitialized(&_IVault.CallOpts)
+}
+
+// IsSlasherInitialized is a free data retrieval call binding the contract method 0x6ec1e3f8.
+//
+// Solidity: function isSlasherInitialized() view returns(bool)
+func (_IVault *IVaultCallerSession) IsSlasherInitialized() (bool, error) {
+	return _IVault.Contract.IsSlasherInitialized(&_IVault.CallOpts)
+}
+
+// IsWithdrawalsClaimed is a free data retrieval call binding the contract method 0xa5d03223.
+//
+// Solidity: function isWithdrawalsClaimed(uint256 epoch, address account) view returns(bool)
+func (_IVault *IVaultCaller) IsWithdrawalsClaimed(opts *bind.CallOpts, epoch *big.Int, account common.Address) (bool, error) {
+	var out []interface{}
+	err := _IVault.contract.Call(opts, &out, "isWithdrawalsClaimed", epoch, account)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsWithdrawalsClaimed is a free data retrieval call binding the contract method 0xa5d03223.
+//
+// Solidity: function isWithdrawalsClaimed(uint256 epoch, address account) view returns(bool)
+func (_IVault *IVaultSession) IsWithdrawalsClaimed(epoch *big.Int, account common.Address) (bool, error) {
+	return _IVault.Contract.IsWithdrawalsClaimed(&_IVault.CallOpts, epoch, account)
+}
+
+// IsWithdrawalsClaimed is a free data retrieval call binding the contract method 0xa5d03223.
+//
+// Solidity: function isWithdrawalsClaimed(uint256 epoch, address account) view returns(bool)
+func (_IVault *IVaultCallerSession) IsWithdrawalsClaimed(epoch *big.Int, account common.Address) (bool, error) {
+	return _IVault.Contract.IsWithdrawalsClaimed(&_IVault.CallOpts, epoch, account)
+}
+
+// NextEpochStart is a free data retrieval call binding the contract method 0x73790ab3.
+//
+// Solidity: function nextEpochStart() view returns(uint48)
+func (_IVault *IVaultCaller) NextEpochStart(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _IVault.contract.Call(opts, &out, "nextEpochStart")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// NextEpochStart is a free data retrieval call binding the contract method 0x73790ab3.
+//
+// Solidity: function nextEpochStart() view returns(uint48)
+func (_IVault *IVaultSession) NextEpochStart() (*big.Int, error) {
+	return _IVault.Contract.NextEpochStart(&_IVault.CallOpts)
+}
+
+// NextEpochStart is a free data retrieval call binding the contract method 0x73790ab3.
+//
+// Solidity: function nextEpochStart() view returns(uint48)
+func (_IVault *IVaultCallerSession) NextEpochStart() (*big.Int, error) {
+	return _IVault.Contract.NextEpochStart(&_IVault.CallOpts)
+}
+
+// PreviousEpochStart is a free data retrieval call binding the contract method 0x281f5752.
+//
+// Solidity: function previousEpochStart() view returns(uint48)
+func (_IVault *IVaultCaller) PreviousEpochStart(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _IVault.contract.Call(opts, &out, "previousEpochStart")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// PreviousEpochStart is a free data retrieval call binding the contract method 0x281f5752.
+//
+// Solidity: function previousEpochStart() view returns(uint48)
+func (_IVault *IVaultSession) PreviousEpochStart() (*big.Int, error) {
+	return _IVault.Contract.PreviousEpochStart(&_IVault.CallOpts)
+}
+
+// PreviousEpochStart is a free data retrieval call binding the contract method 0x281f5752.
+//
+// Solidity: function previousEpochStart() view returns(uint48)
+func (_IVault *IVaultCallerSession) PreviousEpochStart() (*big.Int, error) {
+	return _IVault.Contract.PreviousEpochStart(&_IVault.CallOpts)
+}
+
+// SlashableBalanceOf is a free data retrieval call binding the contract method 0xc31e8dd7.
+//
+// Solidity: function slashableBalanceOf(address account) view returns(uint256)
+func (_IVault *IVaultCaller) SlashableBalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _IVault.contract.Call(opts, &out, "slashableBalanceOf", account)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// SlashableBalanceOf is a free data retrieval call binding the contract method 0xc31e8dd7.
+//
+// Solidity: function slashableBalanceOf(address account) view returns(uint256)
+func (_IVault *IVaultSession) SlashableBalanceOf(account common.Address) (*big.Int, error) {
+	return _IVault.Contract.SlashableBalanceOf(&_IVault.CallOpts, account)
+}
+
+// SlashableBalanceOf is a free data retrieval call binding the contract method 0xc31e8dd7.
+//
+// Solidity: function slashableBalanceOf(address account) view returns(uint256)
+func (_IVault *IVaultCallerSession) SlashableBalanceOf(account common.Address) (*big.Int, error) {
+	return _IVault.Contract.SlashableBalanceOf(&_IVault.CallOpts, account)
+}
+
+// Slasher is a free data retrieval call binding the contract method 0xb1344271.
+//
+// Solidity: function slasher() view returns(address)
+func (_IVault *IVaultCaller) Slasher(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _IVault.contract.Call(opts, &out, "slasher")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Slasher is a free data retrieval call binding the contract method 0xb1344271.
+//
+// Solidity: function slasher() view returns(address)
+func (_IVault *IVaultSession) Slasher() (common.Address, error) {
+	return _IVault.Contract.Slasher(&_IVault.CallOpts)
+}
+
+// Slasher is a free data retrieval call binding the contract method 0xb1344271.
+//
+// Solidity: function slasher() view returns(address)
+func (_IVault *IVaultCallerSession) Slasher() (common.Address, error) {
+	return _IVault.Contract.Slasher(&_IVault.CallOpts)
+}
+
+// TotalStake is a free data retrieval call binding the contract method 0x8b0e9f3f.
+//
+// Solidity: function totalStake() view returns(uint256)
+func (_IVault *IVaultCaller) TotalStake(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _IVault.contract.Call(opts, &out, "totalStake")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// TotalStake is a free data retrieval call binding the contract method 0x8b0e9f3f.
+//
+// Solidity: function totalStake() view returns(uint256)
+func (_IVault *IVaultSession) TotalStake() (*big.Int, error) {
+	return _IVault.Contract.TotalStake(&_IVault.CallOpts)
+}
+
+// TotalStake is a free data retrieval call binding the contract method 0x8b0e9f3f.
+//
+// Solidity: function totalStake() view returns(uint256)
+func (_IVault *IVaultCallerSession) TotalStake() (*big.Int, error) {
+	return _IVault.Contract.TotalStake(&_IVault.CallOpts)
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() view returns(uint64)
+func (_IVault *IVaultCaller) Version(opts *bind.CallOpts) (uint64, error) {
+	var out []interface{}
+	err := _IVault.contract.Call(opts, &out, "version")
+
+	if err != nil {
+		return *new(uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint64)).(*uint64)
+
+	return out0, err
+
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() view returns(uint64)
+func (_IVault *IVaultSession) Version() (uint64, error) {
+	return _IVault.Contract.Version(&_IVault.CallOpts)
+}
+
+// Version is a free data retrieval call binding the contract method 0x54fd4d50.
+//
+// Solidity: function version() view returns(uint64)
+func (_IVault *IVaultCallerSession) Version() (uint64, error) {
+	return _IVault.Contract.Version(&_IVault.CallOpts)
+}
+
+// WithdrawalShares is a free data retrieval call binding the contract method 0xafba70ad.
+//
+// Solidity: function withdrawalShares(uint256 epoch) view returns(uint256)
+func (_IVault *IVaultCaller) WithdrawalShares(opts *bind.CallOpts, epoch *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _IVault.contract.Call(opts, &out, "withdrawalShares", epoch)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// WithdrawalShares is a free data retrieval call binding the contract method 0xafba70ad.
+//
+// Solidity: function withdrawalShares(uint256 epoch) view returns(uint256)
+func (_IVault *IVaultSession) WithdrawalShares(epoch *big.Int) (*big.Int, error) {
+	return _IVault.Contract.WithdrawalShares(&_IVault.CallOpts, epoch)
+}
+
+// WithdrawalShares is a free data retrieval call binding the contract method 0xafba70ad.
+//
+// Solidity: function withdrawalShares(uint256 epoch) view returns(uint256)
+func (_IVault *IVaultCallerSession) WithdrawalShares(epoch *big.Int) (*big.Int, error) {
+	return _IVault.Contract.WithdrawalShares(&_IVault.CallOpts, epoch)
+}
+
+// WithdrawalSharesOf is a free data retrieval call binding the contract method 0xa3b54172.
+//
+// Solidity: function withdrawalSharesOf(uint256 epoch, address account) view returns(uint256)
+func (_IVault *IVaultCaller) WithdrawalSharesOf(opts *bind.CallOpts, epoch *big.Int, account common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _IVault.contract.Call(opts, &out, "withdrawalSharesOf", epoch, account)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// WithdrawalSharesOf is a free data retrieval call binding the contract method 0xa3b54172.
+//
+// Solidity: function withdrawalSharesOf(uint256 epoch, address account) view returns(uint256)
+func (_IVault *IVaultSession) WithdrawalSharesOf(epoch *big.Int, account common.Address) (*big.Int, error) {
+	return _IVault.Contract.WithdrawalSharesOf(&_IVault.CallOpts, epoch, account)
+}
+
+// WithdrawalSharesOf is a free data retrieval call binding the contract method 0xa3b54172.
+//
+// Solidity: function withdrawalSharesOf(uint256 epoch, address account) view returns(uint256)
+func (_IVault *IVaultCallerSession) WithdrawalSharesOf(epoch *big.Int, account common.Address) (*big.Int, error) {
+	return _IVault.Contract.WithdrawalSharesOf(&_IVault.CallOpts, epoch, account)
+}
+
+// Withdrawals is a free data retrieval call binding the contract method 0x5cc07076.
+//
+// Solidity: function withdrawals(uint256 epoch) view returns(uint256)
+func (_IVault *IVaultCaller) Withdrawals(opts *bind.CallOpts, epoch *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _IVault.contract.Call(opts, &out, "withdrawals", epoch)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Withdrawals is a free data retrieval call binding the contract method 0x5cc07076.
+//
+// Solidity: function withdrawals(uint256 epoch) view returns(uint256)
+func (_IVault *IVaultSession) Withdrawals(epoch *big.Int) (*big.Int, error) {
+	return _IVault.Contract.Withdrawals(&_IVault.CallOpts, epoch)
+}
+
+// Withdrawals is a free data retrieval call binding the contract method 0x5cc07076.
+//
+// Solidity: function withdrawals(uint256 epoch) view returns(uint256)
+func (_IVault *IVaultCallerSession) Withdrawals(epoch *big.Int) (*big.Int, error) {
+	return _IVault.Contract.Withdrawals(&_IVault.CallOpts, epoch)
+}
+
+// WithdrawalsOf is a free data retrieval call binding the contract method 0xf5e7ee0f.
+//
+// Solidity: function withdrawalsOf(uint256 epoch, address account) view returns(uint256)
+func (_IVault *IVaultCaller) WithdrawalsOf(opts *bind.CallOpts, epoch *big.Int, account common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _IVault.contract.Call(opts, &out, "withdrawalsOf", epoch, account)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// WithdrawalsOf is a free data retrieval call binding the contract method 0xf5e7ee0f.
+//
+// Solidity: function withdrawalsOf(uint256 epoch, address account) view returns(uint256)
+func (_IVault *IVaultSession) WithdrawalsOf(epoch *big.Int, account common.Address) (*big.Int, error) {
+	return _IVault.Contract.WithdrawalsOf(&_IVault.CallOpts, epoch, account)
+}
+
+// WithdrawalsOf is a free data retrieval call binding the contract method 0xf5e7ee0f.
+//
+// Solidity: function withdrawalsOf(uint256 epoch, address account) view returns(uint256)
+func (_IVault *IVaultCallerSession) WithdrawalsOf(epoch *big.Int, account common.Address) (*big.Int, error) {
+	return _IVault.Contract.WithdrawalsOf(&_IVault.CallOpts, epoch, account)
+}
+
+// Claim is a paid mutator transaction binding the contract method 0xaad3ec96.
+//
+// Solidity: function claim(address recipient, uint256 epoch) returns(uint256 amount)
+func (_IVault *IVaultTransactor) Claim(opts *bind.TransactOpts, recipient common.Address, epoch *big.Int) (*types.Transaction, error) {
+	return _IVault.contract.Transact(opts, "claim", recipient, epoch)
+}
+
+// Claim is a paid mutator transaction binding the contract method 0xaad3ec96.
+//
+// Solidity: function claim(address recipient, uint256 epoch) returns(uint256 amount)
+func (_IVault *IVaultSession) Claim(recipient common.Address, epoch *big.Int) (*types.Transaction, error) {
+	return _IVault.Contract.Claim(&_IVault.TransactOpts, recipient, epoch)
+}
+
+// Claim is a paid mutator transaction binding the contract method 0xaad3ec96.
+//
+// Solidity: function claim(address recipient, uint256 epoch) returns(uint256 amount)
+func (_IVault *IVaultTransactorSession) Claim(recipient common.Address, epoch *big.Int) (*types.Transaction, error) {
+	return _IVault.Contract.Claim(&_IVault.TransactOpts, recipient, epoch)
+}
+
+// ClaimBatch is a paid mutator transaction binding the contract method 0x7c04c80a.
+//
+// Solidity: function claimBatch(address recipient, uint256[] epochs) returns(uint256 amount)
+func (_IVault *IVaultTransactor) ClaimBatch(opts *bind.TransactOpts, recipient common.Address, epochs []*big.Int) (*types.Transaction, error) {
+	return _IVault.contract.Transact(opts, "claimBatch", recipient, epochs)
+}
+
+// ClaimBatch is a paid mutator transaction binding the contract method 0x7c04c80a.
+//
+// Solidity: function claimBatch(address recipient, uint256[] epochs) returns(uint256 amount)
+func (_IVault *IVaultSession) ClaimBatch(recipient common.Address, epochs []*big.Int) (*types.Transaction, error) {
+	return _IVault.Contract.ClaimBatch(&_IVault.TransactOpts, recipient, epochs)
+}
+
+// ClaimBatch is a paid mutator transaction binding the contract method 0x7c04c80a.
+//
+// Solidity: function claimBatch(address recipient, uint256[] epochs) returns(uint256 amount)
+func (_IVault *IVaultTransactorSession) ClaimBatch(recipient common.Address, epochs []*big.Int) (*types.Transaction, error) {
+	return _IVault.Contract.ClaimBatch(&_IVault.TransactOpts, recipient, epochs)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0x47e7ef24.
+//
+// Solidity: function deposit(address onBehalfOf, uint256 amount) returns(uint256 depositedAmount, uint256 mintedShares)
+func (_IVault *IVaultTransactor) Deposit(opts *bind.TransactOpts, onBehalfOf common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _IVault.contract.Transact(opts, "deposit", onBehalfOf, amount)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0x47e7ef24.
+//
+// Solidity: function deposit(address onBehalfOf, uint256 amount) returns(uint256 depositedAmount, uint256 mintedShares)
+func (_IVault *IVaultSession) Deposit(onBehalfOf common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _IVault.Contract.Deposit(&_IVault.TransactOpts, onBehalfOf, amount)
+}
+
+// Deposit is a paid mutator transaction binding the contract method 0x47e7ef24.
+//
+// Solidity: function deposit(address onBehalfOf, uint256 amount) returns(uint256 depositedAmount, uint256 mintedShares)
+func (_IVault *IVaultTransactorSession) Deposit(onBehalfOf common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _IVault.Contract.Deposit(&_IVault.TransactOpts, onBehalfOf, amount)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x57ec83cc.
+//
+// Solidity: function initialize(uint64 initialVersion, address owner, bytes data) returns()
+func (_IVault *IVaultTransactor) Initialize(opts *bind.TransactOpts, initialVersion uint64, owner common.Address, data []byte) (*types.Transaction, error) {
+	return _IVault.contract.Transact(opts, "initialize", initialVersion, owner, data)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x57ec83cc.
+//
+// Solidity: function initialize(uint64 initialVersion, address owner, bytes data) returns()
+func (_IVault *IVaultSession) Initialize(initialVersion uint64, owner common.Address, data []byte) (*types.Transaction, error) {
+	return _IVault.Contract.Initialize(&_IVault.TransactOpts, initialVersion, owner, data)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x57ec83cc.
+//
+// Solidity: function initialize(uint64 initialVersion, address owner, bytes data) returns()
+func (_IVault *IVaultTransactorSession) Initialize(initialVersion uint64, owner common.Address, data []byte) (*types.Transaction, error) {
+	return _IVault.Contract.Initialize(&_IVault.TransactOpts, initialVersion, owner, data)
+}
+
+// Migrate is a paid mutator transaction binding the contract method 0x2abe3048.
+//
+// Solidity: function migrate(uint64 newVersion, bytes data) returns()
+func (_IVault *IVaultTransactor) Migrate(opts *bind.TransactOpts, newVersion uint64, data []byte) (*types.Transaction, error) {
+	return _IVault.contract.Transact(opts, "migrate", newVersion, data)
+}
+
+// Migrate is a paid mutator transaction binding the contract method 0x2abe3048.
+//
+// Solidity: function migrate(uint64 newVersion, bytes data) returns()
+func (_IVault *IVaultSession) Migrate(newVersion uint64, data []byte) (*types.Transaction, error) {
+	return _IVault.Contract.Migrate(&_IVault.TransactOpts, newVersion, data)
+}
+
+// Migrate is a paid mutator transaction binding the contract method 0x2abe3048.
+//
+// Solidity: function migrate(uint64 newVersion, bytes data) returns()
+func (_IVault *IVaultTransactorSession) Migrate(newVersion uint64, data []byte) (*types.Transaction, error) {
+	return _IVault.Contract.Migrate(&_IVault.TransactOpts, newVersion, data)
+}
+
+// OnSlash is a paid mutator transaction binding the contract method 0x7278e31c.
+//
+// Solidity: function onSlash(uint256 amount, uint48 captureTimestamp) returns(uint256 slashedAmount)
+func (_IVault *IVaultTransactor) OnSlash(opts *bind.TransactOpts, amount *big.Int, captureTimestamp *big.Int) (*types.Transaction, error) {
+	return _IVault.contract.Transact(opts, "onSlash", amount, captureTimestamp)
+}
+
+// OnSlash is a paid mutator transaction binding the contract method 0x7278e31c.
+//
+// Solidity: function onSlash(uint256 amount, uint48 captureTimestamp) returns(uint256 slashedAmount)
+func (_IVault *IVaultSession) OnSlash(amount *big.Int, captureTimestamp *big.Int) (*types.Transaction, error) {
+	return _IVault.Contract.OnSlash(&_IVault.TransactOpts, amount, captureTimestamp)
+}
+
+// OnSlash is a paid mutator transaction binding the contract method 0x7278e31c.
+//
+// Solidity: function onSlash(uint256 amount, uint48 captureTimestamp) returns(uint256 slashedAmount)
+func (_IVault *IVaultTransactorSession) OnSlash(amount *big.Int, captureTimestamp *big.Int) (*types.Transaction, error) {
+	return _IVault.Contract.OnSlash(&_IVault.TransactOpts, amount, captureTimestamp)
+}
+
+// Redeem is a paid mutator transaction binding the contract method 0x1e9a6950.
+//
+// Solidity: function redeem(address claimer, uint256 shares) returns(uint256 withdrawnAssets, uint256 mintedShares)
+func (_IVault *IVaultTransactor) Redeem(opts *bind.TransactOpts, claimer common.Address, shares *big.Int) (*types.Transaction, error) {
+	return _IVault.contract.Transact(opts, "redeem", claimer, shares)
+}
+
+// Redeem is a paid mutator transaction binding the contract method 0x1e9a6950.
+//
+// Solidity: function redeem(address claimer, uint256 shares) returns(uint256 withdrawnAssets, uint256 mintedShares)
+func (_IVault *IVaultSession) Redeem(claimer common.Address, shares *big.Int) (*types.Transaction, error) {
+	return _IVault.Contract.Redeem(&_IVault.TransactOpts, claimer, shares)
+}
+
+// Redeem is a paid mutator transaction binding the contract method 0x1e9a6950.
+//
+// Solidity: function redeem(address claimer, uint256 shares) returns(uint256 withdrawnAssets, uint256 mintedShares)
+func (_IVault *IVaultTransactorSession) Redeem(claimer common.Address, shares *big.Int) (*types.Transaction, error) {
+	return _IVault.Contract.Redeem(&_IVault.TransactOpts, claimer, shares)
+}
+
+// SetDelegator is a paid mutator transaction binding the contract method 0x83cd9cc3.
+//
+// Solidity: function setDelegator(address delegator) returns()
+func (_IVault *IVaultTransactor) SetDelegator(opts *bind.TransactOpts, delegator common.Address) (*types.Transaction, error) {
+	return _IVault.contract.Transact(opts, "setDelegator", delegator)
+}
+
+// SetDelegator is a paid mutator transaction binding the contract method 0x83cd9cc3.
+//
+// Solidity: function setDelegator(address delegator) returns()
+func (_IVault *IVaultSession) SetDelegator(delegator common.Address) (*types.Transaction, error) {
+	return _IVault.Contract.SetDelegator(&_IVault.TransactOpts, delegator)
+}
+
+// SetDelegator is a paid mutator transaction binding the contract method 0x83cd9cc3.
+//
+// Solidity: function setDelegator(address delegator) returns()
+func (_IVault *IVaultTransactorSession) SetDelegator(delegator common.Address) (*types.Transaction, error) {
+	return _IVault.Contract.SetDelegator(&_IVault.TransactOpts, delegator)
+}
+
+// SetDepositLimit is a paid mutator transaction binding the contract method 0xbdc8144b.
+//
+// Solidity: function setDepositLimit(uint256 limit) returns()
+func (_IVault *IVaultTransactor) SetDepositLimit(opts *bind.TransactOpts, limit *big.Int) (*types.Transaction, error) {
+	return _IVault.contract.Transact(opts, "setDepositLimit", limit)
+}
+
+// SetDepositLimit is a paid mutator transaction binding the contract method 0xbdc8144b.
+//
+// Solidity: function setDepositLimit(uint256 limit) returns()
+func (_IVault *IVaultSession) SetDepositLimit(limit *big.Int) (*types.Transaction, error) {
+	return _IVault.Contract.SetDepositLimit(&_IVault.TransactOpts, limit)
+}
+
+// SetDepositLimit is a paid mutator transaction binding the contract method 0xbdc8144b.
+//
+// Solidity: function setDepositLimit(uint256 limit) returns()
+func (_IVault *IVaultTransactorSession) SetDepositLimit(limit *big.Int) (*types.Transaction, error) {
+	return _IVault.Contract.SetDepositLimit(&_IVault.TransactOpts, limit)
+}
+
+// SetDepositWhitelist is a paid mutator transaction binding the contract method 0x4105a7dd.
+//
+// Solidity: function setDepositWhitelist(bool status) returns()
+func (_IVault *IVaultTransactor) SetDepositWhitelist(opts *bind.TransactOpts, status bool) (*types.Transaction, error) {
+	return _IVault.contract.Transact(opts, "setDepositWhitelist", status)
+}
+
+// SetDepositWhitelist is a paid mutator transaction binding the contract method 0x4105a7dd.
+//
+// Solidity: function setDepositWhitelist(bool status) returns()
+func (_IVault *IVaultSession) SetDepositWhitelist(status bool) (*types.Transaction, error) {
+	return _IVault.Contract.SetDepositWhitelist(&_IVault.TransactOpts, status)
+}
+
+// SetDepositWhitelist is a paid mutator transaction binding the contract method 0x4105a7dd.
+//
+// Solidity: function setDepositWhitelist(bool status) returns()
+func (_IVault *IVaultTransactorSession) SetDepositWhitelist(status bool) (*types.Transaction, error) {
+	return _IVault.Contract.SetDepositWhitelist(&_IVault.TransactOpts, status)
+}
+
+// SetDepositorWhitelistStatus is a paid mutator transaction binding the contract method 0xa2861466.
+//
+// Solidity: function setDepositorWhitelistStatus(address account, bool status) returns()
+func (_IVault *IVaultTransactor) SetDepositorWhitelistStatus(opts *bind.TransactOpts, account common.Address, status bool) (*types.Transaction, error) {
+	return _IVault.contract.Transact(opts, "setDepositorWhitelistStatus", account, status)
+}
+
+// SetDepositorWhitelistStatus is a paid mutator transaction binding the contract method 0xa2861466.
+//
+// Solidity: function setDepositorWhitelistStatus(address account, bool status) returns()
+func (_IVault *IVaultSession) SetDepositorWhitelistStatus(account common.Address, status bool) (*types.Transaction, error) {
+	return _IVault.Contract.SetDepositorWhitelistStatus(&_IVault.TransactOpts, account, status)
+}
+
+// SetDepositorWhitelistStatus is a paid mutator transaction binding the contract method 0xa2861466.
+//
+// Solidity: function setDepositorWhitelistStatus(address account, bool status) returns()
+func (_IVault *IVaultTransactorSession) SetDepositorWhitelistStatus(account common.Address, status bool) (*types.Transaction, error) {
+	return _IVault.Contract.SetDepositorWhitelistStatus(&_IVault.TransactOpts, account, status)
+}
+
+// SetIsDepositLimit is a paid mutator transaction binding the contract method 0x5346e34f.
+//
+// Solidity: function setIsDepositLimit(bool status) returns()
+func (_IVault *IVaultTransactor) SetIsDepositLimit(opts *bind.TransactOpts, status bool) (*types.Transaction, error) {
+	return _IVault.contract.Transact(opts, "setIsDepositLimit", status)
+}
+
+// SetIsDepositLimit is a paid mutator transaction binding the contract method 0x5346e34f.
+//
+// Solidity: function setIsDepositLimit(bool status) returns()
+func (_IVault *IVaultSession) SetIsDepositLimit(status bool) (*types.Transaction, error) {
+	return _IVault.Contract.SetIsDepositLimit(&_IVault.TransactOpts, status)
+}
+
+// SetIsDepositLimit is a paid mutator transaction binding the contract method 0x5346e34f.
+//
+// Solidity: function setIsDepositLimit(bool status) returns()
+func (_IVault *IVaultTransactorSession) SetIsDepositLimit(status bool) (*types.Transaction, error) {
+	return _IVault.Contract.SetIsDepositLimit(&_IVault.TransactOpts, status)
+}
+
+// SetSlasher is a paid mutator transaction binding the contract method 0xaabc2496.
+//
+// Solidity: function setSlasher(address slasher) returns()
+func (_IVault *IVaultTransactor) SetSlasher(opts *bind.TransactOpts, slasher common.Address) (*types.Transaction, error) {
+	return _IVault.contract.Transact(opts, "setSlasher", slasher)
+}
+
+// SetSlasher is a paid mutator transaction binding the contract method 0xaabc2496.
+//
+// Solidity: function setSlasher(address slasher) returns()
+func (_IVault *IVaultSession) SetSlasher(slasher common.Address) (*types.Transaction, error) {
+	return _IVault.Contract.SetSlasher(&_IVault.TransactOpts, slasher)
+}
+
+// SetSlasher is a paid mutator transaction binding the contract method 0xaabc2496.
+//
+// Solidity: function setSlasher(address slasher) returns()
+func (_IVault *IVaultTransactorSession) SetSlasher(slasher common.Address) (*types.Transaction, error) {
+	return _IVault.Contract.SetSlasher(&_IVault.TransactOpts, slasher)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0xf3fef3a3.
+//
+// Solidity: function withdraw(address claimer, uint256 amount) returns(uint256 burnedShares, uint256 mintedShares)
+func (_IVault *IVaultTransactor) Withdraw(opts *bind.TransactOpts, claimer common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _IVault.contract.Transact(opts, "withdraw", claimer, amount)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0xf3fef3a3.
+//
+// Solidity: function withdraw(address claimer, uint256 amount) returns(uint256 burnedShares, uint256 mintedShares)
+func (_IVault *IVaultSession) Withdraw(claimer common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _IVault.Contract.Withdraw(&_IVault.TransactOpts, claimer, amount)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0xf3fef3a3.
+//
+// Solidity: function withdraw(address claimer, uint256 amount) returns(uint256 burnedShares, uint256 mintedShares)
+func (_IVault *IVaultTransactorSession) Withdraw(claimer common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _IVault.Contract.Withdraw(&_IVault.TransactOpts, claimer, amount)
+}
+
+// IVaultClaimIterator is returned from FilterClaim and is used to iterate over the raw logs and unpacked data for Claim events raised by the IVault contract.
+type IVaultClaimIterator struct {
+	Event *IVaultClaim // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IVaultClaimIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IVaultClaim)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IVaultClaim)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IVaultClaimIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IVaultClaimIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IVaultClaim represents a Claim event raised by the IVault contract.
+type IVaultClaim struct {
+	Claimer   common.Address
+	Recipient common.Address
+	Epoch     *big.Int
+	Amount    *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterClaim is a free log retrieval operation binding the contract event 0x865ca08d59f5cb456e85cd2f7ef63664ea4f73327414e9d8152c4158b0e94645.
+//
+// Solidity: event Claim(address indexed claimer, address indexed recipient, uint256 epoch, uint256 amount)
+func (_IVault *IVaultFilterer) FilterClaim(opts *bind.FilterOpts, claimer []common.Address, recipient []common.Address) (*IVaultClaimIterator, error) {
+
+	var claimerRule []interface{}
+	for _, claimerItem := range claimer {
+		claimerRule = append(claimerRule, claimerItem)
+	}
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+
+	logs, sub, err := _IVault.contract.FilterLogs(opts, "Claim", claimerRule, recipientRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IVaultClaimIterator{contract: _IVault.contract, event: "Claim", logs: logs, sub: sub}, nil
+}
+
+// WatchClaim is a free log subscription operation binding the contract event 0x865ca08d59f5cb456e85cd2f7ef63664ea4f73327414e9d8152c4158b0e94645.
+//
+// Solidity: event Claim(address indexed claimer, address indexed recipient, uint256 epoch, uint256 amount)
+func (_IVault *IVaultFilterer) WatchClaim(opts *bind.WatchOpts, sink chan<- *IVaultClaim, claimer []common.Address, recipient []common.Address) (event.Subscription, error) {
+
+	var claimerRule []interface{}
+	for _, claimerItem := range claimer {
+		claimerRule = append(claimerRule, claimerItem)
+	}
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+
+	logs, sub, err := _IVault.contract.WatchLogs(opts, "Claim", claimerRule, recipientRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IVaultClaim)
+				if err := _IVault.contract.UnpackLog(event, "Claim", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseClaim is a log parse operation binding the contract event 0x865ca08d59f5cb456e85cd2f7ef63664ea4f73327414e9d8152c4158b0e94645.
+//
+// Solidity: event Claim(address indexed claimer, address indexed recipient, uint256 epoch, uint256 amount)
+func (_IVault *IVaultFilterer) ParseClaim(log types.Log) (*IVaultClaim, error) {
+	event := new(IVaultClaim)
+	if err := _IVault.contract.UnpackLog(event, "Claim", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IVaultClaimBatchIterator is returned from FilterClaimBatch and is used to iterate over the raw logs and unpacked data for ClaimBatch events raised by the IVault contract.
+type IVaultClaimBatchIterator struct {
+	Event *IVaultClaimBatch // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IVaultClaimBatchIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IVaultClaimBatch)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IVaultClaimBatch)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IVaultClaimBatchIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IVaultClaimBatchIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IVaultClaimBatch represents a ClaimBatch event raised by the IVault contract.
+type IVaultClaimBatch struct {
+	Claimer   common.Address
+	Recipient common.Address
+	Epochs    []*big.Int
+	Amount    *big.Int
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterClaimBatch is a free log retrieval operation binding the contract event 0x326b6aff1cd2fb1c8234de4f9dcfb9047c5c36eb9ef2eb34af5121e969a75d27.
+//
+// Solidity: event ClaimBatch(address indexed claimer, address indexed recipient, uint256[] epochs, uint256 amount)
+func (_IVault *IVaultFilterer) FilterClaimBatch(opts *bind.FilterOpts, claimer []common.Address, recipient []common.Address) (*IVaultClaimBatchIterator, error) {
+
+	var claimerRule []interface{}
+	for _, claimerItem := range claimer {
+		claimerRule = append(claimerRule, claimerItem)
+	}
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+
+	logs, sub, err := _IVault.contract.FilterLogs(opts, "ClaimBatch", claimerRule, recipientRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IVaultClaimBatchIterator{contract: _IVault.contract, event: "ClaimBatch", logs: logs, sub: sub}, nil
+}
+
+// WatchClaimBatch is a free log subscription operation binding the contract event 0x326b6aff1cd2fb1c8234de4f9dcfb9047c5c36eb9ef2eb34af5121e969a75d27.
+//
+// Solidity: event ClaimBatch(address indexed claimer, address indexed recipient, uint256[] epochs, uint256 amount)
+func (_IVault *IVaultFilterer) WatchClaimBatch(opts *bind.WatchOpts, sink chan<- *IVaultClaimBatch, claimer []common.Address, recipient []common.Address) (event.Subscription, error) {
+
+	var claimerRule []interface{}
+	for _, claimerItem := range claimer {
+		claimerRule = append(claimerRule, claimerItem)
+	}
+	var recipientRule []interface{}
+	for _, recipientItem := range recipient {
+		recipientRule = append(recipientRule, recipientItem)
+	}
+
+	logs, sub, err := _IVault.contract.WatchLogs(opts, "ClaimBatch", claimerRule, recipientRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IVaultClaimBatch)
+				if err := _IVault.contract.UnpackLog(event, "ClaimBatch", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseClaimBatch is a log parse operation binding the contract event 0x326b6aff1cd2fb1c8234de4f9dcfb9047c5c36eb9ef2eb34af5121e969a75d27.
+//
+// Solidity: event ClaimBatch(address indexed claimer, address indexed recipient, uint256[] epochs, uint256 amount)
+func (_IVault *IVaultFilterer) ParseClaimBatch(log types.Log) (*IVaultClaimBatch, error) {
+	event := new(IVaultClaimBatch)
+	if err := _IVault.contract.UnpackLog(event, "ClaimBatch", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IVaultDepositIterator is returned from FilterDeposit and is used to iterate over the raw logs and unpacked data for Deposit events raised by the IVault contract.
+type IVaultDepositIterator struct {
+	Event *IVaultDeposit // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IVaultDepositIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IVaultDeposit)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IVaultDeposit)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IVaultDepositIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IVaultDepositIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IVaultDeposit represents a Deposit event raised by the IVault contract.
+type IVaultDeposit struct {
+	Depositor  common.Address
+	OnBehalfOf common.Address
+	Amount     *big.Int
+	Shares     *big.Int
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterDeposit is a free log retrieval operation binding the contract event 0xdcbc1c05240f31ff3ad067ef1ee35ce4997762752e3a095284754544f4c709d7.
+//
+// Solidity: event Deposit(address indexed depositor, address indexed onBehalfOf, uint256 amount, uint256 shares)
+func (_IVault *IVaultFilterer) FilterDeposit(opts *bind.FilterOpts, depositor []common.Address, onBehalfOf []common.Address) (*IVaultDepositIterator, error) {
+
+	var depositorRule []interface{}
+	for _, depositorItem := range depositor {
+		depositorRule = append(depositorRule, depositorItem)
+	}
+	var onBehalfOfRule []interface{}
+	for _, onBehalfOfItem := range onBehalfOf {
+		onBehalfOfRule = append(onBehalfOfRule, onBehalfOfItem)
+	}
+
+	logs, sub, err := _IVault.contract.FilterLogs(opts, "Deposit", depositorRule, onBehalfOfRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IVaultDepositIterator{contract: _IVault.contract, event: "Deposit", logs: logs, sub: sub}, nil
+}
+
+// WatchDeposit is a free log subscription operation binding the contract event 0xdcbc1c05240f31ff3ad067ef1ee35ce4997762752e3a095284754544f4c709d7.
+//
+// Solidity: event Deposit(address indexed depositor, address indexed onBehalfOf, uint256 amount, uint256 shares)
+func (_IVault *IVaultFilterer) WatchDeposit(opts *bind.WatchOpts, sink chan<- *IVaultDeposit, depositor []common.Address, onBehalfOf []common.Address) (event.Subscription, error) {
+
+	var depositorRule []interface{}
+	for _, depositorItem := range depositor {
+		depositorRule = append(depositorRule, depositorItem)
+	}
+	var onBehalfOfRule []interface{}
+	for _, onBehalfOfItem := range onBehalfOf {
+		onBehalfOfRule = append(onBehalfOfRule, onBehalfOfItem)
+	}
+
+	logs, sub, err := _IVault.contract.WatchLogs(opts, "Deposit", depositorRule, onBehalfOfRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IVaultDeposit)
+				if err := _IVault.contract.UnpackLog(event, "Deposit", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseDeposit is a log parse operation binding the contract event 0xdcbc1c05240f31ff3ad067ef1ee35ce4997762752e3a095284754544f4c709d7.
+//
+// Solidity: event Deposit(address indexed depositor, address indexed onBehalfOf, uint256 amount, uint256 shares)
+func (_IVault *IVaultFilterer) ParseDeposit(log types.Log) (*IVaultDeposit, error) {
+	event := new(IVaultDeposit)
+	if err := _IVault.contract.UnpackLog(event, "Deposit", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IVaultOnSlashIterator is returned from FilterOnSlash and is used to iterate over the raw logs and unpacked data for OnSlash events raised by the IVault contract.
+type IVaultOnSlashIterator struct {
+	Event *IVaultOnSlash // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IVaultOnSlashIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IVaultOnSlash)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IVaultOnSlash)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IVaultOnSlashIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IVaultOnSlashIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IVaultOnSlash represents a OnSlash event raised by the IVault contract.
+type IVaultOnSlash struct {
+	Amount           *big.Int
+	CaptureTimestamp *big.Int
+	SlashedAmount    *big.Int
+	Raw              types.Log // Blockchain specific contextual infos
+}
+
+// FilterOnSlash is a free log retrieval operation binding the contract event 0xf9d090c096f71cd1659861a9ce5b6f384bceb4e2fa4e4a19edf6489a9b8d56c7.
+//
+// Solidity: event OnSlash(uint256 amount, uint48 captureTimestamp, uint256 slashedAmount)
+func (_IVault *IVaultFilterer) FilterOnSlash(opts *bind.FilterOpts) (*IVaultOnSlashIterator, error) {
+
+	logs, sub, err := _IVault.contract.FilterLogs(opts, "OnSlash")
+	if err != nil {
+		return nil, err
+	}
+	return &IVaultOnSlashIterator{contract: _IVault.contract, event: "OnSlash", logs: logs, sub: sub}, nil
+}
+
+// WatchOnSlash is a free log subscription operation binding the contract event 0xf9d090c096f71cd1659861a9ce5b6f384bceb4e2fa4e4a19edf6489a9b8d56c7.
+//
+// Solidity: event OnSlash(uint256 amount, uint48 captureTimestamp, uint256 slashedAmount)
+func (_IVault *IVaultFilterer) WatchOnSlash(opts *bind.WatchOpts, sink chan<- *IVaultOnSlash) (event.Subscription, error) {
+
+	logs, sub, err := _IVault.contract.WatchLogs(opts, "OnSlash")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IVaultOnSlash)
+				if err := _IVault.contract.UnpackLog(event, "OnSlash", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseOnSlash is a log parse operation binding the contract event 0xf9d090c096f71cd1659861a9ce5b6f384bceb4e2fa4e4a19edf6489a9b8d56c7.
+//
+// Solidity: event OnSlash(uint256 amount, uint48 captureTimestamp, uint256 slashedAmount)
+func (_IVault *IVaultFilterer) ParseOnSlash(log types.Log) (*IVaultOnSlash, error) {
+	event := new(IVaultOnSlash)
+	if err := _IVault.contract.UnpackLog(event, "OnSlash", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IVaultSetDelegatorIterator is returned from FilterSetDelegator and is used to iterate over the raw logs and unpacked data for SetDelegator events raised by the IVault contract.
+type IVaultSetDelegatorIterator struct {
+	Event *IVaultSetDelegator // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IVaultSetDelegatorIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IVaultSetDelegator)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IVaultSetDelegator)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IVaultSetDelegatorIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IVaultSetDelegatorIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IVaultSetDelegator represents a SetDelegator event raised by the IVault contract.
+type IVaultSetDelegator struct {
+	Delegator common.Address
+	Raw       types.Log // Blockchain specific contextual infos
+}
+
+// FilterSetDelegator is a free log retrieval operation binding the contract event 0xdb2160616f776a37b24808115554e79439bf26cccbbd4438190cc6d28e80ecd1.
+//
+// Solidity: event SetDelegator(address indexed delegator)
+func (_IVault *IVaultFilterer) FilterSetDelegator(opts *bind.FilterOpts, delegator []common.Address) (*IVaultSetDelegatorIterator, error) {
+
+	var delegatorRule []interface{}
+	for _, delegatorItem := range delegator {
+		delegatorRule = append(delegatorRule, delegatorItem)
+	}
+
+	logs, sub, err := _IVault.contract.FilterLogs(opts, "SetDelegator", delegatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IVaultSetDelegatorIterator{contract: _IVault.contract, event: "SetDelegator", logs: logs, sub: sub}, nil
+}
+
+// WatchSetDelegator is a free log subscription operation binding the contract event 0xdb2160616f776a37b24808115554e79439bf26cccbbd4438190cc6d28e80ecd1.
+//
+// Solidity: event SetDelegator(address indexed delegator)
+func (_IVault *IVaultFilterer) WatchSetDelegator(opts *bind.WatchOpts, sink chan<- *IVaultSetDelegator, delegator []common.Address) (event.Subscription, error) {
+
+	var delegatorRule []interface{}
+	for _, delegatorItem := range delegator {
+		delegatorRule = append(delegatorRule, delegatorItem)
+	}
+
+	logs, sub, err := _IVault.contract.WatchLogs(opts, "SetDelegator", delegatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IVaultSetDelegator)
+				if err := _IVault.contract.UnpackLog(event, "SetDelegator", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSetDelegator is a log parse operation binding the contract event 0xdb2160616f776a37b24808115554e79439bf26cccbbd4438190cc6d28e80ecd1.
+//
+// Solidity: event SetDelegator(address indexed delegator)
+func (_IVault *IVaultFilterer) ParseSetDelegator(log types.Log) (*IVaultSetDelegator, error) {
+	event := new(IVaultSetDelegator)
+	if err := _IVault.contract.UnpackLog(event, "SetDelegator", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IVaultSetDepositLimitIterator is returned from FilterSetDepositLimit and is used to iterate over the raw logs and unpacked data for SetDepositLimit events raised by the IVault contract.
+type IVaultSetDepositLimitIterator struct {
+	Event *IVaultSetDepositLimit // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IVaultSetDepositLimitIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IVaultSetDepositLimit)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IVaultSetDepositLimit)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IVaultSetDepositLimitIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IVaultSetDepositLimitIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IVaultSetDepositLimit represents a SetDepositLimit event raised by the IVault contract.
+type IVaultSetDepositLimit struct {
+	Limit *big.Int
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterSetDepositLimit is a free log retrieval operation binding the contract event 0x854df3eb95564502c8bc871ebdd15310ee26270f955f6c6bd8cea68e75045bc0.
+//
+// Solidity: event SetDepositLimit(uint256 limit)
+func (_IVault *IVaultFilterer) FilterSetDepositLimit(opts *bind.FilterOpts) (*IVaultSetDepositLimitIterator, error) {
+
+	logs, sub, err := _IVault.contract.FilterLogs(opts, "SetDepositLimit")
+	if err != nil {
+		return nil, err
+	}
+	return &IVaultSetDepositLimitIterator{contract: _IVault.contract, event: "SetDepositLimit", logs: logs, sub: sub}, nil
+}
+
+// WatchSetDepositLimit is a free log subscription operation binding the contract event 0x854df3eb95564502c8bc871ebdd15310ee26270f955f6c6bd8cea68e75045bc0.
+//
+// Solidity: event SetDepositLimit(uint256 limit)
+func (_IVault *IVaultFilterer) WatchSetDepositLimit(opts *bind.WatchOpts, sink chan<- *IVaultSetDepositLimit) (event.Subscription, error) {
+
+	logs, sub, err := _IVault.contract.WatchLogs(opts, "SetDepositLimit")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IVaultSetDepositLimit)
+				if err := _IVault.contract.UnpackLog(event, "SetDepositLimit", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSetDepositLimit is a log parse operation binding the contract event 0x854df3eb95564502c8bc871ebdd15310ee26270f955f6c6bd8cea68e75045bc0.
+//
+// Solidity: event SetDepositLimit(uint256 limit)
+func (_IVault *IVaultFilterer) ParseSetDepositLimit(log types.Log) (*IVaultSetDepositLimit, error) {
+	event := new(IVaultSetDepositLimit)
+	if err := _IVault.contract.UnpackLog(event, "SetDepositLimit", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IVaultSetDepositWhitelistIterator is returned from FilterSetDepositWhitelist and is used to iterate over the raw logs and unpacked data for SetDepositWhitelist events raised by the IVault contract.
+type IVaultSetDepositWhitelistIterator struct {
+	Event *IVaultSetDepositWhitelist // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IVaultSetDepositWhitelistIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IVaultSetDepositWhitelist)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IVaultSetDepositWhitelist)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IVaultSetDepositWhitelistIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IVaultSetDepositWhitelistIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IVaultSetDepositWhitelist represents a SetDepositWhitelist event raised by the IVault contract.
+type IVaultSetDepositWhitelist struct {
+	Status bool
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterSetDepositWhitelist is a free log retrieval operation binding the contract event 0x3e12b7b36c75ac9609a3f58609b331210428e1a85909132638955ba0301eec33.
+//
+// Solidity: event SetDepositWhitelist(bool status)
+func (_IVault *IVaultFilterer) FilterSetDepositWhitelist(opts *bind.FilterOpts) (*IVaultSetDepositWhitelistIterator, error) {
+
+	logs, sub, err := _IVault.contract.FilterLogs(opts, "SetDepositWhitelist")
+	if err != nil {
+		return nil, err
+	}
+	return &IVaultSetDepositWhitelistIterator{contract: _IVault.contract, event: "SetDepositWhitelist", logs: logs, sub: sub}, nil
+}
+
+// WatchSetDepositWhitelist is a free log subscription operation binding the contract event 0x3e12b7b36c75ac9609a3f58609b331210428e1a85909132638955ba0301eec33.
+//
+// Solidity: event SetDepositWhitelist(bool status)
+func (_IVault *IVaultFilterer) WatchSetDepositWhitelist(opts *bind.WatchOpts, sink chan<- *IVaultSetDepositWhitelist) (event.Subscription, error) {
+
+	logs, sub, err := _IVault.contract.WatchLogs(opts, "SetDepositWhitelist")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IVaultSetDepositWhitelist)
+				if err := _IVault.contract.UnpackLog(event, "SetDepositWhitelist", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSetDepositWhitelist is a log parse operation binding the contract event 0x3e12b7b36c75ac9609a3f58609b331210428e1a85909132638955ba0301eec33.
+//
+// Solidity: event SetDepositWhitelist(bool status)
+func (_IVault *IVaultFilterer) ParseSetDepositWhitelist(log types.Log) (*IVaultSetDepositWhitelist, error) {
+	event := new(IVaultSetDepositWhitelist)
+	if err := _IVault.contract.UnpackLog(event, "SetDepositWhitelist", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IVaultSetDepositorWhitelistStatusIterator is returned from FilterSetDepositorWhitelistStatus and is used to iterate over the raw logs and unpacked data for SetDepositorWhitelistStatus events raised by the IVault contract.
+type IVaultSetDepositorWhitelistStatusIterator struct {
+	Event *IVaultSetDepositorWhitelistStatus // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IVaultSetDepositorWhitelistStatusIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IVaultSetDepositorWhitelistStatus)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IVaultSetDepositorWhitelistStatus)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IVaultSetDepositorWhitelistStatusIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IVaultSetDepositorWhitelistStatusIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IVaultSetDepositorWhitelistStatus represents a SetDepositorWhitelistStatus event raised by the IVault contract.
+type IVaultSetDepositorWhitelistStatus struct {
+	Account common.Address
+	Status  bool
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterSetDepositorWhitelistStatus is a free log retrieval operation binding the contract event 0xf991b1ecfb5115cbb36a2b2e2240c058406d2acc2fcc6e9e2dc99d845ff70a62.
+//
+// Solidity: event SetDepositorWhitelistStatus(address indexed account, bool status)
+func (_IVault *IVaultFilterer) FilterSetDepositorWhitelistStatus(opts *bind.FilterOpts, account []common.Address) (*IVaultSetDepositorWhitelistStatusIterator, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _IVault.contract.FilterLogs(opts, "SetDepositorWhitelistStatus", accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IVaultSetDepositorWhitelistStatusIterator{contract: _IVault.contract, event: "SetDepositorWhitelistStatus", logs: logs, sub: sub}, nil
+}
+
+// WatchSetDepositorWhitelistStatus is a free log subscription operation binding the contract event 0xf991b1ecfb5115cbb36a2b2e2240c058406d2acc2fcc6e9e2dc99d845ff70a62.
+//
+// Solidity: event SetDepositorWhitelistStatus(address indexed account, bool status)
+func (_IVault *IVaultFilterer) WatchSetDepositorWhitelistStatus(opts *bind.WatchOpts, sink chan<- *IVaultSetDepositorWhitelistStatus, account []common.Address) (event.Subscription, error) {
+
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+
+	logs, sub, err := _IVault.contract.WatchLogs(opts, "SetDepositorWhitelistStatus", accountRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IVaultSetDepositorWhitelistStatus)
+				if err := _IVault.contract.UnpackLog(event, "SetDepositorWhitelistStatus", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSetDepositorWhitelistStatus is a log parse operation binding the contract event 0xf991b1ecfb5115cbb36a2b2e2240c058406d2acc2fcc6e9e2dc99d845ff70a62.
+//
+// Solidity: event SetDepositorWhitelistStatus(address indexed account, bool status)
+func (_IVault *IVaultFilterer) ParseSetDepositorWhitelistStatus(log types.Log) (*IVaultSetDepositorWhitelistStatus, error) {
+	event := new(IVaultSetDepositorWhitelistStatus)
+	if err := _IVault.contract.UnpackLog(event, "SetDepositorWhitelistStatus", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IVaultSetIsDepositLimitIterator is returned from FilterSetIsDepositLimit and is used to iterate over the raw logs and unpacked data for SetIsDepositLimit events raised by the IVault contract.
+type IVaultSetIsDepositLimitIterator struct {
+	Event *IVaultSetIsDepositLimit // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IVaultSetIsDepositLimitIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IVaultSetIsDepositLimit)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IVaultSetIsDepositLimit)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IVaultSetIsDepositLimitIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IVaultSetIsDepositLimitIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IVaultSetIsDepositLimit represents a SetIsDepositLimit event raised by the IVault contract.
+type IVaultSetIsDepositLimit struct {
+	Status bool
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterSetIsDepositLimit is a free log retrieval operation binding the contract event 0xfa7a25a0b611d4ba3c0ea990e90dc23d484a5dd7a1be4733fef2946ba74530c6.
+//
+// Solidity: event SetIsDepositLimit(bool status)
+func (_IVault *IVaultFilterer) FilterSetIsDepositLimit(opts *bind.FilterOpts) (*IVaultSetIsDepositLimitIterator, error) {
+
+	logs, sub, err := _IVault.contract.FilterLogs(opts, "SetIsDepositLimit")
+	if err != nil {
+		return nil, err
+	}
+	return &IVaultSetIsDepositLimitIterator{contract: _IVault.contract, event: "SetIsDepositLimit", logs: logs, sub: sub}, nil
+}
+
+// WatchSetIsDepositLimit is a free log subscription operation binding the contract event 0xfa7a25a0b611d4ba3c0ea990e90dc23d484a5dd7a1be4733fef2946ba74530c6.
+//
+// Solidity: event SetIsDepositLimit(bool status)
+func (_IVault *IVaultFilterer) WatchSetIsDepositLimit(opts *bind.WatchOpts, sink chan<- *IVaultSetIsDepositLimit) (event.Subscription, error) {
+
+	logs, sub, err := _IVault.contract.WatchLogs(opts, "SetIsDepositLimit")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IVaultSetIsDepositLimit)
+				if err := _IVault.contract.UnpackLog(event, "SetIsDepositLimit", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSetIsDepositLimit is a log parse operation binding the contract event 0xfa7a25a0b611d4ba3c0ea990e90dc23d484a5dd7a1be4733fef2946ba74530c6.
+//
+// Solidity: event SetIsDepositLimit(bool status)
+func (_IVault *IVaultFilterer) ParseSetIsDepositLimit(log types.Log) (*IVaultSetIsDepositLimit, error) {
+	event := new(IVaultSetIsDepositLimit)
+	if err := _IVault.contract.UnpackLog(event, "SetIsDepositLimit", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IVaultSetSlasherIterator is returned from FilterSetSlasher and is used to iterate over the raw logs and unpacked data for SetSlasher events raised by the IVault contract.
+type IVaultSetSlasherIterator struct {
+	Event *IVaultSetSlasher // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IVaultSetSlasherIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IVaultSetSlasher)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IVaultSetSlasher)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IVaultSetSlasherIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IVaultSetSlasherIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IVaultSetSlasher represents a SetSlasher event raised by the IVault contract.
+type IVaultSetSlasher struct {
+	Slasher common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterSetSlasher is a free log retrieval operation binding the contract event 0xe7e4c932e03abddfe20f83af42c33627e816115c7ec2b168441f65dc14bfc3ba.
+//
+// Solidity: event SetSlasher(address indexed slasher)
+func (_IVault *IVaultFilterer) FilterSetSlasher(opts *bind.FilterOpts, slasher []common.Address) (*IVaultSetSlasherIterator, error) {
+
+	var slasherRule []interface{}
+	for _, slasherItem := range slasher {
+		slasherRule = append(slasherRule, slasherItem)
+	}
+
+	logs, sub, err := _IVault.contract.FilterLogs(opts, "SetSlasher", slasherRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IVaultSetSlasherIterator{contract: _IVault.contract, event: "SetSlasher", logs: logs, sub: sub}, nil
+}
+
+// WatchSetSlasher is a free log subscription operation binding the contract event 0xe7e4c932e03abddfe20f83af42c33627e816115c7ec2b168441f65dc14bfc3ba.
+//
+// Solidity: event SetSlasher(address indexed slasher)
+func (_IVault *IVaultFilterer) WatchSetSlasher(opts *bind.WatchOpts, sink chan<- *IVaultSetSlasher, slasher []common.Address) (event.Subscription, error) {
+
+	var slasherRule []interface{}
+	for _, slasherItem := range slasher {
+		slasherRule = append(slasherRule, slasherItem)
+	}
+
+	logs, sub, err := _IVault.contract.WatchLogs(opts, "SetSlasher", slasherRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IVaultSetSlasher)
+				if err := _IVault.contract.UnpackLog(event, "SetSlasher", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSetSlasher is a log parse operation binding the contract event 0xe7e4c932e03abddfe20f83af42c33627e816115c7ec2b168441f65dc14bfc3ba.
+//
+// Solidity: event SetSlasher(address indexed slasher)
+func (_IVault *IVaultFilterer) ParseSetSlasher(log types.Log) (*IVaultSetSlasher, error) {
+	event := new(IVaultSetSlasher)
+	if err := _IVault.contract.UnpackLog(event, "SetSlasher", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IVaultWithdrawIterator is returned from FilterWithdraw and is used to iterate over the raw logs and unpacked data for Withdraw events raised by the IVault contract.
+type IVaultWithdrawIterator struct {
+	Event *IVaultWithdraw // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IVaultWithdrawIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IVaultWithdraw)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IVaultWithdraw)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IVaultWithdrawIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IVaultWithdrawIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IVaultWithdraw represents a Withdraw event raised by the IVault contract.
+type IVaultWithdraw struct {
+	Withdrawer   common.Address
+	Claimer      common.Address
+	Amount       *big.Int
+	BurnedShares *big.Int
+	MintedShares *big.Int
+	Raw          types.Log // Blockchain specific contextual infos
+}
+
+// FilterWithdraw is a free log retrieval operation binding the contract event 0xebff2602b3f468259e1e99f613fed6691f3a6526effe6ef3e768ba7ae7a36c4f.
+//
+// Solidity: event Withdraw(address indexed withdrawer, address indexed claimer, uint256 amount, uint256 burnedShares, uint256 mintedShares)
+func (_IVault *IVaultFilterer) FilterWithdraw(opts *bind.FilterOpts, withdrawer []common.Address, claimer []common.Address) (*IVaultWithdrawIterator, error) {
+
+	var withdrawerRule []interface{}
+	for _, withdrawerItem := range withdrawer {
+		withdrawerRule = append(withdrawerRule, withdrawerItem)
+	}
+	var claimerRule []interface{}
+	for _, claimerItem := range claimer {
+		claimerRule = append(claimerRule, claimerItem)
+	}
+
+	logs, sub, err := _IVault.contract.FilterLogs(opts, "Withdraw", withdrawerRule, claimerRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IVaultWithdrawIterator{contract: _IVault.contract, event: "Withdraw", logs: logs, sub: sub}, nil
+}
+
+// WatchWithdraw is a free log subscription operation binding the contract event 0xebff2602b3f468259e1e99f613fed6691f3a6526effe6ef3e768ba7ae7a36c4f.
+//
+// Solidity: event Withdraw(address indexed withdrawer, address indexed claimer, uint256 amount, uint256 burnedShares, uint256 mintedShares)
+func (_IVault *IVaultFilterer) WatchWithdraw(opts *bind.WatchOpts, sink chan<- *IVaultWithdraw, withdrawer []common.Address, claimer []common.Address) (event.Subscription, error) {
+
+	var withdrawerRule []interface{}
+	for _, withdrawerItem := range withdrawer {
+		withdrawerRule = append(withdrawerRule, withdrawerItem)
+	}
+	var claimerRule []interface{}
+	for _, claimerItem := range claimer {
+		claimerRule = append(claimerRule, claimerItem)
+	}
+
+	logs, sub, err := _IVault.contract.WatchLogs(opts, "Withdraw", withdrawerRule, claimerRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IVaultWithdraw)
+				if err := _IVault.contract.UnpackLog(event, "Withdraw", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseWithdraw is a log parse operation binding the contract event 0xebff2602b3f468259e1e99f613fed6691f3a6526effe6ef3e768ba7ae7a36c4f.
+//
+// Solidity: event Withdraw(address indexed withdrawer, address indexed claimer, uint256 amount, uint256 burnedShares, uint256 mintedShares)
+func (_IVault *IVaultFilterer) ParseWithdraw(log types.Log) (*IVaultWithdraw, error) {
+	event := new(IVaultWithdraw)
+	if err := _IVault.contract.UnpackLog(event, "Withdraw", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
@@ -0,0 +1,1517 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package networkcontracts
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// IVetoSlasherMetaData contains all meta data concerning the IVetoSlasher contract.
+var IVetoSlasherMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"BURNER_GAS_LIMIT\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"BURNER_RESERVE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"FACTORY\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"NETWORK_MIDDLEWARE_SERVICE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"NETWORK_REGISTRY\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"TYPE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint64\",\"internalType\":\"uint64\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"VAULT_FACTORY\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"cumulativeSlash\",\"inputs\":[{\"name\":\"subnetwork\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"operator\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"cumulativeSlashAt\",\"inputs\":[{\"name\":\"subnetwork\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"operator\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"timestamp\",\"type\":\"uint48\",\"internalType\":\"uint48\"},{\"name\":\"hint\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"executeSlash\",\"inputs\":[{\"name\":\"slashIndex\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"hints\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"slashedAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"initialize\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"isBurnerHook\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"latestSlashedCaptureTimestamp\",\"inputs\":[{\"name\":\"subnetwork\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"operator\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint48\",\"internalType\":\"uint48\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"requestSlash\",\"inputs\":[{\"name\":\"subnetwork\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"operator\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"captureTimestamp\",\"type\":\"uint48\",\"internalType\":\"uint48\"},{\"name\":\"hints\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"slashIndex\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"resolver\",\"inputs\":[{\"name\":\"subnetwork\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"hint\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"resolverAt\",\"inputs\":[{\"name\":\"subnetwork\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"timestamp\",\"type\":\"uint48\",\"internalType\":\"uint48\"},{\"name\":\"hint\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"resolverSetEpochsDelay\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"setResolver\",\"inputs\":[{\"name\":\"identifier\",\"type\":\"uint96\",\"internalType\":\"uint96\"},{\"name\":\"resolver\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hints\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"slashRequests\",\"inputs\":[{\"name\":\"slashIndex\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"subnetwork\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"operator\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"captureTimestamp\",\"type\":\"uint48\",\"internalType\":\"uint48\"},{\"name\":\"vetoDeadline\",\"type\":\"uint48\",\"internalType\":\"uint48\"},{\"name\":\"completed\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"slashRequestsLength\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"slashableStake\",\"inputs\":[{\"name\":\"subnetwork\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"operator\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"captureTimestamp\",\"type\":\"uint48\",\"internalType\":\"uint48\"},{\"name\":\"hints\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"vault\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"vetoDuration\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint48\",\"internalType\":\"uint48\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"vetoSlash\",\"inputs\":[{\"name\":\"slashIndex\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"hints\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"ExecuteSlash\",\"inputs\":[{\"name\":\"slashIndex\",\"type\":\"uint256\",\"indexed\":true,\"internalType\":\"uint256\"},{\"name\":\"slashedAmount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"RequestSlash\",\"inputs\":[{\"name\":\"slashIndex\",\"type\":\"uint256\",\"indexed\":true,\"internalType\":\"uint256\"},{\"name\":\"subnetwork\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"operator\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"slashAmount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"captureTimestamp\",\"type\":\"uint48\",\"indexed\":false,\"internalType\":\"uint48\"},{\"name\":\"vetoDeadline\",\"type\":\"uint48\",\"indexed\":false,\"internalType\":\"uint48\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"SetResolver\",\"inputs\":[{\"name\":\"subnetwork\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"resolver\",\"type\":\"address\",\"indexed\":false,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"VetoSlash\",\"inputs\":[{\"name\":\"slashIndex\",\"type\":\"uint256\",\"indexed\":true,\"internalType\":\"uint256\"},{\"name\":\"resolver\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"AlreadySet\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InsufficientBurnerGas\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InsufficientSlash\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidCaptureTimestamp\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidResolverSetEpochsDelay\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidVetoDuration\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NoBurner\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NoResolver\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NotInitialized\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NotNetwork\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NotNetworkMiddleware\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NotResolver\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NotVault\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"SlashPeriodEnded\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"SlashRequestCompleted\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"SlashRequestNotExist\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"VetoPeriodEnded\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"VetoPeriodNotEnded\",\"inputs\":[]}]",
+}
+
+// IVetoSlasherABI is the input ABI used to generate the binding from.
+// Deprecated: Use IVetoSlasherMetaData.ABI instead.
+var IVetoSlasherABI = IVetoSlasherMetaData.ABI
+
+// IVetoSlasher is an auto generated Go binding around an Ethereum contract.
+type IVetoSlasher struct {
+	IVetoSlasherCaller     // Read-only binding to the contract
+	IVetoSlasherTransactor // Write-only binding to the contract
+	IVetoSlasherFilterer   // Log filterer for contract events
+}
+
+// IVetoSlasherCaller is an auto generated read-only Go binding around an Ethereum contract.
+type IVetoSlasherCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IVetoSlasherTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type IVetoSlasherTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IVetoSlasherFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type IVetoSlasherFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// IVetoSlasherSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type IVetoSlasherSession struct {
+	Contract     *IVetoSlasher     // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// IVetoSlasherCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type IVetoSlasherCallerSession struct {
+	Contract *IVetoSlasherCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts       // Call options to use throughout this session
+}
+
+// IVetoSlasherTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type IVetoSlasherTransactorSession struct {
+	Contract     *IVetoSlasherTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts       // Transaction auth options to use throughout this session
+}
+
+// IVetoSlasherRaw is an auto generated low-level Go binding around an Ethereum contract.
+type IVetoSlasherRaw struct {
+	Contract *IVetoSlasher // Generic contract binding to access the raw methods on
+}
+
+// IVetoSlasherCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type IVetoSlasherCallerRaw struct {
+	Contract *IVetoSlasherCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// IVetoSlasherTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type IVetoSlasherTransactorRaw struct {
+	Contract *IVetoSlasherTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewIVetoSlasher creates a new instance of IVetoSlasher, bound to a specific deployed contract.
+func NewIVetoSlasher(address common.Address, backend bind.ContractBackend) (*IVetoSlasher, error) {
+	contract, err := bindIVetoSlasher(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &IVetoSlasher{IVetoSlasherCaller: IVetoSlasherCaller{contract: contract}, IVetoSlasherTransactor: IVetoSlasherTransactor{contract: contract}, IVetoSlasherFilterer: IVetoSlasherFilterer{contract: contract}}, nil
+}
+
+// NewIVetoSlasherCaller creates a new read-only instance of IVetoSlasher, bound to a specific deployed contract.
+func NewIVetoSlasherCaller(address common.Address, caller bind.ContractCaller) (*IVetoSlasherCaller, error) {
+	contract, err := bindIVetoSlasher(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IVetoSlasherCaller{contract: contract}, nil
+}
+
+// NewIVetoSlasherTransactor creates a new write-only instance of IVetoSlasher, bound to a specific deployed contract.
+func NewIVetoSlasherTransactor(address common.Address, transactor bind.ContractTransactor) (*IVetoSlasherTransactor, error) {
+	contract, err := bindIVetoSlasher(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &IVetoSlasherTransactor{contract: contract}, nil
+}
+
+// NewIVetoSlasherFilterer creates a new log filterer instance of IVetoSlasher, bound to a specific deployed contract.
+func NewIVetoSlasherFilterer(address common.Address, filterer bind.ContractFilterer) (*IVetoSlasherFilterer, error) {
+	contract, err := bindIVetoSlasher(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &IVetoSlasherFilterer{contract: contract}, nil
+}
+
+// bindIVetoSlasher binds a generic wrapper to an already deployed contract.
+func bindIVetoSlasher(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := IVetoSlasherMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_IVetoSlasher *IVetoSlasherRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _IVetoSlasher.Contract.IVetoSlasherCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_IVetoSlasher *IVetoSlasherRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IVetoSlasher.Contract.IVetoSlasherTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_IVetoSlasher *IVetoSlasherRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _IVetoSlasher.Contract.IVetoSlasherTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_IVetoSlasher *IVetoSlasherCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _IVetoSlasher.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_IVetoSlasher *IVetoSlasherTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _IVetoSlasher.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_IVetoSlasher *IVetoSlasherTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _IVetoSlasher.Contract.contract.Transact(opts, method, params...)
+}
+
+// BURNERGASLIMIT is a free data retrieval call binding the contract method 0xd5c24e1a.
+//
+// Solidity: function BURNER_GAS_LIMIT() view returns(uint256)
+func (_IVetoSlasher *IVetoSlasherCaller) BURNERGASLIMIT(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _IVetoSlasher.contract.Call(opts, &out, "BURNER_GAS_LIMIT")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BURNERGASLIMIT is a free data retrieval call binding the contract method 0xd5c24e1a.
+//
+// Solidity: function BURNER_GAS_LIMIT() view returns(uint256)
+func (_IVetoSlasher *IVetoSlasherSession) BURNERGASLIMIT() (*big.Int, error) {
+	return _IVetoSlasher.Contract.BURNERGASLIMIT(&_IVetoSlasher.CallOpts)
+}
+
+// BURNERGASLIMIT is a free data retrieval call binding the contract method 0xd5c24e1a.
+//
+// Solidity: function BURNER_GAS_LIMIT() view returns(uint256)
+func (_IVetoSlasher *IVetoSlasherCallerSession) BURNERGASLIMIT() (*big.Int, error) {
+	return _IVetoSlasher.Contract.BURNERGASLIMIT(&_IVetoSlasher.CallOpts)
+}
+
+// BURNERRESERVE is a free data retrieval call binding the contract method 0x2964f8dc.
+//
+// Solidity: function BURNER_RESERVE() view returns(uint256)
+func (_IVetoSlasher *IVetoSlasherCaller) BURNERRESERVE(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _IVetoSlasher.contract.Call(opts, &out, "BURNER_RESERVE")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BURNERRESERVE is a free data retrieval call binding the contract method 0x2964f8dc.
+//
+// Solidity: function BURNER_RESERVE() view returns(uint256)
+func (_IVetoSlasher *IVetoSlasherSession) BURNERRESERVE() (*big.Int, error) {
+	return _IVetoSlasher.Contract.BURNERRESERVE(&_IVetoSlasher.CallOpts)
+}
+
+// BURNERRESERVE is a free data retrieval call binding the contract method 0x2964f8dc.
+//
+// Solidity: function BURNER_RESERVE() view returns(uint256)
+func (_IVetoSlasher *IVetoSlasherCallerSession) BURNERRESERVE() (*big.Int, error) {
+	return _IVetoSlasher.Contract.BURNERRESERVE(&_IVetoSlasher.CallOpts)
+}
+
+// FACTORY is a free data retrieval call binding the contract method 0x2dd31000.
+//
+// Solidity: function FACTORY() view returns(address)
+func (_IVetoSlasher *IVetoSlasherCaller) FACTORY(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _IVetoSlasher.contract.Call(opts, &out, "FACTORY")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// FACTORY is a free data retrieval call binding the contract method 0x2dd31000.
+//
+// Solidity: function FACTORY() view returns(address)
+func (_IVetoSlasher *IVetoSlasherSession) FACTORY() (common.Address, error) {
+	return _IVetoSlasher.Contract.FACTORY(&_IVetoSlasher.CallOpts)
+}
+
+// FACTORY is a free data retrieval call binding the contract method 0x2dd31000.
+//
+// Solidity: function FACTORY() view returns(address)
+func (_IVetoSlasher *IVetoSlasherCallerSession) FACTORY() (common.Address, error) {
+	return _IVetoSlasher.Contract.FACTORY(&_IVetoSlasher.CallOpts)
+}
+
+// NETWORKMIDDLEWARESERVICE is a free data retrieval call binding the contract method 0x2c9d45b3.
+//
+// Solidity: function NETWORK_MIDDLEWARE_SERVICE() view returns(address)
+func (_IVetoSlasher *IVetoSlasherCaller) NETWORKMIDDLEWARESERVICE(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _IVetoSlasher.contract.Call(opts, &out, "NETWORK_MIDDLEWARE_SERVICE")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// NETWORKMIDDLEWARESERVICE is a free data retrieval call binding the contract method 0x2c9d45b3.
+//
+// Solidity: function NETWORK_MIDDLEWARE_SERVICE() view returns(address)
+func (_IVetoSlasher *IVetoSlasherSession) NETWORKMIDDLEWARESERVICE() (common.Address, error) {
+	return _IVetoSlasher.Contract.NETWORKMIDDLEWARESERVICE(&_IVetoSlasher.CallOpts)
+}
+
+// NETWORKMIDDLEWARESERVICE is a free data retrieval call binding the contract method 0x2c9d45b3.
+//
+// Solidity: function NETWORK_MIDDLEWARE_SERVICE() view returns(address)
+func (_IVetoSlasher *IVetoSlasherCallerSession) NETWORKMIDDLEWARESERVICE() (common.Address, error) {
+	return _IVetoSlasher.Contract.NETWORKMIDDLEWARESERVICE(&_IVetoSlasher.CallOpts)
+}
+
+// NETWORKREGISTRY is a free data retrieval call binding the contract method 0xc0cd7c3e.
+//
+// Solidity: function NETWORK_REGISTRY() view returns(address)
+func (_IVetoSlasher *IVetoSlasherCaller) NETWORKREGISTRY(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _IVetoSlasher.contract.Call(opts, &out, "NETWORK_REGISTRY")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// NETWORKREGISTRY is a free data retrieval call binding the contract method 0xc0cd7c3e.
+//
+// Solidity: function NETWORK_REGISTRY() view returns(address)
+func (_IVetoSlasher *IVetoSlasherSession) NETWORKREGISTRY() (common.Address, error) {
+	return _IVetoSlasher.Contract.NETWORKREGISTRY(&_IVetoSlasher.CallOpts)
+}
+
+// NETWORKREGISTRY is a free data retrieval call binding the contract method 0xc0cd7c3e.
+//
+// Solidity: function NETWORK_REGISTRY() view returns(address)
+func (_IVetoSlasher *IVetoSlasherCallerSession) NETWORKREGISTRY() (common.Address, error) {
+	return _IVetoSlasher.Contract.NETWORKREGISTRY(&_IVetoSlasher.CallOpts)
+}
+
+// TYPE is a free data retrieval call binding the contract method 0xbb24fe8a.
+//
+// Solidity: function TYPE() view returns(uint64)
+func (_IVetoSlasher *IVetoSlasherCaller) TYPE(opts *bind.CallOpts) (uint64, error) {
+	var out []interface{}
+	err := _IVetoSlasher.contract.Call(opts, &out, "TYPE")
+
+	if err != nil {
+		return *new(uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint64)).(*uint64)
+
+	return out0, err
+
+}
+
+// TYPE is a free data retrieval call binding the contract method 0xbb24fe8a.
+//
+// Solidity: function TYPE() view returns(uint64)
+func (_IVetoSlasher *IVetoSlasherSession) TYPE() (uint64, error) {
+	return _IVetoSlasher.Contract.TYPE(&_IVetoSlasher.CallOpts)
+}
+
+// TYPE is a free data retrieval call binding the contract method 0xbb24fe8a.
+//
+// Solidity: function TYPE() view returns(uint64)
+func (_IVetoSlasher *IVetoSlasherCallerSession) TYPE() (uint64, error) {
+	return _IVetoSlasher.Contract.TYPE(&_IVetoSlasher.CallOpts)
+}
+
+// VAULTFACTORY is a free data retrieval call binding the contract method 0x103f2907.
+//
+// Solidity: function VAULT_FACTORY() view returns(address)
+func (_IVetoSlasher *IVetoSlasherCaller) VAULTFACTORY(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _IVetoSlasher.contract.Call(opts, &out, "VAULT_FACTORY")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// VAULTFACTORY is a free data retrieval call binding the contract method 0x103f2907.
+//
+// Solidity: function VAULT_FACTORY() view returns(address)
+func (_IVetoSlasher *IVetoSlasherSession) VAULTFACTORY() (common.Address, error) {
+	return _IVetoSlasher.Contract.VAULTFACTORY(&_IVetoSlasher.CallOpts)
+}
+
+// VAULTFACTORY is a free data retrieval call binding the contract method 0x103f2907.
+//
+// Solidity: function VAULT_FACTORY() view returns(address)
+func (_IVetoSlasher *IVetoSlasherCallerSession) VAULTFACTORY() (common.Address, error) {
+	return _IVetoSlasher.Contract.VAULTFACTORY(&_IVetoSlasher.CallOpts)
+}
+
+// CumulativeSlash is a free data retrieval call binding the contract method 0x1a2be2c4.
+//
+// Solidity: function cumulativeSlash(bytes32 subnetwork, address operator) view returns(uint256)
+func (_IVetoSlasher *IVetoSlasherCaller) CumulativeSlash(opts *bind.CallOpts, subnetwork [32]byte, operator common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _IVetoSlasher.contract.Call(opts, &out, "cumulativeSlash", subnetwork, operator)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// CumulativeSlash is a free data retrieval call binding the contract method 0x1a2be2c4.
+//
+// Solidity: function cumulativeSlash(bytes32 subnetwork, address operator) view returns(uint256)
+func (_IVetoSlasher *IVetoSlasherSession) CumulativeSlash(subnetwork [32]byte, operator common.Address) (*big.Int, error) {
+	return _IVetoSlasher.Contract.CumulativeSlash(&_IVetoSlasher.CallOpts, subnetwork, operator)
+}
+
+// CumulativeSlash is a free data retrieval call binding the contract method 0x1a2be2c4.
+//
+// Solidity: function cumulativeSlash(bytes32 subnetwork, address operator) view returns(uint256)
+func (_IVetoSlasher *IVetoSlasherCallerSession) CumulativeSlash(subnetwork [32]byte, operator common.Address) (*big.Int, error) {
+	return _IVetoSlasher.Contract.CumulativeSlash(&_IVetoSlasher.CallOpts, subnetwork, operator)
+}
+
+// CumulativeSlashAt is a free data retrieval call binding the contract method 0xaa8e6489.
+//
+// Solidity: function cumulativeSlashAt(bytes32 subnetwork, address operator, uint48 timestamp, bytes hint) view returns(uint256)
+func (_IVetoSlasher *IVetoSlasherCaller) CumulativeSlashAt(opts *bind.CallOpts, subnetwork [32]byte, operator common.Address, timestamp *big.Int, hint []byte) (*big.Int, error) {
+	var out []interface{}
+	err := _IVetoSlasher.contract.Call(opts, &out, "cumulativeSlashAt", subnetwork, operator, timestamp, hint)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// CumulativeSlashAt is a free data retrieval call binding the contract method 0xaa8e6489.
+//
+// Solidity: function cumulativeSlashAt(bytes32 subnetwork, address operator, uint48 timestamp, bytes hint) view returns(uint256)
+func (_IVetoSlasher *IVetoSlasherSession) CumulativeSlashAt(subnetwork [32]byte, operator common.Address, timestamp *big.Int, hint []byte) (*big.Int, error) {
+	return _IVetoSlasher.Contract.CumulativeSlashAt(&_IVetoSlasher.CallOpts, subnetwork, operator, timestamp, hint)
+}
+
+// CumulativeSlashAt is a free data retrieval call binding the contract method 0xaa8e6489.
+//
+// Solidity: function cumulativeSlashAt(bytes32 subnetwork, address operator, uint48 timestamp, bytes hint) view returns(uint256)
+func (_IVetoSlasher *IVetoSlasherCallerSession) CumulativeSlashAt(subnetwork [32]byte, operator common.Address, timestamp *big.Int, hint []byte) (*big.Int, error) {
+	return _IVetoSlasher.Contract.CumulativeSlashAt(&_IVetoSlasher.CallOpts, subnetwork, operator, timestamp, hint)
+}
+
+// IsBurnerHook is a free data retrieval call binding the contract method 0x34d098ea.
+//
+// Solidity: function isBurnerHook() view returns(bool)
+func (_IVetoSlasher *IVetoSlasherCaller) IsBurnerHook(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _IVetoSlasher.contract.Call(opts, &out, "isBurnerHook")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsBurnerHook is a free data retrieval call binding the contract method 0x34d098ea.
+//
+// Solidity: function isBurnerHook() view returns(bool)
+func (_IVetoSlasher *IVetoSlasherSession) IsBurnerHook() (bool, error) {
+	return _IVetoSlasher.Contract.IsBurnerHook(&_IVetoSlasher.CallOpts)
+}
+
+// IsBurnerHook is a free data retrieval call binding the contract method 0x34d098ea.
+//
+// Solidity: function isBurnerHook() view returns(bool)
+func (_IVetoSlasher *IVetoSlasherCallerSession) IsBurnerHook() (bool, error) {
+	return _IVetoSlasher.Contract.IsBurnerHook(&_IVetoSlasher.CallOpts)
+}
+
+// LatestSlashedCaptureTimestamp is a free data retrieval call binding the contract method 0x267de1f3.
+//
+// Solidity: function latestSlashedCaptureTimestamp(bytes32 subnetwork, address operator) view returns(uint48)
+func (_IVetoSlasher *IVetoSlasherCaller) LatestSlashedCaptureTimestamp(opts *bind.CallOpts, subnetwork [32]byte, operator common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _IVetoSlasher.contract.Call(opts, &out, "latestSlashedCaptureTimestamp", subnetwork, operator)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// LatestSlashedCaptureTimestamp is a free data retrieval call binding the contract method 0x267de1f3.
+//
+// Solidity: function latestSlashedCaptureTimestamp(bytes32 subnetwork, address operator) view returns(uint48)
+func (_IVetoSlasher *IVetoSlasherSession) LatestSlashedCaptureTimestamp(subnetwork [32]byte, operator common.Address) (*big.Int, error) {
+	return _IVetoSlasher.Contract.LatestSlashedCaptureTimestamp(&_IVetoSlasher.CallOpts, subnetwork, operator)
+}
+
+// LatestSlashedCaptureTimestamp is a free data retrieval call binding the contract method 0x267de1f3.
+//
+// Solidity: function latestSlashedCaptureTimestamp(bytes32 subnetwork, address operator) view returns(uint48)
+func (_IVetoSlasher *IVetoSlasherCallerSession) LatestSlashedCaptureTimestamp(subnetwork [32]byte, operator common.Address) (*big.Int, error) {
+	return _IVetoSlasher.Contract.LatestSlashedCaptureTimestamp(&_IVetoSlasher.CallOpts, subnetwork, operator)
+}
+
+// Resolver is a free data retrieval call binding the contract method 0xcd05b8a1.
+//
+// Solidity: function resolver(bytes32 subnetwork, bytes hint) view returns(address)
+func (_IVetoSlasher *IVetoSlasherCaller) Resolver(opts *bind.CallOpts, subnetwork [32]byte, hint []byte) (common.Address, error) {
+	var out []interface{}
+	err := _IVetoSlasher.contract.Call(opts, &out, "resolver", subnetwork, hint)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Resolver is a free data retrieval call binding the contract method 0xcd05b8a1.
+//
+// Solidity: function resolver(bytes32 subnetwork, bytes hint) view returns(address)
+func (_IVetoSlasher *IVetoSlasherSession) Resolver(subnetwork [32]byte, hint []byte) (common.Address, error) {
+	return _IVetoSlasher.Contract.Resolver(&_IVetoSlasher.CallOpts, subnetwork, hint)
+}
+
+// Resolver is a free data retrieval call binding the contract method 0xcd05b8a1.
+//
+// Solidity: function resolver(bytes32 subnetwork, bytes hint) view returns(address)
+func (_IVetoSlasher *IVetoSlasherCallerSession) Resolver(subnetwork [32]byte, hint []byte) (common.Address, error) {
+	return _IVetoSlasher.Contract.Resolver(&_IVetoSlasher.CallOpts, subnetwork, hint)
+}
+
+// ResolverAt is a free data retrieval call binding the contract method 0x31ce4dfe.
+//
+// Solidity: function resolverAt(bytes32 subnetwork, uint48 timestamp, bytes hint) view returns(address)
+func (_IVetoSlasher *IVetoSlasherCaller) ResolverAt(opts *bind.CallOpts, subnetwork [32]byte, timestamp *big.Int, hint []byte) (common.Address, error) {
+	var out []interface{}
+	err := _IVetoSlasher.contract.Call(opts, &out, "resolverAt", subnetwork, timestamp, hint)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// ResolverAt is a free data retrieval call binding the contract method 0x31ce4dfe.
+//
+// Solidity: function resolverAt(bytes32 subnetwork, uint48 timestamp, bytes hint) view returns(address)
+func (_IVetoSlasher *IVetoSlasherSession) ResolverAt(subnetwork [32]byte, timestamp *big.Int, hint []byte) (common.Address, error) {
+	return _IVetoSlasher.Contract.ResolverAt(&_IVetoSlasher.CallOpts, subnetwork, timestamp, hint)
+}
+
+// ResolverAt is a free data retrieval call binding the contract method 0x31ce4dfe.
+//
+// Solidity: function resolverAt(bytes32 subnetwork, uint48 timestamp, bytes hint) view returns(address)
+func (_IVetoSlasher *IVetoSlasherCallerSession) ResolverAt(subnetwork [32]byte, timestamp *big.Int, hint []byte) (common.Address, error) {
+	return _IVetoSlasher.Contract.ResolverAt(&_IVetoSlasher.CallOpts, subnetwork, timestamp, hint)
+}
+
+// ResolverSetEpochsDelay is a free data retrieval call binding the contract method 0xbc6eac5b.
+//
+// Solidity: function resolverSetEpochsDelay() view returns(uint256)
+func (_IVetoSlasher *IVetoSlasherCaller) ResolverSetEpochsDelay(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _IVetoSlasher.contract.Call(opts, &out, "resolverSetEpochsDelay")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ResolverSetEpochsDelay is a free data retrieval call binding the contract method 0xbc6eac5b.
+//
+// Solidity: function resolverSetEpochsDelay() view returns(uint256)
+func (_IVetoSlasher *IVetoSlasherSession) ResolverSetEpochsDelay() (*big.Int, error) {
+	return _IVetoSlasher.Contract.ResolverSetEpochsDelay(&_IVetoSlasher.CallOpts)
+}
+
+// ResolverSetEpochsDelay is a free data retrieval call binding the contract method 0xbc6eac5b.
+//
+// Solidity: function resolverSetEpochsDelay() view returns(uint256)
+func (_IVetoSlasher *IVetoSlasherCallerSession) ResolverSetEpochsDelay() (*big.Int, error) {
+	return _IVetoSlasher.Contract.ResolverSetEpochsDelay(&_IVetoSlasher.CallOpts)
+}
+
+// SlashRequests is a free data retrieval call binding the contract method 0x9a896551.
+//
+// Solidity: function slashRequests(uint256 slashIndex) view returns(bytes32 subnetwork, address operator, uint256 amount, uint48 captureTimestamp, uint48 vetoDeadline, bool completed)
+func (_IVetoSlasher *IVetoSlasherCaller) SlashRequests(opts *bind.CallOpts, slashIndex *big.Int) (struct {
+	Subnetwork       [32]byte
+	Operator         common.Address
+	Amount           *big.Int
+	CaptureTimestamp *big.Int
+	VetoDeadline     *big.Int
+	Completed        bool
+}, error) {
+	var out []interface{}
+	err := _IVetoSlasher.contract.Call(opts, &out, "slashRequests", slashIndex)
+
+	outstruct := new(struct {
+		Subnetwork       [32]byte
+		Operator         common.Address
+		Amount           *big.Int
+		CaptureTimestamp *big.Int
+		VetoDeadline     *big.Int
+		Completed        bool
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Subnetwork = *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+	outstruct.Operator = *abi.ConvertType(out[1], new(common.Address)).(*common.Address)
+	outstruct.Amount = *abi.ConvertType(out[2], new(*big.Int)).(**big.Int)
+	outstruct.CaptureTimestamp = *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	outstruct.VetoDeadline = *abi.ConvertType(out[4], new(*big.Int)).(**big.Int)
+	outstruct.Completed = *abi.ConvertType(out[5], new(bool)).(*bool)
+
+	return *outstruct, err
+
+}
+
+// SlashRequests is a free data retrieval call binding the contract method 0x9a896551.
+//
+// Solidity: function slashRequests(uint256 slashIndex) view returns(bytes32 subnetwork, address operator, uint256 amount, uint48 captureTimestamp, uint48 vetoDeadline, bool completed)
+func (_IVetoSlasher *IVetoSlasherSession) SlashRequests(slashIndex *big.Int) (struct {
+	Subnetwork       [32]byte
+	Operator         common.Address
+	Amount           *big.Int
+	CaptureTimestamp *big.Int
+	VetoDeadline     *big.Int
+	Completed        bool
+}, error) {
+	return _IVetoSlasher.Contract.SlashRequests(&_IVetoSlasher.CallOpts, slashIndex)
+}
+
+// SlashRequests is a free data retrieval call binding the contract method 0x9a896551.
+//
+// Solidity: function slashRequests(uint256 slashIndex) view returns(bytes32 subnetwork, address operator, uint256 amount, uint48 captureTimestamp, uint48 vetoDeadline, bool completed)
+func (_IVetoSlasher *IVetoSlasherCallerSession) SlashRequests(slashIndex *big.Int) (struct {
+	Subnetwork       [32]byte
+	Operator         common.Address
+	Amount           *big.Int
+	CaptureTimestamp *big.Int
+	VetoDeadline     *big.Int
+	Completed        bool
+}, error) {
+	return _IVetoSlasher.Contract.SlashRequests(&_IVetoSlasher.CallOpts, slashIndex)
+}
+
+// SlashRequestsLength is a free data retrieval call binding the contract method 0x8f42f9ab.
+//
+// Solidity: function slashRequestsLength() view returns(uint256)
+func (_IVetoSlasher *IVetoSlasherCaller) SlashRequestsLength(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _IVetoSlasher.contract.Call(opts, &out, "slashRequestsLength")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// SlashRequestsLength is a free data retrieval call binding the contract method 0x8f42f9ab.
+//
+// Solidity: function slashRequestsLength() view returns(uint256)
+func (_IVetoSlasher *IVetoSlasherSession) SlashRequestsLength() (*big.Int, error) {
+	return _IVetoSlasher.Contract.SlashRequestsLength(&_IVetoSlasher.CallOpts)
+}
+
+// SlashRequestsLength is a free data retrieval call binding the contract method 0x8f42f9ab.
+//
+// Solidity: function slashRequestsLength() view returns(uint256)
+func (_IVetoSlasher *IVetoSlasherCallerSession) SlashRequestsLength() (*big.Int, error) {
+	return _IVetoSlasher.Contract.SlashRequestsLength(&_IVetoSlasher.CallOpts)
+}
+
+// SlashableStake is a free data retrieval call binding the contract method 0xaab3595f.
+//
+// Solidity: function slashableStake(bytes32 subnetwork, address operator, uint48 captureTimestamp, bytes hints) view returns(uint256)
+func (_IVetoSlasher *IVetoSlasherCaller) SlashableStake(opts *bind.CallOpts, subnetwork [32]byte, operator common.Address, captureTimestamp *big.Int, hints []byte) (*big.Int, error) {
+	var out []interface{}
+	err := _IVetoSlasher.contract.Call(opts, &out, "slashableStake", subnetwork, operator, captureTimestamp, hints)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// SlashableStake is a free data retrieval call binding the contract method 0xaab3595f.
+//
+// Solidity: function slashableStake(bytes32 subnetwork, address operator, uint48 captureTimestamp, bytes hints) view returns(uint256)
+func (_IVetoSlasher *IVetoSlasherSession) SlashableStake(subnetwork [32]byte, operator common.Address, captureTimestamp *big.Int, hints []byte) (*big.Int, error) {
+	return _IVetoSlasher.Contract.SlashableStake(&_IVetoSlasher.CallOpts, subnetwork, operator, captureTimestamp, hints)
+}
+
+// SlashableStake is a free data retrieval call binding the contract method 0xaab3595f.
+//
+// Solidity: function slashableStake(bytes32 subnetwork, address operator, uint48 captureTimestamp, bytes hints) view returns(uint256)
+func (_IVetoSlasher *IVetoSlasherCallerSession) SlashableStake(subnetwork [32]byte, operator common.Address, captureTimestamp *big.Int, hints []byte) (*big.Int, error) {
+	return _IVetoSlasher.Contract.SlashableStake(&_IVetoSlasher.CallOpts, subnetwork, operator, captureTimestamp, hints)
+}
+
+// Vault is a free data retrieval call binding the contract method 0xfbfa77cf.
+//
+// Solidity: function vault() view returns(address)
+func (_IVetoSlasher *IVetoSlasherCaller) Vault(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _IVetoSlasher.contract.Call(opts, &out, "vault")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Vault is a free data retrieval call binding the contract method 0xfbfa77cf.
+//
+// Solidity: function vault() view returns(address)
+func (_IVetoSlasher *IVetoSlasherSession) Vault() (common.Address, error) {
+	return _IVetoSlasher.Contract.Vault(&_IVetoSlasher.CallOpts)
+}
+
+// Vault is a free data retrieval call binding the contract method 0xfbfa77cf.
+//
+// Solidity: function vault() view returns(address)
+func (_IVetoSlasher *IVetoSlasherCallerSession) Vault() (common.Address, error) {
+	return _IVetoSlasher.Contract.Vault(&_IVetoSlasher.CallOpts)
+}
+
+// VetoDuration is a free data retrieval call binding the contract method 0xe054e08b.
+//
+// Solidity: function vetoDuration() view returns(uint48)
+func (_IVetoSlasher *IVetoSlasherCaller) VetoDuration(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _IVetoSlasher.contract.Call(opts, &out, "vetoDuration")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// VetoDuration is a free data retrieval call binding the contract method 0xe054e08b.
+//
+// Solidity: function vetoDuration() view returns(uint48)
+func (_IVetoSlasher *IVetoSlasherSession) VetoDuration() (*big.Int, error) {
+	return _IVetoSlasher.Contract.VetoDuration(&_IVetoSlasher.CallOpts)
+}
+
+// VetoDuration is a free data retrieval call binding the contract method 0xe054e08b.
+//
+// Solidity: function vetoDuration() view returns(uint48)
+func (_IVetoSlasher *IVetoSlasherCallerSession) VetoDuration() (*big.Int, error) {
+	return _IVetoSlasher.Contract.VetoDuration(&_IVetoSlasher.CallOpts)
+}
+
+// ExecuteSlash is a paid mutator transaction binding the contract method 0xb94c386e.
+//
+// Solidity: function executeSlash(uint256 slashIndex, bytes hints) returns(uint256 slashedAmount)
+func (_IVetoSlasher *IVetoSlasherTransactor) ExecuteSlash(opts *bind.TransactOpts, slashIndex *big.Int, hints []byte) (*types.Transaction, error) {
+	return _IVetoSlasher.contract.Transact(opts, "executeSlash", slashIndex, hints)
+}
+
+// ExecuteSlash is a paid mutator transaction binding the contract method 0xb94c386e.
+//
+// Solidity: function executeSlash(uint256 slashIndex, bytes hints) returns(uint256 slashedAmount)
+func (_IVetoSlasher *IVetoSlasherSession) ExecuteSlash(slashIndex *big.Int, hints []byte) (*types.Transaction, error) {
+	return _IVetoSlasher.Contract.ExecuteSlash(&_IVetoSlasher.TransactOpts, slashIndex, hints)
+}
+
+// ExecuteSlash is a paid mutator transaction binding the contract method 0xb94c386e.
+//
+// Solidity: function executeSlash(uint256 slashIndex, bytes hints) returns(uint256 slashedAmount)
+func (_IVetoSlasher *IVetoSlasherTransactorSession) ExecuteSlash(slashIndex *big.Int, hints []byte) (*types.Transaction, error) {
+	return _IVetoSlasher.Contract.ExecuteSlash(&_IVetoSlasher.TransactOpts, slashIndex, hints)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x439fab91.
+//
+// Solidity: function initialize(bytes data) returns()
+func (_IVetoSlasher *IVetoSlasherTransactor) Initialize(opts *bind.TransactOpts, data []byte) (*types.Transaction, error) {
+	return _IVetoSlasher.contract.Transact(opts, "initialize", data)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x439fab91.
+//
+// Solidity: function initialize(bytes data) returns()
+func (_IVetoSlasher *IVetoSlasherSession) Initialize(data []byte) (*types.Transaction, error) {
+	return _IVetoSlasher.Contract.Initialize(&_IVetoSlasher.TransactOpts, data)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0x439fab91.
+//
+// Solidity: function initialize(bytes data) returns()
+func (_IVetoSlasher *IVetoSlasherTransactorSession) Initialize(data []byte) (*types.Transaction, error) {
+	return _IVetoSlasher.Contract.Initialize(&_IVetoSlasher.TransactOpts, data)
+}
+
+// RequestSlash is a paid mutator transaction binding the contract method 0x545ce389.
+//
+// Solidity: function requestSlash(bytes32 subnetwork, address operator, uint256 amount, uint48 captureTimestamp, bytes hints) returns(uint256 slashIndex)
+func (_IVetoSlasher *IVetoSlasherTransactor) RequestSlash(opts *bind.TransactOpts, subnetwork [32]byte, operator common.Address, amount *big.Int, captureTimestamp *big.Int, hints []byte) (*types.Transaction, error) {
+	return _IVetoSlasher.contract.Transact(opts, "requestSlash", subnetwork, operator, amount, captureTimestamp, hints)
+}
+
+// RequestSlash is a paid mutator transaction binding the contract method 0x545ce389.
+//
+// Solidity: function requestSlash(bytes32 subnetwork, address operator, uint256 amount, uint48 captureTimestamp, bytes hints) returns(uint256 slashIndex)
+func (_IVetoSlasher *IVetoSlasherSession) RequestSlash(subnetwork [32]byte, operator common.Address, amount *big.Int, captureTimestamp *big.Int, hints []byte) (*types.Transaction, error) {
+	return _IVetoSlasher.Contract.RequestSlash(&_IVetoSlasher.TransactOpts, subnetwork, operator, amount, captureTimestamp, hints)
+}
+
+// RequestSlash is a paid mutator transaction binding the contract method 0x545ce389.
+//
+// Solidity: function requestSlash(bytes32 subnetwork, address operator, uint256 amount, uint48 captureTimestamp, bytes hints) returns(uint256 slashIndex)
+func (_IVetoSlasher *IVetoSlasherTransactorSession) RequestSlash(subnetwork [32]byte, operator common.Address, amount *big.Int, captureTimestamp *big.Int, hints []byte) (*types.Transaction, error) {
+	return _IVetoSlasher.Contract.RequestSlash(&_IVetoSlasher.TransactOpts, subnetwork, operator, amount, captureTimestamp, hints)
+}
+
+// SetResolver is a paid mutator transaction binding the contract method 0x9168f9d2.
+//
+// Solidity: function setResolver(uint96 identifier, address resolver, bytes hints) returns()
+func (_IVetoSlasher *IVetoSlasherTransactor) SetResolver(opts *bind.TransactOpts, identifier *big.Int, resolver common.Address, hints []byte) (*types.Transaction, error) {
+	return _IVetoSlasher.contract.Transact(opts, "setResolver", identifier, resolver, hints)
+}
+
+// SetResolver is a paid mutator transaction binding the contract method 0x9168f9d2.
+//
+// Solidity: function setResolver(uint96 identifier, address resolver, bytes hints) returns()
+func (_IVetoSlasher *IVetoSlasherSession) SetResolver(identifier *big.Int, resolver common.Address, hints []byte) (*types.Transaction, error) {
+	return _IVetoSlasher.Contract.SetResolver(&_IVetoSlasher.TransactOpts, identifier, resolver, hints)
+}
+
+// SetResolver is a paid mutator transaction binding the contract method 0x9168f9d2.
+//
+// Solidity: function setResolver(uint96 identifier, address resolver, bytes hints) returns()
+func (_IVetoSlasher *IVetoSlasherTransactorSession) SetResolver(identifier *big.Int, resolver common.Address, hints []byte) (*types.Transaction, error) {
+	return _IVetoSlasher.Contract.SetResolver(&_IVetoSlasher.TransactOpts, identifier, resolver, hints)
+}
+
+// VetoSlash is a paid mutator transaction binding the contract method 0xf9268d18.
+//
+// Solidity: function vetoSlash(uint256 slashIndex, bytes hints) returns()
+func (_IVetoSlasher *IVetoSlasherTransactor) VetoSlash(opts *bind.TransactOpts, slashIndex *big.Int, hints []byte) (*types.Transaction, error) {
+	return _IVetoSlasher.contract.Transact(opts, "vetoSlash", slashIndex, hints)
+}
+
+// VetoSlash is a paid mutator transaction binding the contract method 0xf9268d18.
+//
+// Solidity: function vetoSlash(uint256 slashIndex, bytes hints) returns()
+func (_IVetoSlasher *IVetoSlasherSession) VetoSlash(slashIndex *big.Int, hints []byte) (*types.Transaction, error) {
+	return _IVetoSlasher.Contract.VetoSlash(&_IVetoSlasher.TransactOpts, slashIndex, hints)
+}
+
+// VetoSlash is a paid mutator transaction binding the contract method 0xf9268d18.
+//
+// Solidity: function vetoSlash(uint256 slashIndex, bytes hints) returns()
+func (_IVetoSlasher *IVetoSlasherTransactorSession) VetoSlash(slashIndex *big.Int, hints []byte) (*types.Transaction, error) {
+	return _IVetoSlasher.Contract.VetoSlash(&_IVetoSlasher.TransactOpts, slashIndex, hints)
+}
+
+// IVetoSlasherExecuteSlashIterator is returned from FilterExecuteSlash and is used to iterate over the raw logs and unpacked data for ExecuteSlash events raised by the IVetoSlasher contract.
+type IVetoSlasherExecuteSlashIterator struct {
+	Event *IVetoSlasherExecuteSlash // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IVetoSlasherExecuteSlashIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IVetoSlasherExecuteSlash)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IVetoSlasherExecuteSlash)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IVetoSlasherExecuteSlashIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IVetoSlasherExecuteSlashIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IVetoSlasherExecuteSlash represents a ExecuteSlash event raised by the IVetoSlasher contract.
+type IVetoSlasherExecuteSlash struct {
+	SlashIndex    *big.Int
+	SlashedAmount *big.Int
+	Raw           types.Log // Blockchain specific contextual infos
+}
+
+// FilterExecuteSlash is a free log retrieval operation binding the contract event 0x5cc28df3b54da9d06f805d87616ebf969c81310f2aa8369cb14ca50f815d4111.
+//
+// Solidity: event ExecuteSlash(uint256 indexed slashIndex, uint256 slashedAmount)
+func (_IVetoSlasher *IVetoSlasherFilterer) FilterExecuteSlash(opts *bind.FilterOpts, slashIndex []*big.Int) (*IVetoSlasherExecuteSlashIterator, error) {
+
+	var slashIndexRule []interface{}
+	for _, slashIndexItem := range slashIndex {
+		slashIndexRule = append(slashIndexRule, slashIndexItem)
+	}
+
+	logs, sub, err := _IVetoSlasher.contract.FilterLogs(opts, "ExecuteSlash", slashIndexRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IVetoSlasherExecuteSlashIterator{contract: _IVetoSlasher.contract, event: "ExecuteSlash", logs: logs, sub: sub}, nil
+}
+
+// WatchExecuteSlash is a free log subscription operation binding the contract event 0x5cc28df3b54da9d06f805d87616ebf969c81310f2aa8369cb14ca50f815d4111.
+//
+// Solidity: event ExecuteSlash(uint256 indexed slashIndex, uint256 slashedAmount)
+func (_IVetoSlasher *IVetoSlasherFilterer) WatchExecuteSlash(opts *bind.WatchOpts, sink chan<- *IVetoSlasherExecuteSlash, slashIndex []*big.Int) (event.Subscription, error) {
+
+	var slashIndexRule []interface{}
+	for _, slashIndexItem := range slashIndex {
+		slashIndexRule = append(slashIndexRule, slashIndexItem)
+	}
+
+	logs, sub, err := _IVetoSlasher.contract.WatchLogs(opts, "ExecuteSlash", slashIndexRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IVetoSlasherExecuteSlash)
+				if err := _IVetoSlasher.contract.UnpackLog(event, "ExecuteSlash", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseExecuteSlash is a log parse operation binding the contract event 0x5cc28df3b54da9d06f805d87616ebf969c81310f2aa8369cb14ca50f815d4111.
+//
+// Solidity: event ExecuteSlash(uint256 indexed slashIndex, uint256 slashedAmount)
+func (_IVetoSlasher *IVetoSlasherFilterer) ParseExecuteSlash(log types.Log) (*IVetoSlasherExecuteSlash, error) {
+	event := new(IVetoSlasherExecuteSlash)
+	if err := _IVetoSlasher.contract.UnpackLog(event, "ExecuteSlash", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IVetoSlasherRequestSlashIterator is returned from FilterRequestSlash and is used to iterate over the raw logs and unpacked data for RequestSlash events raised by the IVetoSlasher contract.
+type IVetoSlasherRequestSlashIterator struct {
+	Event *IVetoSlasherRequestSlash // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IVetoSlasherRequestSlashIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IVetoSlasherRequestSlash)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IVetoSlasherRequestSlash)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IVetoSlasherRequestSlashIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IVetoSlasherRequestSlashIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IVetoSlasherRequestSlash represents a RequestSlash event raised by the IVetoSlasher contract.
+type IVetoSlasherRequestSlash struct {
+	SlashIndex       *big.Int
+	Subnetwork       [32]byte
+	Operator         common.Address
+	SlashAmount      *big.Int
+	CaptureTimestamp *big.Int
+	VetoDeadline     *big.Int
+	Raw              types.Log // Blockchain specific contextual infos
+}
+
+// FilterRequestSlash is a free log retrieval operation binding the contract event 0x3cb7f4ff6cecf74cee0eb9ae3ebf8933fa60a53014cce52f96da72a78dba8464.
+//
+// Solidity: event RequestSlash(uint256 indexed slashIndex, bytes32 indexed subnetwork, address indexed operator, uint256 slashAmount, uint48 captureTimestamp, uint48 vetoDeadline)
+func (_IVetoSlasher *IVetoSlasherFilterer) FilterRequestSlash(opts *bind.FilterOpts, slashIndex []*big.Int, subnetwork [][32]byte, operator []common.Address) (*IVetoSlasherRequestSlashIterator, error) {
+
+	var slashIndexRule []interface{}
+	for _, slashIndexItem := range slashIndex {
+		slashIndexRule = append(slashIndexRule, slashIndexItem)
+	}
+	var subnetworkRule []interface{}
+	for _, subnetworkItem := range subnetwork {
+		subnetworkRule = append(subnetworkRule, subnetworkItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _IVetoSlasher.contract.FilterLogs(opts, "RequestSlash", slashIndexRule, subnetworkRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IVetoSlasherRequestSlashIterator{contract: _IVetoSlasher.contract, event: "RequestSlash", logs: logs, sub: sub}, nil
+}
+
+// WatchRequestSlash is a free log subscription operation binding the contract event 0x3cb7f4ff6cecf74cee0eb9ae3ebf8933fa60a53014cce52f96da72a78dba8464.
+//
+// Solidity: event RequestSlash(uint256 indexed slashIndex, bytes32 indexed subnetwork, address indexed operator, uint256 slashAmount, uint48 captureTimestamp, uint48 vetoDeadline)
+func (_IVetoSlasher *IVetoSlasherFilterer) WatchRequestSlash(opts *bind.WatchOpts, sink chan<- *IVetoSlasherRequestSlash, slashIndex []*big.Int, subnetwork [][32]byte, operator []common.Address) (event.Subscription, error) {
+
+	var slashIndexRule []interface{}
+	for _, slashIndexItem := range slashIndex {
+		slashIndexRule = append(slashIndexRule, slashIndexItem)
+	}
+	var subnetworkRule []interface{}
+	for _, subnetworkItem := range subnetwork {
+		subnetworkRule = append(subnetworkRule, subnetworkItem)
+	}
+	var operatorRule []interface{}
+	for _, operatorItem := range operator {
+		operatorRule = append(operatorRule, operatorItem)
+	}
+
+	logs, sub, err := _IVetoSlasher.contract.WatchLogs(opts, "RequestSlash", slashIndexRule, subnetworkRule, operatorRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IVetoSlasherRequestSlash)
+				if err := _IVetoSlasher.contract.UnpackLog(event, "RequestSlash", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRequestSlash is a log parse operation binding the contract event 0x3cb7f4ff6cecf74cee0eb9ae3ebf8933fa60a53014cce52f96da72a78dba8464.
+//
+// Solidity: event RequestSlash(uint256 indexed slashIndex, bytes32 indexed subnetwork, address indexed operator, uint256 slashAmount, uint48 captureTimestamp, uint48 vetoDeadline)
+func (_IVetoSlasher *IVetoSlasherFilterer) ParseRequestSlash(log types.Log) (*IVetoSlasherRequestSlash, error) {
+	event := new(IVetoSlasherRequestSlash)
+	if err := _IVetoSlasher.contract.UnpackLog(event, "RequestSlash", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IVetoSlasherSetResolverIterator is returned from FilterSetResolver and is used to iterate over the raw logs and unpacked data for SetResolver events raised by the IVetoSlasher contract.
+type IVetoSlasherSetResolverIterator struct {
+	Event *IVetoSlasherSetResolver // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IVetoSlasherSetResolverIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IVetoSlasherSetResolver)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IVetoSlasherSetResolver)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IVetoSlasherSetResolverIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IVetoSlasherSetResolverIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IVetoSlasherSetResolver represents a SetResolver event raised by the IVetoSlasher contract.
+type IVetoSlasherSetResolver struct {
+	Subnetwork [32]byte
+	Resolver   common.Address
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterSetResolver is a free log retrieval operation binding the contract event 0x7b0b759b513b299fd2811f51926dca8854222a675557e8d544ce0933bf5b7570.
+//
+// Solidity: event SetResolver(bytes32 indexed subnetwork, address resolver)
+func (_IVetoSlasher *IVetoSlasherFilterer) FilterSetResolver(opts *bind.FilterOpts, subnetwork [][32]byte) (*IVetoSlasherSetResolverIterator, error) {
+
+	var subnetworkRule []interface{}
+	for _, subnetworkItem := range subnetwork {
+		subnetworkRule = append(subnetworkRule, subnetworkItem)
+	}
+
+	logs, sub, err := _IVetoSlasher.contract.FilterLogs(opts, "SetResolver", subnetworkRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IVetoSlasherSetResolverIterator{contract: _IVetoSlasher.contract, event: "SetResolver", logs: logs, sub: sub}, nil
+}
+
+// WatchSetResolver is a free log subscription operation binding the contract event 0x7b0b759b513b299fd2811f51926dca8854222a675557e8d544ce0933bf5b7570.
+//
+// Solidity: event SetResolver(bytes32 indexed subnetwork, address resolver)
+func (_IVetoSlasher *IVetoSlasherFilterer) WatchSetResolver(opts *bind.WatchOpts, sink chan<- *IVetoSlasherSetResolver, subnetwork [][32]byte) (event.Subscription, error) {
+
+	var subnetworkRule []interface{}
+	for _, subnetworkItem := range subnetwork {
+		subnetworkRule = append(subnetworkRule, subnetworkItem)
+	}
+
+	logs, sub, err := _IVetoSlasher.contract.WatchLogs(opts, "SetResolver", subnetworkRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IVetoSlasherSetResolver)
+				if err := _IVetoSlasher.contract.UnpackLog(event, "SetResolver", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseSetResolver is a log parse operation binding the contract event 0x7b0b759b513b299fd2811f51926dca8854222a675557e8d544ce0933bf5b7570.
+//
+// Solidity: event SetResolver(bytes32 indexed subnetwork, address resolver)
+func (_IVetoSlasher *IVetoSlasherFilterer) ParseSetResolver(log types.Log) (*IVetoSlasherSetResolver, error) {
+	event := new(IVetoSlasherSetResolver)
+	if err := _IVetoSlasher.contract.UnpackLog(event, "SetResolver", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// IVetoSlasherVetoSlashIterator is returned from FilterVetoSlash and is used to iterate over the raw logs and unpacked data for VetoSlash events raised by the IVetoSlasher contract.
+type IVetoSlasherVetoSlashIterator struct {
+	Event *IVetoSlasherVetoSlash // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *IVetoSlasherVetoSlashIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(IVetoSlasherVetoSlash)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(IVetoSlasherVetoSlash)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *IVetoSlasherVetoSlashIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *IVetoSlasherVetoSlashIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// IVetoSlasherVetoSlash represents a VetoSlash event raised by the IVetoSlasher contract.
+type IVetoSlasherVetoSlash struct {
+	SlashIndex *big.Int
+	Resolver   common.Address
+	Raw        types.Log // Blockchain specific contextual infos
+}
+
+// FilterVetoSlash is a free log retrieval operation binding the contract event 0x7d3d269c851b72257a5194a401d151902e9be4347d555996dcff3ff86a077e92.
+//
+// Solidity: event VetoSlash(uint256 indexed slashIndex, address indexed resolver)
+func (_IVetoSlasher *IVetoSlasherFilterer) FilterVetoSlash(opts *bind.FilterOpts, slashIndex []*big.Int, resolver []common.Address) (*IVetoSlasherVetoSlashIterator, error) {
+
+	var slashIndexRule []interface{}
+	for _, slashIndexItem := range slashIndex {
+		slashIndexRule = append(slashIndexRule, slashIndexItem)
+	}
+	var resolverRule []interface{}
+	for _, resolverItem := range resolver {
+		resolverRule = append(resolverRule, resolverItem)
+	}
+
+	logs, sub, err := _IVetoSlasher.contract.FilterLogs(opts, "VetoSlash", slashIndexRule, resolverRule)
+	if err != nil {
+		return nil, err
+	}
+	return &IVetoSlasherVetoSlashIterator{contract: _IVetoSlasher.contract, event: "VetoSlash", logs: logs, sub: sub}, nil
+}
+
+// WatchVetoSlash is a free log subscription operation binding the contract event 0x7d3d269c851b72257a5194a401d151902e9be4347d555996dcff3ff86a077e92.
+//
+// Solidity: event VetoSlash(uint256 indexed slashIndex, address indexed resolver)
+func (_IVetoSlasher *IVetoSlasherFilterer) WatchVetoSlash(opts *bind.WatchOpts, sink chan<- *IVetoSlasherVetoSlash, slashIndex []*big.Int, resolver []common.Address) (event.Subscription, error) {
+
+	var slashIndexRule []interface{}
+	for _, slashIndexItem := range slashIndex {
+		slashIndexRule = append(slashIndexRule, slashIndexItem)
+	}
+	var resolverRule []interface{}
+	for _, resolverItem := range resolver {
+		resolverRule = append(resolverRule, resolverItem)
+	}
+
+	logs, sub, err := _IVetoSlasher.contract.WatchLogs(opts, "VetoSlash", slashIndexRule, resolverRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(IVetoSlasherVetoSlash)
+				if err := _IVetoSlasher.contract.UnpackLog(event, "VetoSlash", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseVetoSlash is a log parse operation binding the contract event 0x7d3d269c851b72257a5194a401d151902e9be4347d555996dcff3ff86a077e92.
+//
+// Solidity: event VetoSlash(uint256 indexed slashIndex, address indexed resolver)
+func (_IVetoSlasher *IVetoSlasherFilterer) ParseVetoSlash(log types.Log) (*IVetoSlasherVetoSlash, error) {
+	event := new(IVetoSlasherVetoSlash)
+	if err := _IVetoSlasher.contract.UnpackLog(event, "VetoSlash", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
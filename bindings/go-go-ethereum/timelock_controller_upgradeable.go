@@ -0,0 +1,2329 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package networkcontracts
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// TimelockControllerUpgradeableMetaData contains all meta data concerning the TimelockControllerUpgradeable contract.
+var TimelockControllerUpgradeableMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"receive\",\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"CANCELLER_ROLE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"DEFAULT_ADMIN_ROLE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"EXECUTOR_ROLE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"PROPOSER_ROLE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"cancel\",\"inputs\":[{\"name\":\"id\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"execute\",\"inputs\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"payload\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"predecessor\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"salt\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"executeBatch\",\"inputs\":[{\"name\":\"targets\",\"type\":\"address[]\",\"internalType\":\"address[]\"},{\"name\":\"values\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"},{\"name\":\"payloads\",\"type\":\"bytes[]\",\"internalType\":\"bytes[]\"},{\"name\":\"predecessor\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"salt\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"getMinDelay\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOperationState\",\"inputs\":[{\"name\":\"id\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumTimelockControllerUpgradeable.OperationState\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getRoleAdmin\",\"inputs\":[{\"name\":\"role\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTimestamp\",\"inputs\":[{\"name\":\"id\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"grantRole\",\"inputs\":[{\"name\":\"role\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"hasRole\",\"inputs\":[{\"name\":\"role\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hashOperation\",\"inputs\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"predecessor\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"salt\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"hashOperationBatch\",\"inputs\":[{\"name\":\"targets\",\"type\":\"address[]\",\"internalType\":\"address[]\"},{\"name\":\"values\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"},{\"name\":\"payloads\",\"type\":\"bytes[]\",\"internalType\":\"bytes[]\"},{\"name\":\"predecessor\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"salt\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"initialize\",\"inputs\":[{\"name\":\"minDelay\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"proposers\",\"type\":\"address[]\",\"internalType\":\"address[]\"},{\"name\":\"executors\",\"type\":\"address[]\",\"internalType\":\"address[]\"},{\"name\":\"admin\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"isOperation\",\"inputs\":[{\"name\":\"id\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"isOperationDone\",\"inputs\":[{\"name\":\"id\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"isOperationPending\",\"inputs\":[{\"name\":\"id\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"isOperationReady\",\"inputs\":[{\"name\":\"id\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"onERC1155BatchReceived\",\"inputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"},{\"name\":\"\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"},{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes4\",\"internalType\":\"bytes4\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"onERC1155Received\",\"inputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes4\",\"internalType\":\"bytes4\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"onERC721Received\",\"inputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes4\",\"internalType\":\"bytes4\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"renounceRole\",\"inputs\":[{\"name\":\"role\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"callerConfirmation\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"revokeRole\",\"inputs\":[{\"name\":\"role\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"schedule\",\"inputs\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"predecessor\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"salt\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"delay\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"scheduleBatch\",\"inputs\":[{\"name\":\"targets\",\"type\":\"address[]\",\"internalType\":\"address[]\"},{\"name\":\"values\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"},{\"name\":\"payloads\",\"type\":\"bytes[]\",\"internalType\":\"bytes[]\"},{\"name\":\"predecessor\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"salt\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"delay\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"supportsInterface\",\"inputs\":[{\"name\":\"interfaceId\",\"type\":\"bytes4\",\"internalType\":\"bytes4\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"updateDelay\",\"inputs\":[{\"name\":\"newDelay\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"CallExecuted\",\"inputs\":[{\"name\":\"id\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"index\",\"type\":\"uint256\",\"indexed\":true,\"internalType\":\"uint256\"},{\"name\":\"target\",\"type\":\"address\",\"indexed\":false,\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"data\",\"type\":\"bytes\",\"indexed\":false,\"internalType\":\"bytes\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"CallSalt\",\"inputs\":[{\"name\":\"id\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"salt\",\"type\":\"bytes32\",\"indexed\":false,\"internalType\":\"bytes32\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"CallScheduled\",\"inputs\":[{\"name\":\"id\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"index\",\"type\":\"uint256\",\"indexed\":true,\"internalType\":\"uint256\"},{\"name\":\"target\",\"type\":\"address\",\"indexed\":false,\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"data\",\"type\":\"bytes\",\"indexed\":false,\"internalType\":\"bytes\"},{\"name\":\"predecessor\",\"type\":\"bytes32\",\"indexed\":false,\"internalType\":\"bytes32\"},{\"name\":\"delay\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Cancelled\",\"inputs\":[{\"name\":\"id\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Initialized\",\"inputs\":[{\"name\":\"version\",\"type\":\"uint64\",\"indexed\":false,\"internalType\":\"uint64\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"MinDelayChange\",\"inputs\":[{\"name\":\"oldDuration\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"newDuration\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"RoleAdminChanged\",\"inputs\":[{\"name\":\"role\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"previousAdminRole\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"newAdminRole\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"RoleGranted\",\"inputs\":[{\"name\":\"role\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"account\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"sender\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"RoleRevoked\",\"inputs\":[{\"name\":\"role\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"account\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"sender\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"AccessControlBadConfirmation\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AccessControlUnauthorizedAccount\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"neededRole\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}]},{\"type\":\"error\",\"name\":\"FailedCall\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidInitialization\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NotInitializing\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"TimelockInsufficientDelay\",\"inputs\":[{\"name\":\"delay\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"minDelay\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"TimelockInvalidOperationLength\",\"inputs\":[{\"name\":\"targets\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"payloads\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"values\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"TimelockUnauthorizedCaller\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"TimelockUnexecutedPredecessor\",\"inputs\":[{\"name\":\"predecessorId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}]},{\"type\":\"error\",\"name\":\"TimelockUnexpectedOperationState\",\"inputs\":[{\"name\":\"operationId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"expectedStates\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}]}]",
+}
+
+// TimelockControllerUpgradeableABI is the input ABI used to generate the binding from.
+// Deprecated: Use TimelockControllerUpgradeableMetaData.ABI instead.
+var TimelockControllerUpgradeableABI = TimelockControllerUpgradeableMetaData.ABI
+
+// TimelockControllerUpgradeable is an auto generated Go binding around an Ethereum contract.
+type TimelockControllerUpgradeable struct {
+	TimelockControllerUpgradeableCaller     // Read-only binding to the contract
+	TimelockControllerUpgradeableTransactor // Write-only binding to the contract
+	TimelockControllerUpgradeableFilterer   // Log filterer for contract events
+}
+
+// TimelockControllerUpgradeableCaller is an auto generated read-only Go binding around an Ethereum contract.
+type TimelockControllerUpgradeableCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// TimelockControllerUpgradeableTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type TimelockControllerUpgradeableTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// TimelockControllerUpgradeableFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type TimelockControllerUpgradeableFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// TimelockControllerUpgradeableSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type TimelockControllerUpgradeableSession struct {
+	Contract     *TimelockControllerUpgradeable // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts                  // Call options to use throughout this session
+	TransactOpts bind.TransactOpts              // Transaction auth options to use throughout this session
+}
+
+// TimelockControllerUpgradeableCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type TimelockControllerUpgradeableCallerSession struct {
+	Contract *TimelockControllerUpgradeableCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                        // Call options to use throughout this session
+}
+
+// TimelockControllerUpgradeableTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type TimelockControllerUpgradeableTransactorSession struct {
+	Contract     *TimelockControllerUpgradeableTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                        // Transaction auth options to use throughout this session
+}
+
+// TimelockControllerUpgradeableRaw is an auto generated low-level Go binding around an Ethereum contract.
+type TimelockControllerUpgradeableRaw struct {
+	Contract *TimelockControllerUpgradeable // Generic contract binding to access the raw methods on
+}
+
+// TimelockControllerUpgradeableCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type TimelockControllerUpgradeableCallerRaw struct {
+	Contract *TimelockControllerUpgradeableCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// TimelockControllerUpgradeableTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type TimelockControllerUpgradeableTransactorRaw struct {
+	Contract *TimelockControllerUpgradeableTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewTimelockControllerUpgradeable creates a new instance of TimelockControllerUpgradeable, bound to a specific deployed contract.
+func NewTimelockControllerUpgradeable(address common.Address, backend bind.ContractBackend) (*TimelockControllerUpgradeable, error) {
+	contract, err := bindTimelockControllerUpgradeable(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &TimelockControllerUpgradeable{TimelockControllerUpgradeableCaller: TimelockControllerUpgradeableCaller{contract: contract}, TimelockControllerUpgradeableTransactor: TimelockControllerUpgradeableTransactor{contract: contract}, TimelockControllerUpgradeableFilterer: TimelockControllerUpgradeableFilterer{contract: contract}}, nil
+}
+
+// NewTimelockControllerUpgradeableCaller creates a new read-only instance of TimelockControllerUpgradeable, bound to a specific deployed contract.
+func NewTimelockControllerUpgradeableCaller(address common.Address, caller bind.ContractCaller) (*TimelockControllerUpgradeableCaller, error) {
+	contract, err := bindTimelockControllerUpgradeable(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &TimelockControllerUpgradeableCaller{contract: contract}, nil
+}
+
+// NewTimelockControllerUpgradeableTransactor creates a new write-only instance of TimelockControllerUpgradeable, bound to a specific deployed contract.
+func NewTimelockControllerUpgradeableTransactor(address common.Address, transactor bind.ContractTransactor) (*TimelockControllerUpgradeableTransactor, error) {
+	contract, err := bindTimelockControllerUpgradeable(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &TimelockControllerUpgradeableTransactor{contract: contract}, nil
+}
+
+// NewTimelockControllerUpgradeableFilterer creates a new log filterer instance of TimelockControllerUpgradeable, bound to a specific deployed contract.
+func NewTimelockControllerUpgradeableFilterer(address common.Address, filterer bind.ContractFilterer) (*TimelockControllerUpgradeableFilterer, error) {
+	contract, err := bindTimelockControllerUpgradeable(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &TimelockControllerUpgradeableFilterer{contract: contract}, nil
+}
+
+// bindTimelockControllerUpgradeable binds a generic wrapper to an already deployed contract.
+func bindTimelockControllerUpgradeable(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := TimelockControllerUpgradeableMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _TimelockControllerUpgradeable.Contract.TimelockControllerUpgradeableCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _TimelockControllerUpgradeable.Contract.TimelockControllerUpgradeableTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _TimelockControllerUpgradeable.Contract.TimelockControllerUpgradeableTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _TimelockControllerUpgradeable.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _TimelockControllerUpgradeable.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _TimelockControllerUpgradeable.Contract.contract.Transact(opts, method, params...)
+}
+
+// CANCELLERROLE is a free data retrieval call binding the contract method 0xb08e51c0.
+//
+// Solidity: function CANCELLER_ROLE() view returns(bytes32)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableCaller) CANCELLERROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _TimelockControllerUpgradeable.contract.Call(opts, &out, "CANCELLER_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// CANCELLERROLE is a free data retrieval call binding the contract method 0xb08e51c0.
+//
+// Solidity: function CANCELLER_ROLE() view returns(bytes32)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableSession) CANCELLERROLE() ([32]byte, error) {
+	return _TimelockControllerUpgradeable.Contract.CANCELLERROLE(&_TimelockControllerUpgradeable.CallOpts)
+}
+
+// CANCELLERROLE is a free data retrieval call binding the contract method 0xb08e51c0.
+//
+// Solidity: function CANCELLER_ROLE() view returns(bytes32)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableCallerSession) CANCELLERROLE() ([32]byte, error) {
+	return _TimelockControllerUpgradeable.Contract.CANCELLERROLE(&_TimelockControllerUpgradeable.CallOpts)
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableCaller) DEFAULTADMINROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _TimelockControllerUpgradeable.contract.Call(opts, &out, "DEFAULT_ADMIN_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableSession) DEFAULTADMINROLE() ([32]byte, error) {
+	return _TimelockControllerUpgradeable.Contract.DEFAULTADMINROLE(&_TimelockControllerUpgradeable.CallOpts)
+}
+
+// DEFAULTADMINROLE is a free data retrieval call binding the contract method 0xa217fddf.
+//
+// Solidity: function DEFAULT_ADMIN_ROLE() view returns(bytes32)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableCallerSession) DEFAULTADMINROLE() ([32]byte, error) {
+	return _TimelockControllerUpgradeable.Contract.DEFAULTADMINROLE(&_TimelockControllerUpgradeable.CallOpts)
+}
+
+// EXECUTORROLE is a free data retrieval call binding the contract method 0x07bd0265.
+//
+// Solidity: function EXECUTOR_ROLE() view returns(bytes32)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableCaller) EXECUTORROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _TimelockControllerUpgradeable.contract.Call(opts, &out, "EXECUTOR_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// EXECUTORROLE is a free data retrieval call binding the contract method 0x07bd0265.
+//
+// Solidity: function EXECUTOR_ROLE() view returns(bytes32)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableSession) EXECUTORROLE() ([32]byte, error) {
+	return _TimelockControllerUpgradeable.Contract.EXECUTORROLE(&_TimelockControllerUpgradeable.CallOpts)
+}
+
+// EXECUTORROLE is a free data retrieval call binding the contract method 0x07bd0265.
+//
+// Solidity: function EXECUTOR_ROLE() view returns(bytes32)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableCallerSession) EXECUTORROLE() ([32]byte, error) {
+	return _TimelockControllerUpgradeable.Contract.EXECUTORROLE(&_TimelockControllerUpgradeable.CallOpts)
+}
+
+// PROPOSERROLE is a free data retrieval call binding the contract method 0x8f61f4f5.
+//
+// Solidity: function PROPOSER_ROLE() view returns(bytes32)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableCaller) PROPOSERROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _TimelockControllerUpgradeable.contract.Call(opts, &out, "PROPOSER_ROLE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// PROPOSERROLE is a free data retrieval call binding the contract method 0x8f61f4f5.
+//
+// Solidity: function PROPOSER_ROLE() view returns(bytes32)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableSession) PROPOSERROLE() ([32]byte, error) {
+	return _TimelockControllerUpgradeable.Contract.PROPOSERROLE(&_TimelockControllerUpgradeable.CallOpts)
+}
+
+// PROPOSERROLE is a free data retrieval call binding the contract method 0x8f61f4f5.
+//
+// Solidity: function PROPOSER_ROLE() view returns(bytes32)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableCallerSession) PROPOSERROLE() ([32]byte, error) {
+	return _TimelockControllerUpgradeable.Contract.PROPOSERROLE(&_TimelockControllerUpgradeable.CallOpts)
+}
+
+// GetMinDelay is a free data retrieval call binding the contract method 0xf27a0c92.
+//
+// Solidity: function getMinDelay() view returns(uint256)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableCaller) GetMinDelay(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _TimelockControllerUpgradeable.contract.Call(opts, &out, "getMinDelay")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetMinDelay is a free data retrieval call binding the contract method 0xf27a0c92.
+//
+// Solidity: function getMinDelay() view returns(uint256)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableSession) GetMinDelay() (*big.Int, error) {
+	return _TimelockControllerUpgradeable.Contract.GetMinDelay(&_TimelockControllerUpgradeable.CallOpts)
+}
+
+// GetMinDelay is a free data retrieval call binding the contract method 0xf27a0c92.
+//
+// Solidity: function getMinDelay() view returns(uint256)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableCallerSession) GetMinDelay() (*big.Int, error) {
+	return _TimelockControllerUpgradeable.Contract.GetMinDelay(&_TimelockControllerUpgradeable.CallOpts)
+}
+
+// GetOperationState is a free data retrieval call binding the contract method 0x7958004c.
+//
+// Solidity: function getOperationState(bytes32 id) view returns(uint8)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableCaller) GetOperationState(opts *bind.CallOpts, id [32]byte) (uint8, error) {
+	var out []interface{}
+	err := _TimelockControllerUpgradeable.contract.Call(opts, &out, "getOperationState", id)
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// GetOperationState is a free data retrieval call binding the contract method 0x7958004c.
+//
+// Solidity: function getOperationState(bytes32 id) view returns(uint8)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableSession) GetOperationState(id [32]byte) (uint8, error) {
+	return _TimelockControllerUpgradeable.Contract.GetOperationState(&_TimelockControllerUpgradeable.CallOpts, id)
+}
+
+// GetOperationState is a free data retrieval call binding the contract method 0x7958004c.
+//
+// Solidity: function getOperationState(bytes32 id) view returns(uint8)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableCallerSession) GetOperationState(id [32]byte) (uint8, error) {
+	return _TimelockControllerUpgradeable.Contract.GetOperationState(&_TimelockControllerUpgradeable.CallOpts, id)
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableCaller) GetRoleAdmin(opts *bind.CallOpts, role [32]byte) ([32]byte, error) {
+	var out []interface{}
+	err := _TimelockControllerUpgradeable.contract.Call(opts, &out, "getRoleAdmin", role)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableSession) GetRoleAdmin(role [32]byte) ([32]byte, error) {
+	return _TimelockControllerUpgradeable.Contract.GetRoleAdmin(&_TimelockControllerUpgradeable.CallOpts, role)
+}
+
+// GetRoleAdmin is a free data retrieval call binding the contract method 0x248a9ca3.
+//
+// Solidity: function getRoleAdmin(bytes32 role) view returns(bytes32)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableCallerSession) GetRoleAdmin(role [32]byte) ([32]byte, error) {
+	return _TimelockControllerUpgradeable.Contract.GetRoleAdmin(&_TimelockControllerUpgradeable.CallOpts, role)
+}
+
+// GetTimestamp is a free data retrieval call binding the contract method 0xd45c4435.
+//
+// Solidity: function getTimestamp(bytes32 id) view returns(uint256)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableCaller) GetTimestamp(opts *bind.CallOpts, id [32]byte) (*big.Int, error) {
+	var out []interface{}
+	err := _TimelockControllerUpgradeable.contract.Call(opts, &out, "getTimestamp", id)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetTimestamp is a free data retrieval call binding the contract method 0xd45c4435.
+//
+// Solidity: function getTimestamp(bytes32 id) view returns(uint256)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableSession) GetTimestamp(id [32]byte) (*big.Int, error) {
+	return _TimelockControllerUpgradeable.Contract.GetTimestamp(&_TimelockControllerUpgradeable.CallOpts, id)
+}
+
+// GetTimestamp is a free data retrieval call binding the contract method 0xd45c4435.
+//
+// Solidity: function getTimestamp(bytes32 id) view returns(uint256)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableCallerSession) GetTimestamp(id [32]byte) (*big.Int, error) {
+	return _TimelockControllerUpgradeable.Contract.GetTimestamp(&_TimelockControllerUpgradeable.CallOpts, id)
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableCaller) HasRole(opts *bind.CallOpts, role [32]byte, account common.Address) (bool, error) {
+	var out []interface{}
+	err := _TimelockControllerUpgradeable.contract.Call(opts, &out, "hasRole", role, account)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableSession) HasRole(role [32]byte, account common.Address) (bool, error) {
+	return _TimelockControllerUpgradeable.Contract.HasRole(&_TimelockControllerUpgradeable.CallOpts, role, account)
+}
+
+// HasRole is a free data retrieval call binding the contract method 0x91d14854.
+//
+// Solidity: function hasRole(bytes32 role, address account) view returns(bool)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableCallerSession) HasRole(role [32]byte, account common.Address) (bool, error) {
+	return _TimelockControllerUpgradeable.Contract.HasRole(&_TimelockControllerUpgradeable.CallOpts, role, account)
+}
+
+// HashOperation is a free data retrieval call binding the contract method 0x8065657f.
+//
+// Solidity: function hashOperation(address target, uint256 value, bytes data, bytes32 predecessor, bytes32 salt) pure returns(bytes32)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableCaller) HashOperation(opts *bind.CallOpts, target common.Address, value *big.Int, data []byte, predecessor [32]byte, salt [32]byte) ([32]byte, error) {
+	var out []interface{}
+	err := _TimelockControllerUpgradeable.contract.Call(opts, &out, "hashOperation", target, value, data, predecessor, salt)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// HashOperation is a free data retrieval call binding the contract method 0x8065657f.
+//
+// Solidity: function hashOperation(address target, uint256 value, bytes data, bytes32 predecessor, bytes32 salt) pure returns(bytes32)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableSession) HashOperation(target common.Address, value *big.Int, data []byte, predecessor [32]byte, salt [32]byte) ([32]byte, error) {
+	return _TimelockControllerUpgradeable.Contract.HashOperation(&_TimelockControllerUpgradeable.CallOpts, target, value, data, predecessor, salt)
+}
+
+// HashOperation is a free data retrieval call binding the contract method 0x8065657f.
+//
+// Solidity: function hashOperation(address target, uint256 value, bytes data, bytes32 predecessor, bytes32 salt) pure returns(bytes32)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableCallerSession) HashOperation(target common.Address, value *big.Int, data []byte, predecessor [32]byte, salt [32]byte) ([32]byte, error) {
+	return _TimelockControllerUpgradeable.Contract.HashOperation(&_TimelockControllerUpgradeable.CallOpts, target, value, data, predecessor, salt)
+}
+
+// HashOperationBatch is a free data retrieval call binding the contract method 0xb1c5f427.
+//
+// Solidity: function hashOperationBatch(address[] targets, uint256[] values, bytes[] payloads, bytes32 predecessor, bytes32 salt) pure returns(bytes32)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableCaller) HashOperationBatch(opts *bind.CallOpts, targets []common.Address, values []*big.Int, payloads [][]byte, predecessor [32]byte, salt [32]byte) ([32]byte, error) {
+	var out []interface{}
+	err := _TimelockControllerUpgradeable.contract.Call(opts, &out, "hashOperationBatch", targets, values, payloads, predecessor, salt)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// HashOperationBatch is a free data retrieval call binding the contract method 0xb1c5f427.
+//
+// Solidity: function hashOperationBatch(address[] targets, uint256[] values, bytes[] payloads, bytes32 predecessor, bytes32 salt) pure returns(bytes32)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableSession) HashOperationBatch(targets []common.Address, values []*big.Int, payloads [][]byte, predecessor [32]byte, salt [32]byte) ([32]byte, error) {
+	return _TimelockControllerUpgradeable.Contract.HashOperationBatch(&_TimelockControllerUpgradeable.CallOpts, targets, values, payloads, predecessor, salt)
+}
+
+// HashOperationBatch is a free data retrieval call binding the contract method 0xb1c5f427.
+//
+// Solidity: function hashOperationBatch(address[] targets, uint256[] values, bytes[] payloads, bytes32 predecessor, bytes32 salt) pure returns(bytes32)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableCallerSession) HashOperationBatch(targets []common.Address, values []*big.Int, payloads [][]byte, predecessor [32]byte, salt [32]byte) ([32]byte, error) {
+	return _TimelockControllerUpgradeable.Contract.HashOperationBatch(&_TimelockControllerUpgradeable.CallOpts, targets, values, payloads, predecessor, salt)
+}
+
+// IsOperation is a free data retrieval call binding the contract method 0x31d50750.
+//
+// Solidity: function isOperation(bytes32 id) view returns(bool)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableCaller) IsOperation(opts *bind.CallOpts, id [32]byte) (bool, error) {
+	var out []interface{}
+	err := _TimelockControllerUpgradeable.contract.Call(opts, &out, "isOperation", id)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsOperation is a free data retrieval call binding the contract method 0x31d50750.
+//
+// Solidity: function isOperation(bytes32 id) view returns(bool)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableSession) IsOperation(id [32]byte) (bool, error) {
+	return _TimelockControllerUpgradeable.Contract.IsOperation(&_TimelockControllerUpgradeable.CallOpts, id)
+}
+
+// IsOperation is a free data retrieval call binding the contract method 0x31d50750.
+//
+// Solidity: function isOperation(bytes32 id) view returns(bool)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableCallerSession) IsOperation(id [32]byte) (bool, error) {
+	return _TimelockControllerUpgradeable.Contract.IsOperation(&_TimelockControllerUpgradeable.CallOpts, id)
+}
+
+// IsOperationDone is a free data retrieval call binding the contract method 0x2ab0f529.
+//
+// Solidity: function isOperationDone(bytes32 id) view returns(bool)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableCaller) IsOperationDone(opts *bind.CallOpts, id [32]byte) (bool, error) {
+	var out []interface{}
+	err := _TimelockControllerUpgradeable.contract.Call(opts, &out, "isOperationDone", id)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsOperationDone is a free data retrieval call binding the contract method 0x2ab0f529.
+//
+// Solidity: function isOperationDone(bytes32 id) view returns(bool)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableSession) IsOperationDone(id [32]byte) (bool, error) {
+	return _TimelockControllerUpgradeable.Contract.IsOperationDone(&_TimelockControllerUpgradeable.CallOpts, id)
+}
+
+// IsOperationDone is a free data retrieval call binding the contract method 0x2ab0f529.
+//
+// Solidity: function isOperationDone(bytes32 id) view returns(bool)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableCallerSession) IsOperationDone(id [32]byte) (bool, error) {
+	return _TimelockControllerUpgradeable.Contract.IsOperationDone(&_TimelockControllerUpgradeable.CallOpts, id)
+}
+
+// IsOperationPending is a free data retrieval call binding the contract method 0x584b153e.
+//
+// Solidity: function isOperationPending(bytes32 id) view returns(bool)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableCaller) IsOperationPending(opts *bind.CallOpts, id [32]byte) (bool, error) {
+	var out []interface{}
+	err := _TimelockControllerUpgradeable.contract.Call(opts, &out, "isOperationPending", id)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsOperationPending is a free data retrieval call binding the contract method 0x584b153e.
+//
+// Solidity: function isOperationPending(bytes32 id) view returns(bool)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableSession) IsOperationPending(id [32]byte) (bool, error) {
+	return _TimelockControllerUpgradeable.Contract.IsOperationPending(&_TimelockControllerUpgradeable.CallOpts, id)
+}
+
+// IsOperationPending is a free data retrieval call binding the contract method 0x584b153e.
+//
+// Solidity: function isOperationPending(bytes32 id) view returns(bool)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableCallerSession) IsOperationPending(id [32]byte) (bool, error) {
+	return _TimelockControllerUpgradeable.Contract.IsOperationPending(&_TimelockControllerUpgradeable.CallOpts, id)
+}
+
+// IsOperationReady is a free data retrieval call binding the contract method 0x13bc9f20.
+//
+// Solidity: function isOperationReady(bytes32 id) view returns(bool)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableCaller) IsOperationReady(opts *bind.CallOpts, id [32]byte) (bool, error) {
+	var out []interface{}
+	err := _TimelockControllerUpgradeable.contract.Call(opts, &out, "isOperationReady", id)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsOperationReady is a free data retrieval call binding the contract method 0x13bc9f20.
+//
+// Solidity: function isOperationReady(bytes32 id) view returns(bool)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableSession) IsOperationReady(id [32]byte) (bool, error) {
+	return _TimelockControllerUpgradeable.Contract.IsOperationReady(&_TimelockControllerUpgradeable.CallOpts, id)
+}
+
+// IsOperationReady is a free data retrieval call binding the contract method 0x13bc9f20.
+//
+// Solidity: function isOperationReady(bytes32 id) view returns(bool)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableCallerSession) IsOperationReady(id [32]byte) (bool, error) {
+	return _TimelockControllerUpgradeable.Contract.IsOperationReady(&_TimelockControllerUpgradeable.CallOpts, id)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableCaller) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	var out []interface{}
+	err := _TimelockControllerUpgradeable.contract.Call(opts, &out, "supportsInterface", interfaceId)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _TimelockControllerUpgradeable.Contract.SupportsInterface(&_TimelockControllerUpgradeable.CallOpts, interfaceId)
+}
+
+// SupportsInterface is a free data retrieval call binding the contract method 0x01ffc9a7.
+//
+// Solidity: function supportsInterface(bytes4 interfaceId) view returns(bool)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableCallerSession) SupportsInterface(interfaceId [4]byte) (bool, error) {
+	return _TimelockControllerUpgradeable.Contract.SupportsInterface(&_TimelockControllerUpgradeable.CallOpts, interfaceId)
+}
+
+// Cancel is a paid mutator transaction binding the contract method 0xc4d252f5.
+//
+// Solidity: function cancel(bytes32 id) returns()
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableTransactor) Cancel(opts *bind.TransactOpts, id [32]byte) (*types.Transaction, error) {
+	return _TimelockControllerUpgradeable.contract.Transact(opts, "cancel", id)
+}
+
+// Cancel is a paid mutator transaction binding the contract method 0xc4d252f5.
+//
+// Solidity: function cancel(bytes32 id) returns()
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableSession) Cancel(id [32]byte) (*types.Transaction, error) {
+	return _TimelockControllerUpgradeable.Contract.Cancel(&_TimelockControllerUpgradeable.TransactOpts, id)
+}
+
+// Cancel is a paid mutator transaction binding the contract method 0xc4d252f5.
+//
+// Solidity: function cancel(bytes32 id) returns()
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableTransactorSession) Cancel(id [32]byte) (*types.Transaction, error) {
+	return _TimelockControllerUpgradeable.Contract.Cancel(&_TimelockControllerUpgradeable.TransactOpts, id)
+}
+
+// Execute is a paid mutator transaction binding the contract method 0x134008d3.
+//
+// Solidity: function execute(address target, uint256 value, bytes payload, bytes32 predecessor, bytes32 salt) payable returns()
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableTransactor) Execute(opts *bind.TransactOpts, target common.Address, value *big.Int, payload []byte, predecessor [32]byte, salt [32]byte) (*types.Transaction, error) {
+	return _TimelockControllerUpgradeable.contract.Transact(opts, "execute", target, value, payload, predecessor, salt)
+}
+
+// Execute is a paid mutator transaction binding the contract method 0x134008d3.
+//
+// Solidity: function execute(address target, uint256 value, bytes payload, bytes32 predecessor, bytes32 salt) payable returns()
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableSession) Execute(target common.Address, value *big.Int, payload []byte, predecessor [32]byte, salt [32]byte) (*types.Transaction, error) {
+	return _TimelockControllerUpgradeable.Contract.Execute(&_TimelockControllerUpgradeable.TransactOpts, target, value, payload, predecessor, salt)
+}
+
+// Execute is a paid mutator transaction binding the contract method 0x134008d3.
+//
+// Solidity: function execute(address target, uint256 value, bytes payload, bytes32 predecessor, bytes32 salt) payable returns()
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableTransactorSession) Execute(target common.Address, value *big.Int, payload []byte, predecessor [32]byte, salt [32]byte) (*types.Transaction, error) {
+	return _TimelockControllerUpgradeable.Contract.Execute(&_TimelockControllerUpgradeable.TransactOpts, target, value, payload, predecessor, salt)
+}
+
+// ExecuteBatch is a paid mutator transaction binding the contract method 0xe38335e5.
+//
+// Solidity: function executeBatch(address[] targets, uint256[] values, bytes[] payloads, bytes32 predecessor, bytes32 salt) payable returns()
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableTransactor) ExecuteBatch(opts *bind.TransactOpts, targets []common.Address, values []*big.Int, payloads [][]byte, predecessor [32]byte, salt [32]byte) (*types.Transaction, error) {
+	return _TimelockControllerUpgradeable.contract.Transact(opts, "executeBatch", targets, values, payloads, predecessor, salt)
+}
+
+// ExecuteBatch is a paid mutator transaction binding the contract method 0xe38335e5.
+//
+// Solidity: function executeBatch(address[] targets, uint256[] values, bytes[] payloads, bytes32 predecessor, bytes32 salt) payable returns()
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableSession) ExecuteBatch(targets []common.Address, values []*big.Int, payloads [][]byte, predecessor [32]byte, salt [32]byte) (*types.Transaction, error) {
+	return _TimelockControllerUpgradeable.Contract.ExecuteBatch(&_TimelockControllerUpgradeable.TransactOpts, targets, values, payloads, predecessor, salt)
+}
+
+// ExecuteBatch is a paid mutator transaction binding the contract method 0xe38335e5.
+//
+// Solidity: function executeBatch(address[] targets, uint256[] values, bytes[] payloads, bytes32 predecessor, bytes32 salt) payable returns()
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableTransactorSession) ExecuteBatch(targets []common.Address, values []*big.Int, payloads [][]byte, predecessor [32]byte, salt [32]byte) (*types.Transaction, error) {
+	return _TimelockControllerUpgradeable.Contract.ExecuteBatch(&_TimelockControllerUpgradeable.TransactOpts, targets, values, payloads, predecessor, salt)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableTransactor) GrantRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _TimelockControllerUpgradeable.contract.Transact(opts, "grantRole", role, account)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableSession) GrantRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _TimelockControllerUpgradeable.Contract.GrantRole(&_TimelockControllerUpgradeable.TransactOpts, role, account)
+}
+
+// GrantRole is a paid mutator transaction binding the contract method 0x2f2ff15d.
+//
+// Solidity: function grantRole(bytes32 role, address account) returns()
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableTransactorSession) GrantRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _TimelockControllerUpgradeable.Contract.GrantRole(&_TimelockControllerUpgradeable.TransactOpts, role, account)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0xc4c4c7b3.
+//
+// Solidity: function initialize(uint256 minDelay, address[] proposers, address[] executors, address admin) returns()
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableTransactor) Initialize(opts *bind.TransactOpts, minDelay *big.Int, proposers []common.Address, executors []common.Address, admin common.Address) (*types.Transaction, error) {
+	return _TimelockControllerUpgradeable.contract.Transact(opts, "initialize", minDelay, proposers, executors, admin)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0xc4c4c7b3.
+//
+// Solidity: function initialize(uint256 minDelay, address[] proposers, address[] executors, address admin) returns()
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableSession) Initialize(minDelay *big.Int, proposers []common.Address, executors []common.Address, admin common.Address) (*types.Transaction, error) {
+	return _TimelockControllerUpgradeable.Contract.Initialize(&_TimelockControllerUpgradeable.TransactOpts, minDelay, proposers, executors, admin)
+}
+
+// Initialize is a paid mutator transaction binding the contract method 0xc4c4c7b3.
+//
+// Solidity: function initialize(uint256 minDelay, address[] proposers, address[] executors, address admin) returns()
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableTransactorSession) Initialize(minDelay *big.Int, proposers []common.Address, executors []common.Address, admin common.Address) (*types.Transaction, error) {
+	return _TimelockControllerUpgradeable.Contract.Initialize(&_TimelockControllerUpgradeable.TransactOpts, minDelay, proposers, executors, admin)
+}
+
+// OnERC1155BatchReceived is a paid mutator transaction binding the contract method 0xbc197c81.
+//
+// Solidity: function onERC1155BatchReceived(address , address , uint256[] , uint256[] , bytes ) returns(bytes4)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableTransactor) OnERC1155BatchReceived(opts *bind.TransactOpts, arg0 common.Address, arg1 common.Address, arg2 []*big.Int, arg3 []*big.Int, arg4 []byte) (*types.Transaction, error) {
+	return _TimelockControllerUpgradeable.contract.Transact(opts, "onERC1155BatchReceived", arg0, arg1, arg2, arg3, arg4)
+}
+
+// OnERC1155BatchReceived is a paid mutator transaction binding the contract method 0xbc197c81.
+//
+// Solidity: function onERC1155BatchReceived(address , address , uint256[] , uint256[] , bytes ) returns(bytes4)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableSession) OnERC1155BatchReceived(arg0 common.Address, arg1 common.Address, arg2 []*big.Int, arg3 []*big.Int, arg4 []byte) (*types.Transaction, error) {
+	return _TimelockControllerUpgradeable.Contract.OnERC1155BatchReceived(&_TimelockControllerUpgradeable.TransactOpts, arg0, arg1, arg2, arg3, arg4)
+}
+
+// OnERC1155BatchReceived is a paid mutator transaction binding the contract method 0xbc197c81.
+//
+// Solidity: function onERC1155BatchReceived(address , address , uint256[] , uint256[] , bytes ) returns(bytes4)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableTransactorSession) OnERC1155BatchReceived(arg0 common.Address, arg1 common.Address, arg2 []*big.Int, arg3 []*big.Int, arg4 []byte) (*types.Transaction, error) {
+	return _TimelockControllerUpgradeable.Contract.OnERC1155BatchReceived(&_TimelockControllerUpgradeable.TransactOpts, arg0, arg1, arg2, arg3, arg4)
+}
+
+// OnERC1155Received is a paid mutator transaction binding the contract method 0xf23a6e61.
+//
+// Solidity: function onERC1155Received(address , address , uint256 , uint256 , bytes ) returns(bytes4)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableTransactor) OnERC1155Received(opts *bind.TransactOpts, arg0 common.Address, arg1 common.Address, arg2 *big.Int, arg3 *big.Int, arg4 []byte) (*types.Transaction, error) {
+	return _TimelockControllerUpgradeable.contract.Transact(opts, "onERC1155Received", arg0, arg1, arg2, arg3, arg4)
+}
+
+// OnERC1155Received is a paid mutator transaction binding the contract method 0xf23a6e61.
+//
+// Solidity: function onERC1155Received(address , address , uint256 , uint256 , bytes ) returns(bytes4)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableSession) OnERC1155Received(arg0 common.Address, arg1 common.Address, arg2 *big.Int, arg3 *big.Int, arg4 []byte) (*types.Transaction, error) {
+	return _TimelockControllerUpgradeable.Contract.OnERC1155Received(&_TimelockControllerUpgradeable.TransactOpts, arg0, arg1, arg2, arg3, arg4)
+}
+
+// OnERC1155Received is a paid mutator transaction binding the contract method 0xf23a6e61.
+//
+// Solidity: function onERC1155Received(address , address , uint256 , uint256 , bytes ) returns(bytes4)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableTransactorSession) OnERC1155Received(arg0 common.Address, arg1 common.Address, arg2 *big.Int, arg3 *big.Int, arg4 []byte) (*types.Transaction, error) {
+	return _TimelockControllerUpgradeable.Contract.OnERC1155Received(&_TimelockControllerUpgradeable.TransactOpts, arg0, arg1, arg2, arg3, arg4)
+}
+
+// OnERC721Received is a paid mutator transaction binding the contract method 0x150b7a02.
+//
+// Solidity: function onERC721Received(address , address , uint256 , bytes ) returns(bytes4)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableTransactor) OnERC721Received(opts *bind.TransactOpts, arg0 common.Address, arg1 common.Address, arg2 *big.Int, arg3 []byte) (*types.Transaction, error) {
+	return _TimelockControllerUpgradeable.contract.Transact(opts, "onERC721Received", arg0, arg1, arg2, arg3)
+}
+
+// OnERC721Received is a paid mutator transaction binding the contract method 0x150b7a02.
+//
+// Solidity: function onERC721Received(address , address , uint256 , bytes ) returns(bytes4)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableSession) OnERC721Received(arg0 common.Address, arg1 common.Address, arg2 *big.Int, arg3 []byte) (*types.Transaction, error) {
+	return _TimelockControllerUpgradeable.Contract.OnERC721Received(&_TimelockControllerUpgradeable.TransactOpts, arg0, arg1, arg2, arg3)
+}
+
+// OnERC721Received is a paid mutator transaction binding the contract method 0x150b7a02.
+//
+// Solidity: function onERC721Received(address , address , uint256 , bytes ) returns(bytes4)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableTransactorSession) OnERC721Received(arg0 common.Address, arg1 common.Address, arg2 *big.Int, arg3 []byte) (*types.Transaction, error) {
+	return _TimelockControllerUpgradeable.Contract.OnERC721Received(&_TimelockControllerUpgradeable.TransactOpts, arg0, arg1, arg2, arg3)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address callerConfirmation) returns()
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableTransactor) RenounceRole(opts *bind.TransactOpts, role [32]byte, callerConfirmation common.Address) (*types.Transaction, error) {
+	return _TimelockControllerUpgradeable.contract.Transact(opts, "renounceRole", role, callerConfirmation)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address callerConfirmation) returns()
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableSession) RenounceRole(role [32]byte, callerConfirmation common.Address) (*types.Transaction, error) {
+	return _TimelockControllerUpgradeable.Contract.RenounceRole(&_TimelockControllerUpgradeable.TransactOpts, role, callerConfirmation)
+}
+
+// RenounceRole is a paid mutator transaction binding the contract method 0x36568abe.
+//
+// Solidity: function renounceRole(bytes32 role, address callerConfirmation) returns()
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableTransactorSession) RenounceRole(role [32]byte, callerConfirmation common.Address) (*types.Transaction, error) {
+	return _TimelockControllerUpgradeable.Contract.RenounceRole(&_TimelockControllerUpgradeable.TransactOpts, role, callerConfirmation)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableTransactor) RevokeRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _TimelockControllerUpgradeable.contract.Transact(opts, "revokeRole", role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableSession) RevokeRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _TimelockControllerUpgradeable.Contract.RevokeRole(&_TimelockControllerUpgradeable.TransactOpts, role, account)
+}
+
+// RevokeRole is a paid mutator transaction binding the contract method 0xd547741f.
+//
+// Solidity: function revokeRole(bytes32 role, address account) returns()
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableTransactorSession) RevokeRole(role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _TimelockControllerUpgradeable.Contract.RevokeRole(&_TimelockControllerUpgradeable.TransactOpts, role, account)
+}
+
+// Schedule is a paid mutator transaction binding the contract method 0x01d5062a.
+//
+// Solidity: function schedule(address target, uint256 value, bytes data, bytes32 predecessor, bytes32 salt, uint256 delay) returns()
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableTransactor) Schedule(opts *bind.TransactOpts, target common.Address, value *big.Int, data []byte, predecessor [32]byte, salt [32]byte, delay *big.Int) (*types.Transaction, error) {
+	return _TimelockControllerUpgradeable.contract.Transact(opts, "schedule", target, value, data, predecessor, salt, delay)
+}
+
+// Schedule is a paid mutator transaction binding the contract method 0x01d5062a.
+//
+// Solidity: function schedule(address target, uint256 value, bytes data, bytes32 predecessor, bytes32 salt, uint256 delay) returns()
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableSession) Schedule(target common.Address, value *big.Int, data []byte, predecessor [32]byte, salt [32]byte, delay *big.Int) (*types.Transaction, error) {
+	return _TimelockControllerUpgradeable.Contract.Schedule(&_TimelockControllerUpgradeable.TransactOpts, target, value, data, predecessor, salt, delay)
+}
+
+// Schedule is a paid mutator transaction binding the contract method 0x01d5062a.
+//
+// Solidity: function schedule(address target, uint256 value, bytes data, bytes32 predecessor, bytes32 salt, uint256 delay) returns()
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableTransactorSession) Schedule(target common.Address, value *big.Int, data []byte, predecessor [32]byte, salt [32]byte, delay *big.Int) (*types.Transaction, error) {
+	return _TimelockControllerUpgradeable.Contract.Schedule(&_TimelockControllerUpgradeable.TransactOpts, target, value, data, predecessor, salt, delay)
+}
+
+// ScheduleBatch is a paid mutator transaction binding the contract method 0x8f2a0bb0.
+//
+// Solidity: function scheduleBatch(address[] targets, uint256[] values, bytes[] payloads, bytes32 predecessor, bytes32 salt, uint256 delay) returns()
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableTransactor) ScheduleBatch(opts *bind.TransactOpts, targets []common.Address, values []*big.Int, payloads [][]byte, predecessor [32]byte, salt [32]byte, delay *big.Int) (*types.Transaction, error) {
+	return _TimelockControllerUpgradeable.contract.Transact(opts, "scheduleBatch", targets, values, payloads, predecessor, salt, delay)
+}
+
+// ScheduleBatch is a paid mutator transaction binding the contract method 0x8f2a0bb0.
+//
+// Solidity: function scheduleBatch(address[] targets, uint256[] values, bytes[] payloads, bytes32 predecessor, bytes32 salt, uint256 delay) returns()
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableSession) ScheduleBatch(targets []common.Address, values []*big.Int, payloads [][]byte, predecessor [32]byte, salt [32]byte, delay *big.Int) (*types.Transaction, error) {
+	return _TimelockControllerUpgradeable.Contract.ScheduleBatch(&_TimelockControllerUpgradeable.TransactOpts, targets, values, payloads, predecessor, salt, delay)
+}
+
+// ScheduleBatch is a paid mutator transaction binding the contract method 0x8f2a0bb0.
+//
+// Solidity: function scheduleBatch(address[] targets, uint256[] values, bytes[] payloads, bytes32 predecessor, bytes32 salt, uint256 delay) returns()
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableTransactorSession) ScheduleBatch(targets []common.Address, values []*big.Int, payloads [][]byte, predecessor [32]byte, salt [32]byte, delay *big.Int) (*types.Transaction, error) {
+	return _TimelockControllerUpgradeable.Contract.ScheduleBatch(&_TimelockControllerUpgradeable.TransactOpts, targets, values, payloads, predecessor, salt, delay)
+}
+
+// UpdateDelay is a paid mutator transaction binding the contract method 0x64d62353.
+//
+// Solidity: function updateDelay(uint256 newDelay) returns()
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableTransactor) UpdateDelay(opts *bind.TransactOpts, newDelay *big.Int) (*types.Transaction, error) {
+	return _TimelockControllerUpgradeable.contract.Transact(opts, "updateDelay", newDelay)
+}
+
+// UpdateDelay is a paid mutator transaction binding the contract method 0x64d62353.
+//
+// Solidity: function updateDelay(uint256 newDelay) returns()
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableSession) UpdateDelay(newDelay *big.Int) (*types.Transaction, error) {
+	return _TimelockControllerUpgradeable.Contract.UpdateDelay(&_TimelockControllerUpgradeable.TransactOpts, newDelay)
+}
+
+// UpdateDelay is a paid mutator transaction binding the contract method 0x64d62353.
+//
+// Solidity: function updateDelay(uint256 newDelay) returns()
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableTransactorSession) UpdateDelay(newDelay *big.Int) (*types.Transaction, error) {
+	return _TimelockControllerUpgradeable.Contract.UpdateDelay(&_TimelockControllerUpgradeable.TransactOpts, newDelay)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableTransactor) Receive(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _TimelockControllerUpgradeable.contract.RawTransact(opts, nil) // calldata is disallowed for receive function
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableSession) Receive() (*types.Transaction, error) {
+	return _TimelockControllerUpgradeable.Contract.Receive(&_TimelockControllerUpgradeable.TransactOpts)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableTransactorSession) Receive() (*types.Transaction, error) {
+	return _TimelockControllerUpgradeable.Contract.Receive(&_TimelockControllerUpgradeable.TransactOpts)
+}
+
+// TimelockControllerUpgradeableCallExecutedIterator is returned from FilterCallExecuted and is used to iterate over the raw logs and unpacked data for CallExecuted events raised by the TimelockControllerUpgradeable contract.
+type TimelockControllerUpgradeableCallExecutedIterator struct {
+	Event *TimelockControllerUpgradeableCallExecuted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *TimelockControllerUpgradeableCallExecutedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(TimelockControllerUpgradeableCallExecuted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(TimelockControllerUpgradeableCallExecuted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *TimelockControllerUpgradeableCallExecutedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *TimelockControllerUpgradeableCallExecutedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// TimelockControllerUpgradeableCallExecuted represents a CallExecuted event raised by the TimelockControllerUpgradeable contract.
+type TimelockControllerUpgradeableCallExecuted struct {
+	Id     [32]byte
+	Index  *big.Int
+	Target common.Address
+	Value  *big.Int
+	Data   []byte
+	Raw    types.Log // Blockchain specific contextual infos
+}
+
+// FilterCallExecuted is a free log retrieval operation binding the contract event 0xc2617efa69bab66782fa219543714338489c4e9e178271560a91b82c3f612b58.
+//
+// Solidity: event CallExecuted(bytes32 indexed id, uint256 indexed index, address target, uint256 value, bytes data)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableFilterer) FilterCallExecuted(opts *bind.FilterOpts, id [][32]byte, index []*big.Int) (*TimelockControllerUpgradeableCallExecutedIterator, error) {
+
+	var idRule []interface{}
+	for _, idItem := range id {
+		idRule = append(idRule, idItem)
+	}
+	var indexRule []interface{}
+	for _, indexItem := range index {
+		indexRule = append(indexRule, indexItem)
+	}
+
+	logs, sub, err := _TimelockControllerUpgradeable.contract.FilterLogs(opts, "CallExecuted", idRule, indexRule)
+	if err != nil {
+		return nil, err
+	}
+	return &TimelockControllerUpgradeableCallExecutedIterator{contract: _TimelockControllerUpgradeable.contract, event: "CallExecuted", logs: logs, sub: sub}, nil
+}
+
+// WatchCallExecuted is a free log subscription operation binding the contract event 0xc2617efa69bab66782fa219543714338489c4e9e178271560a91b82c3f612b58.
+//
+// Solidity: event CallExecuted(bytes32 indexed id, uint256 indexed index, address target, uint256 value, bytes data)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableFilterer) WatchCallExecuted(opts *bind.WatchOpts, sink chan<- *TimelockControllerUpgradeableCallExecuted, id [][32]byte, index []*big.Int) (event.Subscription, error) {
+
+	var idRule []interface{}
+	for _, idItem := range id {
+		idRule = append(idRule, idItem)
+	}
+	var indexRule []interface{}
+	for _, indexItem := range index {
+		indexRule = append(indexRule, indexItem)
+	}
+
+	logs, sub, err := _TimelockControllerUpgradeable.contract.WatchLogs(opts, "CallExecuted", idRule, indexRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(TimelockControllerUpgradeableCallExecuted)
+				if err := _TimelockControllerUpgradeable.contract.UnpackLog(event, "CallExecuted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseCallExecuted is a log parse operation binding the contract event 0xc2617efa69bab66782fa219543714338489c4e9e178271560a91b82c3f612b58.
+//
+// Solidity: event CallExecuted(bytes32 indexed id, uint256 indexed index, address target, uint256 value, bytes data)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableFilterer) ParseCallExecuted(log types.Log) (*TimelockControllerUpgradeableCallExecuted, error) {
+	event := new(TimelockControllerUpgradeableCallExecuted)
+	if err := _TimelockControllerUpgradeable.contract.UnpackLog(event, "CallExecuted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// TimelockControllerUpgradeableCallSaltIterator is returned from FilterCallSalt and is used to iterate over the raw logs and unpacked data for CallSalt events raised by the TimelockControllerUpgradeable contract.
+type TimelockControllerUpgradeableCallSaltIterator struct {
+	Event *TimelockControllerUpgradeableCallSalt // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *TimelockControllerUpgradeableCallSaltIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(TimelockControllerUpgradeableCallSalt)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(TimelockControllerUpgradeableCallSalt)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *TimelockControllerUpgradeableCallSaltIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *TimelockControllerUpgradeableCallSaltIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// TimelockControllerUpgradeableCallSalt represents a CallSalt event raised by the TimelockControllerUpgradeable contract.
+type TimelockControllerUpgradeableCallSalt struct {
+	Id   [32]byte
+	Salt [32]byte
+	Raw  types.Log // Blockchain specific contextual infos
+}
+
+// FilterCallSalt is a free log retrieval operation binding the contract event 0x20fda5fd27a1ea7bf5b9567f143ac5470bb059374a27e8f67cb44f946f6d0387.
+//
+// Solidity: event CallSalt(bytes32 indexed id, bytes32 salt)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableFilterer) FilterCallSalt(opts *bind.FilterOpts, id [][32]byte) (*TimelockControllerUpgradeableCallSaltIterator, error) {
+
+	var idRule []interface{}
+	for _, idItem := range id {
+		idRule = append(idRule, idItem)
+	}
+
+	logs, sub, err := _TimelockControllerUpgradeable.contract.FilterLogs(opts, "CallSalt", idRule)
+	if err != nil {
+		return nil, err
+	}
+	return &TimelockControllerUpgradeableCallSaltIterator{contract: _TimelockControllerUpgradeable.contract, event: "CallSalt", logs: logs, sub: sub}, nil
+}
+
+// WatchCallSalt is a free log subscription operation binding the contract event 0x20fda5fd27a1ea7bf5b9567f143ac5470bb059374a27e8f67cb44f946f6d0387.
+//
+// Solidity: event CallSalt(bytes32 indexed id, bytes32 salt)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableFilterer) WatchCallSalt(opts *bind.WatchOpts, sink chan<- *TimelockControllerUpgradeableCallSalt, id [][32]byte) (event.Subscription, error) {
+
+	var idRule []interface{}
+	for _, idItem := range id {
+		idRule = append(idRule, idItem)
+	}
+
+	logs, sub, err := _TimelockControllerUpgradeable.contract.WatchLogs(opts, "CallSalt", idRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(TimelockControllerUpgradeableCallSalt)
+				if err := _TimelockControllerUpgradeable.contract.UnpackLog(event, "CallSalt", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseCallSalt is a log parse operation binding the contract event 0x20fda5fd27a1ea7bf5b9567f143ac5470bb059374a27e8f67cb44f946f6d0387.
+//
+// Solidity: event CallSalt(bytes32 indexed id, bytes32 salt)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableFilterer) ParseCallSalt(log types.Log) (*TimelockControllerUpgradeableCallSalt, error) {
+	event := new(TimelockControllerUpgradeableCallSalt)
+	if err := _TimelockControllerUpgradeable.contract.UnpackLog(event, "CallSalt", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// TimelockControllerUpgradeableCallScheduledIterator is returned from FilterCallScheduled and is used to iterate over the raw logs and unpacked data for CallScheduled events raised by the TimelockControllerUpgradeable contract.
+type TimelockControllerUpgradeableCallScheduledIterator struct {
+	Event *TimelockControllerUpgradeableCallScheduled // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *TimelockControllerUpgradeableCallScheduledIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(TimelockControllerUpgradeableCallScheduled)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(TimelockControllerUpgradeableCallScheduled)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *TimelockControllerUpgradeableCallScheduledIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *TimelockControllerUpgradeableCallScheduledIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// TimelockControllerUpgradeableCallScheduled represents a CallScheduled event raised by the TimelockControllerUpgradeable contract.
+type TimelockControllerUpgradeableCallScheduled struct {
+	Id          [32]byte
+	Index       *big.Int
+	Target      common.Address
+	Value       *big.Int
+	Data        []byte
+	Predecessor [32]byte
+	Delay       *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterCallScheduled is a free log retrieval operation binding the contract event 0x4cf4410cc57040e44862ef0f45f3dd5a5e02db8eb8add648d4b0e236f1d07dca.
+//
+// Solidity: event CallScheduled(bytes32 indexed id, uint256 indexed index, address target, uint256 value, bytes data, bytes32 predecessor, uint256 delay)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableFilterer) FilterCallScheduled(opts *bind.FilterOpts, id [][32]byte, index []*big.Int) (*TimelockControllerUpgradeableCallScheduledIterator, error) {
+
+	var idRule []interface{}
+	for _, idItem := range id {
+		idRule = append(idRule, idItem)
+	}
+	var indexRule []interface{}
+	for _, indexItem := range index {
+		indexRule = append(indexRule, indexItem)
+	}
+
+	logs, sub, err := _TimelockControllerUpgradeable.contract.FilterLogs(opts, "CallScheduled", idRule, indexRule)
+	if err != nil {
+		return nil, err
+	}
+	return &TimelockControllerUpgradeableCallScheduledIterator{contract: _TimelockControllerUpgradeable.contract, event: "CallScheduled", logs: logs, sub: sub}, nil
+}
+
+// WatchCallScheduled is a free log subscription operation binding the contract event 0x4cf4410cc57040e44862ef0f45f3dd5a5e02db8eb8add648d4b0e236f1d07dca.
+//
+// Solidity: event CallScheduled(bytes32 indexed id, uint256 indexed index, address target, uint256 value, bytes data, bytes32 predecessor, uint256 delay)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableFilterer) WatchCallScheduled(opts *bind.WatchOpts, sink chan<- *TimelockControllerUpgradeableCallScheduled, id [][32]byte, index []*big.Int) (event.Subscription, error) {
+
+	var idRule []interface{}
+	for _, idItem := range id {
+		idRule = append(idRule, idItem)
+	}
+	var indexRule []interface{}
+	for _, indexItem := range index {
+		indexRule = append(indexRule, indexItem)
+	}
+
+	logs, sub, err := _TimelockControllerUpgradeable.contract.WatchLogs(opts, "CallScheduled", idRule, indexRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(TimelockControllerUpgradeableCallScheduled)
+				if err := _TimelockControllerUpgradeable.contract.UnpackLog(event, "CallScheduled", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseCallScheduled is a log parse operation binding the contract event 0x4cf4410cc57040e44862ef0f45f3dd5a5e02db8eb8add648d4b0e236f1d07dca.
+//
+// Solidity: event CallScheduled(bytes32 indexed id, uint256 indexed index, address target, uint256 value, bytes data, bytes32 predecessor, uint256 delay)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableFilterer) ParseCallScheduled(log types.Log) (*TimelockControllerUpgradeableCallScheduled, error) {
+	event := new(TimelockControllerUpgradeableCallScheduled)
+	if err := _TimelockControllerUpgradeable.contract.UnpackLog(event, "CallScheduled", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// TimelockControllerUpgradeableCancelledIterator is returned from FilterCancelled and is used to iterate over the raw logs and unpacked data for Cancelled events raised by the TimelockControllerUpgradeable contract.
+type TimelockControllerUpgradeableCancelledIterator struct {
+	Event *TimelockControllerUpgradeableCancelled // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *TimelockControllerUpgradeableCancelledIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(TimelockControllerUpgradeableCancelled)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(TimelockControllerUpgradeableCancelled)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *TimelockControllerUpgradeableCancelledIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *TimelockControllerUpgradeableCancelledIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// TimelockControllerUpgradeableCancelled represents a Cancelled event raised by the TimelockControllerUpgradeable contract.
+type TimelockControllerUpgradeableCancelled struct {
+	Id  [32]byte
+	Raw types.Log // Blockchain specific contextual infos
+}
+
+// FilterCancelled is a free log retrieval operation binding the contract event 0xbaa1eb22f2a492ba1a5fea61b8df4d27c6c8b5f3971e63bb58fa14ff72eedb70.
+//
+// Solidity: event Cancelled(bytes32 indexed id)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableFilterer) FilterCancelled(opts *bind.FilterOpts, id [][32]byte) (*TimelockControllerUpgradeableCancelledIterator, error) {
+
+	var idRule []interface{}
+	for _, idItem := range id {
+		idRule = append(idRule, idItem)
+	}
+
+	logs, sub, err := _TimelockControllerUpgradeable.contract.FilterLogs(opts, "Cancelled", idRule)
+	if err != nil {
+		return nil, err
+	}
+	return &TimelockControllerUpgradeableCancelledIterator{contract: _TimelockControllerUpgradeable.contract, event: "Cancelled", logs: logs, sub: sub}, nil
+}
+
+// WatchCancelled is a free log subscription operation binding the contract event 0xbaa1eb22f2a492ba1a5fea61b8df4d27c6c8b5f3971e63bb58fa14ff72eedb70.
+//
+// Solidity: event Cancelled(bytes32 indexed id)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableFilterer) WatchCancelled(opts *bind.WatchOpts, sink chan<- *TimelockControllerUpgradeableCancelled, id [][32]byte) (event.Subscription, error) {
+
+	var idRule []interface{}
+	for _, idItem := range id {
+		idRule = append(idRule, idItem)
+	}
+
+	logs, sub, err := _TimelockControllerUpgradeable.contract.WatchLogs(opts, "Cancelled", idRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(TimelockControllerUpgradeableCancelled)
+				if err := _TimelockControllerUpgradeable.contract.UnpackLog(event, "Cancelled", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseCancelled is a log parse operation binding the contract event 0xbaa1eb22f2a492ba1a5fea61b8df4d27c6c8b5f3971e63bb58fa14ff72eedb70.
+//
+// Solidity: event Cancelled(bytes32 indexed id)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableFilterer) ParseCancelled(log types.Log) (*TimelockControllerUpgradeableCancelled, error) {
+	event := new(TimelockControllerUpgradeableCancelled)
+	if err := _TimelockControllerUpgradeable.contract.UnpackLog(event, "Cancelled", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// TimelockControllerUpgradeableInitializedIterator is returned from FilterInitialized and is used to iterate over the raw logs and unpacked data for Initialized events raised by the TimelockControllerUpgradeable contract.
+type TimelockControllerUpgradeableInitializedIterator struct {
+	Event *TimelockControllerUpgradeableInitialized // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *TimelockControllerUpgradeableInitializedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(TimelockControllerUpgradeableInitialized)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(TimelockControllerUpgradeableInitialized)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *TimelockControllerUpgradeableInitializedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *TimelockControllerUpgradeableInitializedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// TimelockControllerUpgradeableInitialized represents a Initialized event raised by the TimelockControllerUpgradeable contract.
+type TimelockControllerUpgradeableInitialized struct {
+	Version uint64
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterInitialized is a free log retrieval operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableFilterer) FilterInitialized(opts *bind.FilterOpts) (*TimelockControllerUpgradeableInitializedIterator, error) {
+
+	logs, sub, err := _TimelockControllerUpgradeable.contract.FilterLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return &TimelockControllerUpgradeableInitializedIterator{contract: _TimelockControllerUpgradeable.contract, event: "Initialized", logs: logs, sub: sub}, nil
+}
+
+// WatchInitialized is a free log subscription operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableFilterer) WatchInitialized(opts *bind.WatchOpts, sink chan<- *TimelockControllerUpgradeableInitialized) (event.Subscription, error) {
+
+	logs, sub, err := _TimelockControllerUpgradeable.contract.WatchLogs(opts, "Initialized")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(TimelockControllerUpgradeableInitialized)
+				if err := _TimelockControllerUpgradeable.contract.UnpackLog(event, "Initialized", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseInitialized is a log parse operation binding the contract event 0xc7f505b2f371ae2175ee4913f4499e1f2633a7b5936321eed1cdaeb6115181d2.
+//
+// Solidity: event Initialized(uint64 version)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableFilterer) ParseInitialized(log types.Log) (*TimelockControllerUpgradeableInitialized, error) {
+	event := new(TimelockControllerUpgradeableInitialized)
+	if err := _TimelockControllerUpgradeable.contract.UnpackLog(event, "Initialized", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// TimelockControllerUpgradeableMinDelayChangeIterator is returned from FilterMinDelayChange and is used to iterate over the raw logs and unpacked data for MinDelayChange events raised by the TimelockControllerUpgradeable contract.
+type TimelockControllerUpgradeableMinDelayChangeIterator struct {
+	Event *TimelockControllerUpgradeableMinDelayChange // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *TimelockControllerUpgradeableMinDelayChangeIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(TimelockControllerUpgradeableMinDelayChange)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(TimelockControllerUpgradeableMinDelayChange)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *TimelockControllerUpgradeableMinDelayChangeIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *TimelockControllerUpgradeableMinDelayChangeIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// TimelockControllerUpgradeableMinDelayChange represents a MinDelayChange event raised by the TimelockControllerUpgradeable contract.
+type TimelockControllerUpgradeableMinDelayChange struct {
+	OldDuration *big.Int
+	NewDuration *big.Int
+	Raw         types.Log // Blockchain specific contextual infos
+}
+
+// FilterMinDelayChange is a free log retrieval operation binding the contract event 0x11c24f4ead16507c69ac467fbd5e4eed5fb5c699626d2cc6d66421df253886d5.
+//
+// Solidity: event MinDelayChange(uint256 oldDuration, uint256 newDuration)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableFilterer) FilterMinDelayChange(opts *bind.FilterOpts) (*TimelockControllerUpgradeableMinDelayChangeIterator, error) {
+
+	logs, sub, err := _TimelockControllerUpgradeable.contract.FilterLogs(opts, "MinDelayChange")
+	if err != nil {
+		return nil, err
+	}
+	return &TimelockControllerUpgradeableMinDelayChangeIterator{contract: _TimelockControllerUpgradeable.contract, event: "MinDelayChange", logs: logs, sub: sub}, nil
+}
+
+// WatchMinDelayChange is a free log subscription operation binding the contract event 0x11c24f4ead16507c69ac467fbd5e4eed5fb5c699626d2cc6d66421df253886d5.
+//
+// Solidity: event MinDelayChange(uint256 oldDuration, uint256 newDuration)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableFilterer) WatchMinDelayChange(opts *bind.WatchOpts, sink chan<- *TimelockControllerUpgradeableMinDelayChange) (event.Subscription, error) {
+
+	logs, sub, err := _TimelockControllerUpgradeable.contract.WatchLogs(opts, "MinDelayChange")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(TimelockControllerUpgradeableMinDelayChange)
+				if err := _TimelockControllerUpgradeable.contract.UnpackLog(event, "MinDelayChange", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseMinDelayChange is a log parse operation binding the contract event 0x11c24f4ead16507c69ac467fbd5e4eed5fb5c699626d2cc6d66421df253886d5.
+//
+// Solidity: event MinDelayChange(uint256 oldDuration, uint256 newDuration)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableFilterer) ParseMinDelayChange(log types.Log) (*TimelockControllerUpgradeableMinDelayChange, error) {
+	event := new(TimelockControllerUpgradeableMinDelayChange)
+	if err := _TimelockControllerUpgradeable.contract.UnpackLog(event, "MinDelayChange", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// TimelockControllerUpgradeableRoleAdminChangedIterator is returned from FilterRoleAdminChanged and is used to iterate over the raw logs and unpacked data for RoleAdminChanged events raised by the TimelockControllerUpgradeable contract.
+type TimelockControllerUpgradeableRoleAdminChangedIterator struct {
+	Event *TimelockControllerUpgradeableRoleAdminChanged // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *TimelockControllerUpgradeableRoleAdminChangedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(TimelockControllerUpgradeableRoleAdminChanged)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(TimelockControllerUpgradeableRoleAdminChanged)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *TimelockControllerUpgradeableRoleAdminChangedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *TimelockControllerUpgradeableRoleAdminChangedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// TimelockControllerUpgradeableRoleAdminChanged represents a RoleAdminChanged event raised by the TimelockControllerUpgradeable contract.
+type TimelockControllerUpgradeableRoleAdminChanged struct {
+	Role              [32]byte
+	PreviousAdminRole [32]byte
+	NewAdminRole      [32]byte
+	Raw               types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleAdminChanged is a free log retrieval operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableFilterer) FilterRoleAdminChanged(opts *bind.FilterOpts, role [][32]byte, previousAdminRole [][32]byte, newAdminRole [][32]byte) (*TimelockControllerUpgradeableRoleAdminChangedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var previousAdminRoleRule []interface{}
+	for _, previousAdminRoleItem := range previousAdminRole {
+		previousAdminRoleRule = append(previousAdminRoleRule, previousAdminRoleItem)
+	}
+	var newAdminRoleRule []interface{}
+	for _, newAdminRoleItem := range newAdminRole {
+		newAdminRoleRule = append(newAdminRoleRule, newAdminRoleItem)
+	}
+
+	logs, sub, err := _TimelockControllerUpgradeable.contract.FilterLogs(opts, "RoleAdminChanged", roleRule, previousAdminRoleRule, newAdminRoleRule)
+	if err != nil {
+		return nil, err
+	}
+	return &TimelockControllerUpgradeableRoleAdminChangedIterator{contract: _TimelockControllerUpgradeable.contract, event: "RoleAdminChanged", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleAdminChanged is a free log subscription operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableFilterer) WatchRoleAdminChanged(opts *bind.WatchOpts, sink chan<- *TimelockControllerUpgradeableRoleAdminChanged, role [][32]byte, previousAdminRole [][32]byte, newAdminRole [][32]byte) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var previousAdminRoleRule []interface{}
+	for _, previousAdminRoleItem := range previousAdminRole {
+		previousAdminRoleRule = append(previousAdminRoleRule, previousAdminRoleItem)
+	}
+	var newAdminRoleRule []interface{}
+	for _, newAdminRoleItem := range newAdminRole {
+		newAdminRoleRule = append(newAdminRoleRule, newAdminRoleItem)
+	}
+
+	logs, sub, err := _TimelockControllerUpgradeable.contract.WatchLogs(opts, "RoleAdminChanged", roleRule, previousAdminRoleRule, newAdminRoleRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(TimelockControllerUpgradeableRoleAdminChanged)
+				if err := _TimelockControllerUpgradeable.contract.UnpackLog(event, "RoleAdminChanged", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleAdminChanged is a log parse operation binding the contract event 0xbd79b86ffe0ab8e8776151514217cd7cacd52c909f66475c3af44e129f0b00ff.
+//
+// Solidity: event RoleAdminChanged(bytes32 indexed role, bytes32 indexed previousAdminRole, bytes32 indexed newAdminRole)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableFilterer) ParseRoleAdminChanged(log types.Log) (*TimelockControllerUpgradeableRoleAdminChanged, error) {
+	event := new(TimelockControllerUpgradeableRoleAdminChanged)
+	if err := _TimelockControllerUpgradeable.contract.UnpackLog(event, "RoleAdminChanged", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// TimelockControllerUpgradeableRoleGrantedIterator is returned from FilterRoleGranted and is used to iterate over the raw logs and unpacked data for RoleGranted events raised by the TimelockControllerUpgradeable contract.
+type TimelockControllerUpgradeableRoleGrantedIterator struct {
+	Event *TimelockControllerUpgradeableRoleGranted // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *TimelockControllerUpgradeableRoleGrantedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(TimelockControllerUpgradeableRoleGranted)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(TimelockControllerUpgradeableRoleGranted)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *TimelockControllerUpgradeableRoleGrantedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *TimelockControllerUpgradeableRoleGrantedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// TimelockControllerUpgradeableRoleGranted represents a RoleGranted event raised by the TimelockControllerUpgradeable contract.
+type TimelockControllerUpgradeableRoleGranted struct {
+	Role    [32]byte
+	Account common.Address
+	Sender  common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleGranted is a free log retrieval operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableFilterer) FilterRoleGranted(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*TimelockControllerUpgradeableRoleGrantedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _TimelockControllerUpgradeable.contract.FilterLogs(opts, "RoleGranted", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &TimelockControllerUpgradeableRoleGrantedIterator{contract: _TimelockControllerUpgradeable.contract, event: "RoleGranted", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleGranted is a free log subscription operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableFilterer) WatchRoleGranted(opts *bind.WatchOpts, sink chan<- *TimelockControllerUpgradeableRoleGranted, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _TimelockControllerUpgradeable.contract.WatchLogs(opts, "RoleGranted", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(TimelockControllerUpgradeableRoleGranted)
+				if err := _TimelockControllerUpgradeable.contract.UnpackLog(event, "RoleGranted", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleGranted is a log parse operation binding the contract event 0x2f8788117e7eff1d82e926ec794901d17c78024a50270940304540a733656f0d.
+//
+// Solidity: event RoleGranted(bytes32 indexed role, address indexed account, address indexed sender)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableFilterer) ParseRoleGranted(log types.Log) (*TimelockControllerUpgradeableRoleGranted, error) {
+	event := new(TimelockControllerUpgradeableRoleGranted)
+	if err := _TimelockControllerUpgradeable.contract.UnpackLog(event, "RoleGranted", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// TimelockControllerUpgradeableRoleRevokedIterator is returned from FilterRoleRevoked and is used to iterate over the raw logs and unpacked data for RoleRevoked events raised by the TimelockControllerUpgradeable contract.
+type TimelockControllerUpgradeableRoleRevokedIterator struct {
+	Event *TimelockControllerUpgradeableRoleRevoked // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *TimelockControllerUpgradeableRoleRevokedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(TimelockControllerUpgradeableRoleRevoked)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(TimelockControllerUpgradeableRoleRevoked)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *TimelockControllerUpgradeableRoleRevokedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *TimelockControllerUpgradeableRoleRevokedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// TimelockControllerUpgradeableRoleRevoked represents a RoleRevoked event raised by the TimelockControllerUpgradeable contract.
+type TimelockControllerUpgradeableRoleRevoked struct {
+	Role    [32]byte
+	Account common.Address
+	Sender  common.Address
+	Raw     types.Log // Blockchain specific contextual infos
+}
+
+// FilterRoleRevoked is a free log retrieval operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableFilterer) FilterRoleRevoked(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*TimelockControllerUpgradeableRoleRevokedIterator, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _TimelockControllerUpgradeable.contract.FilterLogs(opts, "RoleRevoked", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return &TimelockControllerUpgradeableRoleRevokedIterator{contract: _TimelockControllerUpgradeable.contract, event: "RoleRevoked", logs: logs, sub: sub}, nil
+}
+
+// WatchRoleRevoked is a free log subscription operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableFilterer) WatchRoleRevoked(opts *bind.WatchOpts, sink chan<- *TimelockControllerUpgradeableRoleRevoked, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error) {
+
+	var roleRule []interface{}
+	for _, roleItem := range role {
+		roleRule = append(roleRule, roleItem)
+	}
+	var accountRule []interface{}
+	for _, accountItem := range account {
+		accountRule = append(accountRule, accountItem)
+	}
+	var senderRule []interface{}
+	for _, senderItem := range sender {
+		senderRule = append(senderRule, senderItem)
+	}
+
+	logs, sub, err := _TimelockControllerUpgradeable.contract.WatchLogs(opts, "RoleRevoked", roleRule, accountRule, senderRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(TimelockControllerUpgradeableRoleRevoked)
+				if err := _TimelockControllerUpgradeable.contract.UnpackLog(event, "RoleRevoked", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseRoleRevoked is a log parse operation binding the contract event 0xf6391f5c32d9c69d2a47ea670b442974b53935d1edc7fd64eb21e047a839171b.
+//
+// Solidity: event RoleRevoked(bytes32 indexed role, address indexed account, address indexed sender)
+func (_TimelockControllerUpgradeable *TimelockControllerUpgradeableFilterer) ParseRoleRevoked(log types.Log) (*TimelockControllerUpgradeableRoleRevoked, error) {
+	event := new(TimelockControllerUpgradeableRoleRevoked)
+	if err := _TimelockControllerUpgradeable.contract.UnpackLog(event, "RoleRevoked", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
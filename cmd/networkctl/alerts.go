@@ -0,0 +1,192 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli/v2"
+
+	"github.com/symbioticfi/network/pkg/alert"
+)
+
+var (
+	alertsConfigFlag = &cli.StringFlag{
+		Name:     "config",
+		Usage:    "path of the alerting YAML configuration",
+		Required: true,
+	}
+	receiverListenFlag = &cli.StringFlag{
+		Name:  "listen",
+		Usage: "address to accept webhook and Slack payloads on",
+		Value: "127.0.0.1:9465",
+	}
+)
+
+var alertsCommand = &cli.Command{
+	Name:  "alerts",
+	Usage: "evaluate alerting rules against live Network events",
+	Subcommands: []*cli.Command{
+		{
+			Name:  "run",
+			Usage: "watch the configured Networks and deliver rule matches",
+			Flags: []cli.Flag{
+				optionalRPCURLFlag,
+				alertsConfigFlag,
+				fromBlockFlag,
+				fleetOptionFlag,
+				deploymentFlag,
+			},
+			Action: alertsRun,
+		},
+		{
+			Name:   "test",
+			Usage:  "deliver a synthetic alert to every configured sink",
+			Flags:  []cli.Flag{alertsConfigFlag},
+			Action: alertsTest,
+		},
+		{
+			Name:   "receive",
+			Usage:  "run a local receiver that prints the webhook and Slack payloads it gets",
+			Flags:  []cli.Flag{receiverListenFlag},
+			Action: alertsReceive,
+		},
+	},
+}
+
+func alertsRun(ctx *cli.Context) error {
+	config, err := alert.LoadConfig(ctx.String(alertsConfigFlag.Name))
+	if err != nil {
+		return err
+	}
+	dispatcher, err := config.Dispatcher()
+	if err != nil {
+		return err
+	}
+	watchers, closeWatchers, err := newWatchers(ctx, config)
+	if err != nil {
+		return err
+	}
+	defer closeWatchers()
+
+	runCtx, stop := signal.NotifyContext(ctx.Context, syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	// The first failing Watcher stops the others.
+	errs := make(chan error, len(watchers))
+	for _, watcher := range watchers {
+		if ctx.IsSet(fromBlockFlag.Name) {
+			from := ctx.Uint64(fromBlockFlag.Name)
+			watcher.Start = &from
+		}
+		service := &alert.Service{Watcher: watcher, Rules: config.Rules, Dispatcher: dispatcher}
+		go func() {
+			errs <- service.Run(runCtx)
+		}()
+	}
+	err = <-errs
+	stop()
+	for range len(watchers) - 1 {
+		<-errs
+	}
+	if err != nil && !errors.Is(err, context.Canceled) {
+		return err
+	}
+	return nil
+}
+
+// newWatchers creates a Watcher per deployment of --fleet, or one for the
+// Networks of the configuration on --rpc-url. closeAll closes their
+// connections.
+func newWatchers(ctx *cli.Context, config *alert.Config) (watchers []*alert.Watcher, closeAll func(), err error) {
+	if !ctx.IsSet(fleetOptionFlag.Name) {
+		if !ctx.IsSet(rpcURLFlag.Name) || len(config.Networks) == 0 {
+			return nil, nil, fmt.Errorf("--%s and configured networks are required without --%s", rpcURLFlag.Name, fleetOptionFlag.Name)
+		}
+		client, err := dial(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		return []*alert.Watcher{alert.NewWatcher(client, config.Networks)}, client.Close, nil
+	}
+
+	deployments, err := fleetDeployments(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	var closers []func()
+	closeAll = func() {
+		for _, closeConn := range closers {
+			closeConn()
+		}
+	}
+	for _, d := range deployments {
+		conn, err := d.Dial(ctx.Context)
+		if err != nil {
+			closeAll()
+			return nil, nil, err
+		}
+		closers = append(closers, conn.Close)
+		watcher := alert.NewWatcher(conn.Backend, []common.Address{d.Network})
+		watcher.Logger = watcher.Logger.With("deployment", d.Name)
+		watchers = append(watchers, watcher)
+	}
+	return watchers, closeAll, nil
+}
+
+func alertsTest(ctx *cli.Context) error {
+	config, err := alert.LoadConfig(ctx.String(alertsConfigFlag.Name))
+	if err != nil {
+		return err
+	}
+	dispatcher, err := config.Dispatcher()
+	if err != nil {
+		return err
+	}
+	target := common.Address{}
+	id := common.Hash{}
+	var network common.Address
+	if len(config.Networks) > 0 {
+		network = config.Networks[0]
+	}
+	test := alert.Alert{
+		Rule:        "test",
+		Severity:    alert.SeverityInfo,
+		Description: "Test alert sent by networkctl alerts test.",
+		Event: alert.Event{
+			Network:     network,
+			Name:        alert.CallScheduled,
+			OperationID: &id,
+			Target:      &target,
+			Delay:       new(big.Int),
+			Details:     map[string]string{"sentAt": time.Now().UTC().Format(time.RFC3339)},
+		},
+	}
+	if err := dispatcher.Dispatch(ctx.Context, test); err != nil {
+		return err
+	}
+	fmt.Fprintf(ctx.App.Writer, "Delivered a test alert to %d sinks.\n", len(config.Sinks))
+	return nil
+}
+
+func alertsReceive(ctx *cli.Context) error {
+	receiver := &alert.Receiver{Output: ctx.App.Writer}
+	server := &http.Server{Addr: ctx.String(receiverListenFlag.Name), Handler: receiver, ReadHeaderTimeout: 10 * time.Second}
+
+	runCtx, stop := signal.NotifyContext(ctx.Context, syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		<-runCtx.Done()
+		server.Close()
+	}()
+	fmt.Fprintf(ctx.App.ErrWriter, "Listening on http://%s\n", server.Addr)
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
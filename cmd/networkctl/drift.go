@@ -0,0 +1,271 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli/v2"
+
+	"github.com/symbioticfi/network/pkg/drift"
+	"github.com/symbioticfi/network/pkg/proposal"
+	"github.com/symbioticfi/network/pkg/quorum"
+)
+
+var (
+	baselineFlag = &cli.StringFlag{
+		Name:     "baseline",
+		Usage:    "path of the baseline file, or of a directory of baselines; a directory with --fleet",
+		Required: true,
+	}
+	proposalsFlag = &cli.StringFlag{
+		Name:  "proposals",
+		Usage: "directory of approved proposal files; changes executed by their operations are not reported as drift",
+	}
+)
+
+var driftCommand = &cli.Command{
+	Name:  "drift",
+	Usage: "compare a Network with a committed configuration baseline",
+	Subcommands: []*cli.Command{
+		{
+			Name:  "capture",
+			Usage: "write the name, metadata URI, implementation, delays and roles of the Network to --baseline, or of every deployment to the --baseline directory",
+			Flags: withFleet(
+				rpcURLFlag,
+				networkFlag,
+				fromBlockFlag,
+				blockFlag,
+				blockRangeFlag,
+				baselineFlag,
+				formatFlag,
+			),
+			Action: driftCapture,
+		},
+		{
+			Name:  "check",
+			Usage: "report every change since --baseline, or since each baseline of the --baseline directory, with the operation and block that caused it, and fail on unapproved ones",
+			Flags: withFleet(
+				rpcURLFlag,
+				baselineFlag,
+				blockFlag,
+				blockRangeFlag,
+				proposalsFlag,
+				reviewerFlag,
+				thresholdFlag,
+				formatFlag,
+			),
+			Action: driftCheck,
+		},
+	},
+}
+
+// captured is a baseline written by drift capture.
+type captured struct {
+	Network     common.Address `json:"network"`
+	BlockNumber uint64         `json:"blockNumber"`
+	Path        string         `json:"path"`
+}
+
+func (c *captured) WriteText(w io.Writer) error {
+	_, err := fmt.Fprintf(w, "Wrote the configuration of %s at block %d to %s\n", c.Network, c.BlockNumber, c.Path)
+	return err
+}
+
+func driftCapture(ctx *cli.Context) error {
+	path := ctx.String(baselineFlag.Name)
+	info, err := os.Stat(path)
+	dir := err == nil && info.IsDir()
+	if ctx.IsSet(fleetOptionFlag.Name) && !dir {
+		return fmt.Errorf("--%s must be a directory with --%s", baselineFlag.Name, fleetOptionFlag.Name)
+	}
+
+	return eachNetwork(ctx, func(ctx *cli.Context, c *chain) (textWriter, error) {
+		detector, err := drift.NewDetector(c.Network, c.Backend)
+		if err != nil {
+			return nil, err
+		}
+		detector.FromBlock = c.FromBlock
+		detector.BlockRange = ctx.Uint64(blockRangeFlag.Name)
+		block := ctx.Uint64(blockFlag.Name)
+		if !ctx.IsSet(blockFlag.Name) {
+			if block, err = c.Backend.BlockNumber(ctx.Context); err != nil {
+				return nil, fmt.Errorf("get latest block: %w", err)
+			}
+		}
+		baseline, err := detector.Capture(ctx.Context, block)
+		if err != nil {
+			return nil, err
+		}
+		path := path
+		switch {
+		case c.Deployment != nil:
+			path = filepath.Join(path, c.Deployment.Name+".json")
+		case dir:
+			path = filepath.Join(path, fmt.Sprintf("%d-%s.json", baseline.ChainID, baseline.Network))
+		}
+		if err := baseline.Save(path); err != nil {
+			return nil, err
+		}
+		return &captured{Network: c.Network, BlockNumber: block, Path: path}, nil
+	})
+}
+
+// checkBaseline checks baseline against the chain of backend. The report is
+// returned with an error when it has unapproved changes.
+func checkBaseline(ctx *cli.Context, backend quorum.Client, baseline *drift.Baseline, approved []*proposal.Proposal) (*drift.Report, error) {
+	detector, err := drift.NewDetector(baseline.Network, backend)
+	if err != nil {
+		return nil, err
+	}
+	detector.BlockRange = ctx.Uint64(blockRangeFlag.Name)
+	block := ctx.Uint64(blockFlag.Name)
+	if !ctx.IsSet(blockFlag.Name) {
+		if block, err = backend.BlockNumber(ctx.Context); err != nil {
+			return nil, fmt.Errorf("get latest block: %w", err)
+		}
+	}
+	report, err := detector.Check(ctx.Context, baseline, block, approved)
+	if err != nil {
+		return nil, err
+	}
+	if unapproved := report.Unapproved(); len(unapproved) > 0 {
+		return report, fmt.Errorf("%d changes did not come from an approved proposal", len(unapproved))
+	}
+	return report, nil
+}
+
+// driftResult is the check of one baseline of a directory.
+type driftResult struct {
+	Network common.Address `json:"network"`
+	ChainID uint64         `json:"chainId"`
+	Report  *drift.Report  `json:"report,omitempty"`
+	Error   string         `json:"error,omitempty"`
+}
+
+// driftResults renders the check of every baseline of a directory, one
+// section per baseline.
+type driftResults []driftResult
+
+func (r driftResults) WriteText(w io.Writer) error {
+	for i, result := range r {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		if result.Report != nil {
+			if err := result.Report.WriteText(w); err != nil {
+				return err
+			}
+		}
+		if result.Error != "" {
+			fmt.Fprintf(w, "Network %s on chain %d: error: %s\n", result.Network, result.ChainID, result.Error)
+		}
+	}
+	return nil
+}
+
+func driftCheck(ctx *cli.Context) error {
+	path := ctx.String(baselineFlag.Name)
+	baselines, err := drift.LoadAll(path)
+	if err != nil {
+		return err
+	}
+	approved, err := approvedProposals(ctx)
+	if err != nil {
+		return err
+	}
+
+	if ctx.IsSet(fleetOptionFlag.Name) {
+		return eachNetwork(ctx, func(ctx *cli.Context, c *chain) (textWriter, error) {
+			for _, baseline := range baselines {
+				if baseline.ChainID != c.Deployment.ChainID || baseline.Network != c.Network {
+					continue
+				}
+				report, err := checkBaseline(ctx, c.Backend, baseline, approved)
+				if report == nil {
+					return nil, err
+				}
+				return report, err
+			}
+			return nil, fmt.Errorf("no baseline of the deployment in %s", path)
+		})
+	}
+
+	if !ctx.IsSet(rpcURLFlag.Name) {
+		return fmt.Errorf("--%s is required without --%s", rpcURLFlag.Name, fleetOptionFlag.Name)
+	}
+	client, err := dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if len(baselines) == 1 {
+		report, err := checkBaseline(ctx, client, baselines[0], approved)
+		if report != nil {
+			if outputErr := output(ctx, report); outputErr != nil {
+				return outputErr
+			}
+		}
+		return err
+	}
+	results := make(driftResults, len(baselines))
+	failed := 0
+	for i, baseline := range baselines {
+		results[i] = driftResult{Network: baseline.Network, ChainID: baseline.ChainID}
+		report, err := checkBaseline(ctx, client, baseline, approved)
+		results[i].Report = report
+		if err != nil {
+			results[i].Error = err.Error()
+			failed++
+		}
+	}
+	if err := output(ctx, results); err != nil {
+		return err
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d baselines failed", failed, len(baselines))
+	}
+	return nil
+}
+
+// approvedProposals loads the *.json proposals in --proposals that carry
+// --threshold of the --reviewer signatures. Proposals still under review are
+// skipped.
+func approvedProposals(ctx *cli.Context) ([]*proposal.Proposal, error) {
+	dir := ctx.String(proposalsFlag.Name)
+	if dir == "" {
+		return nil, nil
+	}
+	accounts, threshold, err := reviewers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if threshold <= 0 || threshold > len(accounts) {
+		return nil, fmt.Errorf("--%s requires --%s between 1 and the number of --%s accounts", proposalsFlag.Name, thresholdFlag.Name, reviewerFlag.Name)
+	}
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) == 0 {
+		if _, err := os.Stat(dir); err != nil {
+			return nil, fmt.Errorf("--%s: %w", proposalsFlag.Name, err)
+		}
+	}
+	var approved []*proposal.Proposal
+	for _, path := range paths {
+		p, err := proposal.Load(path)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		if approvals := p.Approvals(accounts); len(approvals) < threshold {
+			fmt.Fprintf(ctx.App.ErrWriter, "Skipping %s: %d of %d required reviewer signatures\n", path, len(approvals), threshold)
+			continue
+		}
+		approved = append(approved, p)
+	}
+	return approved, nil
+}
@@ -0,0 +1,208 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os/signal"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/urfave/cli/v2"
+
+	"github.com/symbioticfi/network/pkg/exporter"
+	"github.com/symbioticfi/network/pkg/quorum"
+	"github.com/symbioticfi/network/pkg/timelock"
+)
+
+var (
+	exportNetworksFlag = &cli.StringSliceFlag{
+		Name:  "network",
+		Usage: "address of a Network to export, optionally as address@fromBlock; repeatable",
+	}
+	listenFlag = &cli.StringFlag{
+		Name:  "listen",
+		Usage: "address to serve /metrics on",
+		Value: ":9464",
+	}
+	intervalFlag = &cli.DurationFlag{
+		Name:  "interval",
+		Usage: "time between polls",
+		Value: 15 * time.Second,
+	}
+	extraRPCURLFlag = &cli.StringSliceFlag{
+		Name:  "extra-rpc-url",
+		Usage: "additional JSON-RPC endpoint; when set, calls need a majority of the endpoints to agree and logs are cross-checked; repeatable",
+	}
+)
+
+var exporterCommand = &cli.Command{
+	Name:  "exporter",
+	Usage: "serve Prometheus metrics on the timelock health of one or more Networks",
+	Flags: []cli.Flag{
+		optionalRPCURLFlag,
+		extraRPCURLFlag,
+		exportNetworksFlag,
+		fromBlockFlag,
+		fleetOptionFlag,
+		deploymentFlag,
+		blockRangeFlag,
+		listenFlag,
+		intervalFlag,
+	},
+	Action: runExporter,
+}
+
+// parseTarget parses an address@fromBlock --network value. Without a block,
+// scanning starts at --from-block.
+func parseTarget(value string, fromBlock uint64) (exporter.Target, error) {
+	address, block, found := strings.Cut(value, "@")
+	if !common.IsHexAddress(address) {
+		return exporter.Target{}, fmt.Errorf("--%s: invalid address %q", exportNetworksFlag.Name, address)
+	}
+	if found {
+		var err error
+		if fromBlock, err = strconv.ParseUint(block, 10, 64); err != nil {
+			return exporter.Target{}, fmt.Errorf("--%s: invalid block %q", exportNetworksFlag.Name, block)
+		}
+	}
+	return exporter.Target{Network: common.HexToAddress(address), FromBlock: fromBlock}, nil
+}
+
+// newExporters creates the exporters of --fleet, one per deployment with
+// their series labelled by deployment, or a single exporter for --network.
+// closeAll closes their connections.
+func newExporters(ctx *cli.Context, registry prometheus.Registerer) (exporters []*exporter.Exporter, closeAll func(), err error) {
+	var closers []func()
+	closeAll = func() {
+		for _, closeConn := range closers {
+			closeConn()
+		}
+	}
+	defer func() {
+		if err != nil {
+			closeAll()
+		}
+	}()
+
+	if ctx.IsSet(fleetOptionFlag.Name) {
+		deployments, err := fleetDeployments(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, d := range deployments {
+			conn, err := d.Dial(ctx.Context)
+			if err != nil {
+				return nil, nil, err
+			}
+			closers = append(closers, conn.Close)
+			target := exporter.Target{Network: d.Network, FromBlock: d.FromBlock}
+			if ctx.IsSet(fromBlockFlag.Name) {
+				target.FromBlock = ctx.Uint64(fromBlockFlag.Name)
+			}
+			labelled := prometheus.WrapRegistererWith(prometheus.Labels{"deployment": d.Name}, registry)
+			e, err := exporter.New(conn.Backend, labelled, []exporter.Target{target})
+			if err != nil {
+				return nil, nil, err
+			}
+			exporters = append(exporters, e)
+		}
+		return exporters, closeAll, nil
+	}
+
+	if !ctx.IsSet(rpcURLFlag.Name) || !ctx.IsSet(exportNetworksFlag.Name) {
+		return nil, nil, fmt.Errorf("--%s and --%s are required without --%s", rpcURLFlag.Name, exportNetworksFlag.Name, fleetOptionFlag.Name)
+	}
+	var targets []exporter.Target
+	for _, value := range ctx.StringSlice(exportNetworksFlag.Name) {
+		target, err := parseTarget(value, ctx.Uint64(fromBlockFlag.Name))
+		if err != nil {
+			return nil, nil, err
+		}
+		targets = append(targets, target)
+	}
+	var backend timelock.Backend
+	if ctx.IsSet(extraRPCURLFlag.Name) {
+		urls := append([]string{ctx.String(rpcURLFlag.Name)}, ctx.StringSlice(extraRPCURLFlag.Name)...)
+		b, err := quorum.Dial(ctx.Context, urls)
+		if err != nil {
+			return nil, nil, err
+		}
+		closers = append(closers, b.Close)
+		backend = b
+	} else {
+		client, err := dial(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		closers = append(closers, client.Close)
+		backend = client
+	}
+	e, err := exporter.New(backend, registry, targets)
+	if err != nil {
+		return nil, nil, err
+	}
+	return []*exporter.Exporter{e}, closeAll, nil
+}
+
+func runExporter(ctx *cli.Context) error {
+	registry := prometheus.NewRegistry()
+	exporters, closeExporters, err := newExporters(ctx, registry)
+	if err != nil {
+		return err
+	}
+	defer closeExporters()
+	for _, e := range exporters {
+		e.Interval = ctx.Duration(intervalFlag.Name)
+		e.BlockRange = ctx.Uint64(blockRangeFlag.Name)
+	}
+
+	runCtx, stop := signal.NotifyContext(ctx.Context, syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+	server := &http.Server{Addr: ctx.String(listenFlag.Name), Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	serverErr := make(chan error, 1)
+	go func() {
+		serverErr <- server.ListenAndServe()
+	}()
+	slog.Info("serving metrics", "listen", server.Addr, "exporters", len(exporters))
+
+	runErr := make(chan error, 1)
+	go func() {
+		var wg sync.WaitGroup
+		for _, e := range exporters {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				e.Run(runCtx)
+			}()
+		}
+		wg.Wait()
+		runErr <- runCtx.Err()
+	}()
+
+	select {
+	case err := <-serverErr:
+		stop()
+		<-runErr
+		return fmt.Errorf("serve metrics: %w", err)
+	case <-runCtx.Done():
+	}
+	<-runErr
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := server.Shutdown(shutdownCtx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
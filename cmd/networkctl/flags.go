@@ -0,0 +1,200 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/urfave/cli/v2"
+
+	"github.com/symbioticfi/network/pkg/timelock"
+)
+
+var (
+	rpcURLFlag = &cli.StringFlag{
+		Name:     "rpc-url",
+		Usage:    "JSON-RPC endpoint of the chain the Network is deployed on",
+		EnvVars:  []string{"ETH_RPC_URL"},
+		Required: true,
+	}
+	networkFlag = &cli.StringFlag{
+		Name:     "network",
+		Usage:    "address of the Network",
+		Required: true,
+	}
+	// optionalRPCURLFlag and optionalNetworkFlag are rpcURLFlag and
+	// networkFlag for commands that can read the Networks of a fleet
+	// registry instead.
+	optionalRPCURLFlag = &cli.StringFlag{
+		Name:    rpcURLFlag.Name,
+		Usage:   rpcURLFlag.Usage,
+		EnvVars: rpcURLFlag.EnvVars,
+	}
+	optionalNetworkFlag = &cli.StringFlag{
+		Name:  networkFlag.Name,
+		Usage: networkFlag.Usage,
+	}
+	fromBlockFlag = &cli.Uint64Flag{
+		Name:  "from-block",
+		Usage: "first block to scan, normally the Network deployment block",
+	}
+	// requiredFromBlockFlag is fromBlockFlag for commands that send
+	// transactions for what they find, which must not guess the start.
+	requiredFromBlockFlag = &cli.Uint64Flag{
+		Name:     fromBlockFlag.Name,
+		Usage:    fromBlockFlag.Usage,
+		Required: true,
+	}
+	toBlockFlag = &cli.Uint64Flag{
+		Name:        "to-block",
+		Usage:       "last block to scan",
+		DefaultText: "latest",
+	}
+	blockRangeFlag = &cli.Uint64Flag{
+		Name:  "block-range",
+		Usage: "number of blocks requested per eth_getLogs call",
+		Value: 10_000,
+	}
+	saltFlag = &cli.StringFlag{
+		Name:  "salt",
+		Usage: "salt of the timelock operation, as 32-byte hex or a string of at most 32 bytes",
+	}
+	predecessorFlag = &cli.StringFlag{
+		Name:  "predecessor",
+		Usage: "ID of the operation that must be executed before this one",
+	}
+	delayFlag = &cli.Uint64Flag{
+		Name:        "delay",
+		Usage:       "delay of the timelock operation in seconds",
+		DefaultText: "required delay",
+	}
+	formatFlag = &cli.StringFlag{
+		Name:  "format",
+		Usage: "output format: text or json",
+		Value: "text",
+	}
+)
+
+// dial connects to the endpoint given by --rpc-url.
+func dial(ctx *cli.Context) (*ethclient.Client, error) {
+	client, err := ethclient.DialContext(ctx.Context, ctx.String(rpcURLFlag.Name))
+	if err != nil {
+		return nil, fmt.Errorf("dial %s: %w", ctx.String(rpcURLFlag.Name), err)
+	}
+	return client, nil
+}
+
+// addressFlag parses an address flag value.
+func addressFlag(ctx *cli.Context, flag *cli.StringFlag) (common.Address, error) {
+	value := ctx.String(flag.Name)
+	if !common.IsHexAddress(value) {
+		return common.Address{}, fmt.Errorf("--%s: invalid address %q", flag.Name, value)
+	}
+	return common.HexToAddress(value), nil
+}
+
+// operationParams resolves --salt, --predecessor and --delay. The delay is nil
+// when --delay is not set.
+func operationParams(ctx *cli.Context, defaultSalt string) (salt, predecessor common.Hash, delay *big.Int, err error) {
+	saltValue := defaultSalt
+	if ctx.IsSet(saltFlag.Name) {
+		saltValue = ctx.String(saltFlag.Name)
+	}
+	if salt, err = timelock.ParseSalt(saltValue); err != nil {
+		return salt, predecessor, nil, fmt.Errorf("--%s: %w", saltFlag.Name, err)
+	}
+	if ctx.IsSet(predecessorFlag.Name) {
+		b, err := hexutil.Decode(ctx.String(predecessorFlag.Name))
+		if err != nil || len(b) != common.HashLength {
+			return salt, predecessor, nil, fmt.Errorf("--%s: invalid operation ID %q", predecessorFlag.Name, ctx.String(predecessorFlag.Name))
+		}
+		predecessor = common.BytesToHash(b)
+	}
+	if ctx.IsSet(delayFlag.Name) {
+		delay = new(big.Int).SetUint64(ctx.Uint64(delayFlag.Name))
+	}
+	return salt, predecessor, delay, nil
+}
+
+// blockBounds resolves the first block of c and --to-block, defaulting the
+// latter to the latest block.
+func blockBounds(ctx *cli.Context, c *chain) (uint64, uint64, error) {
+	from, to := c.FromBlock, ctx.Uint64(toBlockFlag.Name)
+	if !ctx.IsSet(toBlockFlag.Name) {
+		latest, err := c.Backend.BlockNumber(ctx.Context)
+		if err != nil {
+			return 0, 0, fmt.Errorf("get latest block: %w", err)
+		}
+		to = latest
+	}
+	if from > to {
+		return 0, 0, fmt.Errorf("first block %d is after --to-block %d", from, to)
+	}
+	return from, to, nil
+}
+
+// textWriter is implemented by results that have a human-readable rendering.
+type textWriter interface {
+	WriteText(w io.Writer) error
+}
+
+// output writes v in the format selected by --format.
+func output(ctx *cli.Context, v textWriter) error {
+	switch format := ctx.String(formatFlag.Name); format {
+	case "text":
+		return v.WriteText(ctx.App.Writer)
+	case "json":
+		encoder := json.NewEncoder(ctx.App.Writer)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(v)
+	default:
+		return fmt.Errorf("--%s: unknown format %q", formatFlag.Name, format)
+	}
+}
+
+var privateKeyFlag = &cli.StringFlag{
+	Name:    "private-key",
+	Usage:   "hex private key of the account sending transactions",
+	EnvVars: []string{"PRIVATE_KEY"},
+}
+
+// transactor builds transaction options signed with --private-key for the
+// chain client is connected to.
+func transactor(ctx *cli.Context, client *ethclient.Client) (*bind.TransactOpts, error) {
+	if !ctx.IsSet(privateKeyFlag.Name) {
+		return nil, fmt.Errorf("--%s is required to send transactions", privateKeyFlag.Name)
+	}
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(ctx.String(privateKeyFlag.Name), "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("--%s: %w", privateKeyFlag.Name, err)
+	}
+	chainID, err := client.ChainID(ctx.Context)
+	if err != nil {
+		return nil, fmt.Errorf("get chain ID: %w", err)
+	}
+	opts, err := bind.NewKeyedTransactorWithChainID(key, chainID)
+	if err != nil {
+		return nil, err
+	}
+	opts.Context = ctx.Context
+	return opts, nil
+}
+
+// confirm asks the user to type "yes" before continuing.
+func confirm(ctx *cli.Context, prompt string) (bool, error) {
+	fmt.Fprintf(ctx.App.Writer, "%s Type 'yes' to continue: ", prompt)
+	answer, err := bufio.NewReader(ctx.App.Reader).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return false, err
+	}
+	return strings.TrimSpace(answer) == "yes", nil
+}
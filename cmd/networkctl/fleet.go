@@ -0,0 +1,283 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/urfave/cli/v2"
+
+	"github.com/symbioticfi/network/pkg/fleet"
+	"github.com/symbioticfi/network/pkg/multicall"
+	"github.com/symbioticfi/network/pkg/quorum"
+	"github.com/symbioticfi/network/pkg/timelock"
+)
+
+var (
+	fleetFlag = &cli.StringFlag{
+		Name:     "fleet",
+		Usage:    "path of the fleet registry",
+		EnvVars:  []string{"NETWORKCTL_FLEET"},
+		Required: true,
+	}
+	deploymentFlag = &cli.StringSliceFlag{
+		Name:        "deployment",
+		Usage:       "name of a deployment to include; repeatable",
+		DefaultText: "every deployment",
+	}
+	parallelFlag = &cli.IntFlag{
+		Name:  "parallel",
+		Usage: "number of deployments read at the same time",
+		Value: 4,
+	}
+	// fleetOptionFlag is fleetFlag for commands that read a single Network
+	// from --rpc-url and --network without it.
+	fleetOptionFlag = &cli.StringFlag{
+		Name:  fleetFlag.Name,
+		Usage: "path of a fleet registry; the command runs on every --deployment instead of --rpc-url and --network",
+	}
+	fleetSelectorFlag = &cli.StringSliceFlag{
+		Name:     "selector",
+		Usage:    "selector to compare, as 4-byte hex or a function signature; repeatable",
+		Required: true,
+	}
+	fleetTargetFlag = &cli.StringFlag{
+		Name:  "target",
+		Usage: "contract the selectors are called on: network, middleware, proxyAdmin, vaults or an address",
+		Value: "network",
+	}
+)
+
+var fleetCommand = &cli.Command{
+	Name:  "fleet",
+	Usage: "inspect every Network of a fleet registry",
+	Subcommands: []*cli.Command{
+		{
+			Name:   "list",
+			Usage:  "list the deployments of the registry",
+			Flags:  []cli.Flag{fleetFlag, formatFlag},
+			Action: fleetList,
+		},
+		{
+			Name:   "status",
+			Usage:  "read the name, metadata URI and global delay of every deployment",
+			Flags:  []cli.Flag{fleetFlag, deploymentFlag, parallelFlag, formatFlag},
+			Action: fleetStatus,
+		},
+		{
+			Name:   "delays",
+			Usage:  "compare getMinDelay of selectors across deployments and flag inconsistencies",
+			Flags:  []cli.Flag{fleetFlag, deploymentFlag, fleetSelectorFlag, fleetTargetFlag, parallelFlag, formatFlag},
+			Action: fleetDelays,
+		},
+	},
+}
+
+// fleetDeployments loads --fleet and selects the --deployment entries.
+func fleetDeployments(ctx *cli.Context) ([]*fleet.Deployment, error) {
+	registry, err := fleet.Load(ctx.String(fleetFlag.Name))
+	if err != nil {
+		return nil, err
+	}
+	return registry.Select(ctx.StringSlice(deploymentFlag.Name)...)
+}
+
+// deploymentList renders the registry without its RPC URLs, which may
+// contain credentials.
+type deploymentList []*fleet.Deployment
+
+func (l deploymentList) WriteText(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "NAME\tCHAIN\tNETWORK\tFROM BLOCK\tMIDDLEWARE\tVAULTS\tENDPOINTS")
+	for _, d := range l {
+		middleware := "-"
+		if d.Middleware != ([20]byte{}) {
+			middleware = d.Middleware.Hex()
+		}
+		fmt.Fprintf(tw, "%s\t%d\t%s\t%d\t%s\t%d\t%d\n", d.Name, d.ChainID, d.Network, d.FromBlock, middleware, len(d.Vaults), len(d.RPC))
+	}
+	return tw.Flush()
+}
+
+func fleetList(ctx *cli.Context) error {
+	registry, err := fleet.Load(ctx.String(fleetFlag.Name))
+	if err != nil {
+		return err
+	}
+	return output(ctx, deploymentList(registry.Deployments))
+}
+
+func fleetStatus(ctx *cli.Context) error {
+	deployments, err := fleetDeployments(ctx)
+	if err != nil {
+		return err
+	}
+	report := fleet.Statuses(ctx.Context, deployments, ctx.Int(parallelFlag.Name))
+	if err := output(ctx, report); err != nil {
+		return err
+	}
+	for _, status := range report {
+		if status.Error != "" {
+			return fmt.Errorf("some deployments could not be read")
+		}
+	}
+	return nil
+}
+
+func fleetDelays(ctx *cli.Context) error {
+	deployments, err := fleetDeployments(ctx)
+	if err != nil {
+		return err
+	}
+	var queries []fleet.DelayQuery
+	for _, value := range ctx.StringSlice(fleetSelectorFlag.Name) {
+		selector, err := timelock.ParseSelector(strings.TrimSpace(value))
+		if err != nil {
+			return fmt.Errorf("--%s: %w", fleetSelectorFlag.Name, err)
+		}
+		queries = append(queries, fleet.DelayQuery{Target: fleet.Target(ctx.String(fleetTargetFlag.Name)), Selector: selector})
+	}
+	report, err := fleet.Delays(ctx.Context, deployments, queries, ctx.Int(parallelFlag.Name))
+	if err != nil {
+		return err
+	}
+	if err := output(ctx, report); err != nil {
+		return err
+	}
+	if !report.Consistent() {
+		return fmt.Errorf("delays differ across deployments")
+	}
+	return nil
+}
+
+// withFleet returns flags with --rpc-url and --network made optional and the
+// flags selecting the deployments of a fleet added, for commands run through
+// eachNetwork.
+func withFleet(flags ...cli.Flag) []cli.Flag {
+	out := make([]cli.Flag, 0, len(flags)+3)
+	for _, flag := range flags {
+		switch flag {
+		case rpcURLFlag:
+			flag = optionalRPCURLFlag
+		case networkFlag:
+			flag = optionalNetworkFlag
+		}
+		out = append(out, flag)
+	}
+	return append(out, fleetOptionFlag, deploymentFlag, parallelFlag)
+}
+
+// chain is a Network a command reads and the connection to its chain.
+type chain struct {
+	// Deployment is the fleet deployment, or nil for --rpc-url and --network.
+	Deployment *fleet.Deployment
+	Backend    quorum.Client
+	Network    common.Address
+	// FromBlock is --from-block, defaulting to the deployment block of a
+	// fleet deployment.
+	FromBlock uint64
+
+	caller *multicall.Caller
+}
+
+// newChain creates the chain of a Network reached through client.
+func newChain(ctx *cli.Context, client *ethclient.Client, network common.Address) *chain {
+	return &chain{
+		Backend:   client,
+		Network:   network,
+		FromBlock: ctx.Uint64(fromBlockFlag.Name),
+		caller:    multicall.NewCaller(client, client.Client()),
+	}
+}
+
+// fleetResult is the result of a command on one deployment.
+type fleetResult struct {
+	Deployment string         `json:"deployment"`
+	ChainID    uint64         `json:"chainId"`
+	Network    common.Address `json:"network"`
+	Result     textWriter     `json:"result,omitempty"`
+	Error      string         `json:"error,omitempty"`
+}
+
+// fleetResults renders the result of a command on every deployment, one
+// section per deployment.
+type fleetResults []fleetResult
+
+func (r fleetResults) WriteText(w io.Writer) error {
+	for i, result := range r {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintf(w, "== %s (chain %d, %s) ==\n", result.Deployment, result.ChainID, result.Network)
+		if result.Result != nil {
+			if err := result.Result.WriteText(w); err != nil {
+				return err
+			}
+		}
+		if result.Error != "" {
+			fmt.Fprintf(w, "error: %s\n", result.Error)
+		}
+	}
+	return nil
+}
+
+// eachNetwork runs f on the Network of --rpc-url and --network, or with
+// --fleet on every --deployment, at most --parallel at a time, and writes the
+// results. f may return a result together with an error, for checks that fail
+// on what they report.
+func eachNetwork(ctx *cli.Context, f func(ctx *cli.Context, c *chain) (textWriter, error)) error {
+	if !ctx.IsSet(fleetOptionFlag.Name) {
+		if !ctx.IsSet(rpcURLFlag.Name) || !ctx.IsSet(networkFlag.Name) {
+			return fmt.Errorf("--%s and --%s are required without --%s", rpcURLFlag.Name, networkFlag.Name, fleetOptionFlag.Name)
+		}
+		network, err := addressFlag(ctx, networkFlag)
+		if err != nil {
+			return err
+		}
+		client, err := dial(ctx)
+		if err != nil {
+			return err
+		}
+		defer client.Close()
+		result, err := f(ctx, newChain(ctx, client, network))
+		if result != nil {
+			if outputErr := output(ctx, result); outputErr != nil {
+				return outputErr
+			}
+		}
+		return err
+	}
+
+	deployments, err := fleetDeployments(ctx)
+	if err != nil {
+		return err
+	}
+	results := fleet.Each(ctx.Context, deployments, ctx.Int(parallelFlag.Name), func(_ context.Context, d *fleet.Deployment, conn *fleet.Conn) (textWriter, error) {
+		c := &chain{Deployment: d, Backend: conn.Backend, Network: d.Network, FromBlock: d.FromBlock, caller: conn.Caller()}
+		if ctx.IsSet(fromBlockFlag.Name) {
+			c.FromBlock = ctx.Uint64(fromBlockFlag.Name)
+		}
+		return f(ctx, c)
+	})
+	report := make(fleetResults, len(results))
+	failed := 0
+	for i, result := range results {
+		d := result.Deployment
+		report[i] = fleetResult{Deployment: d.Name, ChainID: d.ChainID, Network: d.Network, Result: result.Value}
+		if result.Err != nil {
+			report[i].Error = result.Err.Error()
+			failed++
+		}
+	}
+	if err := output(ctx, report); err != nil {
+		return err
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d deployments failed", failed, len(results))
+	}
+	return nil
+}
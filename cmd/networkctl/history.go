@@ -0,0 +1,144 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/urfave/cli/v2"
+
+	"github.com/symbioticfi/network/pkg/history"
+	"github.com/symbioticfi/network/pkg/timelock"
+)
+
+var (
+	atFlag = &cli.StringFlag{
+		Name:  "at",
+		Usage: "point in time, as RFC 3339 (2025-03-03T12:00:00Z) or a UTC date (2025-03-03, meaning its end)",
+	}
+	blockFlag = &cli.Uint64Flag{
+		Name:  "block",
+		Usage: "block to read at, instead of --at",
+	}
+	minDelayFlag = &cli.StringSliceFlag{
+		Name:  "min-delay",
+		Usage: "call to evaluate getMinDelay for, as target,selector where the selector is 4-byte hex, a function signature or full calldata; repeatable",
+	}
+	sourceFlag = &cli.StringFlag{
+		Name:  "source",
+		Usage: "where to read from: auto (archive state, falling back to events), archive or events",
+		Value: "auto",
+	}
+)
+
+var historyCommand = &cli.Command{
+	Name:  "history",
+	Usage: "show the name, metadata URI, delays and role holders of a Network at a past time or block",
+	Flags: withFleet(
+		rpcURLFlag,
+		networkFlag,
+		atFlag,
+		blockFlag,
+		minDelayFlag,
+		sourceFlag,
+		fromBlockFlag,
+		blockRangeFlag,
+		formatFlag,
+	),
+	Action: historyShow,
+}
+
+func historyShow(ctx *cli.Context) error {
+	if ctx.IsSet(atFlag.Name) == ctx.IsSet(blockFlag.Name) {
+		return fmt.Errorf("exactly one of --%s or --%s is required", atFlag.Name, blockFlag.Name)
+	}
+	var at time.Time
+	if ctx.IsSet(atFlag.Name) {
+		var err error
+		if at, err = parseTime(ctx.String(atFlag.Name)); err != nil {
+			return fmt.Errorf("--%s: %w", atFlag.Name, err)
+		}
+	}
+	source := ctx.String(sourceFlag.Name)
+	switch source {
+	case "auto", string(history.Archive), string(history.Events):
+	default:
+		return fmt.Errorf("--%s: unknown source %q", sourceFlag.Name, source)
+	}
+	var query history.Query
+	for _, value := range ctx.StringSlice(minDelayFlag.Name) {
+		call, err := parseMinDelayCall(value)
+		if err != nil {
+			return fmt.Errorf("--%s: %w", minDelayFlag.Name, err)
+		}
+		query.Calls = append(query.Calls, call)
+	}
+
+	return eachNetwork(ctx, func(ctx *cli.Context, c *chain) (textWriter, error) {
+		reader, err := history.NewReader(c.Network, c.Backend)
+		if err != nil {
+			return nil, err
+		}
+		reader.FromBlock = c.FromBlock
+		reader.BlockRange = ctx.Uint64(blockRangeFlag.Name)
+
+		// Blocks differ between chains, so --at is resolved on each.
+		block := ctx.Uint64(blockFlag.Name)
+		if !at.IsZero() {
+			header, err := history.BlockAt(ctx.Context, c.Backend, at)
+			if err != nil {
+				return nil, err
+			}
+			block = header.Number.Uint64()
+		}
+
+		var state *history.State
+		switch source {
+		case "auto":
+			state, err = reader.At(ctx.Context, block, query)
+		case string(history.Archive):
+			state, err = reader.Archive(ctx.Context, block, query)
+		case string(history.Events):
+			state, err = reader.Events(ctx.Context, block, query)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return state, nil
+	})
+}
+
+// parseMinDelayCall parses "target,selector", where the selector can also be
+// a function signature or full calldata.
+func parseMinDelayCall(s string) (timelock.Call, error) {
+	target, data, found := strings.Cut(s, ",")
+	if !found || !common.IsHexAddress(target) {
+		return timelock.Call{}, fmt.Errorf("expected target,selector, got %q", s)
+	}
+	call := timelock.Call{Target: common.HexToAddress(target)}
+	if selector, err := timelock.ParseSelector(data); err == nil {
+		call.Data = selector[:]
+		return call, nil
+	}
+	calldata, err := hexutil.Decode(data)
+	if err != nil {
+		return timelock.Call{}, fmt.Errorf("invalid selector or calldata %q", data)
+	}
+	call.Data = calldata
+	return call, nil
+}
+
+// parseTime parses an RFC 3339 time or a UTC date. A date stands for its last
+// second, so the state at the end of that day is shown.
+func parseTime(s string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	day, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %q", s)
+	}
+	return day.Add(24*time.Hour - time.Second), nil
+}
@@ -0,0 +1,147 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli/v2"
+
+	"github.com/symbioticfi/network/pkg/limits"
+	"github.com/symbioticfi/network/pkg/multicall"
+)
+
+var (
+	limitFlag = &cli.StringSliceFlag{
+		Name:     "limit",
+		Usage:    "target max network limit as vault,subnetworkId,amount; repeatable",
+		Required: true,
+	}
+	modeFlag = &cli.StringFlag{
+		Name:  "mode",
+		Usage: "how the calls are made: timelock (scheduleBatch on the delegators) or middleware (setMaxNetworkLimit hook on the Network)",
+		Value: string(limits.Timelock),
+	}
+	sendFlag = &cli.BoolFlag{
+		Name:  "send",
+		Usage: "in middleware mode, send the calls from --private-key, which must be the middleware",
+	}
+)
+
+var limitsCommand = &cli.Command{
+	Name:  "limits",
+	Usage: "plan the setMaxNetworkLimit calls reaching a target allocation across vaults and subnetworks",
+	Flags: []cli.Flag{
+		rpcURLFlag,
+		networkFlag,
+		limitFlag,
+		modeFlag,
+		saltFlag,
+		predecessorFlag,
+		delayFlag,
+		multicallFlag,
+		sendFlag,
+		privateKeyFlag,
+		yesFlag,
+		formatFlag,
+	},
+	Action: limitsPlan,
+}
+
+// parseLimit parses "vault,subnetworkId,amount".
+func parseLimit(s string) (limits.Target, error) {
+	parts := strings.Split(s, ",")
+	if len(parts) != 3 || !common.IsHexAddress(parts[0]) {
+		return limits.Target{}, fmt.Errorf("expected vault,subnetworkId,amount, got %q", s)
+	}
+	id, ok := new(big.Int).SetString(parts[1], 0)
+	if !ok {
+		return limits.Target{}, fmt.Errorf("invalid subnetwork ID %q", parts[1])
+	}
+	amount, ok := new(big.Int).SetString(parts[2], 0)
+	if !ok {
+		return limits.Target{}, fmt.Errorf("invalid amount %q", parts[2])
+	}
+	return limits.Target{Vault: common.HexToAddress(parts[0]), SubnetworkID: id, MaxNetworkLimit: amount}, nil
+}
+
+func limitsPlan(ctx *cli.Context) error {
+	network, err := addressFlag(ctx, networkFlag)
+	if err != nil {
+		return err
+	}
+	req := limits.Request{Mode: limits.Mode(ctx.String(modeFlag.Name))}
+	for _, value := range ctx.StringSlice(limitFlag.Name) {
+		target, err := parseLimit(value)
+		if err != nil {
+			return fmt.Errorf("--%s: %w", limitFlag.Name, err)
+		}
+		req.Targets = append(req.Targets, target)
+	}
+	if req.Salt, req.Predecessor, req.Delay, err = operationParams(ctx, "SetMaxNetworkLimit"); err != nil {
+		return err
+	}
+	if ctx.Bool(sendFlag.Name) && req.Mode != limits.Middleware {
+		return fmt.Errorf("--%s requires --%s %s", sendFlag.Name, modeFlag.Name, limits.Middleware)
+	}
+
+	client, err := dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	caller, err := multicallCaller(ctx, multicall.NewCaller(client, client.Client()))
+	if err != nil {
+		return err
+	}
+	plan, err := limits.PlanLimits(ctx.Context, caller, client, network, req)
+	if err != nil {
+		return err
+	}
+	if err := output(ctx, plan); err != nil {
+		return err
+	}
+	if !ctx.Bool(sendFlag.Name) || len(plan.HookCalls) == 0 {
+		return nil
+	}
+
+	opts, err := transactor(ctx, client)
+	if err != nil {
+		return err
+	}
+	if !ctx.Bool(yesFlag.Name) {
+		ok, err := confirm(ctx, fmt.Sprintf("\nSend %d setMaxNetworkLimit calls from %s?", len(plan.HookCalls), opts.From))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("aborted")
+		}
+	}
+	results, err := plan.Send(ctx.Context, opts, client)
+	if err != nil {
+		return err
+	}
+	if failed := writeSendResults(ctx.App.Writer, results); failed > 0 {
+		return fmt.Errorf("%d of %d calls did not set the target", failed, len(results))
+	}
+	return nil
+}
+
+func writeSendResults(w io.Writer, results []limits.SendResult) int {
+	failed := 0
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "\nDELEGATOR\tSUBNETWORK\tTX\tMAX NETWORK LIMIT\tERROR")
+	for _, result := range results {
+		if result.Error != "" {
+			failed++
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%v\t%s\n", result.Delegator, result.SubnetworkID, result.TxHash, result.MaxNetworkLimit, result.Error)
+	}
+	tw.Flush()
+	return failed
+}
@@ -0,0 +1,37 @@
+// Command networkctl inspects and manages Symbiotic Network contracts.
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/urfave/cli/v2"
+)
+
+func main() {
+	app := &cli.App{
+		Name:  "networkctl",
+		Usage: "inspect and manage Symbiotic Network contracts",
+		Commands: []*cli.Command{
+			rolesCommand,
+			operationsCommand,
+			proposalCommand,
+			exporterCommand,
+			alertsCommand,
+			metadataCommand,
+			historyCommand,
+			snapshotCommand,
+			fleetCommand,
+			driftCommand,
+			upgradeCommand,
+			limitsCommand,
+			middlewareCommand,
+			resolversCommand,
+			vaultsCommand,
+		},
+	}
+	if err := app.Run(os.Args); err != nil {
+		fmt.Fprintln(os.Stderr, "error:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,274 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/urfave/cli/v2"
+
+	networkcontracts "github.com/symbioticfi/network/bindings/go-go-ethereum"
+	"github.com/symbioticfi/network/pkg/metadata"
+)
+
+var ipfsGatewayFlag = &cli.StringFlag{
+	Name:    "ipfs-gateway",
+	Usage:   "URL prefix ipfs:// URIs are resolved against",
+	EnvVars: []string{"IPFS_GATEWAY"},
+	Value:   metadata.DefaultIPFSGateway,
+}
+
+var (
+	templateFlag = &cli.StringFlag{
+		Name:     "template",
+		Usage:    "path of the metadata document template (Go text/template with .Name, .ChainID, .Network and .Values)",
+		Required: true,
+	}
+	setFlag = &cli.StringSliceFlag{
+		Name:  "set",
+		Usage: "template value as key=value, available as .Values.key; repeatable",
+	}
+	ipfsAPIFlag = &cli.StringFlag{
+		Name:  "ipfs-api",
+		Usage: "base URL of an IPFS HTTP API to add the document through",
+	}
+	storageDirFlag = &cli.StringFlag{
+		Name:  "storage-dir",
+		Usage: "directory to store the document in instead of IPFS",
+	}
+	baseURLFlag = &cli.StringFlag{
+		Name:  "base-url",
+		Usage: "URL --storage-dir is served at",
+	}
+	allowHTTPFlag = &cli.BoolFlag{
+		Name:  "allow-http",
+		Usage: "accept http:// document URIs, for local development",
+	}
+	publishDryRunFlag = &cli.BoolFlag{
+		Name:  "dry-run",
+		Usage: "store and verify the document without sending updateMetadataURI",
+	}
+	standInListenFlag = &cli.StringFlag{
+		Name:  "listen",
+		Usage: "address to serve the IPFS API and gateway stand-in on",
+		Value: "127.0.0.1:5001",
+	}
+)
+
+var metadataCommand = &cli.Command{
+	Name:  "metadata",
+	Usage: "verify Network metadata documents",
+	Subcommands: []*cli.Command{
+		{
+			Name:  "verify",
+			Usage: "resolve metadataURI(), validate the document and check it against name()",
+			Flags: withFleet(
+				rpcURLFlag,
+				networkFlag,
+				ipfsGatewayFlag,
+				formatFlag,
+			),
+			Action: metadataVerify,
+		},
+		{
+			Name:      "validate",
+			Usage:     "validate a local metadata document against the schema",
+			ArgsUsage: "<file>",
+			Action:    metadataValidate,
+		},
+		{
+			Name:  "publish",
+			Usage: "build a document from a template, store it, point metadataURI() at it and check MetadataURISet",
+			Flags: []cli.Flag{
+				rpcURLFlag,
+				networkFlag,
+				templateFlag,
+				setFlag,
+				ipfsAPIFlag,
+				storageDirFlag,
+				baseURLFlag,
+				ipfsGatewayFlag,
+				allowHTTPFlag,
+				privateKeyFlag,
+				publishDryRunFlag,
+				yesFlag,
+			},
+			Action: metadataPublish,
+		},
+		{
+			Name:   "ipfs-standin",
+			Usage:  "serve an in-memory IPFS add API and gateway for local publishing",
+			Flags:  []cli.Flag{standInListenFlag},
+			Action: metadataIPFSStandIn,
+		},
+		{
+			Name:   "schema",
+			Usage:  "print the JSON Schema of the latest metadata version",
+			Action: metadataSchema,
+		},
+	},
+}
+
+func metadataVerify(ctx *cli.Context) error {
+	fetcher := &metadata.Fetcher{IPFSGateway: ctx.String(ipfsGatewayFlag.Name)}
+	return eachNetwork(ctx, func(ctx *cli.Context, c *chain) (textWriter, error) {
+		report, err := metadata.Verify(ctx.Context, c.Backend, c.Network, fetcher)
+		if err != nil {
+			return nil, err
+		}
+		if !report.OK() {
+			return report, fmt.Errorf("metadata has %d issues", len(report.Issues))
+		}
+		return report, nil
+	})
+}
+
+func metadataValidate(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return fmt.Errorf("expected a metadata file argument")
+	}
+	data, err := os.ReadFile(ctx.Args().First())
+	if err != nil {
+		return err
+	}
+	if _, issues := metadata.Validate(data); len(issues) > 0 {
+		for _, issue := range issues {
+			fmt.Fprintf(ctx.App.Writer, "  - %s\n", issue)
+		}
+		return fmt.Errorf("metadata has %d issues", len(issues))
+	}
+	fmt.Fprintln(ctx.App.Writer, "OK")
+	return nil
+}
+
+func metadataSchema(ctx *cli.Context) error {
+	schema, err := metadata.Schema(metadata.Version)
+	if err != nil {
+		return err
+	}
+	_, err = ctx.App.Writer.Write(schema)
+	return err
+}
+
+// metadataStorage selects the storage backend from --ipfs-api or
+// --storage-dir.
+func metadataStorage(ctx *cli.Context) (metadata.Storage, error) {
+	switch {
+	case ctx.IsSet(ipfsAPIFlag.Name) && ctx.IsSet(storageDirFlag.Name):
+		return nil, fmt.Errorf("--%s and --%s are exclusive", ipfsAPIFlag.Name, storageDirFlag.Name)
+	case ctx.IsSet(ipfsAPIFlag.Name):
+		return &metadata.IPFS{APIURL: ctx.String(ipfsAPIFlag.Name)}, nil
+	case ctx.IsSet(storageDirFlag.Name):
+		if !ctx.IsSet(baseURLFlag.Name) {
+			return nil, fmt.Errorf("--%s requires --%s", storageDirFlag.Name, baseURLFlag.Name)
+		}
+		return &metadata.Dir{Path: ctx.String(storageDirFlag.Name), BaseURL: ctx.String(baseURLFlag.Name)}, nil
+	default:
+		return nil, fmt.Errorf("one of --%s or --%s is required", ipfsAPIFlag.Name, storageDirFlag.Name)
+	}
+}
+
+func metadataPublish(ctx *cli.Context) error {
+	network, err := addressFlag(ctx, networkFlag)
+	if err != nil {
+		return err
+	}
+	storage, err := metadataStorage(ctx)
+	if err != nil {
+		return err
+	}
+	tmpl, err := os.ReadFile(ctx.String(templateFlag.Name))
+	if err != nil {
+		return err
+	}
+	values := make(map[string]string)
+	for _, value := range ctx.StringSlice(setFlag.Name) {
+		key, v, found := strings.Cut(value, "=")
+		if !found {
+			return fmt.Errorf("--%s: expected key=value, got %q", setFlag.Name, value)
+		}
+		values[key] = v
+	}
+
+	client, err := dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	caller, err := networkcontracts.NewINetworkCaller(network, client)
+	if err != nil {
+		return err
+	}
+	name, err := caller.Name(&bind.CallOpts{Context: ctx.Context})
+	if err != nil {
+		return fmt.Errorf("name(): %w", err)
+	}
+	chainID, err := client.ChainID(ctx.Context)
+	if err != nil {
+		return fmt.Errorf("get chain ID: %w", err)
+	}
+	document, err := metadata.Build(string(tmpl), metadata.TemplateData{
+		Name:    name,
+		ChainID: chainID.Uint64(),
+		Network: network,
+		Values:  values,
+	})
+	if err != nil {
+		return err
+	}
+
+	fetcher := &metadata.Fetcher{IPFSGateway: ctx.String(ipfsGatewayFlag.Name), AllowHTTP: ctx.Bool(allowHTTPFlag.Name)}
+	publisher := metadata.NewPublisher(client, storage, fetcher)
+	publication, err := publisher.Prepare(ctx.Context, network, document)
+	if err != nil {
+		return err
+	}
+	if err := publication.WriteText(ctx.App.Writer); err != nil {
+		return err
+	}
+	if publication.Unchanged || ctx.Bool(publishDryRunFlag.Name) {
+		return nil
+	}
+
+	opts, err := transactor(ctx, client)
+	if err != nil {
+		return err
+	}
+	if !ctx.Bool(yesFlag.Name) {
+		ok, err := confirm(ctx, fmt.Sprintf("\nSet metadataURI of %s to %s from %s?", network, publication.URI, opts.From))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("aborted")
+		}
+	}
+	if err := publisher.Send(ctx.Context, opts, publication); err != nil {
+		return err
+	}
+	fmt.Fprintf(ctx.App.Writer, "MetadataURISet emitted in %s (block %d)\n", publication.TxHash, publication.BlockNumber)
+	return nil
+}
+
+func metadataIPFSStandIn(ctx *cli.Context) error {
+	server := &http.Server{Addr: ctx.String(standInListenFlag.Name), Handler: &metadata.IPFSStandIn{}, ReadHeaderTimeout: 10 * time.Second}
+
+	runCtx, stop := signal.NotifyContext(ctx.Context, syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		<-runCtx.Done()
+		server.Close()
+	}()
+	fmt.Fprintf(ctx.App.ErrWriter, "IPFS API at http://%s, gateway at http://%s/ipfs/\n", server.Addr, server.Addr)
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os/signal"
+	"syscall"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli/v2"
+
+	"github.com/symbioticfi/network/pkg/middleware"
+	"github.com/symbioticfi/network/pkg/multicall"
+)
+
+var (
+	middlewareConfigFlag = &cli.StringFlag{
+		Name:     "config",
+		Usage:    "path of the middleware YAML configuration",
+		Required: true,
+	}
+	onceFlag = &cli.BoolFlag{
+		Name:  "once",
+		Usage: "run a single round, print it and exit",
+	}
+	expectFlag = &cli.StringFlag{
+		Name:        "expect",
+		Usage:       "address of the middleware the Network should have",
+		DefaultText: "the middleware of the deployment with --fleet",
+	}
+	kindFlag = &cli.StringFlag{
+		Name:  "kind",
+		Usage: "kind the middleware must be: contract or key; empty accepts either",
+	}
+	codeHashFlag = &cli.StringFlag{
+		Name:  "code-hash",
+		Usage: "keccak256 the runtime code of a contract middleware must have",
+	}
+)
+
+var middlewareCommand = &cli.Command{
+	Name:  "middleware",
+	Usage: "check the Network's registered middleware or run a reference one managing max network limits",
+	Subcommands: []*cli.Command{
+		{
+			Name:  "run",
+			Usage: "evaluate the policy every interval and send the changes from --private-key, which must be the registered middleware",
+			Flags: []cli.Flag{
+				rpcURLFlag,
+				middlewareConfigFlag,
+				multicallFlag,
+				privateKeyFlag,
+				&cli.BoolFlag{
+					Name:  dryRunFlag.Name,
+					Usage: "plan and log the calls without sending them",
+				},
+				onceFlag,
+				formatFlag,
+			},
+			Action: middlewareRun,
+		},
+		{
+			Name:  "check",
+			Usage: "confirm the middleware registered for the Network is the expected one, or build the timelock operation registering it",
+			Flags: withFleet(
+				rpcURLFlag,
+				networkFlag,
+				expectFlag,
+				kindFlag,
+				codeHashFlag,
+				saltFlag,
+				predecessorFlag,
+				delayFlag,
+				formatFlag,
+			),
+			Action: middlewareCheck,
+		},
+	},
+}
+
+func middlewareRun(ctx *cli.Context) error {
+	config, err := middleware.LoadConfig(ctx.String(middlewareConfigFlag.Name))
+	if err != nil {
+		return err
+	}
+	client, err := dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	caller, err := multicallCaller(ctx, multicall.NewCaller(client, client.Client()))
+	if err != nil {
+		return err
+	}
+	opts, err := transactor(ctx, client)
+	if err != nil {
+		return err
+	}
+	service := middleware.New(caller, client, config.Network, opts, config.NewPolicy(caller))
+	config.Apply(service)
+	service.DryRun = ctx.Bool(dryRunFlag.Name)
+
+	if ctx.Bool(onceFlag.Name) {
+		round, err := service.Round(ctx.Context)
+		if err != nil {
+			return err
+		}
+		return output(ctx, round)
+	}
+
+	runCtx, stop := signal.NotifyContext(ctx.Context, syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	slog.Info("running middleware", "network", config.Network, "from", opts.From, "policy", config.Policy.Type, "interval", service.Interval, "dryRun", service.DryRun)
+	if err := service.Run(runCtx); err != nil && !errors.Is(err, context.Canceled) {
+		return err
+	}
+	return nil
+}
+
+func middlewareCheck(ctx *cli.Context) error {
+	var expected common.Address
+	switch {
+	case ctx.IsSet(expectFlag.Name):
+		var err error
+		if expected, err = addressFlag(ctx, expectFlag); err != nil {
+			return err
+		}
+	case !ctx.IsSet(fleetOptionFlag.Name):
+		return fmt.Errorf("--%s is required without --%s", expectFlag.Name, fleetOptionFlag.Name)
+	}
+	template := middleware.RegistrationRequest{Kind: middleware.Kind(ctx.String(kindFlag.Name))}
+	if ctx.IsSet(codeHashFlag.Name) {
+		var hash common.Hash
+		if err := hash.UnmarshalText([]byte(ctx.String(codeHashFlag.Name))); err != nil {
+			return fmt.Errorf("--%s: %w", codeHashFlag.Name, err)
+		}
+		template.CodeHash = &hash
+	}
+	var err error
+	if template.Salt, template.Predecessor, template.Delay, err = operationParams(ctx, "SetMiddleware"); err != nil {
+		return err
+	}
+
+	return eachNetwork(ctx, func(ctx *cli.Context, c *chain) (textWriter, error) {
+		req := template
+		req.Network = c.Network
+		req.Expected = expected
+		if expected == (common.Address{}) {
+			if c.Deployment.Middleware == (common.Address{}) {
+				return nil, fmt.Errorf("no --%s and no middleware in the registry", expectFlag.Name)
+			}
+			req.Expected = c.Deployment.Middleware
+		}
+		registration, err := middleware.CheckRegistration(ctx.Context, c.Backend, req)
+		if err != nil {
+			return nil, err
+		}
+		if !registration.OK() {
+			return registration, fmt.Errorf("the registered middleware is not the expected one")
+		}
+		return registration, nil
+	})
+}
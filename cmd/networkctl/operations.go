@@ -0,0 +1,197 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli/v2"
+
+	"github.com/symbioticfi/network/pkg/timelock"
+)
+
+var (
+	proposerFilterFlag = &cli.StringSliceFlag{
+		Name:  "proposer",
+		Usage: "only operations scheduled by a transaction from this account; repeatable",
+	}
+	targetFilterFlag = &cli.StringSliceFlag{
+		Name:  "target",
+		Usage: "only operations calling this target; repeatable",
+	}
+	selectorFilterFlag = &cli.StringSliceFlag{
+		Name:  "selector",
+		Usage: "only operations using this selector, as hex or a function signature; repeatable",
+	}
+	dryRunFlag = &cli.BoolFlag{
+		Name:  "dry-run",
+		Usage: "list the operations that would be cancelled without sending transactions",
+	}
+	yesFlag = &cli.BoolFlag{
+		Name:  "yes",
+		Usage: "skip the confirmation prompt",
+	}
+)
+
+var operationsCommand = &cli.Command{
+	Name:  "operations",
+	Usage: "inspect and cancel timelock operations",
+	Subcommands: []*cli.Command{
+		{
+			Name:  "list",
+			Usage: "list pending (Waiting or Ready) operations",
+			Flags: withFleet(
+				rpcURLFlag,
+				networkFlag,
+				fromBlockFlag,
+				toBlockFlag,
+				blockRangeFlag,
+				proposerFilterFlag,
+				targetFilterFlag,
+				selectorFilterFlag,
+				formatFlag,
+			),
+			Action: operationsList,
+		},
+		{
+			Name:  "cancel-all",
+			Usage: "cancel every pending operation matching the filters from a CANCELLER_ROLE key",
+			Flags: []cli.Flag{
+				rpcURLFlag,
+				networkFlag,
+				requiredFromBlockFlag,
+				toBlockFlag,
+				blockRangeFlag,
+				proposerFilterFlag,
+				targetFilterFlag,
+				selectorFilterFlag,
+				privateKeyFlag,
+				dryRunFlag,
+				yesFlag,
+			},
+			Action: operationsCancelAll,
+		},
+	},
+}
+
+// pendingOperations lists the pending operations of c matching the filter
+// flags.
+func pendingOperations(ctx *cli.Context, c *chain) (*timelock.Index, timelock.List, error) {
+	var filter timelock.Filter
+	for _, value := range ctx.StringSlice(proposerFilterFlag.Name) {
+		if !common.IsHexAddress(value) {
+			return nil, nil, fmt.Errorf("--%s: invalid address %q", proposerFilterFlag.Name, value)
+		}
+		filter.Proposers = append(filter.Proposers, common.HexToAddress(value))
+	}
+	for _, value := range ctx.StringSlice(targetFilterFlag.Name) {
+		if !common.IsHexAddress(value) {
+			return nil, nil, fmt.Errorf("--%s: invalid address %q", targetFilterFlag.Name, value)
+		}
+		filter.Targets = append(filter.Targets, common.HexToAddress(value))
+	}
+	for _, value := range ctx.StringSlice(selectorFilterFlag.Name) {
+		selector, err := timelock.ParseSelector(value)
+		if err != nil {
+			return nil, nil, fmt.Errorf("--%s: %w", selectorFilterFlag.Name, err)
+		}
+		filter.Selectors = append(filter.Selectors, selector)
+	}
+
+	from, to, err := blockBounds(ctx, c)
+	if err != nil {
+		return nil, nil, err
+	}
+	index, err := timelock.NewIndex(c.Network, c.Backend)
+	if err != nil {
+		return nil, nil, err
+	}
+	index.BlockRange = ctx.Uint64(blockRangeFlag.Name)
+	pending, err := index.Pending(ctx.Context, from, to, filter)
+	if err != nil {
+		return nil, nil, err
+	}
+	return index, pending, nil
+}
+
+func operationsList(ctx *cli.Context) error {
+	return eachNetwork(ctx, func(ctx *cli.Context, c *chain) (textWriter, error) {
+		_, pending, err := pendingOperations(ctx, c)
+		if err != nil {
+			return nil, err
+		}
+		return pending, nil
+	})
+}
+
+func operationsCancelAll(ctx *cli.Context) error {
+	network, err := addressFlag(ctx, networkFlag)
+	if err != nil {
+		return err
+	}
+	client, err := dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	index, pending, err := pendingOperations(ctx, newChain(ctx, client, network))
+	if err != nil {
+		return err
+	}
+	if len(pending) == 0 {
+		fmt.Fprintln(ctx.App.Writer, "No pending operations match the filters.")
+		return nil
+	}
+	if err := pending.WriteText(ctx.App.Writer); err != nil {
+		return err
+	}
+	if ctx.Bool(dryRunFlag.Name) {
+		fmt.Fprintf(ctx.App.Writer, "\nDry run: %d operations would be cancelled.\n", len(pending))
+		return nil
+	}
+
+	opts, err := transactor(ctx, client)
+	if err != nil {
+		return err
+	}
+	if !ctx.Bool(yesFlag.Name) {
+		ok, err := confirm(ctx, fmt.Sprintf("\nCancel %d operations from %s?", len(pending), opts.From))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("aborted")
+		}
+	}
+
+	ids := make([]common.Hash, len(pending))
+	for i, op := range pending {
+		ids[i] = op.ID
+	}
+	results, err := index.Cancel(ctx.Context, opts, ids)
+	if err != nil {
+		return err
+	}
+	failed := writeCancelResults(ctx.App.Writer, results)
+	if failed > 0 {
+		return fmt.Errorf("%d of %d operations are not Unset after cancellation", failed, len(results))
+	}
+	fmt.Fprintf(ctx.App.Writer, "\nAll %d operations read as Unset.\n", len(results))
+	return nil
+}
+
+func writeCancelResults(w io.Writer, results []timelock.CancelResult) int {
+	failed := 0
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "\nID\tTX\tSTATE\tERROR")
+	for _, result := range results {
+		if !result.Cancelled() {
+			failed++
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", result.ID, result.TxHash, result.State, result.Error)
+	}
+	tw.Flush()
+	return failed
+}
@@ -0,0 +1,418 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/urfave/cli/v2"
+
+	networkcontracts "github.com/symbioticfi/network/bindings/go-go-ethereum"
+	"github.com/symbioticfi/network/pkg/calldata"
+	"github.com/symbioticfi/network/pkg/proposal"
+	"github.com/symbioticfi/network/pkg/timelock"
+)
+
+var (
+	callFlag = &cli.StringSliceFlag{
+		Name:     "call",
+		Usage:    "call of the operation as target,calldata[,value in wei]; repeatable",
+		Required: true,
+	}
+	batchFlag = &cli.BoolFlag{
+		Name:  "batch",
+		Usage: "schedule a single call with scheduleBatch",
+	}
+	rationaleFlag = &cli.StringFlag{
+		Name:  "rationale",
+		Usage: "why the operation is proposed",
+	}
+	outFlag = &cli.StringFlag{
+		Name:     "out",
+		Usage:    "path of the proposal file to write",
+		Required: true,
+	}
+	schemeFlag = &cli.StringFlag{
+		Name:  "scheme",
+		Usage: "signature scheme: eip191 or eip712",
+		Value: string(proposal.EIP712),
+	}
+	signatureFlag = &cli.StringFlag{
+		Name:     "signature",
+		Usage:    "65-byte hex signature over the proposal",
+		Required: true,
+	}
+	reviewerFlag = &cli.StringSliceFlag{
+		Name:  "reviewer",
+		Usage: "account whose signature counts as an approval; repeatable",
+	}
+	thresholdFlag = &cli.IntFlag{
+		Name:  "threshold",
+		Usage: "number of reviewer signatures required",
+	}
+)
+
+var proposalCommand = &cli.Command{
+	Name:  "proposal",
+	Usage: "create, review and schedule operation proposal files",
+	Subcommands: []*cli.Command{
+		{
+			Name:  "create",
+			Usage: "write an unsigned proposal for an operation on the Network",
+			Flags: []cli.Flag{
+				rpcURLFlag,
+				networkFlag,
+				callFlag,
+				batchFlag,
+				saltFlag,
+				predecessorFlag,
+				delayFlag,
+				rationaleFlag,
+				outFlag,
+			},
+			Action: proposalCreate,
+		},
+		{
+			Name:      "show",
+			Usage:     "validate a proposal and show its calls and signatures",
+			ArgsUsage: "<file>",
+			Flags: []cli.Flag{
+				reviewerFlag,
+				thresholdFlag,
+				formatFlag,
+			},
+			Action: proposalShow,
+		},
+		{
+			Name:      "typed-data",
+			Usage:     "print the EIP-712 typed data to sign with an external wallet",
+			ArgsUsage: "<file>",
+			Action:    proposalTypedData,
+		},
+		{
+			Name:      "sign",
+			Usage:     "sign a proposal with --private-key and add the signature to the file",
+			ArgsUsage: "<file>",
+			Flags: []cli.Flag{
+				privateKeyFlag,
+				schemeFlag,
+			},
+			Action: proposalSign,
+		},
+		{
+			Name:      "attach",
+			Usage:     "verify a signature made elsewhere and add it to the file",
+			ArgsUsage: "<file>",
+			Flags: []cli.Flag{
+				signatureFlag,
+				schemeFlag,
+			},
+			Action: proposalAttach,
+		},
+		{
+			Name:      "schedule",
+			Usage:     "schedule a proposal approved by at least --threshold of the --reviewer accounts",
+			ArgsUsage: "<file>",
+			Flags: []cli.Flag{
+				rpcURLFlag,
+				reviewerFlag,
+				thresholdFlag,
+				privateKeyFlag,
+				yesFlag,
+			},
+			Action: proposalSchedule,
+		},
+	},
+}
+
+// parseCall parses a --call value of the form target,calldata[,value].
+func parseCall(value string) (timelock.Call, error) {
+	parts := strings.Split(value, ",")
+	if len(parts) < 2 || len(parts) > 3 {
+		return timelock.Call{}, fmt.Errorf("--%s: expected target,calldata[,value], got %q", callFlag.Name, value)
+	}
+	if !common.IsHexAddress(parts[0]) {
+		return timelock.Call{}, fmt.Errorf("--%s: invalid target %q", callFlag.Name, parts[0])
+	}
+	data, err := hexutil.Decode(parts[1])
+	if err != nil {
+		return timelock.Call{}, fmt.Errorf("--%s: invalid calldata %q: %w", callFlag.Name, parts[1], err)
+	}
+	call := timelock.Call{Target: common.HexToAddress(parts[0]), Value: new(big.Int), Data: data}
+	if len(parts) == 3 {
+		if _, ok := call.Value.SetString(parts[2], 10); !ok || call.Value.Sign() < 0 {
+			return timelock.Call{}, fmt.Errorf("--%s: invalid value %q", callFlag.Name, parts[2])
+		}
+	}
+	return call, nil
+}
+
+// proposalArg loads the proposal file given as the only argument.
+func proposalArg(ctx *cli.Context) (string, *proposal.Proposal, error) {
+	if ctx.NArg() != 1 {
+		return "", nil, fmt.Errorf("expected a proposal file argument")
+	}
+	path := ctx.Args().First()
+	p, err := proposal.Load(path)
+	if err != nil {
+		return "", nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return path, p, nil
+}
+
+// warnMismatches reports the calls whose signed description differs from
+// what they decode to now.
+func warnMismatches(ctx *cli.Context, p *proposal.Proposal) {
+	for _, i := range p.Mismatches(calldata.Default) {
+		fmt.Fprintf(ctx.App.ErrWriter, "warning: call %d is described as %q but decodes as %q\n",
+			i, p.Calls[i].Description, calldata.Default.Describe(p.Calls[i].Data))
+	}
+}
+
+// reviewers resolves --reviewer and --threshold.
+func reviewers(ctx *cli.Context) ([]common.Address, int, error) {
+	var accounts []common.Address
+	for _, value := range ctx.StringSlice(reviewerFlag.Name) {
+		if !common.IsHexAddress(value) {
+			return nil, 0, fmt.Errorf("--%s: invalid address %q", reviewerFlag.Name, value)
+		}
+		accounts = append(accounts, common.HexToAddress(value))
+	}
+	return accounts, ctx.Int(thresholdFlag.Name), nil
+}
+
+func proposalCreate(ctx *cli.Context) error {
+	client, err := dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	network, err := addressFlag(ctx, networkFlag)
+	if err != nil {
+		return err
+	}
+	var calls []timelock.Call
+	for _, value := range ctx.StringSlice(callFlag.Name) {
+		call, err := parseCall(value)
+		if err != nil {
+			return err
+		}
+		calls = append(calls, call)
+	}
+	salt, predecessor, delay, err := operationParams(ctx, "")
+	if err != nil {
+		return err
+	}
+
+	caller, err := networkcontracts.NewINetworkCaller(network, client)
+	if err != nil {
+		return err
+	}
+	prepared, err := timelock.Prepare(&bind.CallOpts{Context: ctx.Context}, caller, timelock.Operation{
+		Calls:       calls,
+		Predecessor: predecessor,
+		Salt:        salt,
+		Delay:       delay,
+		Batch:       ctx.Bool(batchFlag.Name),
+	})
+	if err != nil {
+		return err
+	}
+	chainID, err := client.ChainID(ctx.Context)
+	if err != nil {
+		return fmt.Errorf("get chain ID: %w", err)
+	}
+
+	p, err := proposal.New(chainID.Uint64(), network, prepared.Operation, ctx.String(rationaleFlag.Name))
+	if err != nil {
+		return err
+	}
+	if err := p.Save(ctx.String(outFlag.Name)); err != nil {
+		return err
+	}
+	hash, err := p.Hash()
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(ctx.App.Writer, "Wrote %s\noperation id: %s\nproposal hash: %s\n", ctx.String(outFlag.Name), p.OperationID, hash)
+	return nil
+}
+
+func proposalShow(ctx *cli.Context) error {
+	_, p, err := proposalArg(ctx)
+	if err != nil {
+		return err
+	}
+	if err := output(ctx, p); err != nil {
+		return err
+	}
+	warnMismatches(ctx, p)
+	accounts, threshold, err := reviewers(ctx)
+	if err != nil {
+		return err
+	}
+	if len(accounts) == 0 {
+		return nil
+	}
+	if err := p.RequireApprovals(accounts, threshold); err != nil {
+		return err
+	}
+	fmt.Fprintf(ctx.App.ErrWriter, "Approved by %d of %d reviewers.\n", len(p.Approvals(accounts)), len(accounts))
+	return nil
+}
+
+func proposalTypedData(ctx *cli.Context) error {
+	_, p, err := proposalArg(ctx)
+	if err != nil {
+		return err
+	}
+	typedData, err := p.TypedData()
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(ctx.App.Writer)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(typedData)
+}
+
+func proposalSign(ctx *cli.Context) error {
+	path, p, err := proposalArg(ctx)
+	if err != nil {
+		return err
+	}
+	if !ctx.IsSet(privateKeyFlag.Name) {
+		return fmt.Errorf("--%s is required to sign", privateKeyFlag.Name)
+	}
+	key, err := crypto.HexToECDSA(strings.TrimPrefix(ctx.String(privateKeyFlag.Name), "0x"))
+	if err != nil {
+		return fmt.Errorf("--%s: %w", privateKeyFlag.Name, err)
+	}
+	signature, err := p.Sign(key, proposal.Scheme(ctx.String(schemeFlag.Name)))
+	if err != nil {
+		return err
+	}
+	if err := p.Save(path); err != nil {
+		return err
+	}
+	fmt.Fprintf(ctx.App.Writer, "Added the %s signature of %s to %s\n", signature.Scheme, signature.Signer, path)
+	return nil
+}
+
+func proposalAttach(ctx *cli.Context) error {
+	path, p, err := proposalArg(ctx)
+	if err != nil {
+		return err
+	}
+	sig, err := hexutil.Decode(ctx.String(signatureFlag.Name))
+	if err != nil {
+		return fmt.Errorf("--%s: %w", signatureFlag.Name, err)
+	}
+	scheme := proposal.Scheme(ctx.String(schemeFlag.Name))
+	signer, err := p.Recover(scheme, sig)
+	if err != nil {
+		return err
+	}
+	if err := p.Attach(proposal.Signature{Signer: signer, Scheme: scheme, Signature: sig}); err != nil {
+		return err
+	}
+	if err := p.Save(path); err != nil {
+		return err
+	}
+	fmt.Fprintf(ctx.App.Writer, "Added the %s signature of %s to %s\n", scheme, signer, path)
+	return nil
+}
+
+func proposalSchedule(ctx *cli.Context) error {
+	_, p, err := proposalArg(ctx)
+	if err != nil {
+		return err
+	}
+	accounts, threshold, err := reviewers(ctx)
+	if err != nil {
+		return err
+	}
+	if err := p.RequireApprovals(accounts, threshold); err != nil {
+		return err
+	}
+
+	client, err := dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	chainID, err := client.ChainID(ctx.Context)
+	if err != nil {
+		return fmt.Errorf("get chain ID: %w", err)
+	}
+	if chainID.Uint64() != p.ChainID {
+		return fmt.Errorf("proposal is for chain %d, connected to chain %d", p.ChainID, chainID)
+	}
+	callOpts := &bind.CallOpts{Context: ctx.Context}
+	networkCaller, err := networkcontracts.NewINetworkCaller(p.Network, client)
+	if err != nil {
+		return err
+	}
+	prepared, err := timelock.Prepare(callOpts, networkCaller, *p.Operation())
+	if err != nil {
+		return err
+	}
+	timelockContract, err := networkcontracts.NewTimelockControllerUpgradeable(p.Network, client)
+	if err != nil {
+		return err
+	}
+	state, err := timelockContract.GetOperationState(callOpts, p.OperationID)
+	if err != nil {
+		return fmt.Errorf("getOperationState(%s): %w", p.OperationID, err)
+	}
+	if timelock.OperationState(state) != timelock.Unset {
+		return fmt.Errorf("operation %s is already %s", p.OperationID, timelock.OperationState(state))
+	}
+
+	if err := p.WriteText(ctx.App.Writer); err != nil {
+		return err
+	}
+	warnMismatches(ctx, p)
+	opts, err := transactor(ctx, client)
+	if err != nil {
+		return err
+	}
+	if !ctx.Bool(yesFlag.Name) {
+		ok, err := confirm(ctx, fmt.Sprintf("\nSchedule operation %s from %s?", p.OperationID, opts.From))
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("aborted")
+		}
+	}
+
+	tx, err := bind.NewBoundContract(p.Network, *timelock.ABI, client, client, client).RawTransact(opts, prepared.ScheduleData)
+	if err != nil {
+		return fmt.Errorf("send schedule transaction: %w", err)
+	}
+	fmt.Fprintf(ctx.App.Writer, "Sent %s\n", tx.Hash())
+	receipt, err := bind.WaitMined(ctx.Context, client, tx)
+	if err != nil {
+		return fmt.Errorf("wait for %s: %w", tx.Hash(), err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return fmt.Errorf("schedule transaction %s reverted", tx.Hash())
+	}
+	state, err = timelockContract.GetOperationState(callOpts, p.OperationID)
+	if err != nil {
+		return fmt.Errorf("getOperationState(%s): %w", p.OperationID, err)
+	}
+	if !timelock.OperationState(state).Pending() {
+		return fmt.Errorf("operation %s is %s after scheduling", p.OperationID, timelock.OperationState(state))
+	}
+	fmt.Fprintf(ctx.App.Writer, "Scheduled operation %s, now %s\n", p.OperationID, timelock.OperationState(state))
+	return nil
+}
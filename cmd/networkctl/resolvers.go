@@ -0,0 +1,143 @@
+package main
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/urfave/cli/v2"
+
+	"github.com/symbioticfi/network/pkg/multicall"
+	"github.com/symbioticfi/network/pkg/resolver"
+)
+
+var (
+	subnetworkFlag = &cli.StringSliceFlag{
+		Name:     "subnetwork",
+		Usage:    "subnetwork to read as vault,subnetworkId; repeatable",
+		Required: true,
+	}
+	resolverFlag = &cli.StringSliceFlag{
+		Name:     "resolver",
+		Usage:    "wanted resolver as vault,subnetworkId,resolver; repeatable",
+		Required: true,
+	}
+)
+
+var resolversCommand = &cli.Command{
+	Name:  "resolvers",
+	Usage: "read and change the veto slasher resolvers of the Network's subnetworks",
+	Subcommands: []*cli.Command{
+		{
+			Name:  "list",
+			Usage: "show each vault's slasher type and the current and pending resolver and open slash requests of each subnetwork",
+			Flags: []cli.Flag{
+				rpcURLFlag,
+				networkFlag,
+				subnetworkFlag,
+				multicallFlag,
+				formatFlag,
+			},
+			Action: resolversList,
+		},
+		{
+			Name:  "set",
+			Usage: "build the timelock operation calling setResolver for the resolvers that differ",
+			Flags: []cli.Flag{
+				rpcURLFlag,
+				networkFlag,
+				resolverFlag,
+				multicallFlag,
+				saltFlag,
+				predecessorFlag,
+				delayFlag,
+				formatFlag,
+			},
+			Action: resolversSet,
+		},
+	},
+}
+
+// parseSubnetworkKey parses the "vault,subnetworkId" prefix of parts.
+func parseSubnetworkKey(parts []string) (common.Address, *big.Int, error) {
+	if !common.IsHexAddress(parts[0]) {
+		return common.Address{}, nil, fmt.Errorf("invalid vault %q", parts[0])
+	}
+	id, ok := new(big.Int).SetString(parts[1], 0)
+	if !ok {
+		return common.Address{}, nil, fmt.Errorf("invalid subnetwork ID %q", parts[1])
+	}
+	return common.HexToAddress(parts[0]), id, nil
+}
+
+func resolversList(ctx *cli.Context) error {
+	network, err := addressFlag(ctx, networkFlag)
+	if err != nil {
+		return err
+	}
+	var keys []resolver.Key
+	for _, value := range ctx.StringSlice(subnetworkFlag.Name) {
+		parts := strings.Split(value, ",")
+		if len(parts) != 2 {
+			return fmt.Errorf("--%s: expected vault,subnetworkId, got %q", subnetworkFlag.Name, value)
+		}
+		vault, id, err := parseSubnetworkKey(parts)
+		if err != nil {
+			return fmt.Errorf("--%s: %w", subnetworkFlag.Name, err)
+		}
+		keys = append(keys, resolver.Key{Vault: vault, SubnetworkID: id})
+	}
+
+	client, err := dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	caller, err := multicallCaller(ctx, multicall.NewCaller(client, client.Client()))
+	if err != nil {
+		return err
+	}
+	report, err := resolver.Read(ctx.Context, caller, client, network, keys)
+	if err != nil {
+		return err
+	}
+	return output(ctx, report)
+}
+
+func resolversSet(ctx *cli.Context) error {
+	network, err := addressFlag(ctx, networkFlag)
+	if err != nil {
+		return err
+	}
+	var req resolver.Request
+	for _, value := range ctx.StringSlice(resolverFlag.Name) {
+		parts := strings.Split(value, ",")
+		if len(parts) != 3 || !common.IsHexAddress(parts[2]) {
+			return fmt.Errorf("--%s: expected vault,subnetworkId,resolver, got %q", resolverFlag.Name, value)
+		}
+		vault, id, err := parseSubnetworkKey(parts)
+		if err != nil {
+			return fmt.Errorf("--%s: %w", resolverFlag.Name, err)
+		}
+		req.Targets = append(req.Targets, resolver.Target{Vault: vault, SubnetworkID: id, Resolver: common.HexToAddress(parts[2])})
+	}
+	if req.Salt, req.Predecessor, req.Delay, err = operationParams(ctx, "SetResolver"); err != nil {
+		return err
+	}
+
+	client, err := dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	caller, err := multicallCaller(ctx, multicall.NewCaller(client, client.Client()))
+	if err != nil {
+		return err
+	}
+	plan, err := resolver.PlanChanges(ctx.Context, caller, client, network, req)
+	if err != nil {
+		return err
+	}
+	return output(ctx, plan)
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/symbioticfi/network/pkg/roles"
+)
+
+var rolesCommand = &cli.Command{
+	Name:  "roles",
+	Usage: "inspect role membership",
+	Subcommands: []*cli.Command{
+		{
+			Name:  "audit",
+			Usage: "rebuild role membership from logs and report who granted what",
+			Flags: withFleet(
+				rpcURLFlag,
+				networkFlag,
+				fromBlockFlag,
+				toBlockFlag,
+				blockRangeFlag,
+				formatFlag,
+			),
+			Action: rolesAudit,
+		},
+		{
+			Name:  "rotate",
+			Usage: "build the scheduleBatch operation that replaces an account in its roles",
+			Flags: []cli.Flag{
+				rpcURLFlag,
+				networkFlag,
+				oldAccountFlag,
+				newAccountFlag,
+				roleFlag,
+				saltFlag,
+				predecessorFlag,
+				delayFlag,
+				formatFlag,
+			},
+			Action: rolesRotate,
+		},
+	},
+}
+
+var (
+	oldAccountFlag = &cli.StringFlag{
+		Name:     "old",
+		Usage:    "account to remove from the roles",
+		Required: true,
+	}
+	newAccountFlag = &cli.StringFlag{
+		Name:     "new",
+		Usage:    "account to grant the roles to",
+		Required: true,
+	}
+	roleFlag = &cli.StringSliceFlag{
+		Name:        "role",
+		Usage:       "role to rotate, by name (e.g. PROPOSER_ROLE) or hex value; repeatable",
+		DefaultText: "every role held by --old",
+	}
+)
+
+func rolesAudit(ctx *cli.Context) error {
+	return eachNetwork(ctx, func(ctx *cli.Context, c *chain) (textWriter, error) {
+		from, to, err := blockBounds(ctx, c)
+		if err != nil {
+			return nil, err
+		}
+		indexer, err := roles.NewIndexer(c.Network, c.Backend)
+		if err != nil {
+			return nil, err
+		}
+		indexer.BlockRange = ctx.Uint64(blockRangeFlag.Name)
+
+		report, err := indexer.Audit(ctx.Context, from, to)
+		if err != nil {
+			return nil, err
+		}
+		if len(report.Mismatches) > 0 {
+			return report, fmt.Errorf("%d role memberships disagree with hasRole", len(report.Mismatches))
+		}
+		return report, nil
+	})
+}
+
+func rolesRotate(ctx *cli.Context) error {
+	network, err := addressFlag(ctx, networkFlag)
+	if err != nil {
+		return err
+	}
+	oldAccount, err := addressFlag(ctx, oldAccountFlag)
+	if err != nil {
+		return err
+	}
+	newAccount, err := addressFlag(ctx, newAccountFlag)
+	if err != nil {
+		return err
+	}
+	rotation := roles.Rotation{Old: oldAccount, New: newAccount}
+	for _, name := range ctx.StringSlice(roleFlag.Name) {
+		role, ok := roles.Parse(name)
+		if !ok {
+			return fmt.Errorf("--%s: unknown role %q", roleFlag.Name, name)
+		}
+		rotation.Roles = append(rotation.Roles, role)
+	}
+	if rotation.Salt, rotation.Predecessor, rotation.Delay, err = operationParams(ctx, "RotateRoles"); err != nil {
+		return err
+	}
+
+	client, err := dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	plan, err := roles.PlanRotation(ctx.Context, client, network, rotation)
+	if err != nil {
+		return err
+	}
+	return output(ctx, plan)
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/urfave/cli/v2"
+
+	"github.com/symbioticfi/network/pkg/multicall"
+)
+
+var (
+	accountFlag = &cli.StringSliceFlag{
+		Name:  "account",
+		Usage: "account to check every known role for; repeatable",
+	}
+	operationFlag = &cli.StringSliceFlag{
+		Name:  "operation",
+		Usage: "ID of an operation to read the state and timestamp of; repeatable",
+	}
+	multicallFlag = &cli.StringFlag{
+		Name:  "multicall",
+		Usage: "address of Multicall3; empty to only use JSON-RPC batches",
+		Value: multicall.Multicall3Address.Hex(),
+	}
+)
+
+var snapshotCommand = &cli.Command{
+	Name:  "snapshot",
+	Usage: "read the name, metadata URI, delays, role holders and operation states of a Network in one round-trip",
+	Flags: withFleet(
+		rpcURLFlag,
+		networkFlag,
+		blockFlag,
+		minDelayFlag,
+		accountFlag,
+		operationFlag,
+		multicallFlag,
+		formatFlag,
+	),
+	Action: snapshotShow,
+}
+
+func snapshotShow(ctx *cli.Context) error {
+	var query multicall.SnapshotQuery
+	for _, value := range ctx.StringSlice(minDelayFlag.Name) {
+		call, err := parseMinDelayCall(value)
+		if err != nil {
+			return fmt.Errorf("--%s: %w", minDelayFlag.Name, err)
+		}
+		query.Calls = append(query.Calls, call)
+	}
+	for _, value := range ctx.StringSlice(accountFlag.Name) {
+		if !common.IsHexAddress(value) {
+			return fmt.Errorf("--%s: invalid address %q", accountFlag.Name, value)
+		}
+		query.Accounts = append(query.Accounts, common.HexToAddress(value))
+	}
+	for _, value := range ctx.StringSlice(operationFlag.Name) {
+		b, err := hexutil.Decode(value)
+		if err != nil || len(b) != common.HashLength {
+			return fmt.Errorf("--%s: invalid operation ID %q", operationFlag.Name, value)
+		}
+		query.Operations = append(query.Operations, common.BytesToHash(b))
+	}
+
+	return eachNetwork(ctx, func(ctx *cli.Context, c *chain) (textWriter, error) {
+		caller, err := multicallCaller(ctx, c.caller)
+		if err != nil {
+			return nil, err
+		}
+		var block *big.Int
+		if ctx.IsSet(blockFlag.Name) {
+			block = new(big.Int).SetUint64(ctx.Uint64(blockFlag.Name))
+		}
+		snapshot, err := caller.Snapshot(ctx.Context, c.Network, block, query)
+		if err != nil {
+			return nil, err
+		}
+		return snapshot, nil
+	})
+}
+
+// multicallCaller configures caller to use the Multicall3 deployment of
+// --multicall, or only JSON-RPC batches when it is empty.
+func multicallCaller(ctx *cli.Context, caller *multicall.Caller) (*multicall.Caller, error) {
+	caller.Address = common.Address{}
+	if address := ctx.String(multicallFlag.Name); address != "" {
+		if !common.IsHexAddress(address) {
+			return nil, fmt.Errorf("--%s: invalid address %q", multicallFlag.Name, address)
+		}
+		caller.Address = common.HexToAddress(address)
+	}
+	return caller, nil
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethclient/gethclient"
+	"github.com/urfave/cli/v2"
+
+	"github.com/symbioticfi/network/pkg/upgrade"
+)
+
+var (
+	implementationFlag = &cli.StringFlag{
+		Name:     "implementation",
+		Usage:    "address of the deployed new implementation",
+		Required: true,
+	}
+	artifactFlag = &cli.StringFlag{
+		Name:  "artifact",
+		Usage: "Foundry build artifact the new implementation must match",
+		Value: "out/Network.sol/Network.json",
+	}
+	upgradeDataFlag = &cli.StringFlag{
+		Name:  "upgrade-data",
+		Usage: "hex calldata upgradeAndCall passes to the new implementation",
+		Value: "0x",
+	}
+)
+
+var upgradeCommand = &cli.Command{
+	Name:  "upgrade",
+	Usage: "check a new Network implementation and build the timelock operation that upgrades the proxy to it",
+	Flags: []cli.Flag{
+		rpcURLFlag,
+		networkFlag,
+		implementationFlag,
+		artifactFlag,
+		upgradeDataFlag,
+		minDelayFlag,
+		accountFlag,
+		saltFlag,
+		predecessorFlag,
+		delayFlag,
+		formatFlag,
+	},
+	Action: upgradePrepare,
+}
+
+func upgradePrepare(ctx *cli.Context) error {
+	network, err := addressFlag(ctx, networkFlag)
+	if err != nil {
+		return err
+	}
+	implementation, err := addressFlag(ctx, implementationFlag)
+	if err != nil {
+		return err
+	}
+	artifact, err := upgrade.LoadArtifact(ctx.String(artifactFlag.Name))
+	if err != nil {
+		return err
+	}
+	upgradeData, err := hexutil.Decode(ctx.String(upgradeDataFlag.Name))
+	if err != nil {
+		return fmt.Errorf("--%s: %w", upgradeDataFlag.Name, err)
+	}
+	req := upgrade.Request{
+		Network:           network,
+		NewImplementation: implementation,
+		UpgradeData:       upgradeData,
+		Artifact:          artifact,
+	}
+	for _, value := range ctx.StringSlice(minDelayFlag.Name) {
+		call, err := parseMinDelayCall(value)
+		if err != nil {
+			return fmt.Errorf("--%s: %w", minDelayFlag.Name, err)
+		}
+		req.Calls = append(req.Calls, call)
+	}
+	for _, value := range ctx.StringSlice(accountFlag.Name) {
+		if !common.IsHexAddress(value) {
+			return fmt.Errorf("--%s: invalid address %q", accountFlag.Name, value)
+		}
+		req.Accounts = append(req.Accounts, common.HexToAddress(value))
+	}
+	if req.Salt, req.Predecessor, req.Delay, err = operationParams(ctx, "UpgradeProxy"); err != nil {
+		return err
+	}
+
+	client, err := dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	plan, err := upgrade.Prepare(ctx.Context, client, gethclient.New(client.Client()), client.Client(), req)
+	if err != nil {
+		return err
+	}
+	if err := output(ctx, plan); err != nil {
+		return err
+	}
+	if !plan.Passed() {
+		return fmt.Errorf("the upgrade failed its checks")
+	}
+	return nil
+}
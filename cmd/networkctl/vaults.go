@@ -0,0 +1,70 @@
+package main
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/symbioticfi/network/pkg/vaults"
+)
+
+var subnetworkIDFlag = &cli.StringSliceFlag{
+	Name:  "subnetwork-id",
+	Usage: "only scan this subnetwork identifier; repeatable, every subnetwork of the Network when unset (much slower, see the command help)",
+}
+
+var vaultsCommand = &cli.Command{
+	Name:  "vaults",
+	Usage: "discover the vaults whose delegator has a max network limit or network limit for the Network's subnetworks",
+	Description: "The limit events are indexed by subnetwork, not by Network, so eth_getLogs can only filter them\n" +
+		"to the Network when --subnetwork-id is given. Without it, the scan downloads the SetMaxNetworkLimit and\n" +
+		"SetNetworkLimit events of every Network on the chain and drops the others locally: on a busy chain this\n" +
+		"is many times the logs and eth_getLogs calls, and providers may reject the larger responses, in which\n" +
+		"case lower --block-range. Pass the Network's subnetwork identifiers, usually 0, whenever they are known.",
+	Flags: withFleet(
+		rpcURLFlag,
+		networkFlag,
+		fromBlockFlag,
+		blockRangeFlag,
+		subnetworkIDFlag,
+		multicallFlag,
+		formatFlag,
+	),
+	Action: vaultsDiscover,
+}
+
+func vaultsDiscover(ctx *cli.Context) error {
+	var subnetworkIDs []*big.Int
+	for _, value := range ctx.StringSlice(subnetworkIDFlag.Name) {
+		id, ok := new(big.Int).SetString(value, 0)
+		if !ok {
+			return fmt.Errorf("--%s: invalid subnetwork ID %q", subnetworkIDFlag.Name, value)
+		}
+		subnetworkIDs = append(subnetworkIDs, id)
+	}
+
+	return eachNetwork(ctx, func(ctx *cli.Context, c *chain) (textWriter, error) {
+		// A scan from genesis would both be slow and look complete, so the
+		// start is never defaulted: it is the deployment's fromBlock with
+		// --fleet and --from-block otherwise.
+		if c.Deployment == nil && !ctx.IsSet(fromBlockFlag.Name) {
+			return nil, fmt.Errorf("--%s is required without --%s", fromBlockFlag.Name, fleetOptionFlag.Name)
+		}
+		req := vaults.Request{
+			Network:       c.Network,
+			FromBlock:     c.FromBlock,
+			BlockRange:    ctx.Uint64(blockRangeFlag.Name),
+			SubnetworkIDs: subnetworkIDs,
+		}
+		caller, err := multicallCaller(ctx, c.caller)
+		if err != nil {
+			return nil, err
+		}
+		inventory, err := vaults.Discover(ctx.Context, caller, c.Backend, req)
+		if err != nil {
+			return nil, err
+		}
+		return inventory, nil
+	})
+}
@@ -0,0 +1,164 @@
+package alert
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"gopkg.in/yaml.v3"
+)
+
+// Config is the YAML configuration of the alerting service.
+//
+//	networks:
+//	  - 0x...
+//	rules:
+//	  - name: proxy-admin-operation
+//	    severity: critical
+//	    events: [CallScheduled]
+//	    targets: ["0x..."]
+//	  - name: max-network-limit-delay-lowered
+//	    events: [MinDelayChange]
+//	    selectors: ["setMaxNetworkLimit(address,uint96,uint256)"]
+//	    delayLowered: true
+//	  - name: unexpected-proposer
+//	    events: [CallScheduled]
+//	    allowedSenders: ["0x..."]
+//	sinks:
+//	  - type: webhook
+//	    url: https://example.com/hook
+//	  - type: slack
+//	    url: https://hooks.slack.com/services/...
+//	  - type: email
+//	    smtp: smtp.example.com:587
+//	    username: alerts
+//	    passwordEnv: SMTP_PASSWORD
+//	    from: alerts@example.com
+//	    to: [oncall@example.com]
+//	delivery:
+//	  attempts: 5
+//	  backoff: 2s
+//	  dedupWindow: 24h
+type Config struct {
+	// Networks are the Networks to watch. It may be empty when the Networks
+	// come from a fleet registry instead.
+	Networks []common.Address `yaml:"networks"`
+	Rules    []*Rule          `yaml:"rules"`
+	Sinks    []Sink           `yaml:"sinks"`
+	Delivery Delivery         `yaml:"delivery"`
+}
+
+// Sink configures a Notifier.
+type Sink struct {
+	// Type is webhook, slack or email.
+	Type    string            `yaml:"type"`
+	URL     string            `yaml:"url"`
+	Headers map[string]string `yaml:"headers"`
+
+	SMTP     string `yaml:"smtp"`
+	Username string `yaml:"username"`
+	// PasswordEnv names the environment variable holding the SMTP password.
+	PasswordEnv string   `yaml:"passwordEnv"`
+	From        string   `yaml:"from"`
+	To          []string `yaml:"to"`
+}
+
+// Delivery configures the Dispatcher. Zero values keep the defaults.
+type Delivery struct {
+	Attempts    int           `yaml:"attempts"`
+	Backoff     time.Duration `yaml:"backoff"`
+	DedupWindow time.Duration `yaml:"dedupWindow"`
+}
+
+// LoadConfig reads and validates a configuration file.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	var config Config
+	if err := decoder.Decode(&config); err != nil {
+		return nil, fmt.Errorf("alert: %s: %w", path, err)
+	}
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("%w (in %s)", err, path)
+	}
+	return &config, nil
+}
+
+// Validate checks the configuration and compiles its rules.
+func (c *Config) Validate() error {
+	if len(c.Rules) == 0 {
+		return errors.New("alert: no rules")
+	}
+	names := make(map[string]bool)
+	for _, rule := range c.Rules {
+		if err := rule.Compile(); err != nil {
+			return err
+		}
+		if names[rule.Name] {
+			return fmt.Errorf("alert: duplicate rule %s", rule.Name)
+		}
+		names[rule.Name] = true
+	}
+	if len(c.Sinks) == 0 {
+		return errors.New("alert: no sinks")
+	}
+	_, err := c.Notifiers()
+	return err
+}
+
+// Notifiers builds a Notifier for every sink.
+func (c *Config) Notifiers() ([]Notifier, error) {
+	notifiers := make([]Notifier, 0, len(c.Sinks))
+	for i, sink := range c.Sinks {
+		switch sink.Type {
+		case "webhook":
+			if sink.URL == "" {
+				return nil, fmt.Errorf("alert: sink %d: webhook without url", i)
+			}
+			notifiers = append(notifiers, &Webhook{URL: sink.URL, Headers: sink.Headers})
+		case "slack":
+			if sink.URL == "" {
+				return nil, fmt.Errorf("alert: sink %d: slack without url", i)
+			}
+			notifiers = append(notifiers, &Slack{URL: sink.URL})
+		case "email":
+			if sink.SMTP == "" || sink.From == "" || len(sink.To) == 0 {
+				return nil, fmt.Errorf("alert: sink %d: email needs smtp, from and to", i)
+			}
+			email := &Email{Addr: sink.SMTP, Username: sink.Username, From: sink.From, To: sink.To}
+			if sink.PasswordEnv != "" {
+				email.Password = os.Getenv(sink.PasswordEnv)
+			}
+			notifiers = append(notifiers, email)
+		default:
+			return nil, fmt.Errorf("alert: sink %d: unknown type %q", i, sink.Type)
+		}
+	}
+	return notifiers, nil
+}
+
+// Dispatcher builds a Dispatcher for the sinks and delivery settings.
+func (c *Config) Dispatcher() (*Dispatcher, error) {
+	notifiers, err := c.Notifiers()
+	if err != nil {
+		return nil, err
+	}
+	dispatcher := NewDispatcher(notifiers...)
+	if c.Delivery.Attempts > 0 {
+		dispatcher.Attempts = c.Delivery.Attempts
+	}
+	if c.Delivery.Backoff > 0 {
+		dispatcher.Backoff = c.Delivery.Backoff
+	}
+	if c.Delivery.DedupWindow > 0 {
+		dispatcher.DedupWindow = c.Delivery.DedupWindow
+	}
+	return dispatcher, nil
+}
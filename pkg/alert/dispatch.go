@@ -0,0 +1,147 @@
+package alert
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// Dispatcher delivers alerts to every notifier, retrying failed deliveries and
+// suppressing alerts it has already delivered.
+type Dispatcher struct {
+	notifiers []Notifier
+
+	// Attempts is the maximum number of delivery attempts per notifier.
+	Attempts int
+	// Backoff is the wait before the first retry. It doubles on each retry.
+	Backoff time.Duration
+	// DedupWindow is how long an alert key is remembered.
+	DedupWindow time.Duration
+	// Logger receives delivery failures.
+	Logger *slog.Logger
+
+	mu   sync.Mutex
+	seen map[string]time.Time
+}
+
+// NewDispatcher creates a Dispatcher for the notifiers with default retry and
+// deduplication settings.
+func NewDispatcher(notifiers ...Notifier) *Dispatcher {
+	return &Dispatcher{
+		notifiers:   notifiers,
+		Attempts:    5,
+		Backoff:     2 * time.Second,
+		DedupWindow: 24 * time.Hour,
+		Logger:      slog.Default(),
+		seen:        make(map[string]time.Time),
+	}
+}
+
+// Dispatch delivers alert to every notifier unless an alert with the same key
+// was dispatched within DedupWindow. It returns the failures of the notifiers
+// that exhausted their attempts.
+func (d *Dispatcher) Dispatch(ctx context.Context, alert Alert) error {
+	if !d.claim(alert.Key()) {
+		return nil
+	}
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+	for _, notifier := range d.notifiers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := d.deliver(ctx, notifier, alert); err != nil {
+				d.Logger.Error("alert delivery failed", "notifier", notifier.Name(), "rule", alert.Rule, "tx", alert.Event.TxHash, "err", err)
+				mu.Lock()
+				errs = append(errs, fmt.Errorf("%s: %w", notifier.Name(), err))
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	if len(errs) == len(d.notifiers) && len(errs) > 0 {
+		// Nothing was delivered, so a replay of the event may try again.
+		d.mu.Lock()
+		delete(d.seen, alert.Key())
+		d.mu.Unlock()
+	}
+	return errors.Join(errs...)
+}
+
+// claim records key and reports whether it was not seen within DedupWindow.
+func (d *Dispatcher) claim(key string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	now := time.Now()
+	for k, at := range d.seen {
+		if now.Sub(at) > d.DedupWindow {
+			delete(d.seen, k)
+		}
+	}
+	if _, ok := d.seen[key]; ok {
+		return false
+	}
+	d.seen[key] = now
+	return true
+}
+
+func (d *Dispatcher) deliver(ctx context.Context, notifier Notifier, alert Alert) error {
+	backoff := d.Backoff
+	var err error
+	for attempt := 1; ; attempt++ {
+		if err = notifier.Notify(ctx, alert); err == nil || IsPermanent(err) || attempt >= d.Attempts {
+			return err
+		}
+		d.Logger.Warn("alert delivery failed, retrying", "notifier", notifier.Name(), "attempt", attempt, "err", err)
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		backoff *= 2
+	}
+}
+
+// Service evaluates rules against the events of a Watcher and dispatches the
+// resulting alerts.
+type Service struct {
+	Watcher    *Watcher
+	Rules      []*Rule
+	Dispatcher *Dispatcher
+}
+
+// Run processes events until ctx is done or the Watcher fails.
+func (s *Service) Run(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	events := make(chan Event, 64)
+	watchErr := make(chan error, 1)
+	go func() {
+		watchErr <- s.Watcher.Run(ctx, events)
+	}()
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	for {
+		select {
+		case err := <-watchErr:
+			return err
+		case event := <-events:
+			for _, alert := range Evaluate(s.Rules, event) {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					// Failures are logged by the dispatcher.
+					s.Dispatcher.Dispatch(ctx, alert)
+				}()
+			}
+		}
+	}
+}
@@ -0,0 +1,202 @@
+// Package alert evaluates rules against live Network events and delivers the
+// matches to webhooks, Slack and email.
+//
+// Events are read with an events.EventStream per Network: the logs from the
+// start block up to the head first, then new ones through a subscription or,
+// on endpoints without eth_subscribe, by polling. Interrupted streams resume
+// after the last delivered event; alerts replayed after a reorg are suppressed
+// by the dispatcher's deduplication.
+package alert
+
+import (
+	"math/big"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	networkcontracts "github.com/symbioticfi/network/bindings/go-go-ethereum"
+	"github.com/symbioticfi/network/pkg/roles"
+	"github.com/symbioticfi/network/pkg/timelock"
+)
+
+// Names of the events rules can match.
+const (
+	MinDelayChange   = "MinDelayChange"
+	NameSet          = "NameSet"
+	MetadataURISet   = "MetadataURISet"
+	CallScheduled    = "CallScheduled"
+	CallExecuted     = "CallExecuted"
+	Cancelled        = "Cancelled"
+	RoleGranted      = "RoleGranted"
+	RoleRevoked      = "RoleRevoked"
+	RoleAdminChanged = "RoleAdminChanged"
+)
+
+// EventNames lists every event a rule can match.
+var EventNames = []string{
+	MinDelayChange,
+	NameSet,
+	MetadataURISet,
+	CallScheduled,
+	CallExecuted,
+	Cancelled,
+	RoleGranted,
+	RoleRevoked,
+	RoleAdminChanged,
+}
+
+// Event is a Network event in the form rules are evaluated against.
+type Event struct {
+	Network     common.Address `json:"network"`
+	Name        string         `json:"name"`
+	BlockNumber uint64         `json:"blockNumber"`
+	TxHash      common.Hash    `json:"txHash"`
+	LogIndex    uint           `json:"logIndex"`
+	// Sender is the sender of the transaction that emitted the event.
+	Sender common.Address `json:"sender"`
+
+	// OperationID is set for CallScheduled, CallExecuted and Cancelled.
+	OperationID *common.Hash `json:"operationId,omitempty"`
+	// Target and Selector are set for calls and per-selector delay changes. A
+	// zero target in a MinDelayChange applies to every target.
+	Target   *common.Address `json:"target,omitempty"`
+	Selector hexutil.Bytes   `json:"selector,omitempty"`
+	// OldDelay and NewDelay are set for MinDelayChange, Delay for
+	// CallScheduled.
+	OldDelay *big.Int `json:"oldDelay,omitempty"`
+	NewDelay *big.Int `json:"newDelay,omitempty"`
+	Delay    *big.Int `json:"delay,omitempty"`
+	// DelayLowered is set when a MinDelayChange shortens the delay of its
+	// target and selector. Like getMinDelay, the delay is the one of the
+	// entry when enabled, else the one of the zero-target entry when enabled,
+	// else the global delay.
+	DelayLowered bool `json:"delayLowered,omitempty"`
+
+	// Details holds the remaining event fields in readable form.
+	Details map[string]string `json:"details,omitempty"`
+
+	blockHash common.Hash
+	txIndex   uint
+}
+
+func newEvent(network common.Address, name string, log types.Log) Event {
+	return Event{
+		Network:     network,
+		Name:        name,
+		BlockNumber: log.BlockNumber,
+		TxHash:      log.TxHash,
+		LogIndex:    log.Index,
+		Details:     make(map[string]string),
+		blockHash:   log.BlockHash,
+		txIndex:     log.TxIndex,
+	}
+}
+
+// fromMinDelayChange converts a per-selector delay change. fallback is the
+// delay that applies when the entry is disabled; when it is unknown, a change
+// that enables or disables the entry is reported as lowering the delay.
+func fromMinDelayChange(network common.Address, e *networkcontracts.INetworkMinDelayChange, fallback *big.Int) Event {
+	event := newEvent(network, MinDelayChange, e.Raw)
+	target := e.Target
+	event.Target = &target
+	event.Selector = common.CopyBytes(e.Selector[:])
+	event.OldDelay, event.NewDelay = e.OldDelay, e.NewDelay
+	event.DelayLowered = delayLowered(e, fallback)
+	event.Details["oldEnabled"] = strconv.FormatBool(e.OldEnabledStatus)
+	event.Details["newEnabled"] = strconv.FormatBool(e.NewEnabledStatus)
+	return event
+}
+
+// delayLowered reports whether e shortens the effective delay of its target
+// and selector.
+func delayLowered(e *networkcontracts.INetworkMinDelayChange, fallback *big.Int) bool {
+	previous, next := fallback, fallback
+	if e.OldEnabledStatus {
+		previous = e.OldDelay
+	}
+	if e.NewEnabledStatus {
+		next = e.NewDelay
+	}
+	if previous == nil || next == nil {
+		return previous != next
+	}
+	return next.Cmp(previous) < 0
+}
+
+func fromGlobalMinDelayChange(network common.Address, e *networkcontracts.TimelockControllerUpgradeableMinDelayChange) Event {
+	event := newEvent(network, MinDelayChange, e.Raw)
+	event.OldDelay, event.NewDelay = e.OldDuration, e.NewDuration
+	event.DelayLowered = e.NewDuration.Cmp(e.OldDuration) < 0
+	event.Details["scope"] = "global"
+	return event
+}
+
+func fromNameSet(network common.Address, e *networkcontracts.INetworkNameSet) Event {
+	event := newEvent(network, NameSet, e.Raw)
+	event.Details["name"] = e.Name
+	return event
+}
+
+func fromMetadataURISet(network common.Address, e *networkcontracts.INetworkMetadataURISet) Event {
+	event := newEvent(network, MetadataURISet, e.Raw)
+	event.Details["metadataURI"] = e.MetadataURI
+	return event
+}
+
+func fromCallScheduled(network common.Address, e *networkcontracts.TimelockControllerUpgradeableCallScheduled) Event {
+	event := newEvent(network, CallScheduled, e.Raw)
+	event.setCall(e.Id, e.Index, e.Target, e.Value, e.Data)
+	event.Delay = e.Delay
+	event.Details["predecessor"] = common.Hash(e.Predecessor).Hex()
+	return event
+}
+
+func fromCallExecuted(network common.Address, e *networkcontracts.TimelockControllerUpgradeableCallExecuted) Event {
+	event := newEvent(network, CallExecuted, e.Raw)
+	event.setCall(e.Id, e.Index, e.Target, e.Value, e.Data)
+	return event
+}
+
+func fromCancelled(network common.Address, e *networkcontracts.TimelockControllerUpgradeableCancelled) Event {
+	event := newEvent(network, Cancelled, e.Raw)
+	id := common.Hash(e.Id)
+	event.OperationID = &id
+	return event
+}
+
+func fromRoleGranted(network common.Address, e *networkcontracts.TimelockControllerUpgradeableRoleGranted) Event {
+	event := newEvent(network, RoleGranted, e.Raw)
+	event.Details["role"] = roles.Name(common.Hash(e.Role))
+	event.Details["account"] = e.Account.Hex()
+	event.Details["roleSender"] = e.Sender.Hex()
+	return event
+}
+
+func fromRoleRevoked(network common.Address, e *networkcontracts.TimelockControllerUpgradeableRoleRevoked) Event {
+	event := newEvent(network, RoleRevoked, e.Raw)
+	event.Details["role"] = roles.Name(common.Hash(e.Role))
+	event.Details["account"] = e.Account.Hex()
+	event.Details["roleSender"] = e.Sender.Hex()
+	return event
+}
+
+func fromRoleAdminChanged(network common.Address, e *networkcontracts.TimelockControllerUpgradeableRoleAdminChanged) Event {
+	event := newEvent(network, RoleAdminChanged, e.Raw)
+	event.Details["role"] = roles.Name(common.Hash(e.Role))
+	event.Details["previousAdminRole"] = roles.Name(common.Hash(e.PreviousAdminRole))
+	event.Details["newAdminRole"] = roles.Name(common.Hash(e.NewAdminRole))
+	return event
+}
+
+func (e *Event) setCall(id [32]byte, index *big.Int, target common.Address, value *big.Int, data []byte) {
+	operationID := common.Hash(id)
+	selector := timelock.Selector(data)
+	e.OperationID = &operationID
+	e.Target = &target
+	e.Selector = common.CopyBytes(selector[:])
+	e.Details["index"] = index.String()
+	e.Details["value"] = value.String()
+	e.Details["data"] = hexutil.Encode(data)
+}
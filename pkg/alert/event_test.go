@@ -0,0 +1,60 @@
+package alert
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	networkcontracts "github.com/symbioticfi/network/bindings/go-go-ethereum"
+)
+
+func TestFromMinDelayChange(t *testing.T) {
+	tests := []struct {
+		name                   string
+		oldEnabled, newEnabled bool
+		oldDelay, newDelay     int64
+		// fallback is the zero-target entry or global delay, -1 when unknown.
+		fallback int64
+		want     bool
+	}{
+		{name: "shortened", oldEnabled: true, newEnabled: true, oldDelay: 100, newDelay: 50, fallback: 10, want: true},
+		{name: "lengthened", oldEnabled: true, newEnabled: true, oldDelay: 100, newDelay: 200, fallback: 10},
+		{name: "disabled onto a shorter fallback", oldEnabled: true, oldDelay: 100, fallback: 10, want: true},
+		{name: "disabled onto a longer fallback", oldEnabled: true, oldDelay: 100, fallback: 300},
+		{name: "enabled below the fallback", newEnabled: true, newDelay: 50, fallback: 100, want: true},
+		{name: "enabled above the fallback", newEnabled: true, newDelay: 200, fallback: 100},
+		// A disabled entry keeps a stale delay that does not apply.
+		{name: "enabled above a stale delay", oldDelay: 500, newEnabled: true, newDelay: 200, fallback: 100},
+		{name: "enabled below a stale delay", oldDelay: 50, newEnabled: true, newDelay: 80, fallback: 100, want: true},
+		{name: "stays disabled", oldDelay: 500, newDelay: 0, fallback: 100},
+		{name: "unknown fallback, shortened", oldEnabled: true, newEnabled: true, oldDelay: 100, newDelay: 50, fallback: -1, want: true},
+		{name: "unknown fallback, lengthened", oldEnabled: true, newEnabled: true, oldDelay: 100, newDelay: 200, fallback: -1},
+		{name: "unknown fallback, disabled", oldEnabled: true, oldDelay: 100, fallback: -1, want: true},
+		{name: "unknown fallback, stays disabled", fallback: -1},
+	}
+	network := common.HexToAddress("0x7e70000000000000000000000000000000000000")
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := &networkcontracts.INetworkMinDelayChange{
+				Target:           common.HexToAddress("0xad00000000000000000000000000000000000000"),
+				Selector:         [4]byte{0x23, 0xf7, 0x52, 0xd5},
+				OldEnabledStatus: tt.oldEnabled,
+				OldDelay:         big.NewInt(tt.oldDelay),
+				NewEnabledStatus: tt.newEnabled,
+				NewDelay:         big.NewInt(tt.newDelay),
+			}
+			var fallback *big.Int
+			if tt.fallback >= 0 {
+				fallback = big.NewInt(tt.fallback)
+			}
+			event := fromMinDelayChange(network, e, fallback)
+			if event.DelayLowered != tt.want {
+				t.Errorf("DelayLowered = %v, want %v", event.DelayLowered, tt.want)
+			}
+			if event.Name != MinDelayChange || *event.Target != e.Target || common.Bytes2Hex(event.Selector) != "23f752d5" {
+				t.Errorf("event = %+v", event)
+			}
+		})
+	}
+}
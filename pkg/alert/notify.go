@@ -0,0 +1,160 @@
+package alert
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// Notifier delivers alerts to a single destination.
+type Notifier interface {
+	// Name identifies the destination in logs.
+	Name() string
+	// Notify delivers the alert. Errors wrapped with Permanent are not retried.
+	Notify(ctx context.Context, alert Alert) error
+}
+
+// permanentError marks a delivery failure that retrying cannot fix.
+type permanentError struct{ err error }
+
+func (e permanentError) Error() string { return e.err.Error() }
+func (e permanentError) Unwrap() error { return e.err }
+
+// Permanent marks err as not worth retrying.
+func Permanent(err error) error {
+	return permanentError{err}
+}
+
+// IsPermanent reports whether err was marked with Permanent.
+func IsPermanent(err error) bool {
+	var permanent permanentError
+	return errors.As(err, &permanent)
+}
+
+// Webhook posts every alert as JSON to a URL.
+type Webhook struct {
+	URL     string
+	Headers map[string]string
+	Client  *http.Client
+}
+
+// Name implements Notifier.
+func (h *Webhook) Name() string {
+	return "webhook " + h.URL
+}
+
+// Notify implements Notifier.
+func (h *Webhook) Notify(ctx context.Context, alert Alert) error {
+	return postJSON(ctx, h.Client, h.URL, h.Headers, alert)
+}
+
+// Slack posts alerts to a Slack incoming webhook, or any service accepting
+// the same payload.
+type Slack struct {
+	URL    string
+	Client *http.Client
+}
+
+// Name implements Notifier.
+func (s *Slack) Name() string {
+	return "slack"
+}
+
+// Notify implements Notifier.
+func (s *Slack) Notify(ctx context.Context, alert Alert) error {
+	var text strings.Builder
+	fmt.Fprintf(&text, "*%s*\n", alert.Title())
+	if alert.Description != "" {
+		fmt.Fprintf(&text, "%s\n", alert.Description)
+	}
+	text.WriteString("```\n")
+	alert.writeDetails(&text)
+	text.WriteString("```")
+	return postJSON(ctx, s.Client, s.URL, nil, map[string]string{"text": text.String()})
+}
+
+// Email sends alerts through an SMTP server.
+type Email struct {
+	// Addr is the host:port of the SMTP server.
+	Addr     string
+	Username string
+	Password string
+	From     string
+	To       []string
+}
+
+// Name implements Notifier.
+func (m *Email) Name() string {
+	return "email " + strings.Join(m.To, ",")
+}
+
+// Notify implements Notifier.
+func (m *Email) Notify(ctx context.Context, alert Alert) error {
+	host, _, err := net.SplitHostPort(m.Addr)
+	if err != nil {
+		return Permanent(fmt.Errorf("alert: smtp address: %w", err))
+	}
+	var auth smtp.Auth
+	if m.Username != "" {
+		auth = smtp.PlainAuth("", m.Username, m.Password, host)
+	}
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\nTo: %s\r\nSubject: %s\r\nDate: %s\r\nContent-Type: text/plain; charset=utf-8\r\n\r\n",
+		m.From, strings.Join(m.To, ", "), alert.Title(), time.Now().UTC().Format(time.RFC1123Z))
+	alert.WriteText(&msg)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- smtp.SendMail(m.Addr, auth, m.From, m.To, bytes.ReplaceAll(msg.Bytes(), []byte("\n"), []byte("\r\n")))
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			return fmt.Errorf("alert: send email: %w", err)
+		}
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func postJSON(ctx context.Context, client *http.Client, url string, headers map[string]string, payload any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return Permanent(err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return Permanent(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("alert: post %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+	if resp.StatusCode/100 == 2 {
+		return nil
+	}
+	err = fmt.Errorf("alert: post %s: %s", url, resp.Status)
+	// Rate limits and server errors are transient; other client errors are not.
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500 {
+		return err
+	}
+	return Permanent(err)
+}
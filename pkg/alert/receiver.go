@@ -0,0 +1,63 @@
+package alert
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Received is a request captured by a Receiver.
+type Received struct {
+	Time time.Time       `json:"time"`
+	Path string          `json:"path"`
+	Body json.RawMessage `json:"body"`
+}
+
+// Receiver is a local stand-in for webhook and Slack endpoints. It accepts
+// any POST, records its JSON body and optionally prints it.
+type Receiver struct {
+	// Output receives each body, indented. It may be nil.
+	Output io.Writer
+
+	mu       sync.Mutex
+	received []Received
+}
+
+// ServeHTTP implements http.Handler.
+func (r *Receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		http.Error(w, "only POST is accepted", http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(req.Body, 1<<20))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if !json.Valid(body) {
+		http.Error(w, "body is not JSON", http.StatusBadRequest)
+		return
+	}
+	received := Received{Time: time.Now().UTC(), Path: req.URL.Path, Body: body}
+
+	r.mu.Lock()
+	r.received = append(r.received, received)
+	if r.Output != nil {
+		var indented bytes.Buffer
+		json.Indent(&indented, body, "", "  ")
+		fmt.Fprintf(r.Output, "%s POST %s\n%s\n\n", received.Time.Format(time.RFC3339), received.Path, indented.Bytes())
+	}
+	r.mu.Unlock()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Received returns the requests captured so far.
+func (r *Receiver) Received() []Received {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Received(nil), r.received...)
+}
@@ -0,0 +1,180 @@
+package alert
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/symbioticfi/network/pkg/timelock"
+)
+
+// Severity levels attached to alerts.
+const (
+	SeverityInfo     = "info"
+	SeverityWarning  = "warning"
+	SeverityCritical = "critical"
+)
+
+// Rule selects events to alert on. Empty criteria match everything; non-empty
+// ones must all match.
+//
+// For example, "any operation targeting a proxy admin" is a rule on
+// CallScheduled with the proxy admin in Targets, "delay lowered on
+// setMaxNetworkLimit" is a rule on MinDelayChange with the selector in
+// Selectors and DelayLowered set, and "operation scheduled by an unexpected
+// sender" is a rule on CallScheduled listing the expected proposers in
+// AllowedSenders.
+type Rule struct {
+	Name        string `yaml:"name" json:"name"`
+	Description string `yaml:"description" json:"description,omitempty"`
+	// Severity is info, warning or critical. It defaults to warning.
+	Severity string   `yaml:"severity" json:"severity"`
+	Events   []string `yaml:"events" json:"events,omitempty"`
+
+	Networks []common.Address `yaml:"networks" json:"networks,omitempty"`
+	Targets  []common.Address `yaml:"targets" json:"targets,omitempty"`
+	// Selectors are given as 4-byte hex or as function signatures.
+	Selectors []string `yaml:"selectors" json:"selectors,omitempty"`
+	// AllowedSenders makes the rule match only transactions sent by any other
+	// account.
+	AllowedSenders []common.Address `yaml:"allowedSenders" json:"allowedSenders,omitempty"`
+	// DelayLowered makes the rule match only MinDelayChange events that
+	// shorten a delay.
+	DelayLowered bool `yaml:"delayLowered" json:"delayLowered,omitempty"`
+
+	selectors [][4]byte
+}
+
+// Compile validates the rule and parses its selectors. It must be called
+// before Match.
+func (r *Rule) Compile() error {
+	if r.Name == "" {
+		return errors.New("alert: rule without a name")
+	}
+	switch r.Severity {
+	case "":
+		r.Severity = SeverityWarning
+	case SeverityInfo, SeverityWarning, SeverityCritical:
+	default:
+		return fmt.Errorf("alert: rule %s: unknown severity %q", r.Name, r.Severity)
+	}
+	for _, name := range r.Events {
+		if !slices.Contains(EventNames, name) {
+			return fmt.Errorf("alert: rule %s: unknown event %q, expected one of %s", r.Name, name, strings.Join(EventNames, ", "))
+		}
+	}
+	r.selectors = r.selectors[:0]
+	for _, s := range r.Selectors {
+		selector, err := timelock.ParseSelector(s)
+		if err != nil {
+			return fmt.Errorf("alert: rule %s: %w", r.Name, err)
+		}
+		r.selectors = append(r.selectors, selector)
+	}
+	return nil
+}
+
+// Match reports whether event satisfies every criterion of the rule.
+func (r *Rule) Match(event Event) bool {
+	if len(r.Events) > 0 && !slices.Contains(r.Events, event.Name) {
+		return false
+	}
+	if len(r.Networks) > 0 && !slices.Contains(r.Networks, event.Network) {
+		return false
+	}
+	if len(r.Targets) > 0 && (event.Target == nil || !slices.Contains(r.Targets, *event.Target)) {
+		return false
+	}
+	if len(r.selectors) > 0 && !slices.ContainsFunc(r.selectors, func(selector [4]byte) bool {
+		return bytes.Equal(event.Selector, selector[:])
+	}) {
+		return false
+	}
+	if len(r.AllowedSenders) > 0 && slices.Contains(r.AllowedSenders, event.Sender) {
+		return false
+	}
+	if r.DelayLowered && !event.DelayLowered {
+		return false
+	}
+	return true
+}
+
+// Alert is a rule match.
+type Alert struct {
+	Rule        string `json:"rule"`
+	Severity    string `json:"severity"`
+	Description string `json:"description,omitempty"`
+	Event       Event  `json:"event"`
+}
+
+// Evaluate returns an alert for every rule event matches.
+func Evaluate(rules []*Rule, event Event) []Alert {
+	var alerts []Alert
+	for _, rule := range rules {
+		if rule.Match(event) {
+			alerts = append(alerts, Alert{
+				Rule:        rule.Name,
+				Severity:    rule.Severity,
+				Description: rule.Description,
+				Event:       event,
+			})
+		}
+	}
+	return alerts
+}
+
+// Key identifies the alert for deduplication: a rule fires at most once per
+// log.
+func (a Alert) Key() string {
+	return fmt.Sprintf("%s/%s/%s/%d", a.Rule, a.Event.Network, a.Event.TxHash, a.Event.LogIndex)
+}
+
+// Title is a one-line summary of the alert.
+func (a Alert) Title() string {
+	return fmt.Sprintf("[%s] %s: %s on Network %s", strings.ToUpper(a.Severity), a.Rule, a.Event.Name, a.Event.Network)
+}
+
+// WriteText renders the alert as plain text.
+func (a Alert) WriteText(w io.Writer) error {
+	fmt.Fprintln(w, a.Title())
+	if a.Description != "" {
+		fmt.Fprintln(w, a.Description)
+	}
+	fmt.Fprintln(w)
+	a.writeDetails(w)
+	return nil
+}
+
+// writeDetails renders the fields of the event, one per line.
+func (a Alert) writeDetails(w io.Writer) {
+	fmt.Fprintf(w, "block: %d\ntx: %s\nsender: %s\n", a.Event.BlockNumber, a.Event.TxHash, a.Event.Sender)
+	if a.Event.OperationID != nil {
+		fmt.Fprintf(w, "operation: %s\n", a.Event.OperationID)
+	}
+	if a.Event.Target != nil {
+		fmt.Fprintf(w, "target: %s\n", a.Event.Target)
+	}
+	if len(a.Event.Selector) > 0 {
+		fmt.Fprintf(w, "selector: %s\n", a.Event.Selector)
+	}
+	if a.Event.Delay != nil {
+		fmt.Fprintf(w, "delay: %s\n", a.Event.Delay)
+	}
+	if a.Event.OldDelay != nil || a.Event.NewDelay != nil {
+		fmt.Fprintf(w, "delay change: %s -> %s\n", a.Event.OldDelay, a.Event.NewDelay)
+	}
+	keys := make([]string, 0, len(a.Event.Details))
+	for key := range a.Event.Details {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		fmt.Fprintf(w, "%s: %s\n", key, a.Event.Details[key])
+	}
+}
@@ -0,0 +1,91 @@
+package alert
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestRuleMatch(t *testing.T) {
+	network := common.HexToAddress("0x7e70000000000000000000000000000000000000")
+	proxyAdmin := common.HexToAddress("0xad00000000000000000000000000000000000000")
+	proposer := common.HexToAddress("0xb0b0000000000000000000000000000000000000")
+	scheduled := Event{
+		Network:  network,
+		Name:     CallScheduled,
+		Sender:   proposer,
+		Target:   &proxyAdmin,
+		Selector: common.FromHex("0x9623609d"),
+		Delay:    big.NewInt(86400),
+	}
+	lowered := Event{
+		Network:      network,
+		Name:         MinDelayChange,
+		Target:       &common.Address{},
+		Selector:     common.FromHex("0x23f752d5"),
+		DelayLowered: true,
+	}
+	tests := []struct {
+		name  string
+		rule  Rule
+		event Event
+		want  bool
+	}{
+		{"empty rule", Rule{}, scheduled, true},
+		{"event", Rule{Events: []string{CallScheduled}}, scheduled, true},
+		{"other event", Rule{Events: []string{CallExecuted, Cancelled}}, scheduled, false},
+		{"network", Rule{Networks: []common.Address{network}}, scheduled, true},
+		{"other network", Rule{Networks: []common.Address{proxyAdmin}}, scheduled, false},
+		{"target", Rule{Targets: []common.Address{proxyAdmin}}, scheduled, true},
+		{"no target", Rule{Targets: []common.Address{proxyAdmin}}, Event{Name: NameSet}, false},
+		{"selector by signature", Rule{Selectors: []string{"upgradeAndCall(address,address,bytes)"}}, scheduled, true},
+		{"selector by hex", Rule{Selectors: []string{"0x23f752d5"}}, scheduled, false},
+		{"allowed sender", Rule{AllowedSenders: []common.Address{proposer}}, scheduled, false},
+		{"unexpected sender", Rule{AllowedSenders: []common.Address{proxyAdmin}}, scheduled, true},
+		{"delay lowered", Rule{Events: []string{MinDelayChange}, DelayLowered: true}, lowered, true},
+		{"delay not lowered", Rule{DelayLowered: true}, Event{Name: MinDelayChange}, false},
+		{"all criteria", Rule{
+			Events:    []string{MinDelayChange},
+			Networks:  []common.Address{network},
+			Targets:   []common.Address{{}},
+			Selectors: []string{"setMaxNetworkLimit(uint96,uint256)"},
+		}, lowered, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.rule.Name = tt.name
+			if err := tt.rule.Compile(); err != nil {
+				t.Fatal(err)
+			}
+			if got := tt.rule.Match(tt.event); got != tt.want {
+				t.Errorf("Match() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRuleCompile(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    Rule
+		wantErr bool
+	}{
+		{"default severity", Rule{Name: "r"}, false},
+		{"no name", Rule{}, true},
+		{"unknown severity", Rule{Name: "r", Severity: "fatal"}, true},
+		{"unknown event", Rule{Name: "r", Events: []string{"Upgraded"}}, true},
+		{"invalid selector", Rule{Name: "r", Selectors: []string{"0x1234"}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.rule.Compile()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Compile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && tt.rule.Severity != SeverityWarning {
+				t.Errorf("Severity = %q, want %q", tt.rule.Severity, SeverityWarning)
+			}
+		})
+	}
+}
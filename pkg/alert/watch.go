@@ -0,0 +1,253 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"sync"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	networkcontracts "github.com/symbioticfi/network/bindings/go-go-ethereum"
+	"github.com/symbioticfi/network/pkg/events"
+	"github.com/symbioticfi/network/pkg/timelock"
+)
+
+// Backend is the part of an Ethereum client used by a Watcher.
+type Backend interface {
+	timelock.Backend
+	BlockNumber(ctx context.Context) (uint64, error)
+}
+
+// Watcher streams the events of a set of Networks.
+type Watcher struct {
+	backend  Backend
+	networks []common.Address
+
+	// Start is the first block to watch from. The events of the blocks
+	// before the head are read first. When nil, only events of new blocks
+	// are delivered.
+	Start *uint64
+	// Logger receives stream interruptions.
+	Logger *slog.Logger
+
+	mu      sync.Mutex
+	senders map[common.Hash]common.Address
+}
+
+// NewWatcher creates a Watcher for the Networks deployed at networks.
+func NewWatcher(backend Backend, networks []common.Address) *Watcher {
+	return &Watcher{
+		backend:  backend,
+		networks: networks,
+		Logger:   slog.Default(),
+		senders:  make(map[common.Hash]common.Address),
+	}
+}
+
+// Run streams every event of every Network from Start and sends them to out
+// until ctx is done or a log cannot be decoded. Interrupted streams resume
+// after the last delivered event.
+func (w *Watcher) Run(ctx context.Context, out chan<- Event) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var start uint64
+	if w.Start != nil {
+		start = *w.Start
+	} else {
+		head, err := w.backend.BlockNumber(ctx)
+		if err != nil {
+			return fmt.Errorf("alert: get head: %w", err)
+		}
+		start = head + 1
+	}
+
+	errs := make(chan error, len(w.networks))
+	var wg sync.WaitGroup
+	for _, network := range w.networks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- w.watch(ctx, network, start, out)
+		}()
+	}
+	err := <-errs
+	cancel()
+	wg.Wait()
+	return err
+}
+
+// topics lists the IDs of the events rules can match.
+func topics() ([]common.Hash, error) {
+	var ids []common.Hash
+	for _, contract := range []struct {
+		metaData *bind.MetaData
+		events   []string
+	}{
+		{networkcontracts.INetworkMetaData, []string{MinDelayChange, NameSet, MetadataURISet}},
+		{networkcontracts.TimelockControllerUpgradeableMetaData, []string{
+			MinDelayChange, CallScheduled, CallExecuted, Cancelled, RoleGranted, RoleRevoked, RoleAdminChanged,
+		}},
+	} {
+		parsed, err := contract.metaData.GetAbi()
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range contract.events {
+			ids = append(ids, parsed.Events[name].ID)
+		}
+	}
+	return ids, nil
+}
+
+// watch streams the events of one Network and converts them. Logs removed by
+// a reorg are dropped; the alerts of the replacing chain are deduplicated by
+// the dispatcher.
+func (w *Watcher) watch(ctx context.Context, network common.Address, start uint64, out chan<- Event) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	ids, err := topics()
+	if err != nil {
+		return err
+	}
+	decoder, err := events.NewDecoder(network)
+	if err != nil {
+		return err
+	}
+	stream := events.New(w.backend, ethereum.FilterQuery{
+		Addresses: []common.Address{network},
+		Topics:    [][]common.Hash{ids},
+	}, decoder.Decode)
+	stream.Start = events.Cursor{BlockNumber: start}
+	stream.Logger = w.Logger.With("network", network)
+
+	in := make(chan events.Event[events.Decoded], 16)
+	done := make(chan error, 1)
+	go func() {
+		done <- stream.Run(ctx, in)
+	}()
+	for {
+		select {
+		case err := <-done:
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return fmt.Errorf("alert: watch %s: %w", network, err)
+		case decoded := <-in:
+			if decoded.Removed {
+				continue
+			}
+			event, ok := w.convert(ctx, network, decoded.Value.Value)
+			if !ok {
+				continue
+			}
+			sender, err := w.sender(ctx, event)
+			if err != nil {
+				w.Logger.Warn("resolve sender", "network", network, "tx", event.TxHash, "err", err)
+			}
+			event.Sender = sender
+			select {
+			case out <- event:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+}
+
+// convert converts a decoded log to an Event. It reports false for logs that
+// are not rule events.
+func (w *Watcher) convert(ctx context.Context, network common.Address, value any) (Event, bool) {
+	switch e := value.(type) {
+	case *networkcontracts.INetworkMinDelayChange:
+		fallback, err := w.fallbackDelay(ctx, network, e)
+		if err != nil {
+			w.Logger.Warn("resolve fallback delay", "network", network, "tx", e.Raw.TxHash, "err", err)
+		}
+		return fromMinDelayChange(network, e, fallback), true
+	case *networkcontracts.TimelockControllerUpgradeableMinDelayChange:
+		return fromGlobalMinDelayChange(network, e), true
+	case *networkcontracts.INetworkNameSet:
+		return fromNameSet(network, e), true
+	case *networkcontracts.INetworkMetadataURISet:
+		return fromMetadataURISet(network, e), true
+	case *networkcontracts.TimelockControllerUpgradeableCallScheduled:
+		return fromCallScheduled(network, e), true
+	case *networkcontracts.TimelockControllerUpgradeableCallExecuted:
+		return fromCallExecuted(network, e), true
+	case *networkcontracts.TimelockControllerUpgradeableCancelled:
+		return fromCancelled(network, e), true
+	case *networkcontracts.TimelockControllerUpgradeableRoleGranted:
+		return fromRoleGranted(network, e), true
+	case *networkcontracts.TimelockControllerUpgradeableRoleRevoked:
+		return fromRoleRevoked(network, e), true
+	case *networkcontracts.TimelockControllerUpgradeableRoleAdminChanged:
+		return fromRoleAdminChanged(network, e), true
+	}
+	return Event{}, false
+}
+
+// fallbackDelay returns the delay that applies to the target and selector of
+// e without its own entry, as of the block before e: the zero-target entry
+// and then the global delay for a target, the global delay for the zero
+// target.
+func (w *Watcher) fallbackDelay(ctx context.Context, network common.Address, e *networkcontracts.INetworkMinDelayChange) (*big.Int, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	if e.Raw.BlockNumber > 0 {
+		opts.BlockNumber = new(big.Int).SetUint64(e.Raw.BlockNumber - 1)
+	}
+	if e.Target == (common.Address{}) {
+		caller, err := networkcontracts.NewTimelockControllerUpgradeableCaller(network, w.backend)
+		if err != nil {
+			return nil, err
+		}
+		return caller.GetMinDelay(opts)
+	}
+	caller, err := networkcontracts.NewINetworkCaller(network, w.backend)
+	if err != nil {
+		return nil, err
+	}
+	networkABI, err := networkcontracts.INetworkMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	// The Network resolves the delay of an updateDelay call as the one of
+	// the updated target and selector, and the zero target as the zero-target
+	// entry and then the global delay.
+	data, err := networkABI.Pack("updateDelay", common.Address{}, e.Selector, false, new(big.Int))
+	if err != nil {
+		return nil, err
+	}
+	return caller.GetMinDelay(opts, network, data)
+}
+
+// sender returns the sender of the transaction that emitted event.
+func (w *Watcher) sender(ctx context.Context, event Event) (common.Address, error) {
+	w.mu.Lock()
+	sender, ok := w.senders[event.TxHash]
+	w.mu.Unlock()
+	if ok {
+		return sender, nil
+	}
+	tx, _, err := w.backend.TransactionByHash(ctx, event.TxHash)
+	if err != nil {
+		return common.Address{}, err
+	}
+	sender, err = w.backend.TransactionSender(ctx, tx, event.blockHash, event.txIndex)
+	if err != nil {
+		return common.Address{}, err
+	}
+	w.mu.Lock()
+	// The cache only needs to cover the events of a few recent transactions.
+	if len(w.senders) >= 1024 {
+		clear(w.senders)
+	}
+	w.senders[event.TxHash] = sender
+	w.mu.Unlock()
+	return sender, nil
+}
@@ -0,0 +1,129 @@
+package alert
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"math/big"
+	"testing"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	networkcontracts "github.com/symbioticfi/network/bindings/go-go-ethereum"
+)
+
+// historyBackend serves a fixed set of logs over HTTP-like polling.
+type historyBackend struct {
+	Backend
+	logs     []types.Log
+	head     uint64
+	fallback *big.Int
+}
+
+func (b *historyBackend) FilterLogs(_ context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	var logs []types.Log
+	for _, log := range b.logs {
+		if log.BlockNumber >= query.FromBlock.Uint64() && log.BlockNumber <= query.ToBlock.Uint64() {
+			logs = append(logs, log)
+		}
+	}
+	return logs, nil
+}
+
+func (b *historyBackend) SubscribeFilterLogs(context.Context, ethereum.FilterQuery, chan<- types.Log) (ethereum.Subscription, error) {
+	return nil, rpc.ErrNotificationsUnsupported
+}
+
+func (b *historyBackend) BlockNumber(context.Context) (uint64, error) {
+	return b.head, nil
+}
+
+func (b *historyBackend) TransactionByHash(context.Context, common.Hash) (*types.Transaction, bool, error) {
+	return nil, false, errors.New("not found")
+}
+
+func (b *historyBackend) CodeAt(context.Context, common.Address, *big.Int) ([]byte, error) {
+	return []byte{1}, nil
+}
+
+func (b *historyBackend) CallContract(_ context.Context, call ethereum.CallMsg, block *big.Int) ([]byte, error) {
+	parsed, err := networkcontracts.INetworkMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Methods["getMinDelay"].Outputs.Pack(b.fallback)
+}
+
+func TestWatcherReplaysFromStart(t *testing.T) {
+	network := common.HexToAddress("0x7e70000000000000000000000000000000000000")
+	parsed, err := networkcontracts.INetworkMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	nameData, err := parsed.Events["NameSet"].Inputs.Pack("Network")
+	if err != nil {
+		t.Fatal(err)
+	}
+	delayData, err := parsed.Events["MinDelayChange"].Inputs.NonIndexed().Pack(true, big.NewInt(100), false, big.NewInt(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	backend := &historyBackend{
+		head:     10,
+		fallback: big.NewInt(10),
+		logs: []types.Log{
+			{Address: network, Topics: []common.Hash{parsed.Events["NameSet"].ID}, Data: nameData, BlockNumber: 2},
+			{Address: network, Topics: []common.Hash{parsed.Events["NameSet"].ID}, Data: nameData, BlockNumber: 5},
+			{
+				Address: network,
+				Topics: []common.Hash{
+					parsed.Events["MinDelayChange"].ID,
+					common.BytesToHash(common.FromHex("0xad00000000000000000000000000000000000000")),
+					common.BytesToHash(common.RightPadBytes(common.FromHex("0x23f752d5"), 32)),
+				},
+				Data:        delayData,
+				BlockNumber: 7,
+				Index:       1,
+			},
+		},
+	}
+
+	watcher := NewWatcher(backend, []common.Address{network})
+	watcher.Logger = slog.New(slog.NewTextHandler(io.Discard, nil))
+	start := uint64(3)
+	watcher.Start = &start
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	out := make(chan Event)
+	done := make(chan error, 1)
+	go func() {
+		done <- watcher.Run(ctx, out)
+	}()
+
+	var got []Event
+	for len(got) < 2 {
+		select {
+		case event := <-out:
+			got = append(got, event)
+		case err := <-done:
+			t.Fatalf("Run() returned %v after %d events", err, len(got))
+		}
+	}
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Errorf("Run() = %v, want context.Canceled", err)
+	}
+
+	if got[0].Name != NameSet || got[0].BlockNumber != 5 {
+		t.Errorf("first event = %s at %d, want NameSet at 5", got[0].Name, got[0].BlockNumber)
+	}
+	if got[1].Name != MinDelayChange || got[1].BlockNumber != 7 || !got[1].DelayLowered {
+		t.Errorf("second event = %s at %d lowered %v, want a lowering MinDelayChange at 7", got[1].Name, got[1].BlockNumber, got[1].DelayLowered)
+	}
+}
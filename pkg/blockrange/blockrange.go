@@ -0,0 +1,32 @@
+// Package blockrange splits block intervals into windows that RPC providers
+// accept for eth_getLogs queries.
+package blockrange
+
+// DefaultSize is the default number of blocks covered by a single log query.
+const DefaultSize = 10_000
+
+// Range is an inclusive interval of block numbers.
+type Range struct {
+	From uint64
+	To   uint64
+}
+
+// Split splits the inclusive interval [from, to] into consecutive ranges of at
+// most size blocks. A zero size falls back to DefaultSize.
+func Split(from, to, size uint64) []Range {
+	if size == 0 {
+		size = DefaultSize
+	}
+	var ranges []Range
+	for start := from; start <= to; start += size {
+		end := start + size - 1
+		if end > to || end < start {
+			end = to
+		}
+		ranges = append(ranges, Range{From: start, To: end})
+		if end == to {
+			break
+		}
+	}
+	return ranges
+}
@@ -0,0 +1,140 @@
+// Package calldata decodes the calls a Network schedules into readable
+// descriptions.
+package calldata
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	networkcontracts "github.com/symbioticfi/network/bindings/go-go-ethereum"
+)
+
+// Decoder resolves calldata against a set of registered ABIs.
+type Decoder struct {
+	methods map[[4]byte]abi.Method
+}
+
+// NewDecoder creates a Decoder for the methods of the given ABIs.
+func NewDecoder(abis ...*abi.ABI) *Decoder {
+	d := &Decoder{methods: make(map[[4]byte]abi.Method)}
+	for _, parsed := range abis {
+		d.Register(parsed)
+	}
+	return d
+}
+
+// Register adds the methods of an ABI. Methods already registered under the
+// same selector are kept.
+func (d *Decoder) Register(parsed *abi.ABI) {
+	for _, method := range parsed.Methods {
+		var selector [4]byte
+		copy(selector[:], method.ID)
+		if _, ok := d.methods[selector]; !ok {
+			d.methods[selector] = method
+		}
+	}
+}
+
+// Decode returns the method called by data and its unpacked arguments.
+func (d *Decoder) Decode(data []byte) (*abi.Method, []interface{}, error) {
+	if len(data) < 4 {
+		return nil, nil, fmt.Errorf("calldata: %d bytes is too short for a selector", len(data))
+	}
+	var selector [4]byte
+	copy(selector[:], data)
+	method, ok := d.methods[selector]
+	if !ok {
+		return nil, nil, fmt.Errorf("calldata: unknown selector %s", hexutil.Encode(selector[:]))
+	}
+	args, err := method.Inputs.Unpack(data[4:])
+	if err != nil {
+		return nil, nil, fmt.Errorf("calldata: unpack %s: %w", method.Sig, err)
+	}
+	return &method, args, nil
+}
+
+// Describe renders data as "method(name: value, ...)". Empty calldata is a
+// native transfer; calldata that cannot be decoded is described by its
+// selector and length.
+func (d *Decoder) Describe(data []byte) string {
+	if len(data) == 0 {
+		return "native transfer"
+	}
+	method, args, err := d.Decode(data)
+	if err != nil {
+		if len(data) < 4 {
+			return fmt.Sprintf("invalid calldata %s", hexutil.Encode(data))
+		}
+		return fmt.Sprintf("unknown %s (%d bytes)", hexutil.Encode(data[:4]), len(data))
+	}
+	parts := make([]string, len(args))
+	for i, arg := range args {
+		name := method.Inputs[i].Name
+		if name == "" {
+			name = "arg" + strconv.Itoa(i)
+		}
+		parts[i] = name + ": " + Format(arg)
+	}
+	return method.RawName + "(" + strings.Join(parts, ", ") + ")"
+}
+
+// Format renders a decoded ABI value.
+func Format(v interface{}) string {
+	switch v := v.(type) {
+	case common.Address:
+		return v.Hex()
+	case *big.Int:
+		return v.String()
+	case []byte:
+		return hexutil.Encode(v)
+	case string:
+		return strconv.Quote(v)
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Array:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			b := make([]byte, rv.Len())
+			reflect.Copy(reflect.ValueOf(b), rv)
+			return hexutil.Encode(b)
+		}
+		fallthrough
+	case reflect.Slice:
+		items := make([]string, rv.Len())
+		for i := range items {
+			items[i] = Format(rv.Index(i).Interface())
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	case reflect.Struct:
+		fields := make([]string, rv.NumField())
+		for i := range fields {
+			fields[i] = rv.Type().Field(i).Name + ": " + Format(rv.Field(i).Interface())
+		}
+		return "{" + strings.Join(fields, ", ") + "}"
+	}
+	return fmt.Sprint(v)
+}
+
+// Default decodes the calls of the Network itself, including the inherited
+// TimelockController and AccessControl methods.
+var Default = NewDecoder(
+	mustABI(networkcontracts.INetworkMetaData),
+	mustABI(networkcontracts.TimelockControllerUpgradeableMetaData),
+	mustABI(networkcontracts.ISetMaxNetworkLimitHookMetaData),
+)
+
+func mustABI(metadata *bind.MetaData) *abi.ABI {
+	parsed, err := metadata.GetAbi()
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
@@ -0,0 +1,28 @@
+package core
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+
+	networkcontracts "github.com/symbioticfi/network/bindings/go-go-ethereum"
+)
+
+// The ABIs of the core contracts, parsed from the generated bindings for
+// multicall batches and calldata.
+var (
+	VaultABI         = mustParseABI(networkcontracts.IVaultMetaData)
+	BaseDelegatorABI = mustParseABI(networkcontracts.IBaseDelegatorMetaData)
+	// NetworkRestakeDelegatorABI adds networkLimit and SetNetworkLimit, which
+	// the NetworkRestake and FullRestake delegators share.
+	NetworkRestakeDelegatorABI = mustParseABI(networkcontracts.INetworkRestakeDelegatorMetaData)
+	VetoSlasherABI             = mustParseABI(networkcontracts.IVetoSlasherMetaData)
+	MiddlewareServiceABI       = mustParseABI(networkcontracts.INetworkMiddlewareServiceMetaData)
+)
+
+func mustParseABI(metaData *bind.MetaData) *abi.ABI {
+	parsed, err := metaData.GetAbi()
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
@@ -0,0 +1,59 @@
+// Package core describes the Symbiotic core contracts the Network tooling
+// reads: the delegator and slasher types their factories register.
+package core
+
+// DelegatorType is the TYPE() of a core delegator.
+type DelegatorType uint64
+
+// The delegator types registered in the core DelegatorFactory.
+const (
+	NetworkRestake DelegatorType = iota
+	FullRestake
+	OperatorSpecific
+	OperatorNetworkSpecific
+)
+
+func (t DelegatorType) String() string {
+	switch t {
+	case NetworkRestake:
+		return "NetworkRestake"
+	case FullRestake:
+		return "FullRestake"
+	case OperatorSpecific:
+		return "OperatorSpecific"
+	case OperatorNetworkSpecific:
+		return "OperatorNetworkSpecific"
+	default:
+		return "Unknown"
+	}
+}
+
+// MarshalText encodes the type by name.
+func (t DelegatorType) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// SlasherType is the TYPE() of a core slasher.
+type SlasherType uint64
+
+// The slasher types registered in the core SlasherFactory.
+const (
+	InstantSlasher SlasherType = iota
+	VetoSlasher
+)
+
+func (t SlasherType) String() string {
+	switch t {
+	case InstantSlasher:
+		return "Slasher"
+	case VetoSlasher:
+		return "VetoSlasher"
+	default:
+		return "Unknown"
+	}
+}
+
+// MarshalText encodes the type by name.
+func (t SlasherType) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
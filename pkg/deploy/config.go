@@ -0,0 +1,273 @@
+// Package deploy reads Network deployment configurations from JSON or YAML
+// and converts them to the initialize arguments of the Go bindings.
+//
+// Params mirrors DeployNetworkBase.DeployNetworkParams:
+//
+//	name: My Network
+//	metadataURI: ""
+//	proposers: ["0x..."]
+//	executors: ["0x..."]
+//	defaultAdminRoleHolder: "0x..."
+//	nameUpdateRoleHolder: "0x..."
+//	metadataURIUpdateRoleHolder: "0x..."
+//	globalMinDelay: 3d
+//	upgradeProxyMinDelay: 14d
+//	setMiddlewareMinDelay: 14d
+//	setMaxNetworkLimitMinDelay: 0
+//	setResolverMinDelay: 0
+//	delays:
+//	  - target: "0x..."
+//	    selector: "updateName(string)"
+//	    delay: 7d
+//	salt: SymNetwork
+package deploy
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/common"
+	"gopkg.in/yaml.v3"
+
+	networkcontracts "github.com/symbioticfi/network/bindings/go-go-ethereum"
+	"github.com/symbioticfi/network/pkg/timelock"
+)
+
+// The selectors DeployNetworkBase sets a delay for.
+var (
+	// UpgradeAndCallSelector is ProxyAdmin.upgradeAndCall.
+	UpgradeAndCallSelector = mustSelector("upgradeAndCall(address,address,bytes)")
+	// SetMiddlewareSelector is INetworkMiddlewareService.setMiddleware.
+	SetMiddlewareSelector = mustSelector("setMiddleware(address)")
+	// SetMaxNetworkLimitSelector is IBaseDelegator.setMaxNetworkLimit.
+	SetMaxNetworkLimitSelector = mustSelector("setMaxNetworkLimit(uint96,uint256)")
+	// SetResolverSelector is IVetoSlasher.setResolver.
+	SetResolverSelector = mustSelector("setResolver(uint96,address,bytes)")
+)
+
+func mustSelector(signature string) Selector {
+	selector, err := timelock.ParseSelector(signature)
+	if err != nil {
+		panic(err)
+	}
+	return selector
+}
+
+// maxSaltLength is the size of the bytes11 salt of the CREATE3 deployment.
+const maxSaltLength = 11
+
+// DelayParams is the delay of calls with Selector to Target. A zero Target
+// applies to any target.
+type DelayParams struct {
+	Target   common.Address `yaml:"target" json:"target"`
+	Selector Selector       `yaml:"selector" json:"selector"`
+	Delay    Duration       `yaml:"delay" json:"delay"`
+}
+
+// Binding converts p to the initialize argument.
+func (p DelayParams) Binding() networkcontracts.INetworkDelayParams {
+	return networkcontracts.INetworkDelayParams{
+		Target:   p.Target,
+		Selector: p.Selector,
+		Delay:    new(big.Int).SetUint64(uint64(p.Delay)),
+	}
+}
+
+// InitParams is the argument of Network.initialize.
+type InitParams struct {
+	Name                        string           `yaml:"name" json:"name"`
+	MetadataURI                 string           `yaml:"metadataURI" json:"metadataURI"`
+	GlobalMinDelay              Duration         `yaml:"globalMinDelay" json:"globalMinDelay"`
+	DelayParams                 []DelayParams    `yaml:"delayParams" json:"delayParams"`
+	Proposers                   []common.Address `yaml:"proposers" json:"proposers"`
+	Executors                   []common.Address `yaml:"executors" json:"executors"`
+	DefaultAdminRoleHolder      common.Address   `yaml:"defaultAdminRoleHolder" json:"defaultAdminRoleHolder"`
+	NameUpdateRoleHolder        common.Address   `yaml:"nameUpdateRoleHolder" json:"nameUpdateRoleHolder"`
+	MetadataURIUpdateRoleHolder common.Address   `yaml:"metadataURIUpdateRoleHolder" json:"metadataURIUpdateRoleHolder"`
+}
+
+// Validate checks that p can initialize a usable Network.
+func (p *InitParams) Validate() error {
+	if p.Name == "" {
+		return errors.New("deploy: name is required")
+	}
+	if len(p.Proposers) == 0 {
+		return errors.New("deploy: at least one proposer is required")
+	}
+	if len(p.Executors) == 0 {
+		return errors.New("deploy: at least one executor is required")
+	}
+	for _, proposer := range p.Proposers {
+		if proposer == (common.Address{}) {
+			return errors.New("deploy: the zero address cannot be a proposer")
+		}
+	}
+	seen := make(map[DelayParams]bool, len(p.DelayParams))
+	for _, d := range p.DelayParams {
+		key := DelayParams{Target: d.Target, Selector: d.Selector}
+		if seen[key] {
+			return fmt.Errorf("deploy: delay for target %s and selector %s is set twice", d.Target, d.Selector)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// Binding validates p and converts it to the initialize argument.
+func (p *InitParams) Binding() (networkcontracts.INetworkNetworkInitParams, error) {
+	if err := p.Validate(); err != nil {
+		return networkcontracts.INetworkNetworkInitParams{}, err
+	}
+	delays := make([]networkcontracts.INetworkDelayParams, len(p.DelayParams))
+	for i, d := range p.DelayParams {
+		delays[i] = d.Binding()
+	}
+	return networkcontracts.INetworkNetworkInitParams{
+		GlobalMinDelay:              new(big.Int).SetUint64(uint64(p.GlobalMinDelay)),
+		DelayParams:                 delays,
+		Proposers:                   p.Proposers,
+		Executors:                   p.Executors,
+		Name:                        p.Name,
+		MetadataURI:                 p.MetadataURI,
+		DefaultAdminRoleHolder:      p.DefaultAdminRoleHolder,
+		NameUpdateRoleHolder:        p.NameUpdateRoleHolder,
+		MetadataURIUpdateRoleHolder: p.MetadataURIUpdateRoleHolder,
+	}, nil
+}
+
+// FromBinding converts an initialize argument, such as one decoded from a
+// deployment transaction. It fails on delays that do not fit a Duration.
+func FromBinding(params networkcontracts.INetworkNetworkInitParams) (*InitParams, error) {
+	globalMinDelay, err := durationOf(params.GlobalMinDelay)
+	if err != nil {
+		return nil, err
+	}
+	p := &InitParams{
+		Name:                        params.Name,
+		MetadataURI:                 params.MetadataURI,
+		GlobalMinDelay:              globalMinDelay,
+		Proposers:                   params.Proposers,
+		Executors:                   params.Executors,
+		DefaultAdminRoleHolder:      params.DefaultAdminRoleHolder,
+		NameUpdateRoleHolder:        params.NameUpdateRoleHolder,
+		MetadataURIUpdateRoleHolder: params.MetadataURIUpdateRoleHolder,
+	}
+	for _, d := range params.DelayParams {
+		delay, err := durationOf(d.Delay)
+		if err != nil {
+			return nil, err
+		}
+		p.DelayParams = append(p.DelayParams, DelayParams{Target: d.Target, Selector: d.Selector, Delay: delay})
+	}
+	return p, nil
+}
+
+func durationOf(delay *big.Int) (Duration, error) {
+	if delay == nil {
+		return 0, nil
+	}
+	if !delay.IsUint64() {
+		return 0, fmt.Errorf("deploy: delay %s does not fit in 64 bits", delay)
+	}
+	return Duration(delay.Uint64()), nil
+}
+
+// Params is a Network deployment, like DeployNetworkBase.DeployNetworkParams.
+// Delays are set after the ones DeployNetworkBase sets and must not repeat
+// them.
+type Params struct {
+	Name                        string           `yaml:"name" json:"name"`
+	MetadataURI                 string           `yaml:"metadataURI" json:"metadataURI"`
+	Proposers                   []common.Address `yaml:"proposers" json:"proposers"`
+	Executors                   []common.Address `yaml:"executors" json:"executors"`
+	DefaultAdminRoleHolder      common.Address   `yaml:"defaultAdminRoleHolder" json:"defaultAdminRoleHolder"`
+	NameUpdateRoleHolder        common.Address   `yaml:"nameUpdateRoleHolder" json:"nameUpdateRoleHolder"`
+	MetadataURIUpdateRoleHolder common.Address   `yaml:"metadataURIUpdateRoleHolder" json:"metadataURIUpdateRoleHolder"`
+	GlobalMinDelay              Duration         `yaml:"globalMinDelay" json:"globalMinDelay"`
+	UpgradeProxyMinDelay        Duration         `yaml:"upgradeProxyMinDelay" json:"upgradeProxyMinDelay"`
+	SetMiddlewareMinDelay       Duration         `yaml:"setMiddlewareMinDelay" json:"setMiddlewareMinDelay"`
+	SetMaxNetworkLimitMinDelay  Duration         `yaml:"setMaxNetworkLimitMinDelay" json:"setMaxNetworkLimitMinDelay"`
+	SetResolverMinDelay         Duration         `yaml:"setResolverMinDelay" json:"setResolverMinDelay"`
+	Delays                      []DelayParams    `yaml:"delays" json:"delays,omitempty"`
+	// Salt is the CREATE3 salt, at most 11 bytes.
+	Salt string `yaml:"salt" json:"salt"`
+}
+
+// Load reads and validates a JSON or YAML deployment file.
+func Load(path string) (*Params, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var params Params
+	if filepath.Ext(path) == ".json" {
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		err = decoder.Decode(&params)
+	} else {
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		err = decoder.Decode(&params)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("deploy: %s: %w", path, err)
+	}
+	if err := params.Validate(); err != nil {
+		return nil, fmt.Errorf("%w (in %s)", err, path)
+	}
+	return &params, nil
+}
+
+// Validate checks the salt and the initialize argument p produces.
+func (p *Params) Validate() error {
+	if len(p.Salt) > maxSaltLength {
+		return fmt.Errorf("deploy: salt %q is longer than %d bytes", p.Salt, maxSaltLength)
+	}
+	// The ProxyAdmin and middleware service addresses are only known at
+	// deployment, so their delays are left out here rather than compared
+	// with Delays for the zero target. Binding checks them again once
+	// InitParams has the real addresses.
+	params := p.InitParams(common.Address{}, common.Address{})
+	params.DelayParams = params.DelayParams[addressedDelays:]
+	return params.Validate()
+}
+
+// addressedDelays is the number of delays InitParams sets on the proxyAdmin
+// and middlewareService targets, which come first.
+const addressedDelays = 2
+
+// InitParams returns the initialize argument of the deployment, with the
+// delays of DeployNetworkBase: upgradeAndCall on proxyAdmin, setMiddleware on
+// middlewareService, and setMaxNetworkLimit and setResolver on any target,
+// followed by Delays.
+func (p *Params) InitParams(proxyAdmin, middlewareService common.Address) *InitParams {
+	delays := []DelayParams{
+		{Target: proxyAdmin, Selector: UpgradeAndCallSelector, Delay: p.UpgradeProxyMinDelay},
+		{Target: middlewareService, Selector: SetMiddlewareSelector, Delay: p.SetMiddlewareMinDelay},
+		{Selector: SetMaxNetworkLimitSelector, Delay: p.SetMaxNetworkLimitMinDelay},
+		{Selector: SetResolverSelector, Delay: p.SetResolverMinDelay},
+	}
+	return &InitParams{
+		Name:                        p.Name,
+		MetadataURI:                 p.MetadataURI,
+		GlobalMinDelay:              p.GlobalMinDelay,
+		DelayParams:                 append(delays, p.Delays...),
+		Proposers:                   p.Proposers,
+		Executors:                   p.Executors,
+		DefaultAdminRoleHolder:      p.DefaultAdminRoleHolder,
+		NameUpdateRoleHolder:        p.NameUpdateRoleHolder,
+		MetadataURIUpdateRoleHolder: p.MetadataURIUpdateRoleHolder,
+	}
+}
+
+// SaltBytes returns the salt as the bytes11 value of the script.
+func (p *Params) SaltBytes() [maxSaltLength]byte {
+	var salt [maxSaltLength]byte
+	copy(salt[:], p.Salt)
+	return salt
+}
@@ -0,0 +1,129 @@
+package deploy
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestParamsValidate(t *testing.T) {
+	proxyAdmin := common.HexToAddress("0xad00000000000000000000000000000000000000")
+	middlewareService := common.HexToAddress("0x5e00000000000000000000000000000000000000")
+	tests := []struct {
+		name    string
+		delays  []DelayParams
+		salt    string
+		wantErr string
+	}{
+		{name: "defaults only"},
+		{
+			name:   "other delays",
+			delays: []DelayParams{{Target: proxyAdmin, Selector: SetMiddlewareSelector}, {Selector: mustSelector("updateName(string)")}},
+		},
+		{
+			name:   "upgradeAndCall on any target",
+			delays: []DelayParams{{Selector: UpgradeAndCallSelector}},
+		},
+		{
+			name:   "setMiddleware on any target",
+			delays: []DelayParams{{Selector: SetMiddlewareSelector}},
+		},
+		{
+			name:    "setMaxNetworkLimit on any target",
+			delays:  []DelayParams{{Selector: SetMaxNetworkLimitSelector}},
+			wantErr: "set twice",
+		},
+		{
+			name:    "setResolver on any target",
+			delays:  []DelayParams{{Selector: SetResolverSelector}},
+			wantErr: "set twice",
+		},
+		{
+			name:    "repeated delay",
+			delays:  []DelayParams{{Target: proxyAdmin, Selector: SetResolverSelector}, {Target: proxyAdmin, Selector: SetResolverSelector}},
+			wantErr: "set twice",
+		},
+		{name: "long salt", salt: "SymbioticNetwork", wantErr: "longer than 11 bytes"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &Params{
+				Name:      "network",
+				Proposers: []common.Address{proxyAdmin},
+				Executors: []common.Address{{}},
+				Delays:    tt.delays,
+				Salt:      tt.salt,
+			}
+			err := p.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Validate() = %v", err)
+				}
+				// The delays must also hold with the deployed addresses.
+				params := p.InitParams(proxyAdmin, middlewareService)
+				if _, err := params.Binding(); err != nil {
+					t.Errorf("Binding() = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() = %v, want an error containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBindingChecksAddressedDelays(t *testing.T) {
+	proxyAdmin := common.HexToAddress("0xad00000000000000000000000000000000000000")
+	p := &Params{
+		Name:      "network",
+		Proposers: []common.Address{proxyAdmin},
+		Executors: []common.Address{{}},
+		Delays:    []DelayParams{{Target: proxyAdmin, Selector: UpgradeAndCallSelector}},
+	}
+	if err := p.Validate(); err != nil {
+		t.Fatalf("Validate() = %v", err)
+	}
+	params := p.InitParams(proxyAdmin, common.HexToAddress("0x5e00000000000000000000000000000000000000"))
+	if _, err := params.Binding(); err == nil || !strings.Contains(err.Error(), "set twice") {
+		t.Errorf("Binding() = %v, want the upgradeAndCall delay set twice", err)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "network.yaml")
+	config := `name: My Network
+metadataURI: ""
+proposers: ["0xad00000000000000000000000000000000000000"]
+executors: ["0x0000000000000000000000000000000000000000"]
+defaultAdminRoleHolder: "0xad00000000000000000000000000000000000000"
+nameUpdateRoleHolder: "0xad00000000000000000000000000000000000000"
+metadataURIUpdateRoleHolder: "0xad00000000000000000000000000000000000000"
+globalMinDelay: 3d
+upgradeProxyMinDelay: 14d
+setMiddlewareMinDelay: 14d
+setMaxNetworkLimitMinDelay: 0
+setResolverMinDelay: 0
+delays:
+  - target: "0x0000000000000000000000000000000000000000"
+    selector: "updateName(string)"
+    delay: 7d
+salt: SymNetwork
+`
+	if err := os.WriteFile(path, []byte(config), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	p, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.GlobalMinDelay != 3*24*60*60 || p.UpgradeProxyMinDelay != 14*24*60*60 || len(p.Delays) != 1 {
+		t.Errorf("Load() = %+v", p)
+	}
+	if p.Delays[0].Selector != mustSelector("updateName(string)") || p.Delays[0].Delay != 7*24*60*60 {
+		t.Errorf("Load() delay = %+v, want updateName(string) after 7d", p.Delays[0])
+	}
+}
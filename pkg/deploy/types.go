@@ -0,0 +1,142 @@
+package deploy
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/symbioticfi/network/pkg/timelock"
+)
+
+// Duration is a delay in seconds. It is written as a number of seconds or as
+// a sequence of amounts with units w, d, h, m and s, such as "14d" or
+// "1d12h", and marshaled in the latter form.
+type Duration uint64
+
+var durationUnits = []struct {
+	suffix  byte
+	seconds uint64
+}{
+	{'w', 7 * 24 * 60 * 60},
+	{'d', 24 * 60 * 60},
+	{'h', 60 * 60},
+	{'m', 60},
+	{'s', 1},
+}
+
+// ParseDuration parses a Duration.
+func ParseDuration(s string) (Duration, error) {
+	s = strings.TrimSpace(s)
+	if seconds, err := strconv.ParseUint(s, 10, 64); err == nil {
+		return Duration(seconds), nil
+	}
+	if s == "" {
+		return 0, fmt.Errorf("deploy: invalid duration %q", s)
+	}
+	var total uint64
+	for rest := s; rest != ""; {
+		i := 0
+		for i < len(rest) && rest[i] >= '0' && rest[i] <= '9' {
+			i++
+		}
+		if i == 0 || i == len(rest) {
+			return 0, fmt.Errorf("deploy: invalid duration %q", s)
+		}
+		amount, err := strconv.ParseUint(rest[:i], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("deploy: invalid duration %q", s)
+		}
+		unit := uint64(0)
+		for _, u := range durationUnits {
+			if rest[i] == u.suffix {
+				unit = u.seconds
+			}
+		}
+		if unit == 0 {
+			return 0, fmt.Errorf("deploy: invalid duration %q: unknown unit %q", s, rest[i])
+		}
+		if amount > (^uint64(0)-total)/unit {
+			return 0, fmt.Errorf("deploy: duration %q overflows", s)
+		}
+		total += amount * unit
+		rest = rest[i+1:]
+	}
+	return Duration(total), nil
+}
+
+// String formats d with the largest units first, such as "1d12h".
+func (d Duration) String() string {
+	if d == 0 {
+		return "0"
+	}
+	var b strings.Builder
+	rest := uint64(d)
+	for _, u := range durationUnits[1:] {
+		if n := rest / u.seconds; n > 0 {
+			fmt.Fprintf(&b, "%d%c", n, u.suffix)
+			rest -= n * u.seconds
+		}
+	}
+	return b.String()
+}
+
+// Std returns d as a time.Duration.
+func (d Duration) Std() time.Duration {
+	return time.Duration(d) * time.Second
+}
+
+// MarshalText encodes d as formatted by String.
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText decodes d with ParseDuration.
+func (d *Duration) UnmarshalText(text []byte) error {
+	parsed, err := ParseDuration(string(text))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// UnmarshalJSON accepts a number of seconds or a string.
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	if bytes.HasPrefix(data, []byte(`"`)) {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		return d.UnmarshalText([]byte(s))
+	}
+	return d.UnmarshalText(data)
+}
+
+// Selector is a function selector. It is written as 4-byte hex or as a
+// function signature, such as "setMaxNetworkLimit(address,uint96,uint256)",
+// and marshaled as hex.
+type Selector [4]byte
+
+// MarshalText encodes s as hex.
+func (s Selector) MarshalText() ([]byte, error) {
+	return []byte(hexutil.Encode(s[:])), nil
+}
+
+// UnmarshalText decodes s with timelock.ParseSelector.
+func (s *Selector) UnmarshalText(text []byte) error {
+	parsed, err := timelock.ParseSelector(string(text))
+	if err != nil {
+		return err
+	}
+	*s = parsed
+	return nil
+}
+
+func (s Selector) String() string {
+	return hexutil.Encode(s[:])
+}
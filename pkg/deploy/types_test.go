@@ -0,0 +1,118 @@
+package deploy
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    Duration
+		wantErr bool
+	}{
+		{input: "0", want: 0},
+		{input: "3600", want: 3600},
+		{input: "14d", want: 14 * 24 * 60 * 60},
+		{input: "1d12h", want: 36 * 60 * 60},
+		{input: " 2w ", want: 14 * 24 * 60 * 60},
+		{input: "1h30m15s", want: 5415},
+		{input: "18446744073709551615s", want: 18446744073709551615},
+		{input: "100000000000000w", wantErr: true},
+		{input: "18446744073709551615s1s", wantErr: true},
+		{input: "18446744073709551616", wantErr: true},
+		{input: "", wantErr: true},
+		{input: "d", wantErr: true},
+		{input: "1d12", wantErr: true},
+		{input: "3y", wantErr: true},
+		{input: "-1d", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseDuration(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseDuration() error = %v, want error %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseDuration() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDurationString(t *testing.T) {
+	tests := []struct {
+		d    Duration
+		want string
+	}{
+		{0, "0"},
+		{59, "59s"},
+		{14 * 24 * 60 * 60, "14d"},
+		{36 * 60 * 60, "1d12h"},
+		{90061, "1d1h1m1s"},
+		{18446744073709551615, "213503982334601d7h15s"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.d.String(); got != tt.want {
+				t.Errorf("String() = %q, want %q", got, tt.want)
+			}
+			parsed, err := ParseDuration(tt.d.String())
+			if err != nil || parsed != tt.d {
+				t.Errorf("ParseDuration(String()) = %d, %v, want %d", parsed, err, tt.d)
+			}
+		})
+	}
+}
+
+func TestDurationJSON(t *testing.T) {
+	var delays []Duration
+	if err := json.Unmarshal([]byte(`[3600, "1h", "1d12h"]`), &delays); err != nil {
+		t.Fatal(err)
+	}
+	if len(delays) != 3 || delays[0] != 3600 || delays[1] != 3600 || delays[2] != 36*60*60 {
+		t.Errorf("json.Unmarshal() = %v, want [3600 3600 129600]", delays)
+	}
+	encoded, err := json.Marshal(delays)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(encoded) != `["1h","1h","1d12h"]` {
+		t.Errorf("json.Marshal() = %s", encoded)
+	}
+}
+
+func TestSelector(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{input: "0xa9059cbb", want: "0xa9059cbb"},
+		{input: "transfer(address,uint256)", want: "0xa9059cbb"},
+		{input: "transfer(address, uint256)", want: "0xa9059cbb"},
+		{input: "a9059cbb", wantErr: true},
+		{input: "0xa9059c", wantErr: true},
+		{input: "0xa9059cbb00", wantErr: true},
+		{input: "transfer(address", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			var s Selector
+			err := s.UnmarshalText([]byte(tt.input))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("UnmarshalText() error = %v, want error %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if s.String() != tt.want {
+				t.Errorf("UnmarshalText() = %s, want %s", s, tt.want)
+			}
+			text, err := s.MarshalText()
+			if err != nil || string(text) != tt.want {
+				t.Errorf("MarshalText() = %s, %v, want %s", text, err, tt.want)
+			}
+		})
+	}
+}
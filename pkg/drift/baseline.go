@@ -0,0 +1,316 @@
+// Package drift compares the live configuration of a Network with a baseline
+// committed to a repository and explains every difference.
+//
+// A baseline is a canonical JSON file holding the name, metadata URI,
+// implementation behind the proxy, global and per-selector delays and role
+// holders of a Network as of a block. Checking it replays the events emitted
+// since that block, so each difference is linked to the transaction, block
+// and timelock operation that caused it, and to the approved proposal that
+// operation came from, if any.
+package drift
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+
+	networkcontracts "github.com/symbioticfi/network/bindings/go-go-ethereum"
+	"github.com/symbioticfi/network/pkg/blockrange"
+	"github.com/symbioticfi/network/pkg/history"
+	"github.com/symbioticfi/network/pkg/roles"
+	"github.com/symbioticfi/network/pkg/upgrade"
+)
+
+// Version is the baseline format version produced by this package.
+const Version = 1
+
+// Backend is the chain access needed to capture and check baselines. It is
+// satisfied by *ethclient.Client.
+type Backend interface {
+	bind.ContractBackend
+	ChainID(ctx context.Context) (*big.Int, error)
+	StorageAt(ctx context.Context, account common.Address, key common.Hash, block *big.Int) ([]byte, error)
+}
+
+// Baseline is the configuration of a Network as of a block.
+type Baseline struct {
+	Version int            `json:"version"`
+	ChainID uint64         `json:"chainId"`
+	Network common.Address `json:"network"`
+	// FromBlock is the deployment block of the Network, from which delays
+	// and roles are replayed.
+	FromBlock   uint64 `json:"fromBlock"`
+	BlockNumber uint64 `json:"blockNumber"`
+
+	Name           string           `json:"name"`
+	MetadataURI    string           `json:"metadataURI"`
+	Implementation common.Address   `json:"implementation"`
+	GlobalMinDelay *math.Decimal256 `json:"globalMinDelay"`
+	// Delays are the enabled per-selector delays, ordered by target and
+	// selector. A zero target applies to any target.
+	Delays []Delay `json:"delays"`
+	// Roles are ordered as roles.Snapshot.Roles, with members ordered by
+	// address.
+	Roles []Role `json:"roles"`
+}
+
+// Delay is a delay set with updateDelay.
+type Delay struct {
+	Target   common.Address   `json:"target"`
+	Selector hexutil.Bytes    `json:"selector"`
+	Delay    *math.Decimal256 `json:"delay"`
+}
+
+// Role lists the holders of a role. Name is informational.
+type Role struct {
+	Role    common.Hash      `json:"role"`
+	Name    string           `json:"name"`
+	Members []common.Address `json:"members"`
+}
+
+// Decode parses a baseline, rejecting unknown fields.
+func Decode(data []byte) (*Baseline, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	var b Baseline
+	if err := decoder.Decode(&b); err != nil {
+		return nil, fmt.Errorf("drift: %w", err)
+	}
+	if decoder.More() {
+		return nil, errors.New("drift: trailing data after the baseline")
+	}
+	switch {
+	case b.Version != Version:
+		return nil, fmt.Errorf("drift: unsupported baseline version %d", b.Version)
+	case b.Network == (common.Address{}):
+		return nil, errors.New("drift: baseline has no network")
+	case b.GlobalMinDelay == nil:
+		return nil, errors.New("drift: baseline has no globalMinDelay")
+	}
+	return &b, nil
+}
+
+// Encode returns the canonical file representation of the baseline:
+// indented JSON with fields in the order of the Baseline type, so that
+// re-capturing an unchanged Network only changes blockNumber.
+func (b *Baseline) Encode() ([]byte, error) {
+	data, err := json.MarshalIndent(b, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+// Load reads a baseline file.
+func Load(path string) (*Baseline, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Decode(data)
+}
+
+// LoadAll reads the baseline file at path or, when path is a directory, every
+// *.json baseline in it in file name order. A directory must not hold two
+// baselines of the same Network.
+func LoadAll(path string) ([]*Baseline, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		b, err := Load(path)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		return []*Baseline{b}, nil
+	}
+	paths, err := filepath.Glob(filepath.Join(path, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("drift: no baselines in %s", path)
+	}
+	type chainNetwork struct {
+		chainID uint64
+		network common.Address
+	}
+	seen := make(map[chainNetwork]string)
+	baselines := make([]*Baseline, 0, len(paths))
+	for _, p := range paths {
+		b, err := Load(p)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", p, err)
+		}
+		key := chainNetwork{b.ChainID, b.Network}
+		if other, ok := seen[key]; ok {
+			return nil, fmt.Errorf("drift: %s and %s are baselines of the same Network", other, p)
+		}
+		seen[key] = p
+		baselines = append(baselines, b)
+	}
+	return baselines, nil
+}
+
+// Save writes the baseline to path in its canonical representation.
+func (b *Baseline) Save(path string) error {
+	data, err := b.Encode()
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// Detector captures and checks the baselines of a single Network.
+type Detector struct {
+	address  common.Address
+	backend  Backend
+	network  *networkcontracts.INetwork
+	timelock *networkcontracts.TimelockControllerUpgradeable
+	reader   *history.Reader
+
+	// FromBlock is the first block scanned for events when capturing a
+	// baseline, normally the deployment block of the Network. Check uses the
+	// FromBlock of the baseline instead.
+	FromBlock uint64
+	// BlockRange is the number of blocks requested per eth_getLogs call.
+	BlockRange uint64
+}
+
+// NewDetector creates a Detector for the Network deployed at address.
+func NewDetector(address common.Address, backend Backend) (*Detector, error) {
+	network, err := networkcontracts.NewINetwork(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	timelock, err := networkcontracts.NewTimelockControllerUpgradeable(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	reader, err := history.NewReader(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Detector{
+		address:    address,
+		backend:    backend,
+		network:    network,
+		timelock:   timelock,
+		reader:     reader,
+		BlockRange: blockrange.DefaultSize,
+	}, nil
+}
+
+// Capture reads the configuration of the Network as of block. The name,
+// metadata URI, global delay and roles come from history.Reader, so nodes
+// without archive state are supported; the implementation is read from the
+// ERC-1967 slot at block.
+func (d *Detector) Capture(ctx context.Context, block uint64) (*Baseline, error) {
+	return d.capture(ctx, d.FromBlock, block)
+}
+
+func (d *Detector) capture(ctx context.Context, from, block uint64) (*Baseline, error) {
+	chainID, err := d.backend.ChainID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("drift: get chain ID: %w", err)
+	}
+	d.reader.FromBlock, d.reader.BlockRange = from, d.BlockRange
+	state, err := d.reader.At(ctx, block, history.Query{})
+	if err != nil {
+		return nil, err
+	}
+	slot, err := d.backend.StorageAt(ctx, d.address, upgrade.ImplementationSlot, new(big.Int).SetUint64(block))
+	if err != nil {
+		return nil, fmt.Errorf("drift: read implementation slot at block %d: %w", block, err)
+	}
+	delays, err := d.delays(ctx, from, block)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &Baseline{
+		Version:        Version,
+		ChainID:        chainID.Uint64(),
+		Network:        d.address,
+		FromBlock:      from,
+		BlockNumber:    block,
+		Name:           state.Name,
+		MetadataURI:    state.MetadataURI,
+		Implementation: common.BytesToAddress(slot),
+		GlobalMinDelay: (*math.Decimal256)(state.GlobalMinDelay),
+		Delays:         delays,
+		Roles:          []Role{},
+	}
+	for _, role := range state.Roles {
+		members := append([]common.Address(nil), role.Members...)
+		sort.Slice(members, func(i, j int) bool { return bytes.Compare(members[i][:], members[j][:]) < 0 })
+		b.Roles = append(b.Roles, Role{Role: role.Role, Name: roles.Name(role.Role), Members: members})
+	}
+	return b, nil
+}
+
+// delays replays the Network's MinDelayChange events in [from, block]. The
+// contract does not expose the table of per-selector delays, so the events
+// are the only way to enumerate it.
+func (d *Detector) delays(ctx context.Context, from, block uint64) ([]Delay, error) {
+	type key struct {
+		target   common.Address
+		selector [4]byte
+	}
+	enabled := make(map[key]*big.Int)
+	for _, r := range blockrange.Split(from, block, d.BlockRange) {
+		logs, err := d.backend.FilterLogs(ctx, ethereum.FilterQuery{
+			FromBlock: new(big.Int).SetUint64(r.From),
+			ToBlock:   new(big.Int).SetUint64(r.To),
+			Addresses: []common.Address{d.address},
+			Topics:    [][]common.Hash{{networkABI.Events["MinDelayChange"].ID}},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("drift: filter logs in blocks %d-%d: %w", r.From, r.To, err)
+		}
+		for _, log := range logs {
+			if log.Removed {
+				continue
+			}
+			event, err := d.network.ParseMinDelayChange(log)
+			if err != nil {
+				return nil, err
+			}
+			k := key{target: event.Target, selector: event.Selector}
+			if event.NewEnabledStatus {
+				enabled[k] = event.NewDelay
+			} else {
+				delete(enabled, k)
+			}
+		}
+	}
+
+	delays := make([]Delay, 0, len(enabled))
+	for k, delay := range enabled {
+		delays = append(delays, Delay{
+			Target:   k.target,
+			Selector: append(hexutil.Bytes(nil), k.selector[:]...),
+			Delay:    (*math.Decimal256)(delay),
+		})
+	}
+	sort.Slice(delays, func(i, j int) bool {
+		if c := bytes.Compare(delays[i].Target[:], delays[j].Target[:]); c != 0 {
+			return c < 0
+		}
+		return bytes.Compare(delays[i].Selector, delays[j].Selector) < 0
+	})
+	return delays, nil
+}
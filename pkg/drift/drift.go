@@ -0,0 +1,390 @@
+package drift
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+	"text/tabwriter"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	networkcontracts "github.com/symbioticfi/network/bindings/go-go-ethereum"
+	"github.com/symbioticfi/network/pkg/blockrange"
+	"github.com/symbioticfi/network/pkg/proposal"
+	"github.com/symbioticfi/network/pkg/roles"
+	"github.com/symbioticfi/network/pkg/timelock"
+)
+
+// networkABI is the parsed INetwork ABI.
+var networkABI = mustParseNetworkABI()
+
+func mustParseNetworkABI() *abi.ABI {
+	parsed, err := networkcontracts.INetworkMetaData.GetAbi()
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
+
+// upgradedTopic is the ERC-1967 Upgraded(address indexed implementation)
+// event emitted by the proxy.
+var upgradedTopic = crypto.Keccak256Hash([]byte("Upgraded(address)"))
+
+// Field is the part of the configuration a difference is in.
+type Field string
+
+const (
+	Name           Field = "name"
+	MetadataURI    Field = "metadataURI"
+	Implementation Field = "implementation"
+	GlobalMinDelay Field = "globalMinDelay"
+	// MinDelay differences are keyed by "target selector".
+	MinDelay Field = "minDelay"
+	// RoleMember differences are keyed by "role account".
+	RoleMember Field = "role"
+)
+
+// Diff is a difference between the baseline and the live configuration.
+type Diff struct {
+	Field    Field  `json:"field"`
+	Key      string `json:"key,omitempty"`
+	Baseline string `json:"baseline"`
+	Live     string `json:"live"`
+	// Cause is the last event since the baseline block that changed the
+	// value. It is nil when no such event was found.
+	Cause *Cause `json:"cause,omitempty"`
+	// Approved is set when the cause was executed by an operation of an
+	// approved proposal.
+	Approved bool `json:"approved"`
+}
+
+// Cause is the event that produced a live value.
+type Cause struct {
+	Event       string      `json:"event"`
+	BlockNumber uint64      `json:"blockNumber"`
+	TxHash      common.Hash `json:"txHash"`
+	LogIndex    uint        `json:"logIndex"`
+	// Operation is set when the change was executed by a timelock operation.
+	Operation *roles.Operation `json:"operation,omitempty"`
+}
+
+// Report is the result of checking a baseline.
+type Report struct {
+	Network       common.Address `json:"network"`
+	BaselineBlock uint64         `json:"baselineBlock"`
+	BlockNumber   uint64         `json:"blockNumber"`
+	Diffs         []Diff         `json:"diffs"`
+}
+
+// Unapproved returns the differences that did not come from an approved
+// proposal.
+func (r *Report) Unapproved() []Diff {
+	var diffs []Diff
+	for _, diff := range r.Diffs {
+		if !diff.Approved {
+			diffs = append(diffs, diff)
+		}
+	}
+	return diffs
+}
+
+// Compare returns the differences between two captures of the same Network,
+// in the order of the Baseline fields.
+func Compare(baseline, live *Baseline) []Diff {
+	diffs := []Diff{}
+	add := func(field Field, key, before, after string) {
+		if before != after {
+			diffs = append(diffs, Diff{Field: field, Key: key, Baseline: before, Live: after})
+		}
+	}
+	add(Name, "", baseline.Name, live.Name)
+	add(MetadataURI, "", baseline.MetadataURI, live.MetadataURI)
+	add(Implementation, "", baseline.Implementation.Hex(), live.Implementation.Hex())
+	add(GlobalMinDelay, "", formatDelay(baseline.GlobalMinDelay), formatDelay(live.GlobalMinDelay))
+
+	before, after := delayValues(baseline), delayValues(live)
+	for _, key := range unionKeys(delayKeys(baseline), delayKeys(live)) {
+		add(MinDelay, key, before[key], after[key])
+	}
+	before, after = memberValues(baseline), memberValues(live)
+	for _, key := range unionKeys(memberKeys(baseline), memberKeys(live)) {
+		add(RoleMember, key, before[key], after[key])
+	}
+	return diffs
+}
+
+func formatDelay(delay *math.Decimal256) string {
+	return (*big.Int)(delay).String() + "s"
+}
+
+func delayKey(target common.Address, selector []byte) string {
+	return target.Hex() + " " + hexutil.Encode(selector)
+}
+
+func delayKeys(b *Baseline) []string {
+	keys := make([]string, len(b.Delays))
+	for i, d := range b.Delays {
+		keys[i] = delayKey(d.Target, d.Selector)
+	}
+	return keys
+}
+
+// delayValues maps the enabled delays of b by key. Missing keys compare as "".
+func delayValues(b *Baseline) map[string]string {
+	values := make(map[string]string)
+	for _, d := range b.Delays {
+		values[delayKey(d.Target, d.Selector)] = formatDelay(d.Delay)
+	}
+	return values
+}
+
+func memberKey(role common.Hash, account common.Address) string {
+	return roles.Name(role) + " " + account.Hex()
+}
+
+func memberKeys(b *Baseline) []string {
+	var keys []string
+	for _, role := range b.Roles {
+		for _, member := range role.Members {
+			keys = append(keys, memberKey(role.Role, member))
+		}
+	}
+	return keys
+}
+
+// memberValues marks the role holders of b. Missing keys compare as "".
+func memberValues(b *Baseline) map[string]string {
+	values := make(map[string]string)
+	for _, key := range memberKeys(b) {
+		values[key] = "member"
+	}
+	return values
+}
+
+// unionKeys returns the keys of a followed by the keys only in b.
+func unionKeys(a, b []string) []string {
+	seen := make(map[string]bool)
+	var keys []string
+	for _, list := range [][]string{a, b} {
+		for _, key := range list {
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	return keys
+}
+
+// Check captures the Network as of block, compares it with baseline and links
+// every difference to the event that caused it. A difference is approved when
+// its cause was executed by the operation of one of the approved proposals.
+func (d *Detector) Check(ctx context.Context, baseline *Baseline, block uint64, approved []*proposal.Proposal) (*Report, error) {
+	if baseline.Network != d.address {
+		return nil, fmt.Errorf("drift: baseline is for Network %s, not %s", baseline.Network, d.address)
+	}
+	if block < baseline.BlockNumber {
+		return nil, fmt.Errorf("drift: block %d is before the baseline block %d", block, baseline.BlockNumber)
+	}
+	live, err := d.capture(ctx, baseline.FromBlock, block)
+	if err != nil {
+		return nil, err
+	}
+	if live.ChainID != baseline.ChainID {
+		return nil, fmt.Errorf("drift: baseline is for chain %d, connected to chain %d", baseline.ChainID, live.ChainID)
+	}
+
+	report := &Report{
+		Network:       d.address,
+		BaselineBlock: baseline.BlockNumber,
+		BlockNumber:   block,
+		Diffs:         Compare(baseline, live),
+	}
+	if len(report.Diffs) == 0 {
+		return report, nil
+	}
+	causes, err := d.causes(ctx, baseline.BlockNumber+1, block)
+	if err != nil {
+		return nil, err
+	}
+	operations := make(map[common.Hash]bool)
+	for _, p := range approved {
+		if p.ChainID == baseline.ChainID && p.Network == d.address {
+			operations[p.OperationID] = true
+		}
+	}
+	for i := range report.Diffs {
+		diff := &report.Diffs[i]
+		diff.Cause = causes[causeKey{diff.Field, diff.Key}]
+		diff.Approved = diff.Cause != nil && diff.Cause.Operation != nil && operations[diff.Cause.Operation.ID]
+	}
+	return report, nil
+}
+
+// causeKey identifies the value an event changes.
+type causeKey struct {
+	field Field
+	key   string
+}
+
+// causes returns the last event in [from, to] that changed each value,
+// linked to the timelock operation that executed it.
+func (d *Detector) causes(ctx context.Context, from, to uint64) (map[causeKey]*Cause, error) {
+	topics := []common.Hash{
+		networkABI.Events["NameSet"].ID,
+		networkABI.Events["MetadataURISet"].ID,
+		networkABI.Events["MinDelayChange"].ID,
+		timelock.ABI.Events["MinDelayChange"].ID,
+		timelock.ABI.Events["RoleGranted"].ID,
+		timelock.ABI.Events["RoleRevoked"].ID,
+		timelock.ABI.Events["CallScheduled"].ID,
+		timelock.ABI.Events["CallExecuted"].ID,
+		upgradedTopic,
+	}
+
+	type pending struct {
+		cause *Cause
+		// self is set when the event can only have been caused by the timelock
+		// executing an operation.
+		self bool
+	}
+	var (
+		causes    = make(map[causeKey]*Cause)
+		events    []pending
+		executed  = make(map[common.Hash][]*networkcontracts.TimelockControllerUpgradeableCallExecuted)
+		scheduled = make(map[common.Hash]types.Log)
+	)
+	for _, r := range blockrange.Split(from, to, d.BlockRange) {
+		logs, err := d.backend.FilterLogs(ctx, ethereum.FilterQuery{
+			FromBlock: new(big.Int).SetUint64(r.From),
+			ToBlock:   new(big.Int).SetUint64(r.To),
+			Addresses: []common.Address{d.address},
+			Topics:    [][]common.Hash{topics},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("drift: filter logs in blocks %d-%d: %w", r.From, r.To, err)
+		}
+		for _, log := range logs {
+			if log.Removed || len(log.Topics) == 0 {
+				continue
+			}
+			var (
+				key  causeKey
+				name string
+				self = true
+			)
+			switch log.Topics[0] {
+			case networkABI.Events["NameSet"].ID:
+				key, name = causeKey{field: Name}, "NameSet"
+			case networkABI.Events["MetadataURISet"].ID:
+				key, name = causeKey{field: MetadataURI}, "MetadataURISet"
+			case networkABI.Events["MinDelayChange"].ID:
+				event, err := d.network.ParseMinDelayChange(log)
+				if err != nil {
+					return nil, err
+				}
+				key, name = causeKey{MinDelay, delayKey(event.Target, event.Selector[:])}, "MinDelayChange"
+			case timelock.ABI.Events["MinDelayChange"].ID:
+				key, name = causeKey{field: GlobalMinDelay}, "MinDelayChange"
+			case timelock.ABI.Events["RoleGranted"].ID:
+				event, err := d.timelock.ParseRoleGranted(log)
+				if err != nil {
+					return nil, err
+				}
+				key, name, self = causeKey{RoleMember, memberKey(event.Role, event.Account)}, "RoleGranted", event.Sender == d.address
+			case timelock.ABI.Events["RoleRevoked"].ID:
+				event, err := d.timelock.ParseRoleRevoked(log)
+				if err != nil {
+					return nil, err
+				}
+				key, name, self = causeKey{RoleMember, memberKey(event.Role, event.Account)}, "RoleRevoked", event.Sender == d.address
+			case upgradedTopic:
+				// The upgrade is executed by the proxy admin, which the
+				// timelock calls.
+				key, name = causeKey{field: Implementation}, "Upgraded"
+			case timelock.ABI.Events["CallExecuted"].ID:
+				event, err := d.timelock.ParseCallExecuted(log)
+				if err != nil {
+					return nil, err
+				}
+				executed[log.TxHash] = append(executed[log.TxHash], event)
+				continue
+			case timelock.ABI.Events["CallScheduled"].ID:
+				id := common.Hash(log.Topics[1])
+				if _, ok := scheduled[id]; !ok {
+					scheduled[id] = log
+				}
+				continue
+			default:
+				continue
+			}
+			cause := &Cause{Event: name, BlockNumber: log.BlockNumber, TxHash: log.TxHash, LogIndex: log.Index}
+			causes[key] = cause
+			events = append(events, pending{cause: cause, self: self})
+		}
+	}
+
+	// The timelock emits CallExecuted after making the call, so the first
+	// CallExecuted after an event in the same transaction is the call that
+	// emitted it.
+	for _, e := range events {
+		if !e.self {
+			continue
+		}
+		for _, call := range executed[e.cause.TxHash] {
+			if call.Raw.Index < e.cause.LogIndex {
+				continue
+			}
+			op := &roles.Operation{ID: call.Id, Index: call.Index.Uint64()}
+			if log, ok := scheduled[op.ID]; ok {
+				op.ScheduledTx, op.ScheduledBlock = log.TxHash, log.BlockNumber
+			}
+			e.cause.Operation = op
+			break
+		}
+	}
+	return causes, nil
+}
+
+// WriteText renders one row per difference, marking unapproved ones with "!".
+func (r *Report) WriteText(w io.Writer) error {
+	fmt.Fprintf(w, "Network %s, baseline block %d, checked at block %d: %d differences, %d unapproved\n",
+		r.Network, r.BaselineBlock, r.BlockNumber, len(r.Diffs), len(r.Unapproved()))
+	if len(r.Diffs) == 0 {
+		return nil
+	}
+	fmt.Fprintln(w)
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "\tFIELD\tKEY\tBASELINE\tLIVE\tEVENT\tBLOCK\tTX\tOPERATION")
+	for _, diff := range r.Diffs {
+		mark := ""
+		if !diff.Approved {
+			mark = "!"
+		}
+		event, block, tx, operation := "-", "-", "-", "-"
+		if c := diff.Cause; c != nil {
+			event, block, tx = c.Event, fmt.Sprint(c.BlockNumber), c.TxHash.Hex()
+			if c.Operation != nil {
+				operation = fmt.Sprintf("%s[%d]", c.Operation.ID, c.Operation.Index)
+			}
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", mark, diff.Field, orDash(diff.Key),
+			orDash(diff.Baseline), orDash(diff.Live), event, block, tx, operation)
+	}
+	return tw.Flush()
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return strings.ReplaceAll(s, "\t", " ")
+}
@@ -0,0 +1,343 @@
+package drift
+
+import (
+	"context"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/symbioticfi/network/pkg/roles"
+	"github.com/symbioticfi/network/pkg/timelock"
+)
+
+var (
+	network = common.HexToAddress("0x7e70000000000000000000000000000000000000")
+	alice   = common.HexToAddress("0xa11ce00000000000000000000000000000000000")
+	bob     = common.HexToAddress("0xb0b0000000000000000000000000000000000000")
+	vault   = common.HexToAddress("0xfa00000000000000000000000000000000000000")
+)
+
+func baseline() *Baseline {
+	return &Baseline{
+		Version:        Version,
+		ChainID:        1,
+		Network:        network,
+		Name:           "Network",
+		MetadataURI:    "ipfs://a",
+		Implementation: common.HexToAddress("0x1111111111111111111111111111111111111111"),
+		GlobalMinDelay: (*math.Decimal256)(big.NewInt(86400)),
+		Delays: []Delay{
+			{Target: vault, Selector: hexutil.Bytes{0x23, 0xf7, 0x52, 0xd5}, Delay: (*math.Decimal256)(big.NewInt(3600))},
+		},
+		Roles: []Role{
+			{Role: roles.DefaultAdminRole, Name: "DEFAULT_ADMIN_ROLE", Members: []common.Address{alice}},
+		},
+	}
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		name   string
+		change func(b *Baseline)
+		want   []Diff
+	}{
+		{
+			name:   "unchanged",
+			change: func(*Baseline) {},
+			want:   []Diff{},
+		},
+		{
+			name: "name and global delay",
+			change: func(b *Baseline) {
+				b.Name = "Renamed"
+				b.GlobalMinDelay = (*math.Decimal256)(big.NewInt(60))
+			},
+			want: []Diff{
+				{Field: Name, Baseline: "Network", Live: "Renamed"},
+				{Field: GlobalMinDelay, Baseline: "86400s", Live: "60s"},
+			},
+		},
+		{
+			name: "delay changed and added",
+			change: func(b *Baseline) {
+				b.Delays = []Delay{
+					{Target: common.Address{}, Selector: hexutil.Bytes{1, 2, 3, 4}, Delay: (*math.Decimal256)(big.NewInt(7))},
+					{Target: vault, Selector: hexutil.Bytes{0x23, 0xf7, 0x52, 0xd5}, Delay: (*math.Decimal256)(big.NewInt(60))},
+				}
+			},
+			want: []Diff{
+				{Field: MinDelay, Key: vault.Hex() + " 0x23f752d5", Baseline: "3600s", Live: "60s"},
+				{Field: MinDelay, Key: common.Address{}.Hex() + " 0x01020304", Live: "7s"},
+			},
+		},
+		{
+			name: "delay disabled",
+			change: func(b *Baseline) {
+				b.Delays = nil
+			},
+			want: []Diff{
+				{Field: MinDelay, Key: vault.Hex() + " 0x23f752d5", Baseline: "3600s"},
+			},
+		},
+		{
+			name: "role member replaced",
+			change: func(b *Baseline) {
+				b.Roles = []Role{{Role: roles.DefaultAdminRole, Members: []common.Address{bob}}}
+			},
+			want: []Diff{
+				{Field: RoleMember, Key: "DEFAULT_ADMIN_ROLE " + alice.Hex(), Baseline: "member"},
+				{Field: RoleMember, Key: "DEFAULT_ADMIN_ROLE " + bob.Hex(), Live: "member"},
+			},
+		},
+		{
+			name: "implementation",
+			change: func(b *Baseline) {
+				b.Implementation = common.HexToAddress("0x2222222222222222222222222222222222222222")
+			},
+			want: []Diff{
+				{
+					Field:    Implementation,
+					Baseline: "0x1111111111111111111111111111111111111111",
+					Live:     "0x2222222222222222222222222222222222222222",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			live := baseline()
+			tt.change(live)
+			got := Compare(baseline(), live)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Compare() = %+v, want %+v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("Compare()[%d] = %+v, want %+v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+// logBackend serves logs from memory.
+type logBackend struct {
+	Backend
+	logs []types.Log
+}
+
+func (b *logBackend) FilterLogs(_ context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	var logs []types.Log
+	for _, log := range b.logs {
+		if log.BlockNumber >= query.FromBlock.Uint64() && log.BlockNumber <= query.ToBlock.Uint64() {
+			logs = append(logs, log)
+		}
+	}
+	return logs, nil
+}
+
+// newLog encodes an event of contract emitted by the Network.
+func newLog(t *testing.T, contract *abi.ABI, name string, tx byte, block uint64, index uint, args ...any) types.Log {
+	t.Helper()
+	event := contract.Events[name]
+	var (
+		indexed []any
+		data    []any
+	)
+	for i, input := range event.Inputs {
+		if input.Indexed {
+			indexed = append(indexed, args[i])
+		} else {
+			data = append(data, args[i])
+		}
+	}
+	topics := []common.Hash{event.ID}
+	for _, arg := range indexed {
+		topic, err := abi.MakeTopics([]any{arg})
+		if err != nil {
+			t.Fatal(err)
+		}
+		topics = append(topics, topic[0][0])
+	}
+	packed, err := event.Inputs.NonIndexed().Pack(data...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return types.Log{
+		Address:     network,
+		Topics:      topics,
+		Data:        packed,
+		BlockNumber: block,
+		TxHash:      common.Hash{tx},
+		Index:       index,
+	}
+}
+
+func TestCauses(t *testing.T) {
+	opA, opB, opC := common.Hash{0xa}, common.Hash{0xb}, common.Hash{0xc}
+	selector := [4]byte{0x23, 0xf7, 0x52, 0xd5}
+	zero := new(big.Int)
+	executed := func(tx byte, block uint64, index uint, id common.Hash, callIndex int64) types.Log {
+		return newLog(t, timelock.ABI, "CallExecuted", tx, block, index, id, big.NewInt(callIndex), network, zero, []byte{})
+	}
+	logs := []types.Log{
+		newLog(t, timelock.ABI, "CallScheduled", 1, 11, 0, opA, zero, network, zero, []byte{}, common.Hash{}, big.NewInt(60)),
+		// Operation A renames the Network.
+		newLog(t, networkABI, "NameSet", 2, 20, 0, "First"),
+		executed(2, 20, 1, opA, 0),
+		// An admin grants a role directly, in a transaction that also
+		// executes an unrelated operation.
+		newLog(t, timelock.ABI, "RoleGranted", 3, 21, 0, roles.DefaultAdminRole, bob, alice),
+		executed(3, 21, 1, opC, 0),
+		// Operation B changes a delay and the metadata URI in one batch.
+		newLog(t, networkABI, "MinDelayChange", 4, 22, 0, vault, selector, true, big.NewInt(3600), true, big.NewInt(60)),
+		executed(4, 22, 1, opB, 0),
+		newLog(t, networkABI, "MetadataURISet", 4, 22, 2, "ipfs://b"),
+		executed(4, 22, 3, opB, 1),
+		// The proxy is upgraded without the timelock.
+		{Address: network, Topics: []common.Hash{upgradedTopic, common.BytesToHash(alice[:])}, BlockNumber: 23, TxHash: common.Hash{5}},
+		// Operation C renames the Network again; the last change is the cause.
+		newLog(t, networkABI, "NameSet", 6, 30, 0, "Second"),
+		executed(6, 30, 1, opC, 0),
+		// A removed log is ignored.
+		func() types.Log {
+			log := newLog(t, networkABI, "NameSet", 7, 31, 0, "Removed")
+			log.Removed = true
+			return log
+		}(),
+	}
+	detector, err := NewDetector(network, &logBackend{logs: logs})
+	if err != nil {
+		t.Fatal(err)
+	}
+	detector.BlockRange = 5
+	causes, err := detector.causes(context.Background(), 10, 40)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		key       causeKey
+		event     string
+		block     uint64
+		operation *roles.Operation
+	}{
+		{
+			key:       causeKey{field: Name},
+			event:     "NameSet",
+			block:     30,
+			operation: &roles.Operation{ID: opC},
+		},
+		{
+			key:   causeKey{RoleMember, memberKey(roles.DefaultAdminRole, bob)},
+			event: "RoleGranted",
+			block: 21,
+		},
+		{
+			key:       causeKey{MinDelay, delayKey(vault, selector[:])},
+			event:     "MinDelayChange",
+			block:     22,
+			operation: &roles.Operation{ID: opB},
+		},
+		{
+			key:       causeKey{field: MetadataURI},
+			event:     "MetadataURISet",
+			block:     22,
+			operation: &roles.Operation{ID: opB, Index: 1},
+		},
+		{
+			key:   causeKey{field: Implementation},
+			event: "Upgraded",
+			block: 23,
+		},
+	}
+	if len(causes) != len(tests) {
+		t.Errorf("causes() returned %d causes, want %d", len(causes), len(tests))
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.key.field)+" "+tt.key.key, func(t *testing.T) {
+			cause := causes[tt.key]
+			if cause == nil {
+				t.Fatal("no cause")
+			}
+			if cause.Event != tt.event || cause.BlockNumber != tt.block {
+				t.Errorf("cause = %s at block %d, want %s at block %d", cause.Event, cause.BlockNumber, tt.event, tt.block)
+			}
+			switch {
+			case tt.operation == nil && cause.Operation != nil:
+				t.Errorf("operation = %+v, want none", cause.Operation)
+			case tt.operation != nil && cause.Operation == nil:
+				t.Errorf("no operation, want %s[%d]", tt.operation.ID, tt.operation.Index)
+			case tt.operation != nil && (cause.Operation.ID != tt.operation.ID || cause.Operation.Index != tt.operation.Index):
+				t.Errorf("operation = %s[%d], want %s[%d]", cause.Operation.ID, cause.Operation.Index, tt.operation.ID, tt.operation.Index)
+			}
+		})
+	}
+	if op := causes[causeKey{field: Name}].Operation; op != nil && op.ScheduledBlock != 0 {
+		t.Errorf("operation C scheduled at block %d, want unknown", op.ScheduledBlock)
+	}
+	// Up to the first rename, operation A was scheduled in range.
+	detector, err = NewDetector(network, &logBackend{logs: logs[:3]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	early, err := detector.causes(context.Background(), 10, 40)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if op := early[causeKey{field: Name}].Operation; op == nil || op.ScheduledBlock != 11 || op.ScheduledTx != (common.Hash{1}) {
+		t.Errorf("operation A = %+v, want scheduled in block 11", op)
+	}
+}
+
+func TestLoadAll(t *testing.T) {
+	dir := t.TempDir()
+	save := func(name string, b *Baseline) string {
+		path := filepath.Join(dir, name)
+		if err := b.Save(path); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	mainnet := baseline()
+	holesky := baseline()
+	holesky.ChainID = 17000
+	save("mainnet.json", mainnet)
+	file := save("holesky.json", holesky)
+	if err := os.WriteFile(filepath.Join(dir, "README.md"), []byte("not a baseline"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	baselines, err := LoadAll(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(baselines) != 2 || baselines[0].ChainID != 17000 || baselines[1].ChainID != 1 {
+		t.Errorf("LoadAll(dir) = %d baselines, want holesky then mainnet", len(baselines))
+	}
+
+	baselines, err = LoadAll(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(baselines) != 1 || baselines[0].ChainID != 17000 {
+		t.Errorf("LoadAll(file) = %d baselines, want holesky", len(baselines))
+	}
+
+	save("mainnet-copy.json", mainnet)
+	if _, err := LoadAll(dir); err == nil || !strings.Contains(err.Error(), "same Network") {
+		t.Errorf("LoadAll(dir) with a duplicate = %v, want a same Network error", err)
+	}
+	if _, err := LoadAll(t.TempDir()); err == nil {
+		t.Error("LoadAll(empty dir) succeeded")
+	}
+}
@@ -0,0 +1,192 @@
+package events
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	networkcontracts "github.com/symbioticfi/network/bindings/go-go-ethereum"
+)
+
+// Decoded is a decoded log. Name tags the event and Value holds it: the
+// generated event type for contracts registered with a binding, such as
+// *networkcontracts.INetworkNameSet or
+// *networkcontracts.TimelockControllerUpgradeableCallScheduled, or a *Generic
+// for contracts registered with an ABI only. Value is nil for logs of
+// unknown contracts or events.
+type Decoded struct {
+	Contract string    `json:"contract,omitempty"`
+	Name     string    `json:"event,omitempty"`
+	Value    any       `json:"value,omitempty"`
+	Log      types.Log `json:"log"`
+}
+
+// Generic is an event decoded with a registered ABI.
+type Generic struct {
+	// Fields holds the indexed and data arguments by name. Indexed
+	// arguments of dynamic types hold the hash of their value.
+	Fields map[string]any `json:"fields"`
+	Raw    types.Log      `json:"-"`
+}
+
+type eventDecoder struct {
+	contract string
+	name     string
+	decode   func(types.Log) (any, error)
+}
+
+// Decoder decodes the logs of transactions sent to a Network, including the
+// logs of the contracts it calls once they are registered.
+type Decoder struct {
+	byAddress map[common.Address]map[common.Hash]eventDecoder
+}
+
+// NewDecoder creates a Decoder for the INetwork, TimelockController and
+// AccessControl events of the Network deployed at network.
+func NewDecoder(network common.Address) (*Decoder, error) {
+	d := &Decoder{byAddress: make(map[common.Address]map[common.Hash]eventDecoder)}
+	// Parsing only uses the ABI, so the filterers need no backend.
+	networkFilterer, err := networkcontracts.NewINetworkFilterer(network, nil)
+	if err != nil {
+		return nil, err
+	}
+	timelockFilterer, err := networkcontracts.NewTimelockControllerUpgradeableFilterer(network, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.RegisterBinding(network, "TimelockControllerUpgradeable", networkcontracts.TimelockControllerUpgradeableMetaData, timelockFilterer); err != nil {
+		return nil, err
+	}
+	if err := d.RegisterBinding(network, "INetwork", networkcontracts.INetworkMetaData, networkFilterer); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// Register decodes the events of parsed emitted by address into *Generic
+// values. The zero address registers the events for every address that has
+// no registration of its own for them, such as all the delegators touched
+// by an operation.
+func (d *Decoder) Register(address common.Address, contract string, parsed *abi.ABI) {
+	for _, event := range parsed.Events {
+		if event.Anonymous {
+			continue
+		}
+		d.add(address, event.ID, eventDecoder{contract: contract, name: event.Name, decode: func(log types.Log) (any, error) {
+			return decodeGeneric(event, log)
+		}})
+	}
+}
+
+// RegisterBinding decodes the events of metaData emitted by address with the
+// Parse methods of filterer, a generated filterer such as
+// *networkcontracts.IBaseDelegatorFilterer. The zero address works as in
+// Register.
+func (d *Decoder) RegisterBinding(address common.Address, contract string, metaData *bind.MetaData, filterer any) error {
+	parsed, err := metaData.GetAbi()
+	if err != nil {
+		return err
+	}
+	value := reflect.ValueOf(filterer)
+	for _, event := range parsed.Events {
+		if event.Anonymous {
+			continue
+		}
+		method := value.MethodByName("Parse" + abi.ToCamelCase(event.Name))
+		if !method.IsValid() || method.Type() != parseType(method.Type()) {
+			return fmt.Errorf("events: %T has no Parse method for %s", filterer, event.Name)
+		}
+		parse := func(log types.Log) (any, error) {
+			out := method.Call([]reflect.Value{reflect.ValueOf(log)})
+			if err, _ := out[1].Interface().(error); err != nil {
+				return nil, err
+			}
+			return out[0].Interface(), nil
+		}
+		d.add(address, event.ID, eventDecoder{contract: contract, name: event.Name, decode: parse})
+	}
+	return nil
+}
+
+// parseType returns the signature of a generated Parse method with the same
+// result as t.
+func parseType(t reflect.Type) reflect.Type {
+	if t.NumOut() != 2 {
+		return nil
+	}
+	logType, errorType := reflect.TypeOf(types.Log{}), reflect.TypeOf((*error)(nil)).Elem()
+	return reflect.FuncOf([]reflect.Type{logType}, []reflect.Type{t.Out(0), errorType}, false)
+}
+
+func (d *Decoder) add(address common.Address, id common.Hash, decoder eventDecoder) {
+	events, ok := d.byAddress[address]
+	if !ok {
+		events = make(map[common.Hash]eventDecoder)
+		d.byAddress[address] = events
+	}
+	events[id] = decoder
+}
+
+// Decode decodes a single log. Logs of unknown contracts or events are
+// returned with a nil Value; logs of known events that fail to decode return
+// an error.
+func (d *Decoder) Decode(log types.Log) (Decoded, error) {
+	decoded := Decoded{Log: log}
+	if len(log.Topics) == 0 {
+		return decoded, nil
+	}
+	decoder, ok := d.byAddress[log.Address][log.Topics[0]]
+	if !ok {
+		if decoder, ok = d.byAddress[common.Address{}][log.Topics[0]]; !ok {
+			return decoded, nil
+		}
+	}
+	value, err := decoder.decode(log)
+	if err != nil {
+		return decoded, fmt.Errorf("events: decode %s.%s log %d of %s: %w", decoder.contract, decoder.name, log.Index, log.TxHash, err)
+	}
+	decoded.Contract, decoded.Name, decoded.Value = decoder.contract, decoder.name, value
+	return decoded, nil
+}
+
+// DecodeLogs decodes logs in order.
+func (d *Decoder) DecodeLogs(logs []types.Log) ([]Decoded, error) {
+	decoded := make([]Decoded, len(logs))
+	for i, log := range logs {
+		var err error
+		if decoded[i], err = d.Decode(log); err != nil {
+			return nil, err
+		}
+	}
+	return decoded, nil
+}
+
+// DecodeReceipt decodes the logs of receipt in order.
+func (d *Decoder) DecodeReceipt(receipt *types.Receipt) ([]Decoded, error) {
+	logs := make([]types.Log, len(receipt.Logs))
+	for i, log := range receipt.Logs {
+		logs[i] = *log
+	}
+	return d.DecodeLogs(logs)
+}
+
+func decodeGeneric(event abi.Event, log types.Log) (*Generic, error) {
+	fields := make(map[string]any)
+	if err := event.Inputs.NonIndexed().UnpackIntoMap(fields, log.Data); err != nil {
+		return nil, err
+	}
+	var indexed abi.Arguments
+	for _, arg := range event.Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopicsIntoMap(fields, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	return &Generic{Fields: fields, Raw: log}, nil
+}
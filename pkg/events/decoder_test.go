@@ -0,0 +1,155 @@
+package events
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	networkcontracts "github.com/symbioticfi/network/bindings/go-go-ethereum"
+)
+
+// eventLog returns a log of the event name of metaData emitted by address
+// with data packed from args. topics follow the event ID.
+func eventLog(t *testing.T, metaData *bind.MetaData, address common.Address, name string, topics []common.Hash, args ...any) types.Log {
+	t.Helper()
+	parsed, err := metaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	event := parsed.Events[name]
+	data, err := event.Inputs.NonIndexed().Pack(args...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return types.Log{Address: address, Topics: append([]common.Hash{event.ID}, topics...), Data: data}
+}
+
+func TestDecode(t *testing.T) {
+	network := common.HexToAddress("0x7e70000000000000000000000000000000000000")
+	delegator := common.HexToAddress("0xde10000000000000000000000000000000000000")
+	subnetwork := common.HexToHash("0x7e70000000000000000000000000000000000000000000000000000000000001")
+
+	decoder, err := NewDecoder(network)
+	if err != nil {
+		t.Fatal(err)
+	}
+	delegatorABI, err := networkcontracts.IBaseDelegatorMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoder.Register(common.Address{}, "IBaseDelegator", delegatorABI)
+
+	nameSet := eventLog(t, networkcontracts.INetworkMetaData, network, "NameSet", nil, "network")
+	minDelayChange := eventLog(t, networkcontracts.TimelockControllerUpgradeableMetaData, network, "MinDelayChange", nil, big.NewInt(1), big.NewInt(2))
+	maxNetworkLimit := eventLog(t, networkcontracts.IBaseDelegatorMetaData, delegator, "SetMaxNetworkLimit", []common.Hash{subnetwork}, big.NewInt(100))
+	otherNameSet := nameSet
+	otherNameSet.Address = delegator
+	unknown := types.Log{Address: network, Topics: []common.Hash{common.HexToHash("0x01")}}
+	truncated := nameSet
+	truncated.Data = truncated.Data[:32]
+
+	tests := []struct {
+		name     string
+		log      types.Log
+		contract string
+		event    string
+		check    func(t *testing.T, value any)
+		wantErr  bool
+	}{
+		{
+			name:     "network event",
+			log:      nameSet,
+			contract: "INetwork",
+			event:    "NameSet",
+			check: func(t *testing.T, value any) {
+				if v, ok := value.(*networkcontracts.INetworkNameSet); !ok || v.Name != "network" {
+					t.Errorf("Value = %#v, want NameSet network", value)
+				}
+			},
+		},
+		{
+			name:     "timelock event",
+			log:      minDelayChange,
+			contract: "TimelockControllerUpgradeable",
+			event:    "MinDelayChange",
+			check: func(t *testing.T, value any) {
+				v, ok := value.(*networkcontracts.TimelockControllerUpgradeableMinDelayChange)
+				if !ok || v.OldDuration.Int64() != 1 || v.NewDuration.Int64() != 2 {
+					t.Errorf("Value = %#v, want MinDelayChange 1 to 2", value)
+				}
+			},
+		},
+		{
+			name:     "wildcard",
+			log:      maxNetworkLimit,
+			contract: "IBaseDelegator",
+			event:    "SetMaxNetworkLimit",
+			check: func(t *testing.T, value any) {
+				v, ok := value.(*Generic)
+				if !ok || v.Fields["subnetwork"] != [32]byte(subnetwork) || v.Fields["amount"].(*big.Int).Int64() != 100 {
+					t.Errorf("Value = %#v, want SetMaxNetworkLimit of 100", value)
+				}
+			},
+		},
+		{name: "unknown contract", log: otherNameSet},
+		{name: "unknown event", log: unknown},
+		{name: "no topics", log: types.Log{Address: network}},
+		{name: "malformed", log: truncated, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decoded, err := decoder.Decode(tt.log)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Decode() error = %v, want error %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if decoded.Contract != tt.contract || decoded.Name != tt.event {
+				t.Errorf("Decode() = %s.%s, want %s.%s", decoded.Contract, decoded.Name, tt.contract, tt.event)
+			}
+			if tt.check == nil {
+				if decoded.Value != nil {
+					t.Errorf("Value = %#v, want nil", decoded.Value)
+				}
+				return
+			}
+			tt.check(t, decoded.Value)
+		})
+	}
+}
+
+func TestRegisterOverridesWildcard(t *testing.T) {
+	network := common.HexToAddress("0x7e70000000000000000000000000000000000000")
+	delegator := common.HexToAddress("0xde10000000000000000000000000000000000000")
+	decoder, err := NewDecoder(network)
+	if err != nil {
+		t.Fatal(err)
+	}
+	delegatorABI, err := networkcontracts.IBaseDelegatorMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoder.Register(common.Address{}, "IBaseDelegator", delegatorABI)
+	filterer, err := networkcontracts.NewIBaseDelegatorFilterer(delegator, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := decoder.RegisterBinding(delegator, "IBaseDelegator", networkcontracts.IBaseDelegatorMetaData, filterer); err != nil {
+		t.Fatal(err)
+	}
+	log := eventLog(t, networkcontracts.IBaseDelegatorMetaData, delegator, "SetMaxNetworkLimit", []common.Hash{{}}, big.NewInt(100))
+	decoded, err := decoder.Decode(log)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := decoded.Value.(*networkcontracts.IBaseDelegatorSetMaxNetworkLimit); !ok {
+		t.Errorf("Value = %T, want the binding's event type", decoded.Value)
+	}
+	if err := decoder.RegisterBinding(delegator, "IBaseDelegator", networkcontracts.IBaseDelegatorMetaData, struct{}{}); err == nil {
+		t.Error("RegisterBinding() with a filterer without Parse methods succeeded")
+	}
+}
@@ -0,0 +1,128 @@
+package events
+
+import (
+	"fmt"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	networkcontracts "github.com/symbioticfi/network/bindings/go-go-ethereum"
+)
+
+// contractStream creates the stream of the event name emitted by address.
+func contractStream[T any](backend Backend, address common.Address, metaData *bind.MetaData, name string, parse func(types.Log) (T, error)) (*EventStream[T], error) {
+	parsed, err := metaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	event, ok := parsed.Events[name]
+	if !ok {
+		return nil, fmt.Errorf("events: no event %s", name)
+	}
+	query := ethereum.FilterQuery{
+		Addresses: []common.Address{address},
+		Topics:    [][]common.Hash{{event.ID}},
+	}
+	return New(backend, query, parse), nil
+}
+
+// MinDelayChange streams the per-selector delay changes of a Network.
+func MinDelayChange(backend Backend, network common.Address) (*EventStream[*networkcontracts.INetworkMinDelayChange], error) {
+	filterer, err := networkcontracts.NewINetworkFilterer(network, backend)
+	if err != nil {
+		return nil, err
+	}
+	return contractStream(backend, network, networkcontracts.INetworkMetaData, "MinDelayChange", filterer.ParseMinDelayChange)
+}
+
+// NameSet streams the name changes of a Network.
+func NameSet(backend Backend, network common.Address) (*EventStream[*networkcontracts.INetworkNameSet], error) {
+	filterer, err := networkcontracts.NewINetworkFilterer(network, backend)
+	if err != nil {
+		return nil, err
+	}
+	return contractStream(backend, network, networkcontracts.INetworkMetaData, "NameSet", filterer.ParseNameSet)
+}
+
+// MetadataURISet streams the metadata URI changes of a Network.
+func MetadataURISet(backend Backend, network common.Address) (*EventStream[*networkcontracts.INetworkMetadataURISet], error) {
+	filterer, err := networkcontracts.NewINetworkFilterer(network, backend)
+	if err != nil {
+		return nil, err
+	}
+	return contractStream(backend, network, networkcontracts.INetworkMetaData, "MetadataURISet", filterer.ParseMetadataURISet)
+}
+
+// GlobalMinDelayChange streams the global delay changes of a Network.
+func GlobalMinDelayChange(backend Backend, network common.Address) (*EventStream[*networkcontracts.TimelockControllerUpgradeableMinDelayChange], error) {
+	filterer, err := networkcontracts.NewTimelockControllerUpgradeableFilterer(network, backend)
+	if err != nil {
+		return nil, err
+	}
+	return contractStream(backend, network, networkcontracts.TimelockControllerUpgradeableMetaData, "MinDelayChange", filterer.ParseMinDelayChange)
+}
+
+// CallScheduled streams the calls scheduled on a Network.
+func CallScheduled(backend Backend, network common.Address) (*EventStream[*networkcontracts.TimelockControllerUpgradeableCallScheduled], error) {
+	filterer, err := networkcontracts.NewTimelockControllerUpgradeableFilterer(network, backend)
+	if err != nil {
+		return nil, err
+	}
+	return contractStream(backend, network, networkcontracts.TimelockControllerUpgradeableMetaData, "CallScheduled", filterer.ParseCallScheduled)
+}
+
+// CallSalt streams the salts of the operations scheduled on a Network.
+func CallSalt(backend Backend, network common.Address) (*EventStream[*networkcontracts.TimelockControllerUpgradeableCallSalt], error) {
+	filterer, err := networkcontracts.NewTimelockControllerUpgradeableFilterer(network, backend)
+	if err != nil {
+		return nil, err
+	}
+	return contractStream(backend, network, networkcontracts.TimelockControllerUpgradeableMetaData, "CallSalt", filterer.ParseCallSalt)
+}
+
+// CallExecuted streams the calls executed by a Network.
+func CallExecuted(backend Backend, network common.Address) (*EventStream[*networkcontracts.TimelockControllerUpgradeableCallExecuted], error) {
+	filterer, err := networkcontracts.NewTimelockControllerUpgradeableFilterer(network, backend)
+	if err != nil {
+		return nil, err
+	}
+	return contractStream(backend, network, networkcontracts.TimelockControllerUpgradeableMetaData, "CallExecuted", filterer.ParseCallExecuted)
+}
+
+// Cancelled streams the operations cancelled on a Network.
+func Cancelled(backend Backend, network common.Address) (*EventStream[*networkcontracts.TimelockControllerUpgradeableCancelled], error) {
+	filterer, err := networkcontracts.NewTimelockControllerUpgradeableFilterer(network, backend)
+	if err != nil {
+		return nil, err
+	}
+	return contractStream(backend, network, networkcontracts.TimelockControllerUpgradeableMetaData, "Cancelled", filterer.ParseCancelled)
+}
+
+// RoleGranted streams the roles granted on a Network.
+func RoleGranted(backend Backend, network common.Address) (*EventStream[*networkcontracts.TimelockControllerUpgradeableRoleGranted], error) {
+	filterer, err := networkcontracts.NewTimelockControllerUpgradeableFilterer(network, backend)
+	if err != nil {
+		return nil, err
+	}
+	return contractStream(backend, network, networkcontracts.TimelockControllerUpgradeableMetaData, "RoleGranted", filterer.ParseRoleGranted)
+}
+
+// RoleRevoked streams the roles revoked on a Network.
+func RoleRevoked(backend Backend, network common.Address) (*EventStream[*networkcontracts.TimelockControllerUpgradeableRoleRevoked], error) {
+	filterer, err := networkcontracts.NewTimelockControllerUpgradeableFilterer(network, backend)
+	if err != nil {
+		return nil, err
+	}
+	return contractStream(backend, network, networkcontracts.TimelockControllerUpgradeableMetaData, "RoleRevoked", filterer.ParseRoleRevoked)
+}
+
+// RoleAdminChanged streams the role admin changes of a Network.
+func RoleAdminChanged(backend Backend, network common.Address) (*EventStream[*networkcontracts.TimelockControllerUpgradeableRoleAdminChanged], error) {
+	filterer, err := networkcontracts.NewTimelockControllerUpgradeableFilterer(network, backend)
+	if err != nil {
+		return nil, err
+	}
+	return contractStream(backend, network, networkcontracts.TimelockControllerUpgradeableMetaData, "RoleAdminChanged", filterer.ParseRoleAdminChanged)
+}
@@ -0,0 +1,371 @@
+// Package events streams decoded contract events from a starting block,
+// delivering the historical logs first and then the live ones, in order and
+// without gaps or duplicates around the switchover.
+package events
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/symbioticfi/network/pkg/blockrange"
+)
+
+// maxBackoff caps the wait between attempts to restart an interrupted stream.
+const maxBackoff = time.Minute
+
+// Backend is the part of an Ethereum client used by a stream. Backends
+// without subscriptions, such as HTTP endpoints, are polled.
+type Backend interface {
+	ethereum.LogFilterer
+	BlockNumber(ctx context.Context) (uint64, error)
+}
+
+// Cursor is the position of a log in the chain.
+type Cursor struct {
+	BlockNumber uint64 `json:"blockNumber"`
+	LogIndex    uint   `json:"logIndex"`
+}
+
+// CursorOf returns the position of log.
+func CursorOf(log types.Log) Cursor {
+	return Cursor{BlockNumber: log.BlockNumber, LogIndex: log.Index}
+}
+
+// Before reports whether c comes before other.
+func (c Cursor) Before(other Cursor) bool {
+	if c.BlockNumber != other.BlockNumber {
+		return c.BlockNumber < other.BlockNumber
+	}
+	return c.LogIndex < other.LogIndex
+}
+
+// Next returns the position right after c. A stream started at c.Next()
+// resumes after the event at c.
+func (c Cursor) Next() Cursor {
+	return Cursor{BlockNumber: c.BlockNumber, LogIndex: c.LogIndex + 1}
+}
+
+func (c Cursor) String() string {
+	return fmt.Sprintf("%d:%d", c.BlockNumber, c.LogIndex)
+}
+
+// Event is a decoded log and its position.
+type Event[T any] struct {
+	Cursor
+	// Removed is set when a log delivered earlier was dropped by a reorg.
+	// The events of the new chain follow.
+	Removed bool `json:"removed,omitempty"`
+	Value   T    `json:"value"`
+}
+
+// EventStream delivers the logs matching a query as decoded events.
+type EventStream[T any] struct {
+	backend Backend
+	query   ethereum.FilterQuery
+	decode  func(types.Log) (T, error)
+
+	// Start is the position of the first event to deliver.
+	Start Cursor
+	// BlockRange is the number of blocks requested per eth_getLogs call.
+	BlockRange uint64
+	// Poll forces polling even when the backend supports subscriptions.
+	Poll bool
+	// PollInterval is the time between polls of backends without
+	// subscriptions.
+	PollInterval time.Duration
+	// ReorgDepth is the number of blocks below the head whose logs are read
+	// again on every poll. Logs that a reorg dropped from them are sent as
+	// removed, followed by the logs of the new chain. Zero disables the
+	// check. Subscriptions report reorgs themselves.
+	ReorgDepth uint64
+	// Buffer is the number of live logs held while the history is read.
+	Buffer int
+	// Logger receives interruptions.
+	Logger *slog.Logger
+}
+
+// New creates a stream of the logs matching query, decoded with decode. The
+// block range of query is ignored.
+func New[T any](backend Backend, query ethereum.FilterQuery, decode func(types.Log) (T, error)) *EventStream[T] {
+	query.FromBlock, query.ToBlock, query.BlockHash = nil, nil, nil
+	return &EventStream[T]{
+		backend:      backend,
+		query:        query,
+		decode:       decode,
+		BlockRange:   blockrange.DefaultSize,
+		PollInterval: 12 * time.Second,
+		ReorgDepth:   64,
+		Buffer:       256,
+		Logger:       slog.Default(),
+	}
+}
+
+// decodeError is a log the stream cannot decode. It stops the stream since
+// retrying cannot fix it.
+type decodeError struct {
+	cursor Cursor
+	err    error
+}
+
+func (e *decodeError) Error() string {
+	return fmt.Sprintf("events: decode log at %s: %v", e.cursor, e.err)
+}
+
+func (e *decodeError) Unwrap() error {
+	return e.err
+}
+
+// Run sends the events from Start to out until ctx is done or a log cannot be
+// decoded. Every event is sent once, in chain order. When the subscription
+// drops or a request fails, the stream restarts after the last delivered
+// event with an exponential backoff.
+func (s *EventStream[T]) Run(ctx context.Context, out chan<- Event[T]) error {
+	next := s.Start
+	// The logs of the Start block before Start were delivered by an earlier
+	// run and cannot be compared, so reorg checks begin at the next block.
+	w := &window{from: s.Start.BlockNumber}
+	if s.Start.LogIndex > 0 {
+		w.from++
+	}
+	backoff := time.Second
+	for {
+		from := next
+		err := s.run(ctx, &next, w, out)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		var decodeErr *decodeError
+		if errors.As(err, &decodeErr) {
+			return err
+		}
+		if from.Before(next) {
+			backoff = time.Second
+		}
+		s.Logger.Warn("event stream interrupted", "next", next, "retry", backoff, "err", err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff = min(2*backoff, maxBackoff)
+	}
+}
+
+// run backfills from next and then follows the chain until an error. next
+// is the position of the first event not delivered yet and w the logs
+// delivered in the last blocks when polling.
+func (s *EventStream[T]) run(ctx context.Context, next *Cursor, w *window, out chan<- Event[T]) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Subscribing before reading the head leaves no gap: logs of blocks
+	// after the head arrive through the subscription and the ones up to the
+	// head, which may arrive through both, are skipped by their cursor.
+	var sub ethereum.Subscription
+	live := make(chan types.Log, s.Buffer)
+	if !s.Poll {
+		var err error
+		sub, err = s.backend.SubscribeFilterLogs(ctx, s.query, live)
+		switch {
+		case errors.Is(err, rpc.ErrNotificationsUnsupported):
+			sub = nil
+		case err != nil:
+			return fmt.Errorf("events: subscribe: %w", err)
+		default:
+			defer sub.Unsubscribe()
+		}
+	}
+
+	if sub != nil {
+		w = nil
+	}
+	head, err := s.backend.BlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+	if err := s.backfill(ctx, next, head, w, out); err != nil {
+		return err
+	}
+	if sub == nil {
+		return s.poll(ctx, next, w, out)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-sub.Err():
+			if err == nil {
+				err = errors.New("subscription closed")
+			}
+			return fmt.Errorf("events: subscription: %w", err)
+		case log := <-live:
+			if err := s.deliver(ctx, next, log, out); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// poll reads the new blocks every PollInterval, after checking the last
+// ReorgDepth blocks for reorgs.
+func (s *EventStream[T]) poll(ctx context.Context, next *Cursor, w *window, out chan<- Event[T]) error {
+	ticker := time.NewTicker(s.PollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+		head, err := s.backend.BlockNumber(ctx)
+		if err != nil {
+			return err
+		}
+		if err := s.reorg(ctx, next, w, out); err != nil {
+			return err
+		}
+		if err := s.backfill(ctx, next, head, w, out); err != nil {
+			return err
+		}
+	}
+}
+
+// window is the logs delivered from block from on, kept to detect reorgs
+// when polling.
+type window struct {
+	from uint64
+	logs []types.Log
+}
+
+// prune drops the logs more than depth blocks below head.
+func (w *window) prune(head, depth uint64) {
+	if head+1 > depth {
+		w.from = max(w.from, head+1-depth)
+	}
+	i := 0
+	for i < len(w.logs) && w.logs[i].BlockNumber < w.from {
+		i++
+	}
+	w.logs = w.logs[i:]
+}
+
+// sameLog reports whether a and b are the same log of the same block.
+func sameLog(a, b types.Log) bool {
+	return a.BlockHash == b.BlockHash && a.TxHash == b.TxHash && a.Index == b.Index
+}
+
+// reorg reads the logs of the blocks of w again. When they differ from the
+// delivered ones, the delivered logs from the first difference on are sent
+// as removed, latest first, and next moves back to the first difference so
+// that the next backfill delivers the new chain.
+func (s *EventStream[T]) reorg(ctx context.Context, next *Cursor, w *window, out chan<- Event[T]) error {
+	if s.ReorgDepth == 0 || next.BlockNumber <= w.from {
+		return nil
+	}
+	query := s.query
+	query.FromBlock, query.ToBlock = new(big.Int).SetUint64(w.from), new(big.Int).SetUint64(next.BlockNumber-1)
+	logs, err := s.backend.FilterLogs(ctx, query)
+	if err != nil {
+		return fmt.Errorf("events: logs of blocks %d-%d: %w", w.from, next.BlockNumber-1, err)
+	}
+	var current []types.Log
+	for _, log := range logs {
+		if !log.Removed && CursorOf(log).Before(*next) {
+			current = append(current, log)
+		}
+	}
+	i := 0
+	for i < len(w.logs) && i < len(current) && sameLog(w.logs[i], current[i]) {
+		i++
+	}
+	if i == len(w.logs) && i == len(current) {
+		return nil
+	}
+	s.Logger.Warn("chain reorganized", "from", w.from, "dropped", len(w.logs)-i)
+	for j := len(w.logs) - 1; j >= i; j-- {
+		log := w.logs[j]
+		log.Removed = true
+		if err := s.deliver(ctx, next, log, out); err != nil {
+			return err
+		}
+		w.logs = w.logs[:j]
+	}
+	if i < len(current) {
+		if cursor := CursorOf(current[i]); cursor.Before(*next) {
+			*next = cursor
+		}
+	}
+	return nil
+}
+
+// backfill delivers the logs from next up to and including head, then moves
+// next past head. The delivered logs are recorded in w, unless it is nil.
+func (s *EventStream[T]) backfill(ctx context.Context, next *Cursor, head uint64, w *window, out chan<- Event[T]) error {
+	if head < next.BlockNumber {
+		return nil
+	}
+	for _, r := range blockrange.Split(next.BlockNumber, head, s.BlockRange) {
+		query := s.query
+		query.FromBlock, query.ToBlock = new(big.Int).SetUint64(r.From), new(big.Int).SetUint64(r.To)
+		logs, err := s.backend.FilterLogs(ctx, query)
+		if err != nil {
+			return fmt.Errorf("events: logs of blocks %d-%d: %w", r.From, r.To, err)
+		}
+		for _, log := range logs {
+			if log.Removed {
+				continue
+			}
+			fresh := !CursorOf(log).Before(*next)
+			if err := s.deliver(ctx, next, log, out); err != nil {
+				return err
+			}
+			if fresh && w != nil {
+				w.logs = append(w.logs, log)
+			}
+		}
+		if end := (Cursor{BlockNumber: r.To + 1}); next.Before(end) {
+			*next = end
+		}
+		if w != nil {
+			w.prune(r.To, s.ReorgDepth)
+		}
+	}
+	return nil
+}
+
+// deliver decodes log and sends it unless it was delivered already. A
+// removed log that was delivered is sent again as removed and moves next
+// back to it, so that the logs replacing it are delivered.
+func (s *EventStream[T]) deliver(ctx context.Context, next *Cursor, log types.Log, out chan<- Event[T]) error {
+	cursor := CursorOf(log)
+	if log.Removed {
+		if !cursor.Before(*next) {
+			return nil
+		}
+		*next = cursor
+	} else if cursor.Before(*next) {
+		return nil
+	}
+	value, err := s.decode(log)
+	if err != nil {
+		return &decodeError{cursor: cursor, err: err}
+	}
+	select {
+	case out <- Event[T]{Cursor: cursor, Removed: log.Removed, Value: value}:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	if !log.Removed {
+		*next = cursor.Next()
+	}
+	return nil
+}
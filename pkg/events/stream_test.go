@@ -0,0 +1,239 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// fakeChain serves the logs of a chain that tests can reorganize. With live
+// set, it supports subscriptions and sends live to each subscriber.
+type fakeChain struct {
+	mu   sync.Mutex
+	head uint64
+	logs []types.Log
+	live []types.Log
+}
+
+func (f *fakeChain) BlockNumber(context.Context) (uint64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.head, nil
+}
+
+func (f *fakeChain) FilterLogs(_ context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var logs []types.Log
+	for _, log := range f.logs {
+		if log.BlockNumber >= query.FromBlock.Uint64() && log.BlockNumber <= query.ToBlock.Uint64() {
+			logs = append(logs, log)
+		}
+	}
+	return logs, nil
+}
+
+func (f *fakeChain) SubscribeFilterLogs(_ context.Context, _ ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	if f.live == nil {
+		return nil, rpc.ErrNotificationsUnsupported
+	}
+	for _, log := range f.live {
+		ch <- log
+	}
+	return &fakeSubscription{err: make(chan error)}, nil
+}
+
+// reorg replaces the logs from block on with logs and moves the head.
+func (f *fakeChain) reorg(block, head uint64, logs ...types.Log) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	kept := f.logs[:0]
+	for _, log := range f.logs {
+		if log.BlockNumber < block {
+			kept = append(kept, log)
+		}
+	}
+	f.logs, f.head = append(kept, logs...), head
+}
+
+type fakeSubscription struct{ err chan error }
+
+func (s *fakeSubscription) Unsubscribe()      {}
+func (s *fakeSubscription) Err() <-chan error { return s.err }
+
+// newLog returns the log at block:index of the chain fork.
+func newLog(block uint64, index uint, fork byte) types.Log {
+	return types.Log{
+		BlockNumber: block,
+		Index:       index,
+		BlockHash:   common.BytesToHash([]byte{fork, byte(block)}),
+		TxHash:      common.BytesToHash([]byte{fork, byte(block), byte(index)}),
+	}
+}
+
+type got struct {
+	cursor  Cursor
+	removed bool
+	fork    byte
+}
+
+// collect runs a stream over chain from start and returns its first n
+// events. act is called after the first k events.
+func collect(t *testing.T, chain *fakeChain, start Cursor, n, k int, act func()) []got {
+	t.Helper()
+	stream := New(chain, ethereum.FilterQuery{}, func(log types.Log) (types.Log, error) { return log, nil })
+	stream.Start = start
+	stream.Poll = chain.live == nil
+	stream.PollInterval = time.Millisecond
+	stream.BlockRange = 2
+	stream.Logger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	out := make(chan Event[types.Log])
+	done := make(chan error, 1)
+	go func() { done <- stream.Run(ctx, out) }()
+
+	var events []got
+	for len(events) < n {
+		select {
+		case e := <-out:
+			events = append(events, got{e.Cursor, e.Removed, e.Value.BlockHash[30]})
+			if len(events) == k && act != nil {
+				act()
+			}
+		case err := <-done:
+			t.Fatalf("Run() = %v after %v", err, events)
+		}
+	}
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Fatalf("Run() = %v", err)
+	}
+	return events
+}
+
+func TestEventStream(t *testing.T) {
+	a := byte(1)
+	b := byte(2)
+	tests := []struct {
+		name  string
+		chain *fakeChain
+		start Cursor
+		k     int
+		act   func(chain *fakeChain)
+		want  []got
+	}{
+		{
+			name:  "history from start",
+			chain: &fakeChain{head: 5, logs: []types.Log{newLog(1, 0, a), newLog(3, 0, a), newLog(3, 1, a), newLog(5, 0, a)}},
+			start: Cursor{BlockNumber: 3, LogIndex: 1},
+			want:  []got{{Cursor{3, 1}, false, a}, {Cursor{5, 0}, false, a}},
+		},
+		{
+			name: "subscription overlapping the history",
+			chain: &fakeChain{
+				head: 4,
+				logs: []types.Log{newLog(2, 0, a), newLog(4, 0, a)},
+				live: []types.Log{newLog(4, 0, a), newLog(5, 0, a), newLog(4, 0, a), newLog(6, 0, a)},
+			},
+			want: []got{{Cursor{2, 0}, false, a}, {Cursor{4, 0}, false, a}, {Cursor{5, 0}, false, a}, {Cursor{6, 0}, false, a}},
+		},
+		{
+			name: "subscription reorg",
+			chain: &fakeChain{
+				head: 2,
+				logs: []types.Log{newLog(2, 0, a)},
+				live: []types.Log{newLog(3, 0, a), func() types.Log { l := newLog(3, 0, a); l.Removed = true; return l }(), newLog(3, 0, b)},
+			},
+			want: []got{{Cursor{2, 0}, false, a}, {Cursor{3, 0}, false, a}, {Cursor{3, 0}, true, a}, {Cursor{3, 0}, false, b}},
+		},
+		{
+			name:  "polled new blocks",
+			chain: &fakeChain{head: 2, logs: []types.Log{newLog(1, 0, a)}},
+			k:     1,
+			act: func(chain *fakeChain) {
+				chain.reorg(3, 4, newLog(3, 0, a), newLog(4, 0, a))
+			},
+			want: []got{{Cursor{1, 0}, false, a}, {Cursor{3, 0}, false, a}, {Cursor{4, 0}, false, a}},
+		},
+		{
+			name:  "polled reorg replacing logs",
+			chain: &fakeChain{head: 3, logs: []types.Log{newLog(1, 0, a), newLog(2, 0, a), newLog(3, 0, a), newLog(3, 1, a)}},
+			k:     4,
+			act: func(chain *fakeChain) {
+				chain.reorg(2, 4, newLog(2, 0, b), newLog(4, 0, b))
+			},
+			want: []got{
+				{Cursor{1, 0}, false, a}, {Cursor{2, 0}, false, a}, {Cursor{3, 0}, false, a}, {Cursor{3, 1}, false, a},
+				{Cursor{3, 1}, true, a}, {Cursor{3, 0}, true, a}, {Cursor{2, 0}, true, a},
+				{Cursor{2, 0}, false, b}, {Cursor{4, 0}, false, b},
+			},
+		},
+		{
+			name:  "polled reorg adding a log to a passed block",
+			chain: &fakeChain{head: 3, logs: []types.Log{newLog(1, 0, a), newLog(3, 0, a)}},
+			k:     2,
+			act: func(chain *fakeChain) {
+				chain.reorg(2, 3, newLog(2, 0, b), newLog(3, 0, b))
+			},
+			want: []got{{Cursor{1, 0}, false, a}, {Cursor{3, 0}, false, a}, {Cursor{3, 0}, true, a}, {Cursor{2, 0}, false, b}, {Cursor{3, 0}, false, b}},
+		},
+		{
+			name:  "polled reorg after a start mid-block",
+			chain: &fakeChain{head: 3, logs: []types.Log{newLog(2, 0, a), newLog(2, 1, a), newLog(3, 0, a)}},
+			start: Cursor{BlockNumber: 2, LogIndex: 1},
+			k:     2,
+			act: func(chain *fakeChain) {
+				chain.reorg(3, 4, newLog(3, 0, b))
+			},
+			want: []got{{Cursor{2, 1}, false, a}, {Cursor{3, 0}, false, a}, {Cursor{3, 0}, true, a}, {Cursor{3, 0}, false, b}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var act func()
+			if tt.act != nil {
+				act = func() { tt.act(tt.chain) }
+			}
+			events := collect(t, tt.chain, tt.start, len(tt.want), tt.k, act)
+			for i := range tt.want {
+				if events[i] != tt.want[i] {
+					t.Fatalf("events = %v, want %v", events, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestEventStreamDecodeError(t *testing.T) {
+	chain := &fakeChain{head: 1, logs: []types.Log{newLog(1, 0, 1)}}
+	stream := New(chain, ethereum.FilterQuery{}, func(types.Log) (any, error) { return nil, errors.New("unknown event") })
+	stream.Poll = true
+	err := stream.Run(context.Background(), make(chan Event[any], 1))
+	var decodeErr *decodeError
+	if !errors.As(err, &decodeErr) || decodeErr.cursor != (Cursor{1, 0}) {
+		t.Fatalf("Run() = %v, want a decode error at 1:0", err)
+	}
+}
+
+func TestWindowPrune(t *testing.T) {
+	w := &window{from: 1, logs: []types.Log{newLog(1, 0, 1), newLog(5, 0, 1), newLog(8, 0, 1)}}
+	w.prune(9, 4)
+	if w.from != 6 || len(w.logs) != 1 || w.logs[0].BlockNumber != 8 {
+		t.Errorf("prune(9, 4) = from %d, %d logs, want from 6, 1 log", w.from, len(w.logs))
+	}
+	w.prune(2, 64)
+	if w.from != 6 {
+		t.Errorf("prune below the depth moved from to %d", w.from)
+	}
+}
@@ -0,0 +1,352 @@
+// Package exporter exposes the timelock health of one or more Networks as
+// Prometheus metrics.
+//
+// Every poll scans the logs emitted since the previous poll, so the first poll
+// catches up from each Network's start block and later polls only read new
+// blocks. Operation states and delays are read at the head block, which for a
+// quorum.Backend is the highest block every endpoint in sync has.
+package exporter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"strings"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/prometheus/client_golang/prometheus"
+
+	networkcontracts "github.com/symbioticfi/network/bindings/go-go-ethereum"
+	"github.com/symbioticfi/network/pkg/blockrange"
+	"github.com/symbioticfi/network/pkg/roles"
+	"github.com/symbioticfi/network/pkg/timelock"
+)
+
+// Target is a Network to export.
+type Target struct {
+	Network common.Address
+	// FromBlock is the first block to scan, normally the deployment block.
+	FromBlock uint64
+}
+
+// Exporter polls Networks and updates their metrics.
+type Exporter struct {
+	backend timelock.Backend
+	metrics *metrics
+	targets []*target
+
+	// Interval is the time between polls in Run.
+	Interval time.Duration
+	// BlockRange is the number of blocks requested per eth_getLogs call.
+	BlockRange uint64
+	// Logger receives poll failures.
+	Logger *slog.Logger
+}
+
+// target is the state accumulated for a single Network.
+type target struct {
+	address  common.Address
+	label    string
+	next     uint64
+	index    *timelock.Index
+	roles    *roles.Indexer
+	network  *networkcontracts.INetworkFilterer
+	timelock *networkcontracts.TimelockControllerUpgradeableCaller
+
+	pending       map[common.Hash]*timelock.Scheduled
+	roleSnapshot  *roles.Snapshot
+	roleLabels    map[string]bool
+	selectorDelay map[[2]string]bool
+}
+
+// New creates an Exporter for the targets and registers its metrics with
+// registerer.
+func New(backend timelock.Backend, registerer prometheus.Registerer, targets []Target) (*Exporter, error) {
+	m, err := newMetrics(registerer)
+	if err != nil {
+		return nil, err
+	}
+	e := &Exporter{
+		backend:    backend,
+		metrics:    m,
+		Interval:   15 * time.Second,
+		BlockRange: blockrange.DefaultSize,
+		Logger:     slog.Default(),
+	}
+	for _, t := range targets {
+		index, err := timelock.NewIndex(t.Network, backend)
+		if err != nil {
+			return nil, err
+		}
+		indexer, err := roles.NewIndexer(t.Network, backend)
+		if err != nil {
+			return nil, err
+		}
+		network, err := networkcontracts.NewINetworkFilterer(t.Network, backend)
+		if err != nil {
+			return nil, err
+		}
+		caller, err := networkcontracts.NewTimelockControllerUpgradeableCaller(t.Network, backend)
+		if err != nil {
+			return nil, err
+		}
+		e.targets = append(e.targets, &target{
+			address:       t.Network,
+			label:         t.Network.Hex(),
+			next:          t.FromBlock,
+			index:         index,
+			roles:         indexer,
+			network:       network,
+			timelock:      caller,
+			pending:       make(map[common.Hash]*timelock.Scheduled),
+			roleSnapshot:  roles.NewSnapshot(),
+			roleLabels:    make(map[string]bool),
+			selectorDelay: make(map[[2]string]bool),
+		})
+	}
+	return e, nil
+}
+
+// Run polls every Interval until ctx is done. Poll failures are logged and
+// counted; the failed blocks are scanned again by the next poll.
+func (e *Exporter) Run(ctx context.Context) error {
+	ticker := time.NewTicker(e.Interval)
+	defer ticker.Stop()
+	for {
+		e.Poll(ctx)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll updates the metrics of every Network once and returns the number of
+// Networks that failed. A Network whose poll reads a block the backend does
+// not have yet is counted as lagging rather than failed, and polled again at
+// the next interval.
+func (e *Exporter) Poll(ctx context.Context) int {
+	failed := 0
+	for _, t := range e.targets {
+		err := e.poll(ctx, t)
+		if unknownBlock(err) {
+			e.metrics.pollLags.WithLabelValues(t.label).Inc()
+			e.Logger.Warn("backend lags, polling again next interval", "network", t.label, "err", err)
+			continue
+		}
+		if err != nil {
+			failed++
+			e.metrics.pollErrors.WithLabelValues(t.label).Inc()
+			e.Logger.Error("poll failed", "network", t.label, "err", err)
+			continue
+		}
+		e.metrics.lastSuccessfulPoll.WithLabelValues(t.label).SetToCurrentTime()
+	}
+	return failed
+}
+
+// headReader is implemented by backends that pick a head every endpoint
+// behind them has, such as a *quorum.Backend.
+type headReader interface {
+	Head(ctx context.Context) (*types.Header, error)
+}
+
+// head returns the block to poll up to.
+func (e *Exporter) head(ctx context.Context) (*types.Header, error) {
+	if r, ok := e.backend.(headReader); ok {
+		return r.Head(ctx)
+	}
+	return e.backend.HeaderByNumber(ctx, nil)
+}
+
+// unknownBlock reports whether err is an endpoint not having a block yet,
+// which load-balanced and quorum backends return while their endpoints catch
+// up with each other.
+func unknownBlock(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, ethereum.NotFound) {
+		return true
+	}
+	msg := err.Error()
+	return strings.Contains(msg, "header not found") || strings.Contains(msg, "unknown block")
+}
+
+func (e *Exporter) poll(ctx context.Context, t *target) error {
+	header, err := e.head(ctx)
+	if err != nil {
+		return fmt.Errorf("exporter: get head: %w", err)
+	}
+	head := header.Number.Uint64()
+	t.index.BlockRange = e.BlockRange
+	t.roles.BlockRange = e.BlockRange
+
+	fresh := make(map[common.Hash]bool)
+	if t.next <= head {
+		scheduled, err := t.index.Scheduled(ctx, t.next, head)
+		if err != nil {
+			return err
+		}
+		for _, op := range scheduled {
+			fresh[op.ID] = true
+			t.pending[op.ID] = op
+		}
+		changes, err := t.roles.Changes(ctx, t.next, head)
+		if err != nil {
+			return fmt.Errorf("exporter: %w", err)
+		}
+		configChanges, err := e.configChanges(ctx, t, t.next, head)
+		if err != nil {
+			return err
+		}
+		// Only apply the scanned logs once every scan of the range succeeded,
+		// so a failed poll can rescan the range without counting twice.
+		t.roleSnapshot.Apply(changes, head)
+		for _, change := range configChanges {
+			e.applyConfigChange(t, change)
+		}
+		t.next = head + 1
+	}
+
+	counts := make(map[timelock.OperationState]int)
+	var nextReady *uint64
+	for id, op := range t.pending {
+		if !fresh[id] {
+			if err := t.index.Refresh(ctx, op, head); err != nil {
+				return err
+			}
+		}
+		if !op.State.Pending() {
+			delete(t.pending, id)
+			continue
+		}
+		counts[op.State]++
+		if op.State == timelock.Waiting && (nextReady == nil || op.ReadyAt < *nextReady) {
+			readyAt := op.ReadyAt
+			nextReady = &readyAt
+		}
+	}
+	for _, state := range []timelock.OperationState{timelock.Waiting, timelock.Ready} {
+		e.metrics.pendingOperations.WithLabelValues(t.label, state.String()).Set(float64(counts[state]))
+	}
+	if nextReady == nil {
+		e.metrics.nextReady.DeleteLabelValues(t.label)
+	} else {
+		e.metrics.nextReady.WithLabelValues(t.label).Set(float64(int64(*nextReady) - int64(header.Time)))
+	}
+
+	minDelay, err := t.timelock.GetMinDelay(&bind.CallOpts{Context: ctx, BlockNumber: header.Number})
+	if err != nil {
+		return fmt.Errorf("exporter: getMinDelay: %w", err)
+	}
+	e.metrics.minDelay.WithLabelValues(t.label).Set(toFloat(minDelay))
+
+	seen := make(map[string]bool)
+	for _, role := range t.roleSnapshot.Roles() {
+		name := roles.Name(role)
+		seen[name] = true
+		e.metrics.roleMembers.WithLabelValues(t.label, name).Set(float64(len(t.roleSnapshot.Members[role])))
+	}
+	for name := range t.roleLabels {
+		if !seen[name] {
+			e.metrics.roleMembers.DeleteLabelValues(t.label, name)
+		}
+	}
+	t.roleLabels = seen
+
+	e.metrics.lastBlock.WithLabelValues(t.label).Set(float64(head))
+	return nil
+}
+
+// configChange is a MinDelayChange, NameSet or MetadataURISet event.
+type configChange struct {
+	event string
+	// delay is set for MinDelayChange events of a target and selector.
+	delay *networkcontracts.INetworkMinDelayChange
+}
+
+// configChanges returns the configuration events emitted in [from, to].
+func (e *Exporter) configChanges(ctx context.Context, t *target, from, to uint64) ([]configChange, error) {
+	events := networkABI.Events
+	topics := []common.Hash{
+		events["MinDelayChange"].ID,
+		events["NameSet"].ID,
+		events["MetadataURISet"].ID,
+		timelock.ABI.Events["MinDelayChange"].ID,
+	}
+	var changes []configChange
+	for _, r := range blockrange.Split(from, to, e.BlockRange) {
+		logs, err := e.backend.FilterLogs(ctx, ethereum.FilterQuery{
+			FromBlock: new(big.Int).SetUint64(r.From),
+			ToBlock:   new(big.Int).SetUint64(r.To),
+			Addresses: []common.Address{t.address},
+			Topics:    [][]common.Hash{topics},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("exporter: filter logs in blocks %d-%d: %w", r.From, r.To, err)
+		}
+		for _, log := range logs {
+			if log.Removed || len(log.Topics) == 0 {
+				continue
+			}
+			switch log.Topics[0] {
+			case events["MinDelayChange"].ID:
+				event, err := t.network.ParseMinDelayChange(log)
+				if err != nil {
+					return nil, err
+				}
+				changes = append(changes, configChange{event: "MinDelayChange", delay: event})
+			case events["NameSet"].ID:
+				changes = append(changes, configChange{event: "NameSet"})
+			case events["MetadataURISet"].ID:
+				changes = append(changes, configChange{event: "MetadataURISet"})
+			case timelock.ABI.Events["MinDelayChange"].ID:
+				// The global delay is read directly; the change is only counted.
+				changes = append(changes, configChange{event: "MinDelayChange"})
+			}
+		}
+	}
+	return changes, nil
+}
+
+// applyConfigChange counts change and tracks the enabled selector delays.
+func (e *Exporter) applyConfigChange(t *target, change configChange) {
+	e.metrics.configChanges.WithLabelValues(t.label, change.event).Inc()
+	if change.delay == nil {
+		return
+	}
+	key := [2]string{change.delay.Target.Hex(), hexutil.Encode(change.delay.Selector[:])}
+	if change.delay.NewEnabledStatus {
+		e.metrics.selectorDelay.WithLabelValues(t.label, key[0], key[1]).Set(toFloat(change.delay.NewDelay))
+		t.selectorDelay[key] = true
+	} else if t.selectorDelay[key] {
+		e.metrics.selectorDelay.DeleteLabelValues(t.label, key[0], key[1])
+		delete(t.selectorDelay, key)
+	}
+}
+
+// networkABI is the parsed INetwork ABI.
+var networkABI = mustParseNetworkABI()
+
+func mustParseNetworkABI() *abi.ABI {
+	parsed, err := networkcontracts.INetworkMetaData.GetAbi()
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
+
+func toFloat(x *big.Int) float64 {
+	f, _ := new(big.Float).SetInt(x).Float64()
+	return f
+}
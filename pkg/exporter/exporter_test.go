@@ -0,0 +1,314 @@
+package exporter
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"math/big"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/symbioticfi/network/pkg/roles"
+	"github.com/symbioticfi/network/pkg/timelock"
+)
+
+var (
+	network  = common.HexToAddress("0x7e70000000000000000000000000000000000000")
+	alice    = common.HexToAddress("0xa11ce00000000000000000000000000000000000")
+	bob      = common.HexToAddress("0xb0b0000000000000000000000000000000000000")
+	vault    = common.HexToAddress("0x5a01700000000000000000000000000000000000")
+	selector = [4]byte{0x12, 0x34, 0x56, 0x78}
+)
+
+// fakeChain is a Network at head whose operations have the given states and
+// ready timestamps. Methods other than the ones below panic.
+type fakeChain struct {
+	timelock.Backend
+
+	head     uint64
+	headTime uint64
+	headErr  error
+	logs     []types.Log
+	states   map[common.Hash]timelock.OperationState
+	readyAt  map[common.Hash]int64
+	minDelay int64
+	callErr  error
+	// from is the lowest block of the log queries since the last reset.
+	from uint64
+}
+
+func (f *fakeChain) HeaderByNumber(_ context.Context, number *big.Int) (*types.Header, error) {
+	if f.headErr != nil {
+		return nil, f.headErr
+	}
+	if number == nil {
+		return &types.Header{Number: new(big.Int).SetUint64(f.head), Time: f.headTime}, nil
+	}
+	return &types.Header{Number: number, Time: f.headTime - 12*(f.head-number.Uint64())}, nil
+}
+
+func (f *fakeChain) FilterLogs(_ context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	f.from = min(f.from, query.FromBlock.Uint64())
+	var logs []types.Log
+	for _, log := range f.logs {
+		if log.BlockNumber < query.FromBlock.Uint64() || log.BlockNumber > query.ToBlock.Uint64() {
+			continue
+		}
+		for _, topic := range query.Topics[0] {
+			if log.Topics[0] == topic {
+				logs = append(logs, log)
+			}
+		}
+	}
+	return logs, nil
+}
+
+func (f *fakeChain) CodeAt(context.Context, common.Address, *big.Int) ([]byte, error) {
+	return []byte{1}, nil
+}
+
+func (f *fakeChain) CallContract(_ context.Context, call ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	method, err := timelock.ABI.MethodById(call.Data[:4])
+	if err != nil {
+		return nil, err
+	}
+	args, err := method.Inputs.Unpack(call.Data[4:])
+	if err != nil {
+		return nil, err
+	}
+	switch method.Name {
+	case "getOperationState":
+		return method.Outputs.Pack(uint8(f.states[args[0].([32]byte)]))
+	case "getTimestamp":
+		return method.Outputs.Pack(big.NewInt(f.readyAt[args[0].([32]byte)]))
+	case "getMinDelay":
+		if f.callErr != nil {
+			return nil, f.callErr
+		}
+		return method.Outputs.Pack(big.NewInt(f.minDelay))
+	}
+	return nil, errors.New("unexpected call")
+}
+
+// headChain picks the polled head itself, like a quorum.Backend.
+type headChain struct {
+	*fakeChain
+	headErr error
+}
+
+func (h *headChain) Head(ctx context.Context) (*types.Header, error) {
+	if h.headErr != nil {
+		return nil, h.headErr
+	}
+	return h.HeaderByNumber(ctx, nil)
+}
+
+// eventLog returns the log of the event name of parsed with args, in order,
+// at block:index.
+func eventLog(t *testing.T, parsed *abi.ABI, block uint64, index uint, name string, args ...any) types.Log {
+	t.Helper()
+	event := parsed.Events[name]
+	var (
+		indexed    [][]any
+		nonIndexed abi.Arguments
+		data       []any
+	)
+	for i, input := range event.Inputs {
+		if input.Indexed {
+			indexed = append(indexed, []any{args[i]})
+		} else {
+			nonIndexed = append(nonIndexed, input)
+			data = append(data, args[i])
+		}
+	}
+	topics, err := abi.MakeTopics(indexed...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	packed, err := nonIndexed.Pack(data...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	log := types.Log{
+		Address:     network,
+		Topics:      []common.Hash{event.ID},
+		Data:        packed,
+		BlockNumber: block,
+		TxHash:      common.Hash{byte(block), byte(index)},
+		Index:       index,
+	}
+	for _, topic := range topics {
+		log.Topics = append(log.Topics, topic[0])
+	}
+	return log
+}
+
+func scheduledLog(t *testing.T, block uint64, index uint, id common.Hash) types.Log {
+	t.Helper()
+	return eventLog(t, timelock.ABI, block, index, "CallScheduled", id, big.NewInt(0), vault, big.NewInt(0), []byte{0x01}, common.Hash{}, big.NewInt(3600))
+}
+
+func newExporter(t *testing.T, backend timelock.Backend) *Exporter {
+	t.Helper()
+	e, err := New(backend, prometheus.NewRegistry(), []Target{{Network: network, FromBlock: 1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	e.BlockRange = 4
+	e.Logger = slog.New(slog.NewTextHandler(io.Discard, nil))
+	return e
+}
+
+func gauge(t *testing.T, vec *prometheus.GaugeVec, labels ...string) float64 {
+	t.Helper()
+	return testutil.ToFloat64(vec.WithLabelValues(append([]string{network.Hex()}, labels...)...))
+}
+
+func TestPoll(t *testing.T) {
+	waiting, ready := common.Hash{0x0a}, common.Hash{0x0b}
+	chain := &fakeChain{
+		head:     10,
+		headTime: 1000,
+		logs: []types.Log{
+			scheduledLog(t, 2, 0, waiting),
+			scheduledLog(t, 2, 1, ready),
+			eventLog(t, timelock.ABI, 3, 0, "RoleGranted", roles.ProposerRole, alice, network),
+			eventLog(t, timelock.ABI, 3, 1, "RoleGranted", roles.ProposerRole, bob, network),
+			eventLog(t, timelock.ABI, 3, 2, "RoleGranted", roles.ExecutorRole, alice, network),
+			eventLog(t, networkABI, 4, 0, "MinDelayChange", vault, selector, false, big.NewInt(0), true, big.NewInt(3600)),
+			eventLog(t, networkABI, 4, 1, "NameSet", "network"),
+		},
+		states:   map[common.Hash]timelock.OperationState{waiting: timelock.Waiting, ready: timelock.Ready},
+		readyAt:  map[common.Hash]int64{waiting: 1300, ready: 900},
+		minDelay: 86400,
+		from:     ^uint64(0),
+	}
+	e := newExporter(t, chain)
+	m := e.metrics
+	selectorLabels := []string{vault.Hex(), "0x12345678"}
+
+	if failed := e.Poll(context.Background()); failed != 0 {
+		t.Fatalf("Poll() = %d failed", failed)
+	}
+	for _, c := range []struct {
+		name string
+		got  float64
+		want float64
+	}{
+		{"waiting operations", gauge(t, m.pendingOperations, "Waiting"), 1},
+		{"ready operations", gauge(t, m.pendingOperations, "Ready"), 1},
+		{"next ready", gauge(t, m.nextReady), 300},
+		{"min delay", gauge(t, m.minDelay), 86400},
+		{"selector delay", gauge(t, m.selectorDelay, selectorLabels...), 3600},
+		{"proposers", gauge(t, m.roleMembers, "PROPOSER_ROLE"), 2},
+		{"executors", gauge(t, m.roleMembers, "EXECUTOR_ROLE"), 1},
+		{"delay changes", testutil.ToFloat64(m.configChanges.WithLabelValues(network.Hex(), "MinDelayChange")), 1},
+		{"name changes", testutil.ToFloat64(m.configChanges.WithLabelValues(network.Hex(), "NameSet")), 1},
+		{"last block", gauge(t, m.lastBlock), 10},
+	} {
+		if c.got != c.want {
+			t.Errorf("first poll: %s = %v, want %v", c.name, c.got, c.want)
+		}
+	}
+
+	// The waiting operation is executed, bob loses the proposer role and the
+	// selector delay is disabled.
+	chain.head, chain.headTime, chain.from = 12, 1200, ^uint64(0)
+	chain.states[waiting] = timelock.Done
+	chain.logs = append(chain.logs,
+		eventLog(t, timelock.ABI, 11, 0, "RoleRevoked", roles.ProposerRole, bob, network),
+		eventLog(t, networkABI, 12, 0, "MinDelayChange", vault, selector, true, big.NewInt(3600), false, big.NewInt(0)),
+	)
+	if failed := e.Poll(context.Background()); failed != 0 {
+		t.Fatalf("Poll() = %d failed", failed)
+	}
+	if chain.from != 11 {
+		t.Errorf("second poll read logs from block %d, want 11", chain.from)
+	}
+	for _, c := range []struct {
+		name string
+		got  float64
+		want float64
+	}{
+		{"waiting operations", gauge(t, m.pendingOperations, "Waiting"), 0},
+		{"ready operations", gauge(t, m.pendingOperations, "Ready"), 1},
+		{"proposers", gauge(t, m.roleMembers, "PROPOSER_ROLE"), 1},
+		{"executors", gauge(t, m.roleMembers, "EXECUTOR_ROLE"), 1},
+		{"delay changes", testutil.ToFloat64(m.configChanges.WithLabelValues(network.Hex(), "MinDelayChange")), 2},
+		{"last block", gauge(t, m.lastBlock), 12},
+	} {
+		if c.got != c.want {
+			t.Errorf("second poll: %s = %v, want %v", c.name, c.got, c.want)
+		}
+	}
+	// Series without a value are removed rather than left at their last one.
+	if n := testutil.CollectAndCount(m.nextReady); n != 0 {
+		t.Errorf("next ready series = %d, want none without a Waiting operation", n)
+	}
+	if n := testutil.CollectAndCount(m.selectorDelay); n != 0 {
+		t.Errorf("selector delay series = %d, want none once disabled", n)
+	}
+}
+
+func TestPollFailures(t *testing.T) {
+	newChain := func() *fakeChain {
+		return &fakeChain{head: 10, headTime: 1000, from: ^uint64(0)}
+	}
+	tests := []struct {
+		name    string
+		backend func(chain *fakeChain) timelock.Backend
+		failed  int
+		errors  float64
+		lags    float64
+	}{
+		{
+			name: "call fails",
+			backend: func(chain *fakeChain) timelock.Backend {
+				chain.callErr = errors.New("rate limited")
+				return chain
+			},
+			failed: 1,
+			errors: 1,
+		},
+		{
+			name: "header not found",
+			backend: func(chain *fakeChain) timelock.Backend {
+				chain.headErr = errors.New("header not found")
+				return chain
+			},
+			lags: 1,
+		},
+		{
+			name: "head not found",
+			backend: func(chain *fakeChain) timelock.Backend {
+				return &headChain{fakeChain: chain, headErr: ethereum.NotFound}
+			},
+			lags: 1,
+		},
+		{
+			name:    "head",
+			backend: func(chain *fakeChain) timelock.Backend { return &headChain{fakeChain: chain} },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := newExporter(t, tt.backend(newChain()))
+			if failed := e.Poll(context.Background()); failed != tt.failed {
+				t.Errorf("Poll() = %d failed, want %d", failed, tt.failed)
+			}
+			if got := testutil.ToFloat64(e.metrics.pollErrors.WithLabelValues(network.Hex())); got != tt.errors {
+				t.Errorf("poll errors = %v, want %v", got, tt.errors)
+			}
+			if got := testutil.ToFloat64(e.metrics.pollLags.WithLabelValues(network.Hex())); got != tt.lags {
+				t.Errorf("poll lags = %v, want %v", got, tt.lags)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package exporter
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const namespace = "symbiotic_network"
+
+// metrics are the collectors shared by every exported Network. Each series is
+// labelled with the Network address.
+type metrics struct {
+	pendingOperations  *prometheus.GaugeVec
+	nextReady          *prometheus.GaugeVec
+	minDelay           *prometheus.GaugeVec
+	selectorDelay      *prometheus.GaugeVec
+	roleMembers        *prometheus.GaugeVec
+	lastBlock          *prometheus.GaugeVec
+	configChanges      *prometheus.CounterVec
+	pollErrors         *prometheus.CounterVec
+	pollLags           *prometheus.CounterVec
+	lastSuccessfulPoll *prometheus.GaugeVec
+}
+
+func newMetrics(registerer prometheus.Registerer) (*metrics, error) {
+	m := &metrics{
+		pendingOperations: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "pending_operations",
+			Help:      "Number of scheduled timelock operations that are not executed or cancelled, by state.",
+		}, []string{"network", "state"}),
+		nextReady: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "next_operation_ready_seconds",
+			Help:      "Seconds from the last seen block until the next Waiting operation becomes Ready. Absent when no operation is Waiting.",
+		}, []string{"network"}),
+		minDelay: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "min_delay_seconds",
+			Help:      "Global minimum delay of the timelock.",
+		}, []string{"network"}),
+		selectorDelay: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "selector_min_delay_seconds",
+			Help:      "Enabled per-target and per-selector delays. The zero target applies to every target.",
+		}, []string{"network", "target", "selector"}),
+		roleMembers: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "role_members",
+			Help:      "Number of accounts holding each role.",
+		}, []string{"network", "role"}),
+		lastBlock: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "last_block",
+			Help:      "Last block processed for the Network.",
+		}, []string{"network"}),
+		configChanges: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "config_changes_total",
+			Help:      "MinDelayChange, NameSet and MetadataURISet events seen since the start block.",
+		}, []string{"network", "event"}),
+		pollErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "poll_errors_total",
+			Help:      "Number of failed polls.",
+		}, []string{"network"}),
+		pollLags: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "poll_lags_total",
+			Help:      "Number of polls skipped because the RPC backend did not have the polled block yet.",
+		}, []string{"network"}),
+		lastSuccessfulPoll: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "last_successful_poll_timestamp_seconds",
+			Help:      "Unix time of the last successful poll.",
+		}, []string{"network"}),
+	}
+	for _, collector := range []prometheus.Collector{
+		m.pendingOperations,
+		m.nextReady,
+		m.minDelay,
+		m.selectorDelay,
+		m.roleMembers,
+		m.lastBlock,
+		m.configChanges,
+		m.pollErrors,
+		m.pollLags,
+		m.lastSuccessfulPoll,
+	} {
+		if err := registerer.Register(collector); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
@@ -0,0 +1,233 @@
+// Package fleet describes the Networks an operator runs across chains and
+// runs reads and reports over all of them.
+package fleet
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"gopkg.in/yaml.v3"
+
+	"github.com/symbioticfi/network/pkg/multicall"
+	"github.com/symbioticfi/network/pkg/quorum"
+)
+
+// Registry is the YAML description of a fleet. RPC URLs may reference
+// environment variables as ${NAME}, so API keys stay out of the file.
+//
+//	deployments:
+//	  - name: mainnet
+//	    chainId: 1
+//	    rpc: ["https://eth.example.com/${API_KEY}", "https://rpc.example.org"]
+//	    network: "0x..."
+//	    fromBlock: 21000000
+//	    proxyAdmin: "0x..."
+//	    middleware: "0x..."
+//	    vaults: ["0x...", "0x..."]
+//	    labels: {env: prod}
+//	  - name: holesky
+//	    chainId: 17000
+//	    rpc: ["https://holesky.example.com"]
+//	    network: "0x..."
+type Registry struct {
+	Deployments []*Deployment `yaml:"deployments"`
+}
+
+// Deployment is a Network deployed on one chain.
+type Deployment struct {
+	// Name is the logical name commands select the deployment by.
+	Name    string         `yaml:"name" json:"name"`
+	ChainID uint64         `yaml:"chainId" json:"chainId"`
+	RPC     []string       `yaml:"rpc" json:"-"`
+	Network common.Address `yaml:"network" json:"network"`
+	// FromBlock is the deployment block of the Network.
+	FromBlock  uint64            `yaml:"fromBlock" json:"fromBlock"`
+	ProxyAdmin common.Address    `yaml:"proxyAdmin" json:"proxyAdmin,omitempty"`
+	Middleware common.Address    `yaml:"middleware" json:"middleware,omitempty"`
+	Vaults     []common.Address  `yaml:"vaults" json:"vaults,omitempty"`
+	Labels     map[string]string `yaml:"labels" json:"labels,omitempty"`
+}
+
+var namePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]*$`)
+
+// Load reads and validates a registry file.
+func Load(path string) (*Registry, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	var registry Registry
+	if err := decoder.Decode(&registry); err != nil {
+		return nil, fmt.Errorf("fleet: %s: %w", path, err)
+	}
+	if err := registry.Validate(); err != nil {
+		return nil, fmt.Errorf("%w (in %s)", err, path)
+	}
+	return &registry, nil
+}
+
+// Validate checks that every deployment is complete and uniquely named.
+func (r *Registry) Validate() error {
+	if len(r.Deployments) == 0 {
+		return errors.New("fleet: no deployments")
+	}
+	type chainNetwork struct {
+		chainID uint64
+		network common.Address
+	}
+	names := make(map[string]bool)
+	networks := make(map[chainNetwork]string)
+	for i, d := range r.Deployments {
+		if !namePattern.MatchString(d.Name) {
+			return fmt.Errorf("fleet: deployment %d: invalid name %q", i, d.Name)
+		}
+		if names[d.Name] {
+			return fmt.Errorf("fleet: duplicate deployment %s", d.Name)
+		}
+		names[d.Name] = true
+		if d.ChainID == 0 {
+			return fmt.Errorf("fleet: %s: no chainId", d.Name)
+		}
+		if len(d.RPC) == 0 {
+			return fmt.Errorf("fleet: %s: no rpc endpoints", d.Name)
+		}
+		if d.Network == (common.Address{}) {
+			return fmt.Errorf("fleet: %s: no network address", d.Name)
+		}
+		key := chainNetwork{d.ChainID, d.Network}
+		if other, ok := networks[key]; ok {
+			return fmt.Errorf("fleet: %s and %s are the same Network", other, d.Name)
+		}
+		networks[key] = d.Name
+	}
+	return nil
+}
+
+// Select returns the deployments with the given names, in registry order, or
+// every deployment when no name is given.
+func (r *Registry) Select(names ...string) ([]*Deployment, error) {
+	if len(names) == 0 {
+		return r.Deployments, nil
+	}
+	wanted := make(map[string]bool)
+	for _, name := range names {
+		wanted[name] = true
+	}
+	var selected []*Deployment
+	for _, d := range r.Deployments {
+		if wanted[d.Name] {
+			selected = append(selected, d)
+			delete(wanted, d.Name)
+		}
+	}
+	if len(wanted) > 0 {
+		missing := make([]string, 0, len(wanted))
+		for name := range wanted {
+			missing = append(missing, name)
+		}
+		sort.Strings(missing)
+		return nil, fmt.Errorf("fleet: unknown deployments %v", missing)
+	}
+	return selected, nil
+}
+
+// Conn is an open connection to the chain of a deployment.
+type Conn struct {
+	// Backend is an *ethclient.Client for a single endpoint and a
+	// *quorum.Backend for several.
+	Backend quorum.Client
+
+	batcher multicall.BatchCaller
+	close   func()
+}
+
+// Caller returns a multicall Caller for the connection. JSON-RPC batching
+// is only available with a single endpoint.
+func (c *Conn) Caller() *multicall.Caller {
+	return multicall.NewCaller(c.Backend, c.batcher)
+}
+
+// Close closes the connection.
+func (c *Conn) Close() {
+	c.close()
+}
+
+// Dial connects to the deployment's endpoints and checks that they serve
+// ChainID.
+func (d *Deployment) Dial(ctx context.Context) (*Conn, error) {
+	urls := make([]string, len(d.RPC))
+	for i, url := range d.RPC {
+		urls[i] = os.ExpandEnv(url)
+	}
+	var conn *Conn
+	if len(urls) == 1 {
+		client, err := ethclient.DialContext(ctx, urls[0])
+		if err != nil {
+			return nil, fmt.Errorf("fleet: %s: dial: %w", d.Name, err)
+		}
+		conn = &Conn{Backend: client, batcher: client.Client(), close: client.Close}
+	} else {
+		backend, err := quorum.Dial(ctx, urls)
+		if err != nil {
+			return nil, fmt.Errorf("fleet: %s: %w", d.Name, err)
+		}
+		conn = &Conn{Backend: backend, close: backend.Close}
+	}
+	chainID, err := conn.Backend.ChainID(ctx)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("fleet: %s: get chain ID: %w", d.Name, err)
+	}
+	if chainID.Uint64() != d.ChainID {
+		conn.Close()
+		return nil, fmt.Errorf("fleet: %s: endpoints serve chain %s, expected %d", d.Name, chainID, d.ChainID)
+	}
+	return conn, nil
+}
+
+// Result is the outcome of a function run on one deployment.
+type Result[T any] struct {
+	Deployment *Deployment
+	Value      T
+	Err        error
+}
+
+// Each dials every deployment and runs f on it, at most parallel at a time,
+// and returns the results in the order of deployments. A failing deployment
+// does not stop the others.
+func Each[T any](ctx context.Context, deployments []*Deployment, parallel int, f func(ctx context.Context, d *Deployment, conn *Conn) (T, error)) []Result[T] {
+	if parallel <= 0 {
+		parallel = 4
+	}
+	results := make([]Result[T], len(deployments))
+	limit := make(chan struct{}, parallel)
+	var wg sync.WaitGroup
+	for i, d := range deployments {
+		results[i].Deployment = d
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			limit <- struct{}{}
+			defer func() { <-limit }()
+			conn, err := d.Dial(ctx)
+			if err != nil {
+				results[i].Err = err
+				return
+			}
+			defer conn.Close()
+			results[i].Value, results[i].Err = f(ctx, d, conn)
+		}()
+	}
+	wg.Wait()
+	return results
+}
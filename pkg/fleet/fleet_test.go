@@ -0,0 +1,112 @@
+package fleet
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestValidate(t *testing.T) {
+	network := common.HexToAddress("0x7e70000000000000000000000000000000000000")
+	valid := func() *Deployment {
+		return &Deployment{Name: "mainnet", ChainID: 1, RPC: []string{"https://eth.example.com"}, Network: network}
+	}
+	tests := []struct {
+		name        string
+		deployments func() []*Deployment
+		wantErr     string
+	}{
+		{
+			name:        "valid",
+			deployments: func() []*Deployment { return []*Deployment{valid()} },
+		},
+		{
+			name:        "empty",
+			deployments: func() []*Deployment { return nil },
+			wantErr:     "no deployments",
+		},
+		{
+			name: "invalid name",
+			deployments: func() []*Deployment {
+				d := valid()
+				d.Name = "Main Net"
+				return []*Deployment{d}
+			},
+			wantErr: "invalid name",
+		},
+		{
+			name:        "duplicate name",
+			deployments: func() []*Deployment { return []*Deployment{valid(), valid()} },
+			wantErr:     "duplicate deployment mainnet",
+		},
+		{
+			name: "same Network under two names",
+			deployments: func() []*Deployment {
+				d := valid()
+				d.Name = "mainnet-2"
+				return []*Deployment{valid(), d}
+			},
+			wantErr: "same Network",
+		},
+		{
+			name: "same address on another chain",
+			deployments: func() []*Deployment {
+				d := valid()
+				d.Name, d.ChainID = "holesky", 17000
+				return []*Deployment{valid(), d}
+			},
+		},
+		{
+			name: "no rpc",
+			deployments: func() []*Deployment {
+				d := valid()
+				d.RPC = nil
+				return []*Deployment{d}
+			},
+			wantErr: "no rpc endpoints",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := (&Registry{Deployments: tt.deployments()}).Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Validate() = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("Validate() = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSelect(t *testing.T) {
+	registry := &Registry{Deployments: []*Deployment{{Name: "mainnet"}, {Name: "holesky"}, {Name: "sepolia"}}}
+	tests := []struct {
+		names   []string
+		want    []string
+		wantErr bool
+	}{
+		{names: nil, want: []string{"mainnet", "holesky", "sepolia"}},
+		{names: []string{"sepolia", "mainnet"}, want: []string{"mainnet", "sepolia"}},
+		{names: []string{"mainnet", "goerli"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.names, ","), func(t *testing.T) {
+			selected, err := registry.Select(tt.names...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Select() error = %v, want error %v", err, tt.wantErr)
+			}
+			var got []string
+			for _, d := range selected {
+				got = append(got, d.Name)
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("Select() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
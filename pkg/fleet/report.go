@@ -0,0 +1,261 @@
+package fleet
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/symbioticfi/network/pkg/multicall"
+	"github.com/symbioticfi/network/pkg/timelock"
+)
+
+// Target names the contract a delay is read for in every deployment:
+// "network", "middleware", "proxyAdmin", "vaults" (each vault) or a fixed
+// address.
+type Target string
+
+// Addresses resolves the target in d.
+func (t Target) Addresses(d *Deployment) ([]common.Address, error) {
+	switch t {
+	case "network":
+		return []common.Address{d.Network}, nil
+	case "middleware":
+		if d.Middleware == (common.Address{}) {
+			return nil, nil
+		}
+		return []common.Address{d.Middleware}, nil
+	case "proxyAdmin":
+		if d.ProxyAdmin == (common.Address{}) {
+			return nil, nil
+		}
+		return []common.Address{d.ProxyAdmin}, nil
+	case "vaults":
+		return d.Vaults, nil
+	}
+	if !common.IsHexAddress(string(t)) {
+		return nil, fmt.Errorf("fleet: invalid target %q", t)
+	}
+	return []common.Address{common.HexToAddress(string(t))}, nil
+}
+
+// DelayQuery selects a delay to compare across the fleet.
+type DelayQuery struct {
+	Target   Target
+	Selector [4]byte
+}
+
+// DelayRow is the delay of one target in one deployment.
+type DelayRow struct {
+	Deployment string         `json:"deployment"`
+	ChainID    uint64         `json:"chainId"`
+	Target     common.Address `json:"target,omitempty"`
+	Delay      *big.Int       `json:"delay,omitempty"`
+	Error      string         `json:"error,omitempty"`
+	// Inconsistent is set when the delay differs from the most common one
+	// or could not be read.
+	Inconsistent bool `json:"inconsistent"`
+}
+
+// DelayGroup compares one query across the fleet.
+type DelayGroup struct {
+	Target   Target        `json:"target"`
+	Selector hexutil.Bytes `json:"selector"`
+	// Expected is the most common delay.
+	Expected   *big.Int   `json:"expected,omitempty"`
+	Consistent bool       `json:"consistent"`
+	Rows       []DelayRow `json:"rows"`
+}
+
+// DelayReport compares delays across the fleet.
+type DelayReport struct {
+	Groups []*DelayGroup `json:"groups"`
+}
+
+// Consistent reports whether every group is consistent.
+func (r *DelayReport) Consistent() bool {
+	for _, g := range r.Groups {
+		if !g.Consistent {
+			return false
+		}
+	}
+	return true
+}
+
+// Delays reads getMinDelay for every query in every deployment, one batch
+// per deployment, and flags the deployments that differ from the rest.
+func Delays(ctx context.Context, deployments []*Deployment, queries []DelayQuery, parallel int) (*DelayReport, error) {
+	// Resolve targets up front, so configuration errors are not reported
+	// as per-deployment read failures.
+	targets := make(map[*Deployment][][]common.Address)
+	for _, d := range deployments {
+		for _, q := range queries {
+			addresses, err := q.Target.Addresses(d)
+			if err != nil {
+				return nil, err
+			}
+			targets[d] = append(targets[d], addresses)
+		}
+	}
+
+	results := Each(ctx, deployments, parallel, func(ctx context.Context, d *Deployment, conn *Conn) (*multicall.Snapshot, error) {
+		var query multicall.SnapshotQuery
+		for i, q := range queries {
+			for _, target := range targets[d][i] {
+				query.Calls = append(query.Calls, timelock.Call{Target: target, Data: q.Selector[:]})
+			}
+		}
+		return conn.Caller().Snapshot(ctx, d.Network, nil, query)
+	})
+
+	report := &DelayReport{}
+	for i, q := range queries {
+		group := &DelayGroup{Target: q.Target, Selector: append(hexutil.Bytes(nil), q.Selector[:]...)}
+		for _, result := range results {
+			d := result.Deployment
+			if result.Err != nil {
+				group.Rows = append(group.Rows, DelayRow{Deployment: d.Name, ChainID: d.ChainID, Error: result.Err.Error()})
+				continue
+			}
+			// The calls of earlier queries precede the calls of this one.
+			offset := 0
+			for j := 0; j < i; j++ {
+				offset += len(targets[d][j])
+			}
+			if len(targets[d][i]) == 0 {
+				group.Rows = append(group.Rows, DelayRow{Deployment: d.Name, ChainID: d.ChainID, Error: fmt.Sprintf("no %s configured", q.Target)})
+				continue
+			}
+			for k, target := range targets[d][i] {
+				delay := result.Value.Delays[offset+k]
+				group.Rows = append(group.Rows, DelayRow{
+					Deployment: d.Name,
+					ChainID:    d.ChainID,
+					Target:     target,
+					Delay:      delay.Delay,
+					Error:      delay.Error,
+				})
+			}
+		}
+		group.compare()
+		report.Groups = append(report.Groups, group)
+	}
+	return report, nil
+}
+
+// compare sets Expected to the most common delay and flags the other rows.
+func (g *DelayGroup) compare() {
+	counts := make(map[string]int)
+	best := ""
+	for _, row := range g.Rows {
+		if row.Delay == nil {
+			continue
+		}
+		key := row.Delay.String()
+		counts[key]++
+		if counts[key] > counts[best] {
+			best = key
+		}
+	}
+	g.Consistent = true
+	for i := range g.Rows {
+		row := &g.Rows[i]
+		if row.Delay == nil || row.Delay.String() != best {
+			row.Inconsistent = true
+			g.Consistent = false
+		} else if g.Expected == nil {
+			g.Expected = row.Delay
+		}
+	}
+}
+
+// WriteText renders one table per group, marking inconsistent rows with "!".
+func (r *DelayReport) WriteText(w io.Writer) error {
+	for i, g := range r.Groups {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		status := "consistent"
+		if !g.Consistent {
+			status = "INCONSISTENT"
+		}
+		fmt.Fprintf(w, "%s %s: %s\n", g.Target, g.Selector, status)
+		tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(tw, "\tDEPLOYMENT\tCHAIN\tTARGET\tMIN DELAY")
+		for _, row := range g.Rows {
+			mark := ""
+			if row.Inconsistent {
+				mark = "!"
+			}
+			value := row.Error
+			if row.Delay != nil {
+				value = row.Delay.String() + "s"
+			}
+			target := "-"
+			if row.Target != (common.Address{}) {
+				target = row.Target.Hex()
+			}
+			fmt.Fprintf(tw, "%s\t%s\t%d\t%s\t%s\n", mark, row.Deployment, row.ChainID, target, value)
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Status is the overview of one deployment.
+type Status struct {
+	Deployment  string         `json:"deployment"`
+	ChainID     uint64         `json:"chainId"`
+	Network     common.Address `json:"network"`
+	BlockNumber uint64         `json:"blockNumber,omitempty"`
+	Name        string         `json:"name,omitempty"`
+	MetadataURI string         `json:"metadataURI,omitempty"`
+	MinDelay    *big.Int       `json:"minDelay,omitempty"`
+	Error       string         `json:"error,omitempty"`
+}
+
+// StatusReport is the overview of a fleet.
+type StatusReport []Status
+
+// Statuses reads the name, metadata URI and global delay of every deployment.
+func Statuses(ctx context.Context, deployments []*Deployment, parallel int) StatusReport {
+	results := Each(ctx, deployments, parallel, func(ctx context.Context, d *Deployment, conn *Conn) (*multicall.Snapshot, error) {
+		return conn.Caller().Snapshot(ctx, d.Network, nil, multicall.SnapshotQuery{})
+	})
+	report := make(StatusReport, len(results))
+	for i, result := range results {
+		d := result.Deployment
+		report[i] = Status{Deployment: d.Name, ChainID: d.ChainID, Network: d.Network}
+		if result.Err != nil {
+			report[i].Error = result.Err.Error()
+			continue
+		}
+		snapshot := result.Value
+		report[i].BlockNumber = snapshot.BlockNumber
+		report[i].Name = snapshot.Name
+		report[i].MetadataURI = snapshot.MetadataURI
+		report[i].MinDelay = snapshot.MinDelay
+	}
+	return report
+}
+
+// WriteText renders one row per deployment.
+func (r StatusReport) WriteText(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "DEPLOYMENT\tCHAIN\tNETWORK\tBLOCK\tNAME\tMIN DELAY\tMETADATA URI")
+	for _, s := range r {
+		if s.Error != "" {
+			fmt.Fprintf(tw, "%s\t%d\t%s\terror: %s\n", s.Deployment, s.ChainID, s.Network, strings.ReplaceAll(s.Error, "\t", " "))
+			continue
+		}
+		fmt.Fprintf(tw, "%s\t%d\t%s\t%d\t%s\t%ss\t%s\n", s.Deployment, s.ChainID, s.Network, s.BlockNumber, s.Name, s.MinDelay, s.MetadataURI)
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,113 @@
+package fleet
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestCompare(t *testing.T) {
+	day, week := big.NewInt(86400), big.NewInt(604800)
+	tests := []struct {
+		name         string
+		rows         []DelayRow
+		expected     *big.Int
+		consistent   bool
+		inconsistent []bool
+	}{
+		{
+			name:         "all equal",
+			rows:         []DelayRow{{Delay: day}, {Delay: day}},
+			expected:     day,
+			consistent:   true,
+			inconsistent: []bool{false, false},
+		},
+		{
+			name:         "outlier",
+			rows:         []DelayRow{{Delay: week}, {Delay: day}, {Delay: week}},
+			expected:     week,
+			consistent:   false,
+			inconsistent: []bool{false, true, false},
+		},
+		{
+			name:         "read error",
+			rows:         []DelayRow{{Delay: day}, {Error: "connection refused"}},
+			expected:     day,
+			consistent:   false,
+			inconsistent: []bool{false, true},
+		},
+		{
+			name:         "tie keeps the first",
+			rows:         []DelayRow{{Delay: day}, {Delay: week}},
+			expected:     day,
+			consistent:   false,
+			inconsistent: []bool{false, true},
+		},
+		{
+			name:         "equal values from different pointers",
+			rows:         []DelayRow{{Delay: big.NewInt(60)}, {Delay: big.NewInt(60)}},
+			expected:     big.NewInt(60),
+			consistent:   true,
+			inconsistent: []bool{false, false},
+		},
+		{
+			name:         "nothing read",
+			rows:         []DelayRow{{Error: "no middleware configured"}},
+			consistent:   false,
+			inconsistent: []bool{true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &DelayGroup{Rows: tt.rows}
+			g.compare()
+			if g.Consistent != tt.consistent {
+				t.Errorf("Consistent = %v, want %v", g.Consistent, tt.consistent)
+			}
+			if (g.Expected == nil) != (tt.expected == nil) || (g.Expected != nil && g.Expected.Cmp(tt.expected) != 0) {
+				t.Errorf("Expected = %v, want %v", g.Expected, tt.expected)
+			}
+			for i, row := range g.Rows {
+				if row.Inconsistent != tt.inconsistent[i] {
+					t.Errorf("row %d Inconsistent = %v, want %v", i, row.Inconsistent, tt.inconsistent[i])
+				}
+			}
+		})
+	}
+}
+
+func TestTargetAddresses(t *testing.T) {
+	network := common.HexToAddress("0x7e70000000000000000000000000000000000000")
+	middleware := common.HexToAddress("0x3d00000000000000000000000000000000000000")
+	vault := common.HexToAddress("0xfa00000000000000000000000000000000000000")
+	d := &Deployment{Network: network, Middleware: middleware, Vaults: []common.Address{vault}}
+	tests := []struct {
+		target  Target
+		want    []common.Address
+		wantErr bool
+	}{
+		{target: "network", want: []common.Address{network}},
+		{target: "middleware", want: []common.Address{middleware}},
+		{target: "proxyAdmin", want: nil},
+		{target: "vaults", want: []common.Address{vault}},
+		{target: Target(vault.Hex()), want: []common.Address{vault}},
+		{target: "vault", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.target), func(t *testing.T) {
+			got, err := tt.target.Addresses(d)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Addresses() error = %v, want error %v", err, tt.wantErr)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("Addresses() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("Addresses() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
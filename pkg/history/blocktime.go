@@ -0,0 +1,62 @@
+package history
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// HeaderReader reads block headers. It is satisfied by *ethclient.Client.
+type HeaderReader interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// BlockAt returns the header of the last block with a timestamp at or before
+// t, found with a binary search over headers. Block timestamps are strictly
+// increasing, so the result is the block whose state was current at t.
+func BlockAt(ctx context.Context, headers HeaderReader, t time.Time) (*types.Header, error) {
+	target := t.Unix()
+	if target < 0 {
+		return nil, fmt.Errorf("history: time %s is before the Unix epoch", t)
+	}
+	get := func(number uint64) (*types.Header, error) {
+		header, err := headers.HeaderByNumber(ctx, new(big.Int).SetUint64(number))
+		if err != nil {
+			return nil, fmt.Errorf("history: get header %d: %w", number, err)
+		}
+		return header, nil
+	}
+
+	latest, err := headers.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("history: get latest header: %w", err)
+	}
+	if latest.Time <= uint64(target) {
+		return latest, nil
+	}
+	low, err := get(0)
+	if err != nil {
+		return nil, err
+	}
+	if low.Time > uint64(target) {
+		return nil, fmt.Errorf("history: time %s is before the genesis block", t.UTC().Format(time.RFC3339))
+	}
+
+	// Invariant: low.Time <= target < high.Time.
+	high := latest
+	for high.Number.Uint64()-low.Number.Uint64() > 1 {
+		middle, err := get((low.Number.Uint64() + high.Number.Uint64()) / 2)
+		if err != nil {
+			return nil, err
+		}
+		if middle.Time <= uint64(target) {
+			low = middle
+		} else {
+			high = middle
+		}
+	}
+	return low, nil
+}
@@ -0,0 +1,53 @@
+package history
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// chain serves headers with the given timestamps, the last one being the head.
+type chain []uint64
+
+func (c chain) HeaderByNumber(_ context.Context, number *big.Int) (*types.Header, error) {
+	n := uint64(len(c) - 1)
+	if number != nil {
+		n = number.Uint64()
+	}
+	if n >= uint64(len(c)) {
+		return nil, errors.New("not found")
+	}
+	return &types.Header{Number: new(big.Int).SetUint64(n), Time: c[n]}, nil
+}
+
+func TestBlockAt(t *testing.T) {
+	headers := chain{100, 112, 124, 136, 148, 160, 172}
+	tests := []struct {
+		time    uint64
+		want    uint64
+		wantErr bool
+	}{
+		{time: 99, wantErr: true},
+		{time: 100, want: 0},
+		{time: 111, want: 0},
+		{time: 112, want: 1},
+		{time: 130, want: 2},
+		{time: 171, want: 5},
+		{time: 172, want: 6},
+		{time: 1000, want: 6},
+	}
+	for _, tt := range tests {
+		header, err := BlockAt(context.Background(), headers, time.Unix(int64(tt.time), 0))
+		if (err != nil) != tt.wantErr {
+			t.Errorf("BlockAt(%d) error = %v, wantErr %v", tt.time, err, tt.wantErr)
+			continue
+		}
+		if err == nil && header.Number.Uint64() != tt.want {
+			t.Errorf("BlockAt(%d) = block %d, want %d", tt.time, header.Number, tt.want)
+		}
+	}
+}
@@ -0,0 +1,199 @@
+package history
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	networkcontracts "github.com/symbioticfi/network/bindings/go-go-ethereum"
+	"github.com/symbioticfi/network/pkg/blockrange"
+	"github.com/symbioticfi/network/pkg/timelock"
+)
+
+// networkABI is the parsed INetwork ABI.
+var networkABI = mustParseNetworkABI()
+
+func mustParseNetworkABI() *abi.ABI {
+	parsed, err := networkcontracts.INetworkMetaData.GetAbi()
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
+
+var (
+	// updateDelaySelector is INetwork.updateDelay(address,bytes4,bool,uint256).
+	updateDelaySelector = selectorOf("updateDelay(address,bytes4,bool,uint256)")
+	// globalUpdateDelaySelector is TimelockController.updateDelay(uint256).
+	globalUpdateDelaySelector = selectorOf("updateDelay(uint256)")
+)
+
+func selectorOf(signature string) [4]byte {
+	var selector [4]byte
+	copy(selector[:], crypto.Keccak256([]byte(signature)))
+	return selector
+}
+
+// delayKey identifies a delay set with updateDelay. A zero target applies to
+// any target.
+type delayKey struct {
+	target   common.Address
+	selector [4]byte
+}
+
+// config is the configuration replayed from events.
+type config struct {
+	network     common.Address
+	name        string
+	metadataURI string
+	globalDelay *big.Int
+	delays      map[delayKey]*big.Int
+}
+
+// Events replays the configuration and role events emitted from FromBlock up
+// to and including block. It only needs eth_getLogs and headers, so it works
+// against nodes without archive state.
+func (r *Reader) Events(ctx context.Context, block uint64, query Query) (*State, error) {
+	state, err := r.newState(ctx, block, Events)
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := r.replay(ctx, block)
+	if err != nil {
+		return nil, err
+	}
+	state.Name, state.MetadataURI, state.GlobalMinDelay = cfg.name, cfg.metadataURI, cfg.globalDelay
+	for _, c := range query.Calls {
+		selector := timelock.Selector(c.Data)
+		delay := Delay{Target: c.Target, Selector: selector[:]}
+		if delay.Delay, err = cfg.minDelay(c.Target, c.Data); err != nil {
+			delay.Error = err.Error()
+		}
+		state.Delays = append(state.Delays, delay)
+	}
+
+	snapshot, err := r.roleSnapshot(ctx, block)
+	if err != nil {
+		return nil, err
+	}
+	for _, role := range snapshot.Roles() {
+		var members []common.Address
+		for _, member := range snapshot.Members[role] {
+			members = append(members, member.Account)
+		}
+		state.addRole(role, members)
+	}
+	return state, nil
+}
+
+// replay applies the NameSet, MetadataURISet and both MinDelayChange events
+// emitted in [FromBlock, block].
+func (r *Reader) replay(ctx context.Context, block uint64) (*config, error) {
+	events := networkABI.Events
+	topics := []common.Hash{
+		events["NameSet"].ID,
+		events["MetadataURISet"].ID,
+		events["MinDelayChange"].ID,
+		timelock.ABI.Events["MinDelayChange"].ID,
+	}
+	cfg := &config{
+		network:     r.address,
+		globalDelay: new(big.Int),
+		delays:      make(map[delayKey]*big.Int),
+	}
+	for _, rng := range blockrange.Split(r.FromBlock, block, r.BlockRange) {
+		logs, err := r.backend.FilterLogs(ctx, ethereum.FilterQuery{
+			FromBlock: new(big.Int).SetUint64(rng.From),
+			ToBlock:   new(big.Int).SetUint64(rng.To),
+			Addresses: []common.Address{r.address},
+			Topics:    [][]common.Hash{topics},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("history: filter logs in blocks %d-%d: %w", rng.From, rng.To, err)
+		}
+		for _, log := range logs {
+			if log.Removed || len(log.Topics) == 0 {
+				continue
+			}
+			switch log.Topics[0] {
+			case events["NameSet"].ID:
+				event, err := r.network.ParseNameSet(log)
+				if err != nil {
+					return nil, err
+				}
+				cfg.name = event.Name
+			case events["MetadataURISet"].ID:
+				event, err := r.network.ParseMetadataURISet(log)
+				if err != nil {
+					return nil, err
+				}
+				cfg.metadataURI = event.MetadataURI
+			case events["MinDelayChange"].ID:
+				event, err := r.network.ParseMinDelayChange(log)
+				if err != nil {
+					return nil, err
+				}
+				key := delayKey{target: event.Target, selector: event.Selector}
+				if event.NewEnabledStatus {
+					cfg.delays[key] = event.NewDelay
+				} else {
+					delete(cfg.delays, key)
+				}
+			case timelock.ABI.Events["MinDelayChange"].ID:
+				event, err := r.timelock.ParseMinDelayChange(log)
+				if err != nil {
+					return nil, err
+				}
+				cfg.globalDelay = event.NewDuration
+			}
+		}
+	}
+	return cfg, nil
+}
+
+// minDelay mirrors Network.getMinDelay(target, data).
+func (c *config) minDelay(target common.Address, data []byte) (*big.Int, error) {
+	if len(data) > 0 && len(data) < 4 {
+		return nil, errors.New("InvalidDataLength()")
+	}
+	selector := timelock.Selector(data)
+	if target == c.network {
+		switch selector {
+		case updateDelaySelector:
+			args, err := networkABI.Methods["updateDelay"].Inputs.Unpack(data[4:])
+			if err != nil {
+				return nil, fmt.Errorf("decode updateDelay arguments: %w", err)
+			}
+			underlyingTarget, underlyingSelector := args[0].(common.Address), args[1].([4]byte)
+			if underlyingTarget == c.network &&
+				(underlyingSelector == updateDelaySelector || underlyingSelector == globalUpdateDelaySelector) {
+				return nil, errors.New("InvalidTargetAndSelector()")
+			}
+			return c.lookup(underlyingTarget, underlyingSelector), nil
+		case globalUpdateDelaySelector:
+			return c.globalDelay, nil
+		}
+	}
+	if target == (common.Address{}) {
+		return nil, errors.New("InvalidTargetAndSelector()")
+	}
+	return c.lookup(target, selector), nil
+}
+
+// lookup returns the delay of the exact target and selector, else the delay
+// of the selector on any target, else the global delay.
+func (c *config) lookup(target common.Address, selector [4]byte) *big.Int {
+	if delay, ok := c.delays[delayKey{target: target, selector: selector}]; ok {
+		return delay
+	}
+	if delay, ok := c.delays[delayKey{selector: selector}]; ok {
+		return delay
+	}
+	return c.globalDelay
+}
@@ -0,0 +1,75 @@
+package history
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/symbioticfi/network/pkg/timelock"
+)
+
+func TestMinDelay(t *testing.T) {
+	network := common.HexToAddress("0x7e70000000000000000000000000000000000000")
+	vault := common.HexToAddress("0x7a00000000000000000000000000000000000000")
+	other := common.HexToAddress("0x0700000000000000000000000000000000000000")
+	setLimit := selectorOf("setMaxNetworkLimit(uint96,uint256)")
+	setResolver := selectorOf("setResolver(uint96,address,bytes)")
+	updateName := selectorOf("updateName(string)")
+	cfg := &config{
+		network:     network,
+		globalDelay: big.NewInt(100),
+		delays: map[delayKey]*big.Int{
+			{target: vault, selector: setLimit}:     big.NewInt(10),
+			{selector: setLimit}:                    big.NewInt(20),
+			{selector: setResolver}:                 big.NewInt(0),
+			{target: network, selector: updateName}: big.NewInt(30),
+		},
+	}
+	updateDelay := func(target common.Address, selector [4]byte) []byte {
+		data, err := networkABI.Pack("updateDelay", target, selector, true, big.NewInt(1))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return data
+	}
+	globalUpdateDelay, err := timelock.ABI.Pack("updateDelay", big.NewInt(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		target  common.Address
+		data    []byte
+		want    int64
+		wantErr bool
+	}{
+		{name: "exact entry", target: vault, data: setLimit[:], want: 10},
+		{name: "zero-target entry", target: other, data: setLimit[:], want: 20},
+		{name: "zero delay entry", target: other, data: setResolver[:], want: 0},
+		{name: "global delay", target: other, data: updateName[:], want: 100},
+		{name: "network entry", target: network, data: updateName[:], want: 30},
+		{name: "native transfer", target: other, want: 100},
+		{name: "updateDelay of an exact entry", target: network, data: updateDelay(vault, setLimit), want: 10},
+		{name: "updateDelay of a zero-target entry", target: network, data: updateDelay(common.Address{}, setLimit), want: 20},
+		{name: "updateDelay of the global delay", target: network, data: globalUpdateDelay, want: 100},
+		{name: "updateDelay of updateDelay", target: network, data: updateDelay(network, updateDelaySelector), wantErr: true},
+		{name: "updateDelay of the global updateDelay", target: network, data: updateDelay(network, globalUpdateDelaySelector), wantErr: true},
+		// updateDelay on another target is an ordinary call.
+		{name: "updateDelay elsewhere", target: other, data: updateDelay(vault, setLimit), want: 100},
+		{name: "zero target", target: common.Address{}, data: setLimit[:], wantErr: true},
+		{name: "short calldata", target: other, data: []byte{1, 2}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := cfg.minDelay(tt.target, tt.data)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("minDelay() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got.Int64() != tt.want {
+				t.Errorf("minDelay() = %s, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,300 @@
+// Package history answers point-in-time questions about a Network: its name
+// and metadata URI, the delay getMinDelay(target, data) returned for a call and
+// the role holders at a given block or wall-clock time.
+//
+// Values are read with eth_call at the historical block. Nodes that prune old
+// state cannot serve those calls, so the same answers can also be derived by
+// replaying the configuration and role events of the Network from its
+// deployment block. Role membership always needs the events: the timelock is
+// not AccessControlEnumerable, so they are the only source of candidate
+// holders.
+package history
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	networkcontracts "github.com/symbioticfi/network/bindings/go-go-ethereum"
+	"github.com/symbioticfi/network/pkg/blockrange"
+	"github.com/symbioticfi/network/pkg/roles"
+	"github.com/symbioticfi/network/pkg/timelock"
+)
+
+// Source is where the values of a State were read from.
+type Source string
+
+const (
+	// Archive values are read with eth_call at the block.
+	Archive Source = "archive"
+	// Events values are replayed from the logs emitted up to the block.
+	Events Source = "events"
+)
+
+// ErrStateUnavailable is returned by Reader.Archive when the node no longer
+// has the state of the requested block.
+var ErrStateUnavailable = errors.New("history: historical state is not available")
+
+// Query selects the delays to read in addition to the name, metadata URI,
+// global delay and roles.
+type Query struct {
+	// Calls are the calls getMinDelay(target, data) is evaluated for. Data can
+	// be just a selector, except for updateDelay calls to the Network, whose
+	// delay depends on their arguments.
+	Calls []timelock.Call
+}
+
+// Delay is the value getMinDelay returned for a call.
+type Delay struct {
+	Target   common.Address `json:"target"`
+	Selector hexutil.Bytes  `json:"selector"`
+	Delay    *big.Int       `json:"delay,omitempty"`
+	// Error is set when getMinDelay reverts for the call.
+	Error string `json:"error,omitempty"`
+}
+
+// Role lists the holders of a role.
+type Role struct {
+	Role    common.Hash      `json:"role"`
+	Members []common.Address `json:"members"`
+}
+
+// State is the configuration of a Network as of a block.
+type State struct {
+	Network     common.Address `json:"network"`
+	BlockNumber uint64         `json:"blockNumber"`
+	BlockTime   uint64         `json:"blockTime"`
+	Source      Source         `json:"source"`
+
+	Name           string   `json:"name"`
+	MetadataURI    string   `json:"metadataURI"`
+	GlobalMinDelay *big.Int `json:"globalMinDelay"`
+	Delays         []Delay  `json:"delays"`
+	Roles          []Role   `json:"roles"`
+}
+
+// Reader reads the historical configuration of a single Network.
+type Reader struct {
+	address  common.Address
+	backend  bind.ContractBackend
+	network  *networkcontracts.INetwork
+	timelock *networkcontracts.TimelockControllerUpgradeable
+	roles    *roles.Indexer
+
+	// FromBlock is the first block scanned for events, normally the
+	// deployment block of the Network.
+	FromBlock uint64
+	// BlockRange is the number of blocks requested per eth_getLogs call.
+	BlockRange uint64
+}
+
+// NewReader creates a Reader for the Network deployed at address.
+func NewReader(address common.Address, backend bind.ContractBackend) (*Reader, error) {
+	network, err := networkcontracts.NewINetwork(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	timelock, err := networkcontracts.NewTimelockControllerUpgradeable(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	indexer, err := roles.NewIndexer(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Reader{
+		address:    address,
+		backend:    backend,
+		network:    network,
+		timelock:   timelock,
+		roles:      indexer,
+		BlockRange: blockrange.DefaultSize,
+	}, nil
+}
+
+// At returns the configuration as of block, read from archive state when the
+// node has it and replayed from events otherwise.
+func (r *Reader) At(ctx context.Context, block uint64, query Query) (*State, error) {
+	state, err := r.Archive(ctx, block, query)
+	if errors.Is(err, ErrStateUnavailable) {
+		return r.Events(ctx, block, query)
+	}
+	return state, err
+}
+
+// AtTime returns the configuration as of the last block at or before t.
+func (r *Reader) AtTime(ctx context.Context, t time.Time, query Query) (*State, error) {
+	header, err := BlockAt(ctx, r.backend, t)
+	if err != nil {
+		return nil, err
+	}
+	return r.At(ctx, header.Number.Uint64(), query)
+}
+
+// Archive reads the configuration with eth_call at block. It returns an error
+// wrapping ErrStateUnavailable when the node has pruned the state of block.
+func (r *Reader) Archive(ctx context.Context, block uint64, query Query) (*State, error) {
+	state, err := r.newState(ctx, block, Archive)
+	if err != nil {
+		return nil, err
+	}
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(block)}
+	if state.Name, err = r.network.Name(opts); err != nil {
+		return nil, callError(block, "name()", err)
+	}
+	if state.MetadataURI, err = r.network.MetadataURI(opts); err != nil {
+		return nil, callError(block, "metadataURI()", err)
+	}
+	if state.GlobalMinDelay, err = r.timelock.GetMinDelay(opts); err != nil {
+		return nil, callError(block, "getMinDelay()", err)
+	}
+	for _, c := range query.Calls {
+		selector := timelock.Selector(c.Data)
+		delay := Delay{Target: c.Target, Selector: selector[:]}
+		value, err := r.network.GetMinDelay(opts, c.Target, c.Data)
+		switch {
+		case err == nil:
+			delay.Delay = value
+		case missingState(err):
+			return nil, callError(block, "getMinDelay(target, data)", err)
+		default:
+			// Reverts, for example for the zero target, are part of the answer.
+			delay.Error = err.Error()
+		}
+		state.Delays = append(state.Delays, delay)
+	}
+
+	snapshot, err := r.roleSnapshot(ctx, block)
+	if err != nil {
+		return nil, err
+	}
+	for _, role := range snapshot.Roles() {
+		candidates := make([]common.Address, 0, len(snapshot.Members[role])+len(snapshot.Former[role]))
+		for _, member := range snapshot.Members[role] {
+			candidates = append(candidates, member.Account)
+		}
+		candidates = append(candidates, snapshot.Former[role]...)
+		var members []common.Address
+		for _, account := range candidates {
+			has, err := r.timelock.HasRole(opts, role, account)
+			if err != nil {
+				return nil, callError(block, fmt.Sprintf("hasRole(%s, %s)", roles.Name(role), account), err)
+			}
+			if has {
+				members = append(members, account)
+			}
+		}
+		state.addRole(role, members)
+	}
+	return state, nil
+}
+
+func (r *Reader) newState(ctx context.Context, block uint64, source Source) (*State, error) {
+	if block < r.FromBlock {
+		return nil, fmt.Errorf("history: block %d is before the deployment block %d", block, r.FromBlock)
+	}
+	header, err := r.backend.HeaderByNumber(ctx, new(big.Int).SetUint64(block))
+	if err != nil {
+		return nil, fmt.Errorf("history: get header %d: %w", block, err)
+	}
+	return &State{
+		Network:     r.address,
+		BlockNumber: block,
+		BlockTime:   header.Time,
+		Source:      source,
+	}, nil
+}
+
+func (r *Reader) roleSnapshot(ctx context.Context, block uint64) (*roles.Snapshot, error) {
+	r.roles.BlockRange = r.BlockRange
+	changes, err := r.roles.Changes(ctx, r.FromBlock, block)
+	if err != nil {
+		return nil, fmt.Errorf("history: %w", err)
+	}
+	return roles.Replay(changes, block), nil
+}
+
+// addRole records the holders of role, skipping known roles nobody holds.
+func (s *State) addRole(role common.Hash, members []common.Address) {
+	if len(members) == 0 {
+		return
+	}
+	s.Roles = append(s.Roles, Role{Role: role, Members: members})
+}
+
+// callError wraps the failure of an eth_call made at block.
+func callError(block uint64, method string, err error) error {
+	if missingState(err) {
+		return fmt.Errorf("%w at block %d: %s: %v", ErrStateUnavailable, block, method, err)
+	}
+	return fmt.Errorf("history: %s at block %d: %w", method, block, err)
+}
+
+// missingState reports whether err is a node failing to serve pruned state.
+// Clients word this differently, so the error message is matched.
+func missingState(err error) bool {
+	msg := strings.ToLower(err.Error())
+	for _, s := range []string{
+		"missing trie node",
+		"historical state",
+		"state not available",
+		"state is not available",
+		"state unavailable",
+		"pruned",
+		"archive",
+	} {
+		if strings.Contains(msg, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// WriteText renders the state.
+func (s *State) WriteText(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(tw, "Network:\t%s\n", s.Network)
+	fmt.Fprintf(tw, "Block:\t%d (%s)\n", s.BlockNumber, time.Unix(int64(s.BlockTime), 0).UTC().Format(time.RFC3339))
+	fmt.Fprintf(tw, "Source:\t%s\n", s.Source)
+	fmt.Fprintf(tw, "Name:\t%s\n", s.Name)
+	fmt.Fprintf(tw, "Metadata URI:\t%s\n", s.MetadataURI)
+	fmt.Fprintf(tw, "Global min delay:\t%ss\n", s.GlobalMinDelay)
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	if len(s.Delays) > 0 {
+		fmt.Fprintln(w)
+		tw = tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(tw, "TARGET\tSELECTOR\tMIN DELAY")
+		for _, d := range s.Delays {
+			value := d.Error
+			if d.Delay != nil {
+				value = d.Delay.String() + "s"
+			}
+			fmt.Fprintf(tw, "%s\t%s\t%s\n", d.Target, d.Selector, value)
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintln(w)
+	tw = tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "ROLE\tMEMBER")
+	for _, role := range s.Roles {
+		for _, member := range role.Members {
+			fmt.Fprintf(tw, "%s\t%s\n", roles.Name(role.Role), member)
+		}
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,329 @@
+// Package limits plans the setMaxNetworkLimit calls that bring the maximum
+// network limits of a Network's vaults to a target allocation.
+//
+// The maximum network limit of a subnetwork is set on the vault's delegator
+// by the Network, either through the timelock, calling the delegator's
+// setMaxNetworkLimit directly, or by the Network's middleware, calling the
+// Network's setMaxNetworkLimit hook. The planner reads the current state of
+// every targeted vault in one multicall and only emits the calls that change
+// something.
+package limits
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"text/tabwriter"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	networkcontracts "github.com/symbioticfi/network/bindings/go-go-ethereum"
+	"github.com/symbioticfi/network/pkg/core"
+	"github.com/symbioticfi/network/pkg/multicall"
+	"github.com/symbioticfi/network/pkg/subnetwork"
+	"github.com/symbioticfi/network/pkg/timelock"
+)
+
+// Mode is how the planned calls are made.
+type Mode string
+
+const (
+	// Timelock schedules a scheduleBatch operation calling each delegator.
+	Timelock Mode = "timelock"
+	// Middleware calls the Network's setMaxNetworkLimit hook from the
+	// middleware.
+	Middleware Mode = "middleware"
+)
+
+// Target is the wanted maximum network limit of a subnetwork in a vault.
+type Target struct {
+	Vault common.Address
+	// SubnetworkID is the uint96 identifier of the subnetwork.
+	SubnetworkID    *big.Int
+	MaxNetworkLimit *big.Int
+}
+
+// Request is a target allocation.
+type Request struct {
+	Targets []Target
+	Mode    Mode
+
+	// Predecessor, Salt and Delay are used in Timelock mode. Delay overrides
+	// the required delay read from the Network and must not be shorter.
+	Predecessor common.Hash
+	Salt        common.Hash
+	Delay       *big.Int
+}
+
+// Entry is the current and wanted state of one target.
+type Entry struct {
+	Vault         common.Address        `json:"vault"`
+	Delegator     common.Address        `json:"delegator"`
+	DelegatorType core.DelegatorType    `json:"delegatorType"`
+	SubnetworkID  *big.Int              `json:"subnetworkId"`
+	Subnetwork    subnetwork.Subnetwork `json:"subnetwork"`
+	Current       *big.Int              `json:"current"`
+	Target        *big.Int              `json:"target"`
+	// NetworkLimit is the limit the vault curator set for the subnetwork. It
+	// is nil for delegators without one.
+	NetworkLimit *big.Int `json:"networkLimit,omitempty"`
+	Change       bool     `json:"change"`
+	// Warning is set when the change lowers the curator's network limit,
+	// which the delegator caps at the maximum.
+	Warning string `json:"warning,omitempty"`
+}
+
+// HookCall is a setMaxNetworkLimit call the middleware makes on the Network.
+type HookCall struct {
+	Delegator       common.Address `json:"delegator"`
+	SubnetworkID    *big.Int       `json:"subnetworkId"`
+	MaxNetworkLimit *big.Int       `json:"maxNetworkLimit"`
+	Data            hexutil.Bytes  `json:"data"`
+}
+
+// Plan is the minimal set of calls reaching a target allocation.
+type Plan struct {
+	Network     common.Address `json:"network"`
+	Mode        Mode           `json:"mode"`
+	BlockNumber uint64         `json:"blockNumber"`
+	Entries     []Entry        `json:"entries"`
+
+	// Set in Middleware mode.
+	Middleware common.Address `json:"middleware,omitempty"`
+	HookCalls  []HookCall     `json:"hookCalls,omitempty"`
+
+	// Set in Timelock mode when there is something to change.
+	*timelock.Prepared
+}
+
+// Changes returns the entries that need a call.
+func (p *Plan) Changes() []Entry {
+	var changes []Entry
+	for _, e := range p.Entries {
+		if e.Change {
+			changes = append(changes, e)
+		}
+	}
+	return changes
+}
+
+// PlanLimits reads the delegator, maximum network limit and network limit of
+// every target and builds the calls for the targets that differ. backend is
+// used for the reads that are not batched: the middleware and the required
+// delay.
+func PlanLimits(ctx context.Context, caller *multicall.Caller, backend bind.ContractCaller, network common.Address, req Request) (*Plan, error) {
+	if req.Mode != Timelock && req.Mode != Middleware {
+		return nil, fmt.Errorf("limits: unknown mode %q", req.Mode)
+	}
+	if len(req.Targets) == 0 {
+		return nil, errors.New("limits: no targets")
+	}
+	type key struct {
+		vault      common.Address
+		identifier string
+	}
+	seen := make(map[key]bool)
+	for _, t := range req.Targets {
+		if err := subnetwork.ValidateIdentifier(t.SubnetworkID); err != nil {
+			return nil, fmt.Errorf("limits: vault %s: %w", t.Vault, err)
+		}
+		if t.MaxNetworkLimit == nil || t.MaxNetworkLimit.Sign() < 0 {
+			return nil, fmt.Errorf("limits: invalid max network limit for vault %s", t.Vault)
+		}
+		k := key{t.Vault, t.SubnetworkID.String()}
+		if seen[k] {
+			return nil, fmt.Errorf("limits: vault %s subnetwork %s is targeted twice", t.Vault, t.SubnetworkID)
+		}
+		seen[k] = true
+	}
+
+	// The delegators are needed to address the second batch, which is pinned
+	// to the block of the first.
+	var delegators multicall.Batch
+	delegatorResults := make([]*multicall.Result[common.Address], len(req.Targets))
+	for i, t := range req.Targets {
+		delegatorResults[i] = multicall.Add[common.Address](&delegators, t.Vault, core.VaultABI, "delegator")
+	}
+	number, err := caller.Do(ctx, nil, &delegators)
+	if err != nil {
+		return nil, err
+	}
+	block := new(big.Int).SetUint64(number)
+
+	plan := &Plan{Network: network, Mode: req.Mode, BlockNumber: number}
+	var state multicall.Batch
+	type reads struct {
+		kind         *multicall.Result[uint64]
+		max, current *multicall.Result[*big.Int]
+	}
+	pending := make([]reads, len(req.Targets))
+	for i, t := range req.Targets {
+		delegator, err := delegatorResults[i].Get()
+		if err != nil {
+			return nil, fmt.Errorf("limits: delegator of vault %s: %w", t.Vault, err)
+		}
+		s, err := subnetwork.FromNetwork(network, t.SubnetworkID)
+		if err != nil {
+			return nil, err
+		}
+		plan.Entries = append(plan.Entries, Entry{
+			Vault:        t.Vault,
+			Delegator:    delegator,
+			SubnetworkID: new(big.Int).Set(t.SubnetworkID),
+			Subnetwork:   s,
+			Target:       new(big.Int).Set(t.MaxNetworkLimit),
+		})
+		pending[i] = reads{
+			kind:    multicall.Add[uint64](&state, delegator, core.BaseDelegatorABI, "TYPE"),
+			max:     multicall.Add[*big.Int](&state, delegator, core.BaseDelegatorABI, "maxNetworkLimit", s.Hash()),
+			current: multicall.Add[*big.Int](&state, delegator, core.NetworkRestakeDelegatorABI, "networkLimit", s.Hash()),
+		}
+	}
+	if _, err := caller.Do(ctx, block, &state); err != nil {
+		return nil, err
+	}
+	for i := range plan.Entries {
+		e := &plan.Entries[i]
+		kind, err := pending[i].kind.Get()
+		if err != nil {
+			return nil, fmt.Errorf("limits: TYPE of delegator %s: %w", e.Delegator, err)
+		}
+		e.DelegatorType = core.DelegatorType(kind)
+		if e.Current, err = pending[i].max.Get(); err != nil {
+			return nil, fmt.Errorf("limits: maxNetworkLimit on delegator %s: %w", e.Delegator, err)
+		}
+		// OperatorNetworkSpecific delegators have no network limit, so the
+		// call reverts. Any other failure is not a missing limit.
+		limit, err := pending[i].current.Get()
+		var callErr *multicall.CallError
+		switch {
+		case err == nil:
+			e.NetworkLimit = limit
+		case !errors.As(err, &callErr):
+			return nil, fmt.Errorf("limits: networkLimit on delegator %s: %w", e.Delegator, err)
+		}
+		e.Change = e.Current.Cmp(e.Target) != 0
+		if e.Change && e.NetworkLimit != nil && e.NetworkLimit.Cmp(e.Target) > 0 {
+			e.Warning = fmt.Sprintf("lowers the curator's network limit from %s to %s", e.NetworkLimit, e.Target)
+		}
+	}
+
+	changes := plan.Changes()
+	if len(changes) == 0 {
+		return plan, nil
+	}
+	switch req.Mode {
+	case Middleware:
+		err = plan.buildHookCalls(ctx, backend, block, changes)
+	case Timelock:
+		err = plan.buildOperation(ctx, backend, block, changes, req)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+func (p *Plan) buildHookCalls(ctx context.Context, backend bind.ContractCaller, block *big.Int, changes []Entry) error {
+	network, err := networkcontracts.NewINetworkCaller(p.Network, backend)
+	if err != nil {
+		return err
+	}
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: block}
+	service, err := network.NETWORKMIDDLEWARESERVICE(opts)
+	if err != nil {
+		return fmt.Errorf("limits: NETWORK_MIDDLEWARE_SERVICE: %w", err)
+	}
+	serviceCaller, err := networkcontracts.NewINetworkMiddlewareServiceCaller(service, backend)
+	if err != nil {
+		return err
+	}
+	if p.Middleware, err = serviceCaller.Middleware(opts, p.Network); err != nil {
+		return fmt.Errorf("limits: middleware of %s: %w", p.Network, err)
+	}
+	if p.Middleware == (common.Address{}) {
+		return fmt.Errorf("limits: Network %s has no middleware", p.Network)
+	}
+
+	hookABI, err := networkcontracts.ISetMaxNetworkLimitHookMetaData.GetAbi()
+	if err != nil {
+		return err
+	}
+	for _, e := range changes {
+		data, err := hookABI.Pack("setMaxNetworkLimit", e.Delegator, e.SubnetworkID, e.Target)
+		if err != nil {
+			return err
+		}
+		p.HookCalls = append(p.HookCalls, HookCall{
+			Delegator:       e.Delegator,
+			SubnetworkID:    e.SubnetworkID,
+			MaxNetworkLimit: e.Target,
+			Data:            data,
+		})
+	}
+	return nil
+}
+
+func (p *Plan) buildOperation(ctx context.Context, backend bind.ContractCaller, block *big.Int, changes []Entry, req Request) error {
+	var calls []timelock.Call
+	for _, e := range changes {
+		data, err := core.BaseDelegatorABI.Pack("setMaxNetworkLimit", e.SubnetworkID, e.Target)
+		if err != nil {
+			return err
+		}
+		calls = append(calls, timelock.Call{Target: e.Delegator, Data: data})
+	}
+	network, err := networkcontracts.NewINetworkCaller(p.Network, backend)
+	if err != nil {
+		return err
+	}
+	p.Prepared, err = timelock.Prepare(&bind.CallOpts{Context: ctx, BlockNumber: block}, network, timelock.Operation{
+		Calls:       calls,
+		Predecessor: req.Predecessor,
+		Salt:        req.Salt,
+		Delay:       req.Delay,
+		Batch:       true,
+	})
+	return err
+}
+
+// WriteText renders one row per target, marking the ones that change with
+// "*", followed by the calls.
+func (p *Plan) WriteText(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(tw, "Max network limits of Network %s at block %d (%s mode)\n\n", p.Network, p.BlockNumber, p.Mode)
+	fmt.Fprintln(tw, "\tVAULT\tDELEGATOR\tTYPE\tSUBNETWORK\tCURRENT\tTARGET\tNETWORK LIMIT\tWARNING")
+	for _, e := range p.Entries {
+		mark, limit := "", "-"
+		if e.Change {
+			mark = "*"
+		}
+		if e.NetworkLimit != nil {
+			limit = e.NetworkLimit.String()
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			mark, e.Vault, e.Delegator, e.DelegatorType, e.SubnetworkID, e.Current, e.Target, limit, e.Warning)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	changes := len(p.Changes())
+	if changes == 0 {
+		_, err := fmt.Fprintln(w, "\nEvery target is already set.")
+		return err
+	}
+	if p.Mode == Middleware {
+		fmt.Fprintf(w, "\n%d setMaxNetworkLimit calls from middleware %s to %s:\n", changes, p.Middleware, p.Network)
+		for _, c := range p.HookCalls {
+			fmt.Fprintf(w, "%s\n", c.Data)
+		}
+		return nil
+	}
+	fmt.Fprintln(w)
+	return p.Prepared.WriteText(w)
+}
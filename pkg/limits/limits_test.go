@@ -0,0 +1,376 @@
+package limits
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	networkcontracts "github.com/symbioticfi/network/bindings/go-go-ethereum"
+	"github.com/symbioticfi/network/pkg/core"
+	"github.com/symbioticfi/network/pkg/multicall"
+	"github.com/symbioticfi/network/pkg/subnetwork"
+	"github.com/symbioticfi/network/pkg/timelock"
+)
+
+var (
+	network    = common.HexToAddress("0x7e70000000000000000000000000000000000000")
+	middleware = common.HexToAddress("0x3dd1e00000000000000000000000000000000000")
+	service    = common.HexToAddress("0x5e7f1ce000000000000000000000000000000000")
+
+	restakeVault, restakeDelegator   = common.HexToAddress("0x5a01700000000000000000000000000000000001"), common.HexToAddress("0xde1e000000000000000000000000000000000001")
+	curatedVault, curatedDelegator   = common.HexToAddress("0x5a01700000000000000000000000000000000002"), common.HexToAddress("0xde1e000000000000000000000000000000000002")
+	specificVault, specificDelegator = common.HexToAddress("0x5a01700000000000000000000000000000000003"), common.HexToAddress("0xde1e000000000000000000000000000000000003")
+)
+
+// fakeDelegator is the state of a delegator. networkLimits is nil for
+// delegators without network limits, whose networkLimit reverts.
+type fakeDelegator struct {
+	kind          core.DelegatorType
+	maxLimits     map[common.Hash]*big.Int
+	networkLimits map[common.Hash]*big.Int
+	// garbled makes networkLimit return data that does not decode.
+	garbled bool
+}
+
+// fakeChain serves Multicall3, the Network, its middleware service, vaults
+// and delegators, and mines every hook call it is sent. Methods other than
+// the ones below panic.
+type fakeChain struct {
+	SendBackend
+
+	vaults     map[common.Address]common.Address
+	delegators map[common.Address]*fakeDelegator
+
+	pendingNonce uint64
+	// sendErr and revert fail the hook calls to a delegator when sent and
+	// when mined.
+	sendErr map[common.Address]error
+	revert  map[common.Address]bool
+	// ignore mines the hook calls to a delegator without effect.
+	ignore map[common.Address]bool
+	sent   []*types.Transaction
+}
+
+// newFakeChain returns a chain with a NetworkRestake vault at the limits of
+// subnetwork 0, one whose curator set a network limit of 500 and an
+// OperatorNetworkSpecific vault.
+func newFakeChain(t *testing.T) *fakeChain {
+	t.Helper()
+	s := subnetworkHash(t, 0)
+	return &fakeChain{
+		vaults: map[common.Address]common.Address{
+			restakeVault:  restakeDelegator,
+			curatedVault:  curatedDelegator,
+			specificVault: specificDelegator,
+		},
+		delegators: map[common.Address]*fakeDelegator{
+			restakeDelegator: {
+				kind:          core.NetworkRestake,
+				maxLimits:     map[common.Hash]*big.Int{s: big.NewInt(100)},
+				networkLimits: map[common.Hash]*big.Int{s: big.NewInt(100)},
+			},
+			curatedDelegator: {
+				kind:          core.NetworkRestake,
+				maxLimits:     map[common.Hash]*big.Int{s: big.NewInt(1000)},
+				networkLimits: map[common.Hash]*big.Int{s: big.NewInt(500)},
+			},
+			specificDelegator: {
+				kind:      core.OperatorNetworkSpecific,
+				maxLimits: map[common.Hash]*big.Int{},
+			},
+		},
+	}
+}
+
+func subnetworkHash(t *testing.T, id int64) common.Hash {
+	t.Helper()
+	s, err := subnetwork.FromNetwork(network, big.NewInt(id))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s.Hash()
+}
+
+// call3 and result3 mirror the Multicall3 structs.
+type call3 struct {
+	Target       common.Address
+	AllowFailure bool
+	CallData     []byte
+}
+
+type result3 struct {
+	Success    bool
+	ReturnData []byte
+}
+
+// execute runs a call and reports whether it succeeded.
+func (f *fakeChain) execute(to common.Address, data []byte) ([]byte, bool) {
+	var parsed []*abi.ABI
+	switch {
+	case to == multicall.Multicall3Address:
+		return common.LeftPadBytes(big.NewInt(42).Bytes(), 32), true
+	case to == network:
+		networkABI, _ := networkcontracts.INetworkMetaData.GetAbi()
+		parsed = []*abi.ABI{networkABI}
+	case to == service:
+		parsed = []*abi.ABI{core.MiddlewareServiceABI}
+	case f.vaults[to] != (common.Address{}):
+		parsed = []*abi.ABI{core.VaultABI}
+	case f.delegators[to] != nil:
+		parsed = []*abi.ABI{core.BaseDelegatorABI, core.NetworkRestakeDelegatorABI}
+	}
+	for _, p := range parsed {
+		method, err := p.MethodById(data)
+		if err != nil {
+			continue
+		}
+		args, err := method.Inputs.Unpack(data[4:])
+		if err != nil {
+			return nil, false
+		}
+		var out []byte
+		switch method.Name {
+		case "NETWORK_MIDDLEWARE_SERVICE":
+			out, err = method.Outputs.Pack(service)
+		case "getMinDelay":
+			out, err = method.Outputs.Pack(big.NewInt(3600))
+		case "middleware":
+			out, err = method.Outputs.Pack(middleware)
+		case "delegator":
+			out, err = method.Outputs.Pack(f.vaults[to])
+		case "TYPE":
+			out, err = method.Outputs.Pack(uint64(f.delegators[to].kind))
+		case "maxNetworkLimit":
+			limit := f.delegators[to].maxLimits[args[0].([32]byte)]
+			out, err = method.Outputs.Pack(orZero(limit))
+		case "networkLimit":
+			d := f.delegators[to]
+			if d.garbled {
+				return []byte{1}, true
+			}
+			if d.networkLimits == nil {
+				return nil, false
+			}
+			out, err = method.Outputs.Pack(orZero(d.networkLimits[args[0].([32]byte)]))
+		default:
+			return nil, false
+		}
+		return out, err == nil
+	}
+	return nil, false
+}
+
+func orZero(x *big.Int) *big.Int {
+	if x == nil {
+		return new(big.Int)
+	}
+	return x
+}
+
+func (f *fakeChain) CodeAt(context.Context, common.Address, *big.Int) ([]byte, error) {
+	return []byte{1}, nil
+}
+
+func (f *fakeChain) CallContract(_ context.Context, msg ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	if *msg.To != multicall.Multicall3Address {
+		out, ok := f.execute(*msg.To, msg.Data)
+		if !ok {
+			return nil, errors.New("execution reverted")
+		}
+		return out, nil
+	}
+	aggregate3 := multicall.Multicall3ABI.Methods["aggregate3"]
+	args, err := aggregate3.Inputs.Unpack(msg.Data[4:])
+	if err != nil {
+		return nil, err
+	}
+	calls := *abi.ConvertType(args[0], new([]call3)).(*[]call3)
+	results := make([]result3, len(calls))
+	for i, c := range calls {
+		results[i].ReturnData, results[i].Success = f.execute(c.Target, c.CallData)
+	}
+	return aggregate3.Outputs.Pack(results)
+}
+
+func planLimits(t *testing.T, chain *fakeChain, req Request) (*Plan, error) {
+	t.Helper()
+	return PlanLimits(context.Background(), multicall.NewCaller(chain, nil), chain, network, req)
+}
+
+func TestPlanLimits(t *testing.T) {
+	target := func(vault common.Address, id, limit int64) Target {
+		return Target{Vault: vault, SubnetworkID: big.NewInt(id), MaxNetworkLimit: big.NewInt(limit)}
+	}
+	tests := []struct {
+		name    string
+		targets []Target
+		// changes lists the delegators of the entries that change.
+		changes []common.Address
+		// warnings lists the delegators of the entries with a warning.
+		warnings []common.Address
+	}{
+		{
+			name:    "already set",
+			targets: []Target{target(restakeVault, 0, 100), target(curatedVault, 0, 1000)},
+		},
+		{
+			name:    "raised",
+			targets: []Target{target(restakeVault, 0, 200), target(curatedVault, 0, 1000)},
+			changes: []common.Address{restakeDelegator},
+		},
+		{
+			name:     "below the curator's network limit",
+			targets:  []Target{target(curatedVault, 0, 200)},
+			changes:  []common.Address{curatedDelegator},
+			warnings: []common.Address{curatedDelegator},
+		},
+		{
+			name:    "delegator without network limits",
+			targets: []Target{target(specificVault, 0, 50), target(restakeVault, 1, 10)},
+			changes: []common.Address{specificDelegator, restakeDelegator},
+		},
+	}
+	for _, tt := range tests {
+		for _, mode := range []Mode{Middleware, Timelock} {
+			t.Run(tt.name+"/"+string(mode), func(t *testing.T) {
+				plan, err := planLimits(t, newFakeChain(t), Request{Targets: tt.targets, Mode: mode})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if plan.BlockNumber != 42 || len(plan.Entries) != len(tt.targets) {
+					t.Fatalf("plan at block %d with %d entries", plan.BlockNumber, len(plan.Entries))
+				}
+				var changes, warnings []common.Address
+				for _, e := range plan.Entries {
+					if e.Change {
+						changes = append(changes, e.Delegator)
+					}
+					if e.Warning != "" {
+						warnings = append(warnings, e.Delegator)
+					}
+					if (e.NetworkLimit == nil) != (e.DelegatorType == core.OperatorNetworkSpecific) {
+						t.Errorf("%s delegator %s has network limit %v", e.DelegatorType, e.Delegator, e.NetworkLimit)
+					}
+				}
+				if !equalAddresses(changes, tt.changes) || !equalAddresses(warnings, tt.warnings) {
+					t.Errorf("changes %v with warnings %v, want %v with %v", changes, warnings, tt.changes, tt.warnings)
+				}
+				checkCalls(t, plan)
+			})
+		}
+	}
+}
+
+// checkCalls checks that the calls of plan set exactly its changes.
+func checkCalls(t *testing.T, plan *Plan) {
+	t.Helper()
+	changes := plan.Changes()
+	if plan.Mode == Middleware {
+		if plan.Prepared != nil {
+			t.Error("Middleware plan has a timelock operation")
+		}
+		if len(changes) > 0 && plan.Middleware != middleware {
+			t.Errorf("middleware = %s, want %s", plan.Middleware, middleware)
+		}
+		if len(plan.HookCalls) != len(changes) {
+			t.Fatalf("%d hook calls for %d changes", len(plan.HookCalls), len(changes))
+		}
+		hookABI, err := networkcontracts.ISetMaxNetworkLimitHookMetaData.GetAbi()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i, e := range changes {
+			want, err := hookABI.Pack("setMaxNetworkLimit", e.Delegator, e.SubnetworkID, e.Target)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(plan.HookCalls[i].Data, want) {
+				t.Errorf("hook call %d = %s, want setMaxNetworkLimit(%s, %s, %s)", i, plan.HookCalls[i].Data, e.Delegator, e.SubnetworkID, e.Target)
+			}
+		}
+		return
+	}
+
+	if len(plan.HookCalls) != 0 {
+		t.Error("Timelock plan has hook calls")
+	}
+	if len(changes) == 0 {
+		if plan.Prepared != nil {
+			t.Error("plan without changes has a timelock operation")
+		}
+		return
+	}
+	op := plan.Operation
+	if !op.IsBatch() || len(op.Calls) != len(changes) {
+		t.Fatalf("operation with %d calls, batch %v, for %d changes", len(op.Calls), op.IsBatch(), len(changes))
+	}
+	for i, e := range changes {
+		want, err := core.BaseDelegatorABI.Pack("setMaxNetworkLimit", e.SubnetworkID, e.Target)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if op.Calls[i].Target != e.Delegator || !bytes.Equal(op.Calls[i].Data, want) {
+			t.Errorf("call %d = %s %x, want setMaxNetworkLimit on %s", i, op.Calls[i].Target, op.Calls[i].Data, e.Delegator)
+		}
+	}
+	if !bytes.Equal(plan.ScheduleData[:4], timelock.ABI.Methods["scheduleBatch"].ID) {
+		t.Errorf("schedule calldata %s does not call scheduleBatch", plan.ScheduleData)
+	}
+	if plan.RequiredDelay.Int64() != 3600 || op.Delay.Int64() != 3600 {
+		t.Errorf("delay %s, required %s, want 3600", op.Delay, plan.RequiredDelay)
+	}
+}
+
+func equalAddresses(a, b []common.Address) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestPlanLimitsErrors(t *testing.T) {
+	valid := Target{Vault: restakeVault, SubnetworkID: big.NewInt(0), MaxNetworkLimit: big.NewInt(1)}
+	tests := []struct {
+		name    string
+		req     Request
+		garbled bool
+	}{
+		{name: "unknown mode", req: Request{Targets: []Target{valid}, Mode: "direct"}},
+		{name: "no targets", req: Request{Mode: Timelock}},
+		{name: "duplicate target", req: Request{Targets: []Target{valid, valid}, Mode: Timelock}},
+		{
+			name: "subnetwork identifier above uint96",
+			req: Request{Mode: Timelock, Targets: []Target{
+				{Vault: restakeVault, SubnetworkID: new(big.Int).Lsh(common.Big1, 96), MaxNetworkLimit: big.NewInt(1)},
+			}},
+		},
+		{
+			name: "negative limit",
+			req:  Request{Mode: Timelock, Targets: []Target{{Vault: restakeVault, SubnetworkID: big.NewInt(0), MaxNetworkLimit: big.NewInt(-1)}}},
+		},
+		{name: "delay too short", req: Request{Targets: []Target{valid}, Mode: Timelock, Delay: big.NewInt(60)}},
+		// Only a revert means the delegator has no network limit.
+		{name: "network limit undecodable", req: Request{Targets: []Target{valid}, Mode: Timelock}, garbled: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chain := newFakeChain(t)
+			chain.delegators[restakeDelegator].garbled = tt.garbled
+			if _, err := planLimits(t, chain, tt.req); err == nil {
+				t.Error("PlanLimits() succeeded")
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package limits
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	networkcontracts "github.com/symbioticfi/network/bindings/go-go-ethereum"
+	"github.com/symbioticfi/network/pkg/subnetwork"
+)
+
+// SendBackend is the chain access needed to send hook calls and await them.
+// It is satisfied by *ethclient.Client.
+type SendBackend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+}
+
+// SendResult is the outcome of a single hook call.
+type SendResult struct {
+	Delegator    common.Address `json:"delegator"`
+	SubnetworkID *big.Int       `json:"subnetworkId"`
+	TxHash       common.Hash    `json:"txHash,omitempty"`
+	// MaxNetworkLimit is read back after the transaction is mined.
+	MaxNetworkLimit *big.Int `json:"maxNetworkLimit,omitempty"`
+	Error           string   `json:"error,omitempty"`
+}
+
+// Send makes the hook calls of a Middleware plan from opts.From, which must
+// be the Network's middleware. All transactions are sent before any is
+// awaited; once they are mined, every maximum network limit is read back.
+func (p *Plan) Send(ctx context.Context, opts *bind.TransactOpts, backend SendBackend) ([]SendResult, error) {
+	if p.Mode != Middleware {
+		return nil, fmt.Errorf("limits: only %s plans can be sent, schedule the operation of %s plans", Middleware, p.Mode)
+	}
+	if opts.From != p.Middleware {
+		return nil, fmt.Errorf("limits: %s is not the middleware %s", opts.From, p.Middleware)
+	}
+	for _, c := range p.HookCalls {
+		if err := subnetwork.ValidateIdentifier(c.SubnetworkID); err != nil {
+			return nil, fmt.Errorf("limits: %w", err)
+		}
+	}
+	hook, err := networkcontracts.NewISetMaxNetworkLimitHookTransactor(p.Network, backend)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]SendResult, len(p.HookCalls))
+	txs := make([]*types.Transaction, len(p.HookCalls))
+	var nonce *big.Int
+	if opts.Nonce != nil {
+		nonce = new(big.Int).Set(opts.Nonce)
+	} else {
+		pending, err := backend.PendingNonceAt(ctx, opts.From)
+		if err != nil {
+			return nil, fmt.Errorf("limits: get nonce: %w", err)
+		}
+		nonce = new(big.Int).SetUint64(pending)
+	}
+	for i, c := range p.HookCalls {
+		results[i].Delegator, results[i].SubnetworkID = c.Delegator, c.SubnetworkID
+		send := *opts
+		send.Context = ctx
+		send.Nonce = new(big.Int).Set(nonce)
+		tx, err := hook.SetMaxNetworkLimit(&send, c.Delegator, c.SubnetworkID, c.MaxNetworkLimit)
+		if err != nil {
+			results[i].Error = err.Error()
+			continue
+		}
+		nonce.Add(nonce, common.Big1)
+		txs[i] = tx
+		results[i].TxHash = tx.Hash()
+	}
+	for i, tx := range txs {
+		if tx == nil {
+			continue
+		}
+		receipt, err := bind.WaitMined(ctx, backend, tx)
+		if err != nil {
+			results[i].Error = err.Error()
+			continue
+		}
+		if receipt.Status != types.ReceiptStatusSuccessful {
+			results[i].Error = "setMaxNetworkLimit transaction reverted"
+		}
+	}
+	callOpts := &bind.CallOpts{Context: ctx}
+	for i, c := range p.HookCalls {
+		delegator, err := networkcontracts.NewIBaseDelegatorCaller(c.Delegator, backend)
+		if err != nil {
+			return nil, err
+		}
+		s, _ := subnetwork.FromNetwork(p.Network, c.SubnetworkID)
+		max, err := delegator.MaxNetworkLimit(callOpts, s.Hash())
+		if err != nil {
+			if results[i].Error == "" {
+				results[i].Error = err.Error()
+			}
+			continue
+		}
+		results[i].MaxNetworkLimit = max
+		if results[i].Error == "" && results[i].MaxNetworkLimit.Cmp(c.MaxNetworkLimit) != 0 {
+			results[i].Error = fmt.Sprintf("maxNetworkLimit is %s, expected %s", results[i].MaxNetworkLimit, c.MaxNetworkLimit)
+		}
+	}
+	return results, nil
+}
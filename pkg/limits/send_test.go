@@ -0,0 +1,184 @@
+package limits
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	networkcontracts "github.com/symbioticfi/network/bindings/go-go-ethereum"
+	"github.com/symbioticfi/network/pkg/subnetwork"
+)
+
+func (f *fakeChain) PendingNonceAt(context.Context, common.Address) (uint64, error) {
+	return f.pendingNonce, nil
+}
+
+func (f *fakeChain) SendTransaction(_ context.Context, tx *types.Transaction) error {
+	hookABI, err := networkcontracts.ISetMaxNetworkLimitHookMetaData.GetAbi()
+	if err != nil {
+		return err
+	}
+	args, err := hookABI.Methods["setMaxNetworkLimit"].Inputs.Unpack(tx.Data()[4:])
+	if err != nil {
+		return err
+	}
+	delegator, id, limit := args[0].(common.Address), args[1].(*big.Int), args[2].(*big.Int)
+	if err := f.sendErr[delegator]; err != nil {
+		return err
+	}
+	f.sent = append(f.sent, tx)
+	if f.revert[delegator] || f.ignore[delegator] {
+		return nil
+	}
+	s, err := subnetwork.FromNetwork(network, id)
+	if err != nil {
+		return err
+	}
+	f.delegators[delegator].maxLimits[s.Hash()] = limit
+	return nil
+}
+
+func (f *fakeChain) TransactionReceipt(_ context.Context, hash common.Hash) (*types.Receipt, error) {
+	hookABI, err := networkcontracts.ISetMaxNetworkLimitHookMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	for _, tx := range f.sent {
+		if tx.Hash() != hash {
+			continue
+		}
+		args, err := hookABI.Methods["setMaxNetworkLimit"].Inputs.Unpack(tx.Data()[4:])
+		if err != nil {
+			return nil, err
+		}
+		receipt := &types.Receipt{TxHash: hash, Status: types.ReceiptStatusSuccessful, BlockNumber: big.NewInt(43)}
+		if f.revert[args[0].(common.Address)] {
+			receipt.Status = types.ReceiptStatusFailed
+		}
+		return receipt, nil
+	}
+	return nil, errors.New("unknown transaction")
+}
+
+// sendOpts signs nothing and sets the gas, so only the nonce is read from
+// the backend.
+func sendOpts(from common.Address, nonce *big.Int) *bind.TransactOpts {
+	return &bind.TransactOpts{
+		From:     from,
+		Nonce:    nonce,
+		GasPrice: big.NewInt(1),
+		GasLimit: 100_000,
+		Signer:   func(_ common.Address, tx *types.Transaction) (*types.Transaction, error) { return tx, nil },
+	}
+}
+
+func middlewarePlan(t *testing.T, chain *fakeChain) *Plan {
+	t.Helper()
+	plan, err := planLimits(t, chain, Request{Mode: Middleware, Targets: []Target{
+		{Vault: restakeVault, SubnetworkID: big.NewInt(0), MaxNetworkLimit: big.NewInt(200)},
+		{Vault: curatedVault, SubnetworkID: big.NewInt(0), MaxNetworkLimit: big.NewInt(2000)},
+		{Vault: specificVault, SubnetworkID: big.NewInt(0), MaxNetworkLimit: big.NewInt(50)},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return plan
+}
+
+func TestSend(t *testing.T) {
+	tests := []struct {
+		name    string
+		nonce   *big.Int
+		sendErr map[common.Address]error
+		revert  map[common.Address]bool
+		ignore  map[common.Address]bool
+		// nonces are the nonces of the sent transactions.
+		nonces []uint64
+		// failed lists the delegators whose result has an error.
+		failed []common.Address
+	}{
+		{name: "pending nonce", nonces: []uint64{5, 6, 7}},
+		{name: "given nonce", nonce: big.NewInt(9), nonces: []uint64{9, 10, 11}},
+		{
+			name:    "failed send keeps its nonce",
+			sendErr: map[common.Address]error{curatedDelegator: errors.New("insufficient funds")},
+			nonces:  []uint64{5, 6},
+			failed:  []common.Address{curatedDelegator},
+		},
+		{
+			name:   "reverted",
+			revert: map[common.Address]bool{restakeDelegator: true},
+			nonces: []uint64{5, 6, 7},
+			failed: []common.Address{restakeDelegator},
+		},
+		{
+			name:   "limit not read back",
+			ignore: map[common.Address]bool{specificDelegator: true},
+			nonces: []uint64{5, 6, 7},
+			failed: []common.Address{specificDelegator},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chain := newFakeChain(t)
+			chain.pendingNonce = 5
+			plan := middlewarePlan(t, chain)
+			chain.sendErr, chain.revert, chain.ignore = tt.sendErr, tt.revert, tt.ignore
+
+			opts := sendOpts(middleware, tt.nonce)
+			results, err := plan.Send(context.Background(), opts, chain)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var nonces []uint64
+			for _, tx := range chain.sent {
+				nonces = append(nonces, tx.Nonce())
+			}
+			if len(nonces) != len(tt.nonces) {
+				t.Fatalf("sent nonces %v, want %v", nonces, tt.nonces)
+			}
+			for i := range nonces {
+				if nonces[i] != tt.nonces[i] {
+					t.Errorf("sent nonces %v, want %v", nonces, tt.nonces)
+					break
+				}
+			}
+			if tt.nonce != nil && opts.Nonce.Cmp(tt.nonce) != 0 {
+				t.Errorf("opts.Nonce changed to %s", opts.Nonce)
+			}
+			var failed []common.Address
+			for i, r := range results {
+				if r.Error != "" {
+					failed = append(failed, r.Delegator)
+					continue
+				}
+				if r.MaxNetworkLimit.Cmp(plan.HookCalls[i].MaxNetworkLimit) != 0 || r.TxHash == (common.Hash{}) {
+					t.Errorf("result %d = %+v, want maxNetworkLimit %s", i, r, plan.HookCalls[i].MaxNetworkLimit)
+				}
+			}
+			if !equalAddresses(failed, tt.failed) {
+				t.Errorf("failed %v, want %v: %+v", failed, tt.failed, results)
+			}
+		})
+	}
+}
+
+func TestSendRejects(t *testing.T) {
+	chain := newFakeChain(t)
+	plan := middlewarePlan(t, chain)
+	if _, err := plan.Send(context.Background(), sendOpts(network, nil), chain); err == nil {
+		t.Error("Send() from an account other than the middleware succeeded")
+	}
+	plan.Mode = Timelock
+	if _, err := plan.Send(context.Background(), sendOpts(middleware, nil), chain); err == nil {
+		t.Error("Send() of a Timelock plan succeeded")
+	}
+	if len(chain.sent) != 0 {
+		t.Errorf("sent %d transactions", len(chain.sent))
+	}
+}
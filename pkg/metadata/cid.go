@@ -0,0 +1,32 @@
+package metadata
+
+import (
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/binary"
+)
+
+const (
+	cidVersion1   = 0x01
+	codecRaw      = 0x55
+	multihashSHA2 = 0x12
+	// ipfsChunkSize is the default chunk size of ipfs add. Documents up to
+	// this size are stored as a single raw block.
+	ipfsChunkSize = 256 << 10
+)
+
+var base32Lower = base32.NewEncoding("abcdefghijklmnopqrstuvwxyz234567").WithPadding(base32.NoPadding)
+
+// CID returns the CIDv1 of data stored as a single raw block, in its base32
+// string form. It equals the CID printed by
+// "ipfs add --cid-version=1 --raw-leaves" for documents of at most 256 KiB.
+func CID(data []byte) string {
+	digest := sha256.Sum256(data)
+	cid := binary.AppendUvarint(nil, cidVersion1)
+	cid = binary.AppendUvarint(cid, codecRaw)
+	cid = binary.AppendUvarint(cid, multihashSHA2)
+	cid = binary.AppendUvarint(cid, uint64(len(digest)))
+	cid = append(cid, digest[:]...)
+	// "b" is the multibase prefix of lowercase, unpadded base32.
+	return "b" + base32Lower.EncodeToString(cid)
+}
@@ -0,0 +1,22 @@
+package metadata
+
+import "testing"
+
+func TestCID(t *testing.T) {
+	tests := []struct {
+		data string
+		want string
+	}{
+		// The CID of an empty raw block, as printed by
+		// "ipfs add --cid-version=1 --raw-leaves" for an empty file.
+		{"", "bafkreihdwdcefgh4dqkjv67uzcmw7ojee6xedzdetojuzjevtenxquvyku"},
+	}
+	for _, tt := range tests {
+		if got := CID([]byte(tt.data)); got != tt.want {
+			t.Errorf("CID(%q) = %s, want %s", tt.data, got, tt.want)
+		}
+	}
+	if CID([]byte("a")) == CID([]byte("b")) {
+		t.Error("different documents have the same CID")
+	}
+}
@@ -0,0 +1,146 @@
+// Package metadata resolves the document a Network's metadataURI points to and
+// validates it against the Symbiotic network metadata schema.
+package metadata
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// DefaultIPFSGateway is the gateway ipfs:// URIs are resolved through unless
+// Fetcher.IPFSGateway is set.
+const DefaultIPFSGateway = "https://ipfs.io/ipfs/"
+
+// DefaultMaxSize is the largest document a Fetcher accepts unless
+// Fetcher.MaxSize is set.
+const DefaultMaxSize = 1 << 20
+
+// Fetcher resolves https://, ipfs:// and data: URIs.
+type Fetcher struct {
+	Client *http.Client
+	// IPFSGateway is the URL prefix an ipfs://<cid>/<path> URI is resolved
+	// against, for example "http://127.0.0.1:8080/ipfs/".
+	IPFSGateway string
+	// MaxSize is the largest document accepted, in bytes.
+	MaxSize int64
+	// AllowHTTP accepts http:// URIs, for local development.
+	AllowHTTP bool
+}
+
+// Fetch returns the document uri points to.
+func (f *Fetcher) Fetch(ctx context.Context, uri string) ([]byte, error) {
+	if uri == "" {
+		return nil, errors.New("metadata: empty URI")
+	}
+	scheme, rest, found := strings.Cut(uri, ":")
+	if !found {
+		return nil, fmt.Errorf("metadata: URI %q has no scheme", uri)
+	}
+	switch strings.ToLower(scheme) {
+	case "https":
+		return f.get(ctx, uri)
+	case "http":
+		if !f.AllowHTTP {
+			return nil, fmt.Errorf("metadata: insecure URI %q", uri)
+		}
+		return f.get(ctx, uri)
+	case "ipfs":
+		return f.get(ctx, f.gatewayURL(strings.TrimPrefix(rest, "//")))
+	case "data":
+		return f.decodeData(rest)
+	default:
+		return nil, fmt.Errorf("metadata: unsupported URI scheme %q", scheme)
+	}
+}
+
+// GatewayURL returns the HTTP URL an ipfs:// URI is fetched from.
+func (f *Fetcher) GatewayURL(uri string) string {
+	return f.gatewayURL(strings.TrimPrefix(uri, "ipfs://"))
+}
+
+func (f *Fetcher) gatewayURL(path string) string {
+	gateway := f.IPFSGateway
+	if gateway == "" {
+		gateway = DefaultIPFSGateway
+	}
+	// Accept both ipfs://<cid> and the legacy ipfs://ipfs/<cid> form.
+	path = strings.TrimPrefix(path, "ipfs/")
+	return strings.TrimSuffix(gateway, "/") + "/" + path
+}
+
+func (f *Fetcher) maxSize() int64 {
+	if f.MaxSize > 0 {
+		return f.MaxSize
+	}
+	return DefaultMaxSize
+}
+
+func (f *Fetcher) get(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("metadata: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	client := f.Client
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("metadata: get %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("metadata: get %s: %s", url, resp.Status)
+	}
+	return readLimited(resp.Body, f.maxSize())
+}
+
+// decodeData decodes the part of a data: URI after the scheme, as defined by
+// RFC 2397.
+func (f *Fetcher) decodeData(rest string) ([]byte, error) {
+	header, payload, found := strings.Cut(rest, ",")
+	if !found {
+		return nil, errors.New("metadata: data URI without a comma")
+	}
+	var (
+		data []byte
+		err  error
+	)
+	if strings.HasSuffix(header, ";base64") {
+		data, err = base64.StdEncoding.DecodeString(payload)
+		if err != nil {
+			// Some encoders emit URL-safe or unpadded base64.
+			data, err = base64.RawURLEncoding.DecodeString(strings.TrimRight(payload, "="))
+		}
+	} else {
+		var s string
+		s, err = url.PathUnescape(payload)
+		data = []byte(s)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("metadata: decode data URI: %w", err)
+	}
+	if int64(len(data)) > f.maxSize() {
+		return nil, fmt.Errorf("metadata: document is larger than %d bytes", f.maxSize())
+	}
+	return data, nil
+}
+
+func readLimited(r io.Reader, limit int64) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(r, limit+1))
+	if err != nil {
+		return nil, fmt.Errorf("metadata: read document: %w", err)
+	}
+	if int64(len(data)) > limit {
+		return nil, fmt.Errorf("metadata: document is larger than %d bytes", limit)
+	}
+	return data, nil
+}
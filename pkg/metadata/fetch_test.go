@@ -0,0 +1,36 @@
+package metadata
+
+import (
+	"context"
+	"testing"
+)
+
+func TestFetchData(t *testing.T) {
+	tests := []struct {
+		uri     string
+		want    string
+		wantErr bool
+	}{
+		{uri: `data:application/json,{"version":1}`, want: `{"version":1}`},
+		{uri: `data:application/json,%7B%22a%22%3A%201%7D`, want: `{"a": 1}`},
+		{uri: `data:application/json;base64,eyJ2ZXJzaW9uIjoxfQ==`, want: `{"version":1}`},
+		{uri: `data:application/json;base64,eyJ2ZXJzaW9uIjoxfQ`, want: `{"version":1}`},
+		{uri: `data:;base64,-_8`, want: "\xfb\xff"},
+		{uri: `DATA:,x`, want: "x"},
+		{uri: `data:application/json`, wantErr: true},
+		{uri: `data:;base64,!!!`, wantErr: true},
+		{uri: `data:,%zz`, wantErr: true},
+		{uri: `data:,0123456789abcdefg`, wantErr: true},
+	}
+	f := &Fetcher{MaxSize: 16}
+	for _, tt := range tests {
+		got, err := f.Fetch(context.Background(), tt.uri)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Fetch(%q) error = %v, wantErr %v", tt.uri, err, tt.wantErr)
+			continue
+		}
+		if err == nil && string(got) != tt.want {
+			t.Errorf("Fetch(%q) = %q, want %q", tt.uri, got, tt.want)
+		}
+	}
+}
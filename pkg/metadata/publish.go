@@ -0,0 +1,220 @@
+package metadata
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	networkcontracts "github.com/symbioticfi/network/bindings/go-go-ethereum"
+	"github.com/symbioticfi/network/pkg/roles"
+)
+
+// TemplateData is the data a metadata template is executed with.
+type TemplateData struct {
+	Name    string
+	ChainID uint64
+	Network common.Address
+	// Values holds user-supplied values, for example from --set flags.
+	Values map[string]string
+}
+
+// Build executes a text/template producing a metadata document, validates the
+// result and returns it in canonical form: indented JSON with the fields in
+// schema order, so equal documents always have the same CID.
+func Build(tmpl string, data TemplateData) ([]byte, error) {
+	t, err := template.New("metadata").Option("missingkey=error").Parse(tmpl)
+	if err != nil {
+		return nil, fmt.Errorf("metadata: parse template: %w", err)
+	}
+	var rendered bytes.Buffer
+	if err := t.Execute(&rendered, data); err != nil {
+		return nil, fmt.Errorf("metadata: execute template: %w", err)
+	}
+	m, issues := Validate(rendered.Bytes())
+	if len(issues) > 0 {
+		return nil, issuesError(issues)
+	}
+	return Canonical(m)
+}
+
+// Canonical returns the canonical encoding of a document.
+func Canonical(m *Metadata) ([]byte, error) {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+func issuesError(issues []Issue) error {
+	messages := make([]string, len(issues))
+	for i, issue := range issues {
+		messages[i] = issue.String()
+	}
+	return fmt.Errorf("metadata: invalid document: %s", strings.Join(messages, "; "))
+}
+
+// PublishBackend is the chain access needed to publish metadata. It is
+// satisfied by *ethclient.Client.
+type PublishBackend interface {
+	Backend
+	bind.ContractBackend
+	bind.DeployBackend
+}
+
+// Publication is a metadata document stored for a Network.
+type Publication struct {
+	Network common.Address `json:"network"`
+	CID     string         `json:"cid"`
+	// ContentHash is the sha256 digest of the document.
+	ContentHash hexutil.Bytes `json:"contentHash"`
+	URI         string        `json:"uri"`
+	// Unchanged is set when metadataURI() already equals URI.
+	Unchanged bool `json:"unchanged,omitempty"`
+
+	TxHash      common.Hash `json:"txHash,omitempty"`
+	BlockNumber uint64      `json:"blockNumber,omitempty"`
+}
+
+// Publisher stores metadata documents and points Networks at them.
+type Publisher struct {
+	backend PublishBackend
+	storage Storage
+	fetcher *Fetcher
+}
+
+// NewPublisher creates a Publisher storing documents in storage. Stored
+// documents are fetched back through fetcher before any transaction is sent.
+func NewPublisher(backend PublishBackend, storage Storage, fetcher *Fetcher) *Publisher {
+	return &Publisher{backend: backend, storage: storage, fetcher: fetcher}
+}
+
+// Prepare checks document against the Network at network, stores it and
+// verifies that its URI serves the exact bytes.
+func (p *Publisher) Prepare(ctx context.Context, network common.Address, document []byte) (*Publication, error) {
+	if len(document) > ipfsChunkSize {
+		return nil, fmt.Errorf("metadata: document is larger than %d bytes", ipfsChunkSize)
+	}
+	m, issues := Validate(document)
+	if len(issues) > 0 {
+		return nil, issuesError(issues)
+	}
+	caller, err := networkcontracts.NewINetworkCaller(network, p.backend)
+	if err != nil {
+		return nil, err
+	}
+	opts := &bind.CallOpts{Context: ctx}
+	name, err := caller.Name(opts)
+	if err != nil {
+		return nil, fmt.Errorf("metadata: name(): %w", err)
+	}
+	chainID, err := p.backend.ChainID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("metadata: get chain ID: %w", err)
+	}
+	if issues := Check(m, name, chainID.Uint64(), network); len(issues) > 0 {
+		return nil, issuesError(issues)
+	}
+
+	cid := CID(document)
+	digest := sha256.Sum256(document)
+	uri, err := p.storage.Put(ctx, cid, document)
+	if err != nil {
+		return nil, err
+	}
+	served, err := p.fetcher.Fetch(ctx, uri)
+	if err != nil {
+		return nil, fmt.Errorf("metadata: fetch stored document: %w", err)
+	}
+	if !bytes.Equal(served, document) {
+		return nil, fmt.Errorf("metadata: %s serves a different document", uri)
+	}
+	current, err := caller.MetadataURI(opts)
+	if err != nil {
+		return nil, fmt.Errorf("metadata: metadataURI(): %w", err)
+	}
+	return &Publication{
+		Network:     network,
+		CID:         cid,
+		ContentHash: digest[:],
+		URI:         uri,
+		Unchanged:   current == uri,
+	}, nil
+}
+
+// Send calls updateMetadataURI from opts.From, which must hold
+// METADATA_URI_UPDATE_ROLE, waits for the transaction and checks that it
+// emitted MetadataURISet with the publication URI.
+func (p *Publisher) Send(ctx context.Context, opts *bind.TransactOpts, publication *Publication) error {
+	if publication.Unchanged {
+		return nil
+	}
+	timelock, err := networkcontracts.NewTimelockControllerUpgradeableCaller(publication.Network, p.backend)
+	if err != nil {
+		return err
+	}
+	allowed, err := timelock.HasRole(&bind.CallOpts{Context: ctx}, roles.MetadataURIUpdateRole, opts.From)
+	if err != nil {
+		return fmt.Errorf("metadata: hasRole: %w", err)
+	}
+	if !allowed {
+		return fmt.Errorf("metadata: %s does not hold METADATA_URI_UPDATE_ROLE", opts.From)
+	}
+
+	network, err := networkcontracts.NewINetwork(publication.Network, p.backend)
+	if err != nil {
+		return err
+	}
+	tx, err := network.UpdateMetadataURI(opts, publication.URI)
+	if err != nil {
+		return fmt.Errorf("metadata: send updateMetadataURI: %w", err)
+	}
+	publication.TxHash = tx.Hash()
+	receipt, err := bind.WaitMined(ctx, p.backend, tx)
+	if err != nil {
+		return fmt.Errorf("metadata: wait for %s: %w", tx.Hash(), err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return fmt.Errorf("metadata: updateMetadataURI transaction %s reverted", tx.Hash())
+	}
+	publication.BlockNumber = receipt.BlockNumber.Uint64()
+	for _, log := range receipt.Logs {
+		if log.Address != publication.Network {
+			continue
+		}
+		event, err := network.ParseMetadataURISet(*log)
+		if err != nil {
+			continue
+		}
+		if event.MetadataURI != publication.URI {
+			return fmt.Errorf("metadata: MetadataURISet emitted %q, expected %q", event.MetadataURI, publication.URI)
+		}
+		return nil
+	}
+	return errors.New("metadata: the transaction did not emit MetadataURISet")
+}
+
+// WriteText renders the publication.
+func (p *Publication) WriteText(w io.Writer) error {
+	fmt.Fprintf(w, "network: %s\ncid: %s\nsha256: %s\nuri: %s\n", p.Network, p.CID, p.ContentHash, p.URI)
+	if p.Unchanged {
+		_, err := fmt.Fprintln(w, "metadataURI() already points to this document")
+		return err
+	}
+	if p.TxHash != (common.Hash{}) {
+		_, err := fmt.Fprintf(w, "tx: %s (block %d)\n", p.TxHash, p.BlockNumber)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,289 @@
+package metadata
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	networkcontracts "github.com/symbioticfi/network/bindings/go-go-ethereum"
+	"github.com/symbioticfi/network/pkg/roles"
+	"github.com/symbioticfi/network/pkg/timelock"
+)
+
+var updater = common.HexToAddress("0x0bd4000000000000000000000000000000000000")
+
+const documentTemplate = `{
+  "deployments": [{"network": "{{.Network}}", "chainId": {{.ChainID}}}],
+  "name": "{{.Name}}",
+  "version": 1,
+  "description": "{{.Values.description}}"
+}`
+
+// fakeNetwork is a Network on chain 1 whose metadataURI is set by mined
+// updateMetadataURI transactions. Methods other than the ones below panic.
+type fakeNetwork struct {
+	PublishBackend
+
+	name     string
+	uri      string
+	updaters []common.Address
+	// revert makes updateMetadataURI transactions fail.
+	revert bool
+	// emit overrides the URI the MetadataURISet event carries.
+	emit    string
+	sent    int
+	emitted string
+}
+
+func (f *fakeNetwork) ChainID(context.Context) (*big.Int, error) {
+	return big.NewInt(1), nil
+}
+
+func (f *fakeNetwork) CallContract(_ context.Context, call ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	networkABI, err := networkcontracts.INetworkMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	for _, parsed := range []*abi.ABI{timelock.ABI, networkABI} {
+		method, err := parsed.MethodById(call.Data[:4])
+		if err != nil {
+			continue
+		}
+		args, err := method.Inputs.Unpack(call.Data[4:])
+		if err != nil {
+			return nil, err
+		}
+		switch method.Name {
+		case "name":
+			return method.Outputs.Pack(f.name)
+		case "metadataURI":
+			return method.Outputs.Pack(f.uri)
+		case "hasRole":
+			role, account := common.Hash(args[0].([32]byte)), args[1].(common.Address)
+			has := false
+			for _, holder := range f.updaters {
+				has = has || role == roles.MetadataURIUpdateRole && holder == account
+			}
+			return method.Outputs.Pack(has)
+		}
+	}
+	return nil, errors.New("unexpected call")
+}
+
+func (f *fakeNetwork) SendTransaction(_ context.Context, tx *types.Transaction) error {
+	networkABI, err := networkcontracts.INetworkMetaData.GetAbi()
+	if err != nil {
+		return err
+	}
+	args, err := networkABI.Methods["updateMetadataURI"].Inputs.Unpack(tx.Data()[4:])
+	if err != nil {
+		return err
+	}
+	f.sent++
+	f.emitted = args[0].(string)
+	if f.emit != "" {
+		f.emitted = f.emit
+	}
+	if !f.revert {
+		f.uri = args[0].(string)
+	}
+	return nil
+}
+
+func (f *fakeNetwork) TransactionReceipt(_ context.Context, hash common.Hash) (*types.Receipt, error) {
+	receipt := &types.Receipt{TxHash: hash, BlockNumber: big.NewInt(7)}
+	if f.revert {
+		receipt.Status = types.ReceiptStatusFailed
+		return receipt, nil
+	}
+	networkABI, err := networkcontracts.INetworkMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	event := networkABI.Events["MetadataURISet"]
+	data, err := event.Inputs.Pack(f.emitted)
+	if err != nil {
+		return nil, err
+	}
+	receipt.Status = types.ReceiptStatusSuccessful
+	receipt.Logs = []*types.Log{{Address: network, Topics: []common.Hash{event.ID}, Data: data, BlockNumber: 7}}
+	return receipt, nil
+}
+
+// transactOpts signs nothing and sets every field the backend would
+// otherwise be asked for.
+func transactOpts(from common.Address) *bind.TransactOpts {
+	return &bind.TransactOpts{
+		From:     from,
+		Nonce:    big.NewInt(0),
+		GasPrice: big.NewInt(1),
+		GasLimit: 100_000,
+		Signer:   func(_ common.Address, tx *types.Transaction) (*types.Transaction, error) { return tx, nil },
+		Context:  context.Background(),
+	}
+}
+
+func buildDocument(t *testing.T, name string) []byte {
+	t.Helper()
+	document, err := Build(documentTemplate, TemplateData{
+		Name:    name,
+		ChainID: 1,
+		Network: network,
+		Values:  map[string]string{"description": "Secures my protocol."},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return document
+}
+
+func TestBuild(t *testing.T) {
+	document := buildDocument(t, "My Network")
+	// The fields are reordered into schema order.
+	m, issues := Validate(document)
+	if len(issues) > 0 {
+		t.Fatal(issues)
+	}
+	canonical, err := Canonical(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(document, canonical) {
+		t.Errorf("Build() = %s, want the canonical form %s", document, canonical)
+	}
+	if !strings.HasPrefix(string(document), "{\n  \"version\": 1,\n  \"name\": \"My Network\"") {
+		t.Errorf("Build() = %s, want version and name first", document)
+	}
+
+	for _, tt := range []struct {
+		name string
+		tmpl string
+	}{
+		{"missing value", strings.Replace(documentTemplate, ".Values.description", ".Values.summary", 1)},
+		{"unknown field", strings.Replace(documentTemplate, "{{.Name}}", "{{.Owner}}", 1)},
+		{"invalid document", strings.Replace(documentTemplate, `"version": 1`, `"version": 2`, 1)},
+		{"unparsable template", documentTemplate + "{{"},
+	} {
+		data := TemplateData{Name: "My Network", ChainID: 1, Network: network, Values: map[string]string{"description": "x"}}
+		if _, err := Build(tt.tmpl, data); err == nil {
+			t.Errorf("%s: Build() succeeded", tt.name)
+		}
+	}
+}
+
+func TestPublishRoundTrip(t *testing.T) {
+	server := httptest.NewServer(&IPFSStandIn{})
+	defer server.Close()
+	fetcher := &Fetcher{IPFSGateway: server.URL + "/ipfs/"}
+	backend := &fakeNetwork{name: "My Network", updaters: []common.Address{updater}}
+	publisher := NewPublisher(backend, &IPFS{APIURL: server.URL}, fetcher)
+	ctx := context.Background()
+	document := buildDocument(t, "My Network")
+
+	publication, err := publisher.Prepare(ctx, network, document)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if publication.CID != CID(document) || publication.URI != "ipfs://"+CID(document) || publication.Unchanged {
+		t.Fatalf("Prepare() = %+v", publication)
+	}
+	if err := publisher.Send(ctx, transactOpts(updater), publication); err != nil {
+		t.Fatal(err)
+	}
+	if backend.uri != publication.URI || publication.TxHash == (common.Hash{}) || publication.BlockNumber != 7 {
+		t.Errorf("after Send() metadataURI() = %q, publication %+v", backend.uri, publication)
+	}
+
+	report, err := Verify(ctx, backend, network, fetcher)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.OK() || report.MetadataURI != publication.URI {
+		t.Errorf("Verify() = %+v, want the published document without issues", report)
+	}
+
+	// Publishing the same document again sends nothing.
+	again, err := publisher.Prepare(ctx, network, document)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !again.Unchanged {
+		t.Error("Prepare() of the published document is not Unchanged")
+	}
+	if err := publisher.Send(ctx, transactOpts(updater), again); err != nil || backend.sent != 1 {
+		t.Errorf("Send() of an unchanged publication = %v after %d transactions, want none", err, backend.sent)
+	}
+}
+
+func TestPrepareErrors(t *testing.T) {
+	// The server serves a different document than the one stored.
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Write([]byte(`{"version": 1}`))
+	}))
+	defer server.Close()
+	tests := []struct {
+		name     string
+		document []byte
+		stored   bool
+	}{
+		{name: "name mismatch", document: buildDocument(t, "Other Network")},
+		{name: "invalid document", document: []byte(`{"version": 1}`)},
+		{name: "too large", document: make([]byte, ipfsChunkSize+1)},
+		{name: "storage serves other bytes", document: buildDocument(t, "My Network"), stored: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storage := &Dir{Path: t.TempDir(), BaseURL: server.URL}
+			publisher := NewPublisher(&fakeNetwork{name: "My Network"}, storage, &Fetcher{AllowHTTP: true})
+			if _, err := publisher.Prepare(context.Background(), network, tt.document); err == nil {
+				t.Fatal("Prepare() succeeded")
+			}
+			// Documents are checked before anything is stored.
+			entries, err := os.ReadDir(storage.Path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if stored := len(entries) > 0; stored != tt.stored {
+				t.Errorf("stored = %v, want %v", stored, tt.stored)
+			}
+		})
+	}
+}
+
+func TestSendErrors(t *testing.T) {
+	uri := "ipfs://" + CID([]byte("document"))
+	tests := []struct {
+		name    string
+		from    common.Address
+		backend *fakeNetwork
+		sent    int
+	}{
+		{name: "missing role", from: network, backend: &fakeNetwork{updaters: []common.Address{updater}}},
+		{name: "reverted", from: updater, backend: &fakeNetwork{updaters: []common.Address{updater}, revert: true}, sent: 1},
+		{name: "other URI emitted", from: updater, backend: &fakeNetwork{updaters: []common.Address{updater}, emit: "ipfs://other"}, sent: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			publisher := NewPublisher(tt.backend, nil, nil)
+			err := publisher.Send(context.Background(), transactOpts(tt.from), &Publication{Network: network, URI: uri})
+			if err == nil {
+				t.Fatal("Send() succeeded")
+			}
+			if tt.backend.sent != tt.sent {
+				t.Errorf("sent %d transactions, want %d", tt.backend.sent, tt.sent)
+			}
+		})
+	}
+}
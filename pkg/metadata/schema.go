@@ -0,0 +1,179 @@
+package metadata
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Version is the latest metadata schema version.
+const Version = 1
+
+//go:embed schema/*.json
+var schemas embed.FS
+
+// Schema returns the JSON Schema document of a metadata schema version.
+// Validate implements the same constraints, except the uniqueness of
+// deployments, which JSON Schema cannot express; TestSchemaInSync keeps the
+// two aligned.
+func Schema(version int) ([]byte, error) {
+	data, err := schemas.ReadFile(fmt.Sprintf("schema/v%d.json", version))
+	if err != nil {
+		return nil, fmt.Errorf("metadata: unknown schema version %d", version)
+	}
+	return data, nil
+}
+
+// LinkTypes lists the accepted link types.
+var LinkTypes = []string{"website", "docs", "x", "github", "discord", "telegram", "blog", "other"}
+
+// LogoSchemes lists the URI prefixes accepted for logos.
+var LogoSchemes = []string{"https://", "ipfs://", "data:image/"}
+
+// Length limits of the text fields, in characters.
+const (
+	MaxNameLength        = 100
+	MaxDescriptionLength = 2000
+)
+
+// Metadata is a Symbiotic network metadata document.
+type Metadata struct {
+	Schema      string       `json:"$schema,omitempty"`
+	Version     int          `json:"version"`
+	Name        string       `json:"name"`
+	Description string       `json:"description"`
+	Logo        string       `json:"logo,omitempty"`
+	Links       []Link       `json:"links,omitempty"`
+	Deployments []Deployment `json:"deployments"`
+}
+
+// Link is a link to a project resource.
+type Link struct {
+	Type string `json:"type"`
+	URL  string `json:"url"`
+}
+
+// Deployment is a Network deployment described by the document.
+type Deployment struct {
+	ChainID    uint64          `json:"chainId"`
+	Network    common.Address  `json:"network"`
+	Middleware *common.Address `json:"middleware,omitempty"`
+}
+
+// Deployment returns the deployment of network on chainID, if listed.
+func (m *Metadata) Deployment(chainID uint64, network common.Address) (Deployment, bool) {
+	for _, deployment := range m.Deployments {
+		if deployment.ChainID == chainID && deployment.Network == network {
+			return deployment, true
+		}
+	}
+	return Deployment{}, false
+}
+
+// Issue is a problem found while validating a document.
+type Issue struct {
+	// Path is the JSON path of the offending value, empty for the document.
+	Path    string `json:"path"`
+	Message string `json:"message"`
+}
+
+func (i Issue) String() string {
+	if i.Path == "" {
+		return i.Message
+	}
+	return i.Path + ": " + i.Message
+}
+
+// Validate parses a metadata document and checks it against its schema
+// version. The document is nil when it cannot be parsed at all.
+func Validate(data []byte) (*Metadata, []Issue) {
+	var probe struct {
+		Version int `json:"version"`
+	}
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return nil, []Issue{{Message: fmt.Sprintf("invalid JSON: %v", err)}}
+	}
+	if probe.Version != Version {
+		return nil, []Issue{{Path: "version", Message: fmt.Sprintf("unsupported schema version %d, expected %d", probe.Version, Version)}}
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	var m Metadata
+	if err := decoder.Decode(&m); err != nil {
+		return nil, []Issue{{Message: err.Error()}}
+	}
+	return &m, m.Validate()
+}
+
+// Validate checks the document against the constraints of the schema.
+func (m *Metadata) Validate() []Issue {
+	var issues []Issue
+	add := func(path, format string, args ...any) {
+		issues = append(issues, Issue{Path: path, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if m.Version != Version {
+		add("version", "unsupported schema version %d, expected %d", m.Version, Version)
+	}
+	switch n := utf8.RuneCountInString(m.Name); {
+	case n == 0:
+		add("name", "is required")
+	case n > MaxNameLength:
+		add("name", "is longer than %d characters", MaxNameLength)
+	case strings.TrimSpace(m.Name) != m.Name:
+		add("name", "has leading or trailing whitespace")
+	}
+	switch n := utf8.RuneCountInString(m.Description); {
+	case n == 0:
+		add("description", "is required")
+	case n > MaxDescriptionLength:
+		add("description", "is longer than %d characters", MaxDescriptionLength)
+	}
+	if m.Logo != "" && !hasAnyPrefix(m.Logo, LogoSchemes...) {
+		add("logo", "must be an https://, ipfs:// or data:image/ URI")
+	}
+	for i, link := range m.Links {
+		path := fmt.Sprintf("links[%d]", i)
+		if !slices.Contains(LinkTypes, link.Type) {
+			add(path+".type", "unknown link type %q, expected one of %s", link.Type, strings.Join(LinkTypes, ", "))
+		}
+		if !strings.HasPrefix(link.URL, "https://") {
+			add(path+".url", "must be an https:// URL")
+		}
+	}
+	if len(m.Deployments) == 0 {
+		add("deployments", "must list at least one deployment")
+	}
+	seen := make(map[Deployment]bool)
+	for i, deployment := range m.Deployments {
+		path := fmt.Sprintf("deployments[%d]", i)
+		if deployment.ChainID == 0 {
+			add(path+".chainId", "is required")
+		}
+		if deployment.Network == (common.Address{}) {
+			add(path+".network", "is required")
+		}
+		key := Deployment{ChainID: deployment.ChainID, Network: deployment.Network}
+		if seen[key] {
+			add(path, "duplicates an earlier deployment")
+		}
+		seen[key] = true
+	}
+	return issues
+}
+
+func hasAnyPrefix(s string, prefixes ...string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+	return false
+}
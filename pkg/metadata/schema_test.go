@@ -0,0 +1,109 @@
+package metadata
+
+import (
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// schemaObject is the part of a JSON Schema object definition compared with
+// the Go types and Validate.
+type schemaObject struct {
+	Required   []string                  `json:"required"`
+	Properties map[string]schemaProperty `json:"properties"`
+}
+
+type schemaProperty struct {
+	Const     *int          `json:"const"`
+	MinLength *int          `json:"minLength"`
+	MaxLength *int          `json:"maxLength"`
+	MinItems  *int          `json:"minItems"`
+	Pattern   string        `json:"pattern"`
+	Enum      []string      `json:"enum"`
+	Items     *schemaObject `json:"items"`
+	Not       *struct {
+		Pattern string `json:"pattern"`
+	} `json:"not"`
+}
+
+// jsonFields returns the JSON names of the fields of t and the ones without
+// omitempty.
+func jsonFields(t reflect.Type) (names, required []string) {
+	for i := 0; i < t.NumField(); i++ {
+		name, options, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		names = append(names, name)
+		if options != "omitempty" {
+			required = append(required, name)
+		}
+	}
+	return names, required
+}
+
+func checkFields(t *testing.T, what string, object *schemaObject, goType reflect.Type) {
+	t.Helper()
+	names, required := jsonFields(goType)
+	var properties []string
+	for name := range object.Properties {
+		properties = append(properties, name)
+	}
+	slices.Sort(names)
+	slices.Sort(properties)
+	if !slices.Equal(names, properties) {
+		t.Errorf("%s: schema properties %v, Go fields %v", what, properties, names)
+	}
+	required = slices.DeleteFunc(required, func(name string) bool { return name == "$schema" })
+	slices.Sort(required)
+	schemaRequired := slices.Clone(object.Required)
+	slices.Sort(schemaRequired)
+	if !slices.Equal(required, schemaRequired) {
+		t.Errorf("%s: schema requires %v, Go requires %v", what, schemaRequired, required)
+	}
+}
+
+func TestSchemaInSync(t *testing.T) {
+	data, err := Schema(Version)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var schema schemaObject
+	if err := json.Unmarshal(data, &schema); err != nil {
+		t.Fatal(err)
+	}
+	checkFields(t, "metadata", &schema, reflect.TypeOf(Metadata{}))
+	checkFields(t, "links", schema.Properties["links"].Items, reflect.TypeOf(Link{}))
+	checkFields(t, "deployments", schema.Properties["deployments"].Items, reflect.TypeOf(Deployment{}))
+
+	props := schema.Properties
+	if c := props["version"].Const; c == nil || *c != Version {
+		t.Errorf("version const = %v, want %d", c, Version)
+	}
+	for name, max := range map[string]int{"name": MaxNameLength, "description": MaxDescriptionLength} {
+		if p := props[name]; p.MinLength == nil || *p.MinLength != 1 || p.MaxLength == nil || *p.MaxLength != max {
+			t.Errorf("%s length = %v-%v, want 1-%d", name, p.MinLength, p.MaxLength, max)
+		}
+	}
+	if p := props["deployments"]; p.MinItems == nil || *p.MinItems != 1 {
+		t.Errorf("deployments minItems = %v, want 1", p.MinItems)
+	}
+	if got := props["links"].Items.Properties["type"].Enum; !slices.Equal(got, LinkTypes) {
+		t.Errorf("link types = %v, want %v", got, LinkTypes)
+	}
+	if want := "^(" + strings.Join(LogoSchemes, "|") + ")"; props["logo"].Pattern != want {
+		t.Errorf("logo pattern = %q, want %q", props["logo"].Pattern, want)
+	}
+	if p := props["deployments"].Items.Properties["network"]; p.Not == nil || p.Not.Pattern != "^0x0{40}$" {
+		t.Error("deployments network does not exclude the zero address")
+	}
+
+	// The name pattern accepts the same names as Validate.
+	namePattern := regexp.MustCompile(props["name"].Pattern)
+	for _, name := range []string{"Network", "My Network", "a", " Network", "Network ", "Net\nwork", "\tNetwork"} {
+		m := &Metadata{Version: Version, Name: name, Description: "d", Deployments: []Deployment{{ChainID: 1, Network: network}}}
+		if valid := len(m.Validate()) == 0; valid != namePattern.MatchString(name) {
+			t.Errorf("name %q: Validate accepts %v, schema pattern accepts %v", name, valid, !valid)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package metadata
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Storage stores metadata documents and returns the URI they are served at.
+type Storage interface {
+	// Put stores data, whose CID is cid, and returns its URI.
+	Put(ctx context.Context, cid string, data []byte) (string, error)
+}
+
+// Dir stores documents as <cid>.json files in a directory served over HTTP,
+// for example a static site or a bucket synced from it.
+type Dir struct {
+	Path string
+	// BaseURL is the URL the directory is served at.
+	BaseURL string
+}
+
+// Put implements Storage.
+func (d *Dir) Put(ctx context.Context, cid string, data []byte) (string, error) {
+	if d.BaseURL == "" {
+		return "", fmt.Errorf("metadata: directory storage %s has no base URL", d.Path)
+	}
+	if err := os.MkdirAll(d.Path, 0o755); err != nil {
+		return "", fmt.Errorf("metadata: %w", err)
+	}
+	name := cid + ".json"
+	if err := os.WriteFile(filepath.Join(d.Path, name), data, 0o644); err != nil {
+		return "", fmt.Errorf("metadata: %w", err)
+	}
+	return strings.TrimSuffix(d.BaseURL, "/") + "/" + name, nil
+}
+
+// IPFS adds documents through the HTTP API of an IPFS node, such as Kubo's
+// /api/v0/add, and returns ipfs:// URIs.
+type IPFS struct {
+	// APIURL is the base URL of the API, for example "http://127.0.0.1:5001".
+	APIURL string
+	Client *http.Client
+}
+
+// Put implements Storage. The CID reported by the node must equal cid.
+func (s *IPFS) Put(ctx context.Context, cid string, data []byte) (string, error) {
+	var body bytes.Buffer
+	form := multipart.NewWriter(&body)
+	part, err := form.CreateFormFile("file", cid+".json")
+	if err != nil {
+		return "", err
+	}
+	part.Write(data)
+	if err := form.Close(); err != nil {
+		return "", err
+	}
+	url := strings.TrimSuffix(s.APIURL, "/") + "/api/v0/add?cid-version=1&raw-leaves=true&pin=true"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, &body)
+	if err != nil {
+		return "", fmt.Errorf("metadata: %w", err)
+	}
+	req.Header.Set("Content-Type", form.FormDataContentType())
+	client := s.Client
+	if client == nil {
+		client = &http.Client{Timeout: time.Minute}
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("metadata: ipfs add: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1<<10))
+		return "", fmt.Errorf("metadata: ipfs add: %s: %s", resp.Status, bytes.TrimSpace(msg))
+	}
+	var added struct {
+		Hash string `json:"Hash"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&added); err != nil {
+		return "", fmt.Errorf("metadata: ipfs add: %w", err)
+	}
+	if added.Hash != cid {
+		return "", fmt.Errorf("metadata: ipfs add returned CID %s, expected %s", added.Hash, cid)
+	}
+	return "ipfs://" + cid, nil
+}
+
+// IPFSStandIn is an in-memory stand-in for an IPFS node during development. It
+// serves the subset of the API the IPFS storage uses, /api/v0/add, and a
+// gateway at /ipfs/<cid>. Documents are lost when the process exits.
+type IPFSStandIn struct {
+	mu     sync.Mutex
+	blocks map[string][]byte
+}
+
+// ServeHTTP implements http.Handler.
+func (s *IPFSStandIn) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	switch {
+	case req.URL.Path == "/api/v0/add" && req.Method == http.MethodPost:
+		file, _, err := req.FormFile("file")
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		defer file.Close()
+		data, err := readLimited(file, ipfsChunkSize)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		cid := CID(data)
+		s.mu.Lock()
+		if s.blocks == nil {
+			s.blocks = make(map[string][]byte)
+		}
+		s.blocks[cid] = data
+		s.mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]string{"Name": cid, "Hash": cid, "Size": fmt.Sprint(len(data))})
+	case strings.HasPrefix(req.URL.Path, "/ipfs/") && req.Method == http.MethodGet:
+		s.mu.Lock()
+		data, ok := s.blocks[strings.TrimPrefix(req.URL.Path, "/ipfs/")]
+		s.mu.Unlock()
+		if !ok {
+			http.NotFound(w, req)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(data)
+	default:
+		http.NotFound(w, req)
+	}
+}
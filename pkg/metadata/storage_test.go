@@ -0,0 +1,98 @@
+package metadata
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDirPut(t *testing.T) {
+	dir := &Dir{Path: filepath.Join(t.TempDir(), "metadata"), BaseURL: "https://example.com/metadata/"}
+	data := []byte(`{"version":1}`)
+	cid := CID(data)
+	uri, err := dir.Put(context.Background(), cid, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "https://example.com/metadata/" + cid + ".json"; uri != want {
+		t.Errorf("Put() = %s, want %s", uri, want)
+	}
+	stored, err := os.ReadFile(filepath.Join(dir.Path, cid+".json"))
+	if err != nil || string(stored) != string(data) {
+		t.Errorf("stored %q, %v, want %q", stored, err, data)
+	}
+
+	if _, err := (&Dir{Path: dir.Path}).Put(context.Background(), cid, data); err == nil {
+		t.Error("Put() without a base URL succeeded")
+	}
+}
+
+func TestIPFSPut(t *testing.T) {
+	data := []byte(`{"version":1}`)
+	tests := []struct {
+		name    string
+		handler http.Handler
+		wantErr bool
+	}{
+		{name: "stand-in", handler: &IPFSStandIn{}},
+		{
+			name: "other CID",
+			handler: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.Write([]byte(`{"Hash": "` + CID([]byte("other")) + `"}`))
+			}),
+			wantErr: true,
+		},
+		{
+			name: "API error",
+			handler: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				http.Error(w, "pinning disabled", http.StatusInternalServerError)
+			}),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(tt.handler)
+			defer server.Close()
+			uri, err := (&IPFS{APIURL: server.URL + "/"}).Put(context.Background(), CID(data), data)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Put() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && uri != "ipfs://"+CID(data) {
+				t.Errorf("Put() = %s, want ipfs://%s", uri, CID(data))
+			}
+		})
+	}
+}
+
+func TestIPFSStandInGateway(t *testing.T) {
+	server := httptest.NewServer(&IPFSStandIn{})
+	defer server.Close()
+	data := []byte(`{"version":1}`)
+	if _, err := (&IPFS{APIURL: server.URL}).Put(context.Background(), CID(data), data); err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range []struct {
+		path   string
+		status int
+		body   string
+	}{
+		{"/ipfs/" + CID(data), http.StatusOK, string(data)},
+		{"/ipfs/" + CID([]byte("missing")), http.StatusNotFound, ""},
+		{"/api/v0/cat", http.StatusNotFound, ""},
+	} {
+		resp, err := http.Get(server.URL + tt.path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if resp.StatusCode != tt.status || tt.status == http.StatusOK && string(body) != tt.body {
+			t.Errorf("GET %s = %s %q, want %d %q", tt.path, resp.Status, body, tt.status, tt.body)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package metadata
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var network = common.HexToAddress("0x7e70000000000000000000000000000000000000")
+
+const validDocument = `{
+  "version": 1,
+  "name": "My Network",
+  "description": "Secures my protocol.",
+  "logo": "ipfs://bafkreihdwdcefgh4dqkjv67uzcmw7ojee6xedzdetojuzjevtenxquvyku",
+  "links": [{"type": "website", "url": "https://example.com"}],
+  "deployments": [{"chainId": 1, "network": "0x7e70000000000000000000000000000000000000"}]
+}`
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		edit func(string) string
+		// paths lists the paths of the expected issues; nil means valid.
+		paths []string
+	}{
+		{"valid", func(s string) string { return s }, nil},
+		{"invalid JSON", func(s string) string { return s[1:] }, []string{""}},
+		{"other version", func(s string) string { return strings.Replace(s, `"version": 1`, `"version": 2`, 1) }, []string{"version"}},
+		{"unknown field", func(s string) string { return strings.Replace(s, `"version": 1`, `"version": 1, "extra": true`, 1) }, []string{""}},
+		{"empty name", func(s string) string { return strings.Replace(s, `"My Network"`, `""`, 1) }, []string{"name"}},
+		{"padded name", func(s string) string { return strings.Replace(s, `"My Network"`, `" My Network"`, 1) }, []string{"name"}},
+		{"long name", func(s string) string { return strings.Replace(s, `"My Network"`, `"`+strings.Repeat("n", 101)+`"`, 1) }, []string{"name"}},
+		{"long description", func(s string) string {
+			return strings.Replace(s, `"Secures my protocol."`, `"`+strings.Repeat("d", 2001)+`"`, 1)
+		}, []string{"description"}},
+		{"http logo", func(s string) string { return strings.Replace(s, `ipfs://`, `http://`, 1) }, []string{"logo"}},
+		{"bad link", func(s string) string {
+			return strings.Replace(s, `{"type": "website", "url": "https://example.com"}`, `{"type": "blog"}, {"type": "forum", "url": "http://x"}`, 1)
+		}, []string{"links[0].url", "links[1].type", "links[1].url"}},
+		{"no deployments", func(s string) string {
+			return strings.Replace(s, `{"chainId": 1, "network": "0x7e70000000000000000000000000000000000000"}`, ``, 1)
+		}, []string{"deployments"}},
+		{"duplicate deployment", func(s string) string {
+			d := `{"chainId": 1, "network": "0x7e70000000000000000000000000000000000000"}`
+			return strings.Replace(s, d, d+`, {"chainId": 1, "network": "0x7e70000000000000000000000000000000000000", "middleware": "0x0000000000000000000000000000000000000001"}`, 1)
+		}, []string{"deployments[1]"}},
+		{"zero deployment", func(s string) string {
+			return strings.Replace(s, `{"chainId": 1, "network": "0x7e70000000000000000000000000000000000000"}`, `{"chainId": 0, "network": "0x0000000000000000000000000000000000000000"}`, 1)
+		}, []string{"deployments[0].chainId", "deployments[0].network"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, issues := Validate([]byte(tt.edit(validDocument)))
+			var paths []string
+			for _, issue := range issues {
+				paths = append(paths, issue.Path)
+			}
+			if strings.Join(paths, " ") != strings.Join(tt.paths, " ") {
+				t.Errorf("issues = %v, want paths %v", issues, tt.paths)
+			}
+		})
+	}
+}
+
+func TestValidateParses(t *testing.T) {
+	m, issues := Validate([]byte(validDocument))
+	if len(issues) != 0 {
+		t.Fatal(issues)
+	}
+	if _, ok := m.Deployment(1, network); !ok {
+		t.Error("Deployment(1, network) not found")
+	}
+	if _, ok := m.Deployment(5, network); ok {
+		t.Error("Deployment(5, network) found")
+	}
+}
@@ -0,0 +1,112 @@
+package metadata
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	networkcontracts "github.com/symbioticfi/network/bindings/go-go-ethereum"
+)
+
+// Backend is the chain access needed to verify a Network's metadata. It is
+// satisfied by *ethclient.Client.
+type Backend interface {
+	bind.ContractCaller
+	ChainID(ctx context.Context) (*big.Int, error)
+}
+
+// Report is the outcome of verifying the metadata of a Network.
+type Report struct {
+	Network     common.Address `json:"network"`
+	ChainID     uint64         `json:"chainId"`
+	Name        string         `json:"name"`
+	MetadataURI string         `json:"metadataURI"`
+	Metadata    *Metadata      `json:"metadata,omitempty"`
+	Issues      []Issue        `json:"issues"`
+}
+
+// OK reports whether no issues were found.
+func (r *Report) OK() bool {
+	return len(r.Issues) == 0
+}
+
+// Check returns the issues of a valid document with respect to the Network it
+// describes: its name must equal name() and it must list the deployment.
+func Check(m *Metadata, name string, chainID uint64, network common.Address) []Issue {
+	var issues []Issue
+	if m.Name != name {
+		issues = append(issues, Issue{Path: "name", Message: fmt.Sprintf("%q does not match name() %q", m.Name, name)})
+	}
+	if _, ok := m.Deployment(chainID, network); !ok {
+		issues = append(issues, Issue{Path: "deployments", Message: fmt.Sprintf("does not list Network %s on chain %d", network, chainID)})
+	}
+	return issues
+}
+
+// Verify resolves the metadataURI of the Network at network, validates the
+// document and checks it against name(). Problems with the document are
+// reported as issues; the error is only set when the chain cannot be read.
+func Verify(ctx context.Context, backend Backend, network common.Address, fetcher *Fetcher) (*Report, error) {
+	caller, err := networkcontracts.NewINetworkCaller(network, backend)
+	if err != nil {
+		return nil, err
+	}
+	opts := &bind.CallOpts{Context: ctx}
+	name, err := caller.Name(opts)
+	if err != nil {
+		return nil, fmt.Errorf("metadata: name(): %w", err)
+	}
+	uri, err := caller.MetadataURI(opts)
+	if err != nil {
+		return nil, fmt.Errorf("metadata: metadataURI(): %w", err)
+	}
+	chainID, err := backend.ChainID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("metadata: get chain ID: %w", err)
+	}
+
+	report := &Report{
+		Network:     network,
+		ChainID:     chainID.Uint64(),
+		Name:        name,
+		MetadataURI: uri,
+		Issues:      []Issue{},
+	}
+	if uri == "" {
+		report.Issues = append(report.Issues, Issue{Path: "metadataURI", Message: "is not set"})
+		return report, nil
+	}
+	data, err := fetcher.Fetch(ctx, uri)
+	if err != nil {
+		report.Issues = append(report.Issues, Issue{Path: "metadataURI", Message: err.Error()})
+		return report, nil
+	}
+	m, issues := Validate(data)
+	report.Metadata = m
+	report.Issues = append(report.Issues, issues...)
+	if m != nil {
+		report.Issues = append(report.Issues, Check(m, name, report.ChainID, network)...)
+	}
+	return report, nil
+}
+
+// WriteText renders the report.
+func (r *Report) WriteText(w io.Writer) error {
+	fmt.Fprintf(w, "network: %s (chain %d)\nname(): %q\nmetadataURI(): %q\n", r.Network, r.ChainID, r.Name, r.MetadataURI)
+	if r.Metadata != nil {
+		fmt.Fprintf(w, "schema version: %d\ndeployments: %d\nlinks: %d\n", r.Metadata.Version, len(r.Metadata.Deployments), len(r.Metadata.Links))
+	}
+	if r.OK() {
+		_, err := fmt.Fprintln(w, "\nOK")
+		return err
+	}
+	fmt.Fprintf(w, "\n%d issues:\n", len(r.Issues))
+	for _, issue := range r.Issues {
+		fmt.Fprintf(w, "  - %s\n", issue)
+	}
+	return nil
+}
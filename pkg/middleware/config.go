@@ -0,0 +1,150 @@
+package middleware
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"gopkg.in/yaml.v3"
+
+	"github.com/symbioticfi/network/pkg/multicall"
+	"github.com/symbioticfi/network/pkg/subnetwork"
+)
+
+// Config is the YAML configuration of the middleware.
+//
+//	network: 0x...
+//	interval: 1m
+//	hysteresisBps: 500
+//	cooldown: 1h
+//	maxChanges: 10
+//	policy:
+//	  type: static
+//	  limits:
+//	    - vault: 0x...
+//	      subnetworkId: 0
+//	      maxNetworkLimit: "1000000000000000000000"
+//
+// An http policy sets url and optional headers; a stake policy sets rules:
+//
+//	policy:
+//	  type: stake
+//	  rules:
+//	    - vault: 0x...
+//	      subnetworkId: 0
+//	      shareBps: 5000
+//	      max: "1000000000000000000000"
+type Config struct {
+	Network       common.Address `yaml:"network"`
+	Interval      time.Duration  `yaml:"interval"`
+	HysteresisBps uint64         `yaml:"hysteresisBps"`
+	Cooldown      time.Duration  `yaml:"cooldown"`
+	MaxChanges    int            `yaml:"maxChanges"`
+	Policy        PolicyConfig   `yaml:"policy"`
+}
+
+// PolicyConfig configures a Policy.
+type PolicyConfig struct {
+	// Type is static, http or stake.
+	Type string `yaml:"type"`
+
+	Limits []Limit `yaml:"limits"`
+
+	URL     string            `yaml:"url"`
+	Headers map[string]string `yaml:"headers"`
+
+	Rules []StakeRule `yaml:"rules"`
+}
+
+// LoadConfig reads and validates a configuration file.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	var config Config
+	if err := decoder.Decode(&config); err != nil {
+		return nil, fmt.Errorf("middleware: %s: %w", path, err)
+	}
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("%w (in %s)", err, path)
+	}
+	return &config, nil
+}
+
+// Validate checks the configuration.
+func (c *Config) Validate() error {
+	if c.Network == (common.Address{}) {
+		return errors.New("middleware: no network")
+	}
+	if c.Interval < 0 || c.Cooldown < 0 || c.MaxChanges < 0 {
+		return errors.New("middleware: interval, cooldown and maxChanges must not be negative")
+	}
+	if c.HysteresisBps >= 10000 {
+		return fmt.Errorf("middleware: hysteresisBps %d is not below 10000", c.HysteresisBps)
+	}
+	p := c.Policy
+	switch p.Type {
+	case "static":
+		if len(p.Limits) == 0 {
+			return errors.New("middleware: static policy without limits")
+		}
+		if _, err := targets(p.Limits); err != nil {
+			return err
+		}
+	case "http":
+		if p.URL == "" {
+			return errors.New("middleware: http policy without url")
+		}
+	case "stake":
+		if len(p.Rules) == 0 {
+			return errors.New("middleware: stake policy without rules")
+		}
+		for i, rule := range p.Rules {
+			if rule.SubnetworkID == nil {
+				return fmt.Errorf("middleware: stake rule %d: missing subnetworkId", i)
+			}
+			if err := subnetwork.ValidateIdentifier((*big.Int)(rule.SubnetworkID)); err != nil {
+				return fmt.Errorf("middleware: stake rule %d: %w", i, err)
+			}
+			if rule.ShareBps == 0 || rule.ShareBps > 10000 {
+				return fmt.Errorf("middleware: stake rule %d: shareBps must be in [1, 10000]", i)
+			}
+			if rule.Min != nil && rule.Max != nil && (*big.Int)(rule.Min).Cmp((*big.Int)(rule.Max)) > 0 {
+				return fmt.Errorf("middleware: stake rule %d: min is above max", i)
+			}
+		}
+	default:
+		return fmt.Errorf("middleware: unknown policy type %q", p.Type)
+	}
+	return nil
+}
+
+// NewPolicy builds the configured Policy. caller is used by stake policies.
+func (c *Config) NewPolicy(caller *multicall.Caller) Policy {
+	switch c.Policy.Type {
+	case "http":
+		return &HTTP{URL: c.Policy.URL, Headers: c.Policy.Headers}
+	case "stake":
+		return &Stake{Caller: caller, Rules: c.Policy.Rules}
+	default:
+		return &Static{Limits: c.Policy.Limits}
+	}
+}
+
+// Apply copies the settings of the configuration to s. Zero values keep the
+// defaults.
+func (c *Config) Apply(s *Service) {
+	if c.Interval > 0 {
+		s.Interval = c.Interval
+	}
+	s.HysteresisBps = c.HysteresisBps
+	s.Cooldown = c.Cooldown
+	s.MaxChanges = c.MaxChanges
+}
@@ -0,0 +1,217 @@
+// Package middleware is a reference middleware that keeps the maximum network
+// limits of a Network's vaults at the allocation computed by a Policy.
+//
+// Network.setMaxNetworkLimit only accepts calls from the middleware registered
+// for the Network in NETWORK_MIDDLEWARE_SERVICE, so the Service must send from
+// that key. Every round it evaluates the policy, plans the hook calls with
+// limits.PlanLimits and sends the ones that pass the hysteresis and rate
+// limits.
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/big"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/symbioticfi/network/pkg/limits"
+	"github.com/symbioticfi/network/pkg/multicall"
+)
+
+// Service pushes policy changes through the Network's setMaxNetworkLimit hook.
+type Service struct {
+	caller  *multicall.Caller
+	backend limits.SendBackend
+	network common.Address
+	opts    *bind.TransactOpts
+	policy  Policy
+
+	// Interval is the time between rounds in Run.
+	Interval time.Duration
+	// HysteresisBps skips changes smaller than this share of the current
+	// maximum network limit, in basis points. Changes from or to zero are
+	// always made.
+	HysteresisBps uint64
+	// Cooldown is the minimum time between two calls for the same
+	// subnetwork of a vault.
+	Cooldown time.Duration
+	// MaxChanges is the number of calls sent per round; zero is unlimited.
+	MaxChanges int
+	// DryRun plans and logs the calls without sending them.
+	DryRun bool
+	// Logger receives round outcomes and failures.
+	Logger *slog.Logger
+
+	last map[key]time.Time
+}
+
+type key struct {
+	delegator  common.Address
+	identifier string
+}
+
+// New creates a Service sending from opts, which must be the middleware of
+// network.
+func New(caller *multicall.Caller, backend limits.SendBackend, network common.Address, opts *bind.TransactOpts, policy Policy) *Service {
+	return &Service{
+		caller:   caller,
+		backend:  backend,
+		network:  network,
+		opts:     opts,
+		policy:   policy,
+		Interval: time.Minute,
+		Logger:   slog.Default(),
+		last:     make(map[key]time.Time),
+	}
+}
+
+// Decision is a planned change and why it was held back, if it was.
+type Decision struct {
+	limits.Entry
+	Skip string `json:"skip,omitempty"`
+}
+
+// Round is the outcome of one evaluation of the policy.
+type Round struct {
+	BlockNumber uint64              `json:"blockNumber"`
+	Decisions   []Decision          `json:"decisions"`
+	Results     []limits.SendResult `json:"results,omitempty"`
+}
+
+// Run executes a round every Interval until ctx is done. Round failures are
+// logged and retried at the next round.
+func (s *Service) Run(ctx context.Context) error {
+	ticker := time.NewTicker(s.Interval)
+	defer ticker.Stop()
+	for {
+		round, err := s.Round(ctx)
+		if err != nil {
+			s.Logger.Error("round failed", "network", s.network, "err", err)
+		} else {
+			s.log(round)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Round evaluates the policy once and sends the calls that pass the
+// hysteresis and rate limits.
+func (s *Service) Round(ctx context.Context) (*Round, error) {
+	targets, err := s.policy.Targets(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(targets) == 0 {
+		return &Round{}, nil
+	}
+	plan, err := limits.PlanLimits(ctx, s.caller, s.backend, s.network, limits.Request{Targets: targets, Mode: limits.Middleware})
+	if err != nil {
+		return nil, err
+	}
+	round := &Round{BlockNumber: plan.BlockNumber}
+	changes := plan.Changes()
+	if len(changes) == 0 {
+		return round, nil
+	}
+	if plan.Middleware != s.opts.From {
+		return nil, fmt.Errorf("middleware: %s is not the middleware %s of Network %s", s.opts.From, plan.Middleware, s.network)
+	}
+
+	// plan.HookCalls follows the order of plan.Changes.
+	send := *plan
+	send.HookCalls = nil
+	now := time.Now()
+	for i, e := range changes {
+		d := Decision{Entry: e, Skip: s.hold(now, e, len(send.HookCalls))}
+		round.Decisions = append(round.Decisions, d)
+		if d.Skip == "" {
+			send.HookCalls = append(send.HookCalls, plan.HookCalls[i])
+		}
+	}
+	if s.DryRun || len(send.HookCalls) == 0 {
+		return round, nil
+	}
+	if round.Results, err = send.Send(ctx, s.opts, s.backend); err != nil {
+		return nil, err
+	}
+	for _, result := range round.Results {
+		if result.TxHash != (common.Hash{}) {
+			s.last[key{result.Delegator, result.SubnetworkID.String()}] = now
+		}
+	}
+	return round, nil
+}
+
+// hold returns why a change is not made this round, or "" to make it.
+func (s *Service) hold(now time.Time, e limits.Entry, selected int) string {
+	if s.HysteresisBps > 0 && e.Current.Sign() != 0 && e.Target.Sign() != 0 {
+		diff := new(big.Int).Sub(e.Target, e.Current)
+		diff.Abs(diff).Mul(diff, big.NewInt(10000))
+		threshold := new(big.Int).Mul(e.Current, new(big.Int).SetUint64(s.HysteresisBps))
+		if diff.Cmp(threshold) < 0 {
+			return fmt.Sprintf("change is below the %d bps hysteresis", s.HysteresisBps)
+		}
+	}
+	if last, ok := s.last[key{e.Delegator, e.SubnetworkID.String()}]; ok && s.Cooldown > 0 && now.Sub(last) < s.Cooldown {
+		return fmt.Sprintf("cooling down until %s", last.Add(s.Cooldown).UTC().Format(time.RFC3339))
+	}
+	if s.MaxChanges > 0 && selected >= s.MaxChanges {
+		return fmt.Sprintf("over the limit of %d calls per round", s.MaxChanges)
+	}
+	return ""
+}
+
+func (s *Service) log(round *Round) {
+	for _, d := range round.Decisions {
+		attrs := []any{"network", s.network, "vault", d.Vault, "subnetwork", d.SubnetworkID, "current", d.Current, "target", d.Target}
+		switch {
+		case d.Skip != "":
+			s.Logger.Info("change held back", append(attrs, "reason", d.Skip)...)
+		case s.DryRun:
+			s.Logger.Info("change planned", attrs...)
+		}
+		if d.Warning != "" {
+			s.Logger.Warn(d.Warning, attrs...)
+		}
+	}
+	for _, result := range round.Results {
+		attrs := []any{"network", s.network, "delegator", result.Delegator, "subnetwork", result.SubnetworkID, "tx", result.TxHash}
+		if result.Error != "" {
+			s.Logger.Error("setMaxNetworkLimit failed", append(attrs, "err", result.Error)...)
+			continue
+		}
+		s.Logger.Info("maxNetworkLimit set", append(attrs, "maxNetworkLimit", result.MaxNetworkLimit)...)
+	}
+}
+
+// WriteText renders one row per planned change, with the reason it was held
+// back, followed by the sent calls.
+func (r *Round) WriteText(w io.Writer) error {
+	if len(r.Decisions) == 0 {
+		_, err := fmt.Fprintf(w, "Every target is already set at block %d.\n", r.BlockNumber)
+		return err
+	}
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(tw, "Changes at block %d\n\n", r.BlockNumber)
+	fmt.Fprintln(tw, "VAULT\tSUBNETWORK\tCURRENT\tTARGET\tHELD BACK\tWARNING")
+	for _, d := range r.Decisions {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n", d.Vault, d.SubnetworkID, d.Current, d.Target, d.Skip, d.Warning)
+	}
+	if len(r.Results) > 0 {
+		fmt.Fprintln(tw, "\nDELEGATOR\tSUBNETWORK\tTX\tMAX NETWORK LIMIT\tERROR")
+		for _, result := range r.Results {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%v\t%s\n", result.Delegator, result.SubnetworkID, result.TxHash, result.MaxNetworkLimit, result.Error)
+		}
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,54 @@
+package middleware
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/symbioticfi/network/pkg/limits"
+)
+
+func TestHold(t *testing.T) {
+	delegator := common.HexToAddress("0xde00000000000000000000000000000000000000")
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	entry := func(current, target int64) limits.Entry {
+		return limits.Entry{Delegator: delegator, SubnetworkID: big.NewInt(0), Current: big.NewInt(current), Target: big.NewInt(target)}
+	}
+	tests := []struct {
+		name       string
+		hysteresis uint64
+		cooldown   time.Duration
+		maxChanges int
+		last       time.Duration
+		entry      limits.Entry
+		selected   int
+		want       string
+	}{
+		{name: "no rules", entry: entry(1000, 1001)},
+		{name: "below hysteresis", hysteresis: 500, entry: entry(1000, 1049), want: "below the 500 bps hysteresis"},
+		{name: "at hysteresis", hysteresis: 500, entry: entry(1000, 1050)},
+		{name: "decrease below hysteresis", hysteresis: 500, entry: entry(1000, 951), want: "below the 500 bps hysteresis"},
+		{name: "from zero ignores hysteresis", hysteresis: 500, entry: entry(0, 1)},
+		{name: "to zero ignores hysteresis", hysteresis: 500, entry: entry(1000, 0)},
+		{name: "cooling down", cooldown: time.Hour, last: 30 * time.Minute, entry: entry(1000, 2000), want: "cooling down until 2025-06-01T12:30:00Z"},
+		{name: "cooled down", cooldown: time.Hour, last: time.Hour, entry: entry(1000, 2000)},
+		{name: "never changed", cooldown: time.Hour, entry: entry(1000, 2000)},
+		{name: "over max changes", maxChanges: 2, selected: 2, entry: entry(1000, 2000), want: "over the limit of 2 calls per round"},
+		{name: "under max changes", maxChanges: 2, selected: 1, entry: entry(1000, 2000)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Service{HysteresisBps: tt.hysteresis, Cooldown: tt.cooldown, MaxChanges: tt.maxChanges, last: make(map[key]time.Time)}
+			if tt.last > 0 {
+				s.last[key{delegator, "0"}] = now.Add(-tt.last)
+			}
+			got := s.hold(now, tt.entry, tt.selected)
+			if (got == "") != (tt.want == "") || !strings.Contains(got, tt.want) {
+				t.Errorf("hold() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+
+	"github.com/symbioticfi/network/pkg/core"
+	"github.com/symbioticfi/network/pkg/limits"
+	"github.com/symbioticfi/network/pkg/multicall"
+)
+
+// Policy computes the wanted maximum network limits.
+type Policy interface {
+	Targets(ctx context.Context) ([]limits.Target, error)
+}
+
+// Limit is a wanted maximum network limit, as configured or served over HTTP.
+// Numbers are decimal or 0x-prefixed hex strings.
+type Limit struct {
+	Vault           common.Address        `json:"vault" yaml:"vault"`
+	SubnetworkID    *math.HexOrDecimal256 `json:"subnetworkId" yaml:"subnetworkId"`
+	MaxNetworkLimit *math.HexOrDecimal256 `json:"maxNetworkLimit" yaml:"maxNetworkLimit"`
+}
+
+// Target converts the limit, failing on missing fields.
+func (l Limit) Target() (limits.Target, error) {
+	if l.SubnetworkID == nil || l.MaxNetworkLimit == nil {
+		return limits.Target{}, fmt.Errorf("middleware: limit of vault %s needs subnetworkId and maxNetworkLimit", l.Vault)
+	}
+	return limits.Target{
+		Vault:           l.Vault,
+		SubnetworkID:    (*big.Int)(l.SubnetworkID),
+		MaxNetworkLimit: (*big.Int)(l.MaxNetworkLimit),
+	}, nil
+}
+
+func targets(list []Limit) ([]limits.Target, error) {
+	result := make([]limits.Target, 0, len(list))
+	for _, l := range list {
+		t, err := l.Target()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, t)
+	}
+	return result, nil
+}
+
+// Static is a fixed allocation.
+type Static struct {
+	Limits []Limit
+}
+
+// Targets returns the configured limits.
+func (p *Static) Targets(context.Context) ([]limits.Target, error) {
+	return targets(p.Limits)
+}
+
+// HTTP fetches the allocation from an endpoint answering GET requests with a
+// JSON array of limits.
+type HTTP struct {
+	URL     string
+	Headers map[string]string
+	// Client defaults to a client with a 10 second timeout.
+	Client *http.Client
+}
+
+// Targets fetches the limits.
+func (p *HTTP) Targets(ctx context.Context) ([]limits.Target, error) {
+	client := p.Client
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	for name, value := range p.Headers {
+		req.Header.Set(name, value)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("middleware: fetch limits: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("middleware: fetch limits: %s returned %s", p.URL, resp.Status)
+	}
+	var list []Limit
+	if err := json.NewDecoder(resp.Body).Decode(&list); err != nil {
+		return nil, fmt.Errorf("middleware: decode limits from %s: %w", p.URL, err)
+	}
+	return targets(list)
+}
+
+// StakeRule sets the maximum network limit of a subnetwork to a share of the
+// vault's active stake, clamped to [Min, Max].
+type StakeRule struct {
+	Vault        common.Address        `yaml:"vault"`
+	SubnetworkID *math.HexOrDecimal256 `yaml:"subnetworkId"`
+	// ShareBps is the share of the active stake in basis points.
+	ShareBps uint64                `yaml:"shareBps"`
+	Min      *math.HexOrDecimal256 `yaml:"min"`
+	Max      *math.HexOrDecimal256 `yaml:"max"`
+}
+
+// Stake derives the allocation from the active stake of the vaults, read in
+// one multicall.
+type Stake struct {
+	Caller *multicall.Caller
+	Rules  []StakeRule
+}
+
+// Targets reads the active stakes and applies the rules.
+func (p *Stake) Targets(ctx context.Context) ([]limits.Target, error) {
+	var batch multicall.Batch
+	stakes := make(map[common.Address]*multicall.Result[*big.Int])
+	for _, rule := range p.Rules {
+		if stakes[rule.Vault] == nil {
+			stakes[rule.Vault] = multicall.Add[*big.Int](&batch, rule.Vault, core.VaultABI, "activeStake")
+		}
+	}
+	if _, err := p.Caller.Do(ctx, nil, &batch); err != nil {
+		return nil, err
+	}
+	result := make([]limits.Target, 0, len(p.Rules))
+	for _, rule := range p.Rules {
+		stake, err := stakes[rule.Vault].Get()
+		if err != nil {
+			return nil, fmt.Errorf("middleware: activeStake of vault %s: %w", rule.Vault, err)
+		}
+		limit := new(big.Int).Mul(stake, new(big.Int).SetUint64(rule.ShareBps))
+		limit.Quo(limit, big.NewInt(10000))
+		if rule.Min != nil && limit.Cmp((*big.Int)(rule.Min)) < 0 {
+			limit.Set((*big.Int)(rule.Min))
+		}
+		if rule.Max != nil && limit.Cmp((*big.Int)(rule.Max)) > 0 {
+			limit.Set((*big.Int)(rule.Max))
+		}
+		result = append(result, limits.Target{Vault: rule.Vault, SubnetworkID: (*big.Int)(rule.SubnetworkID), MaxNetworkLimit: limit})
+	}
+	return result, nil
+}
@@ -0,0 +1,62 @@
+package middleware
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/symbioticfi/network/pkg/multicall"
+)
+
+// fakeStakes answers the JSON-RPC batches of a Caller with the active stake
+// of each vault.
+type fakeStakes map[common.Address]int64
+
+func (f fakeStakes) BatchCallContext(_ context.Context, elems []rpc.BatchElem) error {
+	for i := range elems {
+		switch elems[i].Method {
+		case "eth_blockNumber":
+			*elems[i].Result.(*hexutil.Uint64) = 100
+		case "eth_call":
+			to := elems[i].Args[0].(map[string]any)["to"].(common.Address)
+			*elems[i].Result.(*hexutil.Bytes) = common.LeftPadBytes(big.NewInt(f[to]).Bytes(), 32)
+		}
+	}
+	return nil
+}
+
+func TestStakeTargets(t *testing.T) {
+	vault := common.HexToAddress("0xfa00000000000000000000000000000000000001")
+	other := common.HexToAddress("0xfa00000000000000000000000000000000000002")
+	amount := func(n int64) *math.HexOrDecimal256 { return (*math.HexOrDecimal256)(big.NewInt(n)) }
+	tests := []struct {
+		name string
+		rule StakeRule
+		want int64
+	}{
+		{name: "share", rule: StakeRule{Vault: vault, ShareBps: 2500}, want: 250000},
+		{name: "rounds down", rule: StakeRule{Vault: other, ShareBps: 3333}, want: 3332},
+		{name: "clamped to min", rule: StakeRule{Vault: vault, ShareBps: 1, Min: amount(1000)}, want: 1000},
+		{name: "clamped to max", rule: StakeRule{Vault: vault, ShareBps: 10000, Max: amount(500000)}, want: 500000},
+		{name: "within bounds", rule: StakeRule{Vault: vault, ShareBps: 5000, Min: amount(1000), Max: amount(900000)}, want: 500000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			caller := multicall.NewCaller(nil, fakeStakes{vault: 1000000, other: 9999})
+			caller.Address = common.Address{}
+			tt.rule.SubnetworkID = amount(0)
+			targets, err := (&Stake{Caller: caller, Rules: []StakeRule{tt.rule}}).Targets(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(targets) != 1 || targets[0].Vault != tt.rule.Vault || targets[0].MaxNetworkLimit.Int64() != tt.want {
+				t.Errorf("Targets() = %+v, want %d for %s", targets, tt.want, tt.rule.Vault)
+			}
+		})
+	}
+}
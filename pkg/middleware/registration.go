@@ -0,0 +1,208 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/big"
+	"text/tabwriter"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	networkcontracts "github.com/symbioticfi/network/bindings/go-go-ethereum"
+	"github.com/symbioticfi/network/pkg/core"
+	"github.com/symbioticfi/network/pkg/timelock"
+)
+
+// Kind is whether an account has code.
+type Kind string
+
+// The kinds of account.
+const (
+	Contract Kind = "contract"
+	Key      Kind = "key"
+)
+
+// Backend is the chain access needed to check a registration.
+type Backend interface {
+	bind.ContractCaller
+	BlockNumber(ctx context.Context) (uint64, error)
+}
+
+// Account describes a registered or expected middleware.
+type Account struct {
+	Address common.Address `json:"address"`
+	Kind    Kind           `json:"kind"`
+	// CodeHash is the keccak256 of the runtime code of contracts.
+	CodeHash *common.Hash `json:"codeHash,omitempty"`
+}
+
+// RegistrationRequest is the middleware a Network is expected to have.
+type RegistrationRequest struct {
+	Network  common.Address
+	Expected common.Address
+	// Kind, when set, is the kind the middleware must be.
+	Kind Kind
+	// CodeHash, when set, is the keccak256 the runtime code of the
+	// middleware must have.
+	CodeHash *common.Hash
+
+	// Predecessor, Salt and Delay are used for the setMiddleware operation.
+	// Delay overrides the required delay read from the Network and must not
+	// be shorter.
+	Predecessor common.Hash
+	Salt        common.Hash
+	Delay       *big.Int
+}
+
+// Registration is the middleware registered for a Network and, when it is not
+// the expected one, the operation registering the expected one.
+type Registration struct {
+	Network     common.Address `json:"network"`
+	BlockNumber uint64         `json:"blockNumber"`
+	// Service is the NETWORK_MIDDLEWARE_SERVICE of the Network.
+	Service    common.Address `json:"service"`
+	Registered Account        `json:"registered"`
+	Expected   Account        `json:"expected"`
+	Problems   []string       `json:"problems,omitempty"`
+
+	// Set when the expected middleware can replace the registered one.
+	*timelock.Prepared
+}
+
+// OK reports whether the registered middleware is the expected one.
+func (r *Registration) OK() bool {
+	return len(r.Problems) == 0
+}
+
+// CheckRegistration reads the middleware registered for req.Network in its
+// NETWORK_MIDDLEWARE_SERVICE and compares it with the expected one. When they
+// differ and the expected account matches req, it builds the timelocked
+// setMiddleware call on the service, like SetMiddlewareBase.
+func CheckRegistration(ctx context.Context, backend Backend, req RegistrationRequest) (*Registration, error) {
+	if req.Kind != "" && req.Kind != Contract && req.Kind != Key {
+		return nil, fmt.Errorf("middleware: unknown kind %q", req.Kind)
+	}
+	if req.CodeHash != nil && req.Kind == Key {
+		return nil, fmt.Errorf("middleware: a %s has no code hash", Key)
+	}
+	number, err := backend.BlockNumber(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("middleware: get latest block: %w", err)
+	}
+	block := new(big.Int).SetUint64(number)
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: block}
+
+	network, err := networkcontracts.NewINetworkCaller(req.Network, backend)
+	if err != nil {
+		return nil, err
+	}
+	service, err := network.NETWORKMIDDLEWARESERVICE(opts)
+	if err != nil {
+		return nil, fmt.Errorf("middleware: NETWORK_MIDDLEWARE_SERVICE: %w", err)
+	}
+	serviceCaller, err := networkcontracts.NewINetworkMiddlewareServiceCaller(service, backend)
+	if err != nil {
+		return nil, err
+	}
+	registered, err := serviceCaller.Middleware(opts, req.Network)
+	if err != nil {
+		return nil, fmt.Errorf("middleware: middleware of %s: %w", req.Network, err)
+	}
+	r := &Registration{Network: req.Network, BlockNumber: number, Service: service}
+	if r.Registered, err = describe(ctx, backend, registered, block); err != nil {
+		return nil, err
+	}
+	if r.Expected, err = describe(ctx, backend, req.Expected, block); err != nil {
+		return nil, err
+	}
+
+	var expected []string
+	if req.Kind != "" && r.Expected.Kind != req.Kind {
+		expected = append(expected, fmt.Sprintf("%s is a %s, expected a %s", req.Expected, r.Expected.Kind, req.Kind))
+	}
+	if req.CodeHash != nil && (r.Expected.CodeHash == nil || *r.Expected.CodeHash != *req.CodeHash) {
+		expected = append(expected, fmt.Sprintf("code hash of %s is not %s", req.Expected, req.CodeHash))
+	}
+	if r.Registered.Address == req.Expected {
+		r.Problems = expected
+		return r, nil
+	}
+	if r.Registered.Address == (common.Address{}) {
+		r.Problems = append(r.Problems, "no middleware is registered")
+	} else {
+		r.Problems = append(r.Problems, fmt.Sprintf("registered middleware %s is not %s", r.Registered.Address, req.Expected))
+	}
+	// Registering an account that does not match req would not fix anything.
+	if len(expected) > 0 {
+		r.Problems = append(r.Problems, expected...)
+		return r, nil
+	}
+	if err := r.build(opts, network, req); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func describe(ctx context.Context, backend Backend, address common.Address, block *big.Int) (Account, error) {
+	code, err := backend.CodeAt(ctx, address, block)
+	if err != nil {
+		return Account{}, fmt.Errorf("middleware: get code of %s: %w", address, err)
+	}
+	if len(code) == 0 {
+		return Account{Address: address, Kind: Key}, nil
+	}
+	hash := crypto.Keccak256Hash(code)
+	return Account{Address: address, Kind: Contract, CodeHash: &hash}, nil
+}
+
+// build sets the operation calling setMiddleware on the service.
+func (r *Registration) build(opts *bind.CallOpts, network *networkcontracts.INetworkCaller, req RegistrationRequest) error {
+	data, err := core.MiddlewareServiceABI.Pack("setMiddleware", req.Expected)
+	if err != nil {
+		return err
+	}
+	r.Prepared, err = timelock.Prepare(opts, network, timelock.Operation{
+		Calls:       []timelock.Call{{Target: r.Service, Data: data}},
+		Predecessor: req.Predecessor,
+		Salt:        req.Salt,
+		Delay:       req.Delay,
+	})
+	return err
+}
+
+// WriteText renders both accounts, the problems and, when there is one, the
+// operation.
+func (r *Registration) WriteText(w io.Writer) error {
+	account := func(a Account) string {
+		if a.CodeHash == nil {
+			return fmt.Sprintf("%s (%s)", a.Address, a.Kind)
+		}
+		return fmt.Sprintf("%s (%s, code hash %s)", a.Address, a.Kind, a.CodeHash)
+	}
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(tw, "Network:\t%s\n", r.Network)
+	fmt.Fprintf(tw, "Middleware service:\t%s\n", r.Service)
+	fmt.Fprintf(tw, "Registered middleware:\t%s\n", account(r.Registered))
+	fmt.Fprintf(tw, "Expected middleware:\t%s\n", account(r.Expected))
+	fmt.Fprintf(tw, "Block:\t%d\n", r.BlockNumber)
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	if r.OK() {
+		_, err := fmt.Fprintln(w, "\nThe registered middleware is the expected one.")
+		return err
+	}
+	fmt.Fprintln(w)
+	for _, problem := range r.Problems {
+		fmt.Fprintf(w, "! %s\n", problem)
+	}
+	if r.Prepared == nil {
+		return nil
+	}
+	fmt.Fprintln(w)
+	return r.Prepared.WriteText(w)
+}
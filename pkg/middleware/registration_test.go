@@ -0,0 +1,183 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	networkcontracts "github.com/symbioticfi/network/bindings/go-go-ethereum"
+	"github.com/symbioticfi/network/pkg/core"
+)
+
+var (
+	network    = common.HexToAddress("0x7e70000000000000000000000000000000000000")
+	service    = common.HexToAddress("0x5e7f1ce000000000000000000000000000000000")
+	middleware = common.HexToAddress("0x3dd1e00000000000000000000000000000000001")
+	previous   = common.HexToAddress("0x3dd1e00000000000000000000000000000000002")
+	operator   = common.HexToAddress("0x0be7a70000000000000000000000000000000000")
+
+	middlewareCode = []byte{0x60, 0x80}
+)
+
+// fakeRegistry is a Network whose middleware service has registered
+// middleware. Calls to the service require a delay of 7200 seconds and
+// other calls one of 3600.
+type fakeRegistry struct {
+	registered common.Address
+	code       map[common.Address][]byte
+}
+
+func (f *fakeRegistry) BlockNumber(context.Context) (uint64, error) {
+	return 42, nil
+}
+
+func (f *fakeRegistry) CodeAt(_ context.Context, account common.Address, _ *big.Int) ([]byte, error) {
+	return f.code[account], nil
+}
+
+func (f *fakeRegistry) CallContract(_ context.Context, call ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	networkABI, err := networkcontracts.INetworkMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	parsed := networkABI
+	if *call.To == service {
+		parsed = core.MiddlewareServiceABI
+	}
+	method, err := parsed.MethodById(call.Data[:4])
+	if err != nil {
+		return nil, err
+	}
+	args, err := method.Inputs.Unpack(call.Data[4:])
+	if err != nil {
+		return nil, err
+	}
+	switch method.Name {
+	case "NETWORK_MIDDLEWARE_SERVICE":
+		return method.Outputs.Pack(service)
+	case "middleware":
+		return method.Outputs.Pack(f.registered)
+	case "getMinDelay":
+		if args[0].(common.Address) == service {
+			return method.Outputs.Pack(big.NewInt(7200))
+		}
+		return method.Outputs.Pack(big.NewInt(3600))
+	}
+	return nil, errors.New("unexpected call")
+}
+
+func TestCheckRegistration(t *testing.T) {
+	codeHash := crypto.Keccak256Hash(middlewareCode)
+	otherHash := crypto.Keccak256Hash([]byte("other"))
+	tests := []struct {
+		name       string
+		registered common.Address
+		req        RegistrationRequest
+		problems   int
+		// operation is whether a setMiddleware operation is built.
+		operation bool
+	}{
+		{
+			name:       "expected registered",
+			registered: middleware,
+			req:        RegistrationRequest{Expected: middleware, Kind: Contract, CodeHash: &codeHash},
+		},
+		{
+			name:      "none registered",
+			req:       RegistrationRequest{Expected: middleware, Kind: Contract, CodeHash: &codeHash},
+			problems:  1,
+			operation: true,
+		},
+		{
+			name:       "other registered",
+			registered: previous,
+			req:        RegistrationRequest{Expected: middleware},
+			problems:   1,
+			operation:  true,
+		},
+		{
+			name:       "expected is a key",
+			registered: previous,
+			req:        RegistrationRequest{Expected: operator, Kind: Contract},
+			problems:   2,
+		},
+		{
+			name:       "code hash mismatch",
+			registered: previous,
+			req:        RegistrationRequest{Expected: middleware, CodeHash: &otherHash},
+			problems:   2,
+		},
+		{
+			name:       "registered with code hash mismatch",
+			registered: middleware,
+			req:        RegistrationRequest{Expected: middleware, CodeHash: &otherHash},
+			problems:   1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			backend := &fakeRegistry{
+				registered: tt.registered,
+				code:       map[common.Address][]byte{middleware: middlewareCode, previous: {0x60}},
+			}
+			tt.req.Network = network
+			r, err := CheckRegistration(context.Background(), backend, tt.req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(r.Problems) != tt.problems || r.OK() != (tt.problems == 0) {
+				t.Errorf("problems = %q, want %d", r.Problems, tt.problems)
+			}
+			if r.Service != service || r.BlockNumber != 42 || r.Registered.Address != tt.registered {
+				t.Errorf("registration = %+v", r)
+			}
+			if (r.Prepared != nil) != tt.operation {
+				t.Fatalf("operation = %+v, want one: %v", r.Prepared, tt.operation)
+			}
+			if !tt.operation {
+				return
+			}
+			want, err := core.MiddlewareServiceABI.Pack("setMiddleware", middleware)
+			if err != nil {
+				t.Fatal(err)
+			}
+			calls := r.Operation.Calls
+			if len(calls) != 1 || calls[0].Target != service || !bytes.Equal(calls[0].Data, want) || r.Operation.IsBatch() {
+				t.Errorf("operation calls %+v, want setMiddleware(%s) on the service", calls, middleware)
+			}
+			if r.RequiredDelay.Int64() != 7200 || r.Operation.Delay.Int64() != 7200 {
+				t.Errorf("delay %s, required %s, want getMinDelay's 7200", r.Operation.Delay, r.RequiredDelay)
+			}
+		})
+	}
+}
+
+func TestCheckRegistrationAccounts(t *testing.T) {
+	backend := &fakeRegistry{registered: operator, code: map[common.Address][]byte{middleware: middlewareCode}}
+	r, err := CheckRegistration(context.Background(), backend, RegistrationRequest{Network: network, Expected: middleware})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Registered.Kind != Key || r.Registered.CodeHash != nil {
+		t.Errorf("registered = %+v, want a key", r.Registered)
+	}
+	if r.Expected.Kind != Contract || r.Expected.CodeHash == nil || *r.Expected.CodeHash != crypto.Keccak256Hash(middlewareCode) {
+		t.Errorf("expected = %+v, want a contract with the hash of its code", r.Expected)
+	}
+
+	for _, req := range []RegistrationRequest{
+		{Network: network, Expected: middleware, Kind: "multisig"},
+		{Network: network, Expected: operator, Kind: Key, CodeHash: &common.Hash{}},
+		{Network: network, Expected: middleware, Delay: big.NewInt(60)},
+	} {
+		if _, err := CheckRegistration(context.Background(), backend, req); err == nil {
+			t.Errorf("CheckRegistration(%+v) succeeded", req)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package multicall
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// ErrNotExecuted is the error of a Result whose batch has not been executed.
+var ErrNotExecuted = errors.New("multicall: batch not executed")
+
+// CallError is the error of a call that reverted.
+type CallError struct {
+	Target common.Address
+	Method string
+	// Reason is the Error(string) revert reason, if any.
+	Reason string
+	// Data is the raw revert data.
+	Data []byte
+}
+
+func (e *CallError) Error() string {
+	if e.Reason != "" {
+		return fmt.Sprintf("multicall: %s on %s reverted: %s", e.Method, e.Target, e.Reason)
+	}
+	if len(e.Data) > 0 {
+		return fmt.Sprintf("multicall: %s on %s reverted with %s", e.Method, e.Target, hexutil.Encode(e.Data))
+	}
+	return fmt.Sprintf("multicall: %s on %s reverted", e.Method, e.Target)
+}
+
+// Result is the typed result of a call in a Batch. It is filled in when the
+// batch is executed.
+type Result[T any] struct {
+	Value T
+	Err   error
+}
+
+// Get returns the value and error of the call.
+func (r *Result[T]) Get() (T, error) {
+	return r.Value, r.Err
+}
+
+// call is a call in a Batch.
+type call struct {
+	target common.Address
+	method *abi.Method
+	data   []byte
+	// set decodes the return data into the Result, or records an error.
+	set func(returnData []byte, err error)
+}
+
+// Batch is a set of read-only calls executed together by Caller.Do.
+type Batch struct {
+	calls []*call
+}
+
+// Len returns the number of calls in the batch.
+func (b *Batch) Len() int {
+	return len(b.calls)
+}
+
+// Add adds a call of method on the contract at target to the batch and
+// returns its Result. T must be the Go type the ABI decoder produces for the
+// single output of method, for example *big.Int for uint256 or [32]byte for
+// bytes32. Packing errors are reported through the Result.
+func Add[T any](b *Batch, target common.Address, contract *abi.ABI, method string, args ...any) *Result[T] {
+	result := &Result[T]{Err: ErrNotExecuted}
+	m, ok := contract.Methods[method]
+	if !ok {
+		result.Err = fmt.Errorf("multicall: method %q not found", method)
+		return result
+	}
+	data, err := contract.Pack(method, args...)
+	if err != nil {
+		result.Err = fmt.Errorf("multicall: pack %s: %w", method, err)
+		return result
+	}
+	c := &call{target: target, method: &m, data: data}
+	c.set = func(returnData []byte, err error) {
+		if err != nil {
+			result.Err = err
+			return
+		}
+		result.Value, result.Err = decode[T](c, returnData)
+	}
+	b.calls = append(b.calls, c)
+	return result
+}
+
+func decode[T any](c *call, returnData []byte) (T, error) {
+	var zero T
+	out, err := c.method.Outputs.Unpack(returnData)
+	if err != nil {
+		return zero, fmt.Errorf("multicall: unpack %s on %s: %w", c.method.Name, c.target, err)
+	}
+	if len(out) != 1 {
+		return zero, fmt.Errorf("multicall: %s has %d outputs, expected 1", c.method.Name, len(out))
+	}
+	value, ok := out[0].(T)
+	if !ok {
+		return zero, fmt.Errorf("multicall: %s returns %T, not %T", c.method.Name, out[0], zero)
+	}
+	return value, nil
+}
+
+// revertError builds the CallError of c from its revert data.
+func (c *call) revertError(data []byte) error {
+	reason, _ := abi.UnpackRevert(data)
+	return &CallError{Target: c.target, Method: c.method.Name, Reason: reason, Data: data}
+}
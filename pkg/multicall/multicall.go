@@ -0,0 +1,234 @@
+// Package multicall executes batches of read-only contract calls in as few
+// round-trips as possible.
+//
+// Calls are grouped into Multicall3 aggregate3 calls with allowFailure set, so
+// a reverting call only fails its own Result. On chains or blocks without
+// Multicall3 the same calls are sent as a JSON-RPC batch of eth_call requests.
+package multicall
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// Multicall3Address is the address Multicall3 is deployed at on most chains.
+var Multicall3Address = common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+
+// DefaultMaxCalls is the default number of calls per aggregate3 call.
+const DefaultMaxCalls = 500
+
+// DefaultMaxBatch is the default number of requests per JSON-RPC batch.
+const DefaultMaxBatch = 100
+
+const multicall3ABI = `[
+	{"type":"function","name":"aggregate3","stateMutability":"payable",
+	 "inputs":[{"name":"calls","type":"tuple[]","components":[
+		{"name":"target","type":"address"},
+		{"name":"allowFailure","type":"bool"},
+		{"name":"callData","type":"bytes"}]}],
+	 "outputs":[{"name":"returnData","type":"tuple[]","components":[
+		{"name":"success","type":"bool"},
+		{"name":"returnData","type":"bytes"}]}]},
+	{"type":"function","name":"getBlockNumber","stateMutability":"view",
+	 "inputs":[],"outputs":[{"name":"blockNumber","type":"uint256"}]}
+]`
+
+// Multicall3ABI is the parsed subset of the Multicall3 ABI used by Caller.
+var Multicall3ABI = mustParseABI(multicall3ABI)
+
+func mustParseABI(definition string) *abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(definition))
+	if err != nil {
+		panic(err)
+	}
+	return &parsed
+}
+
+type call3 struct {
+	Target       common.Address
+	AllowFailure bool
+	CallData     []byte
+}
+
+type result3 struct {
+	Success    bool
+	ReturnData []byte
+}
+
+// BatchCaller sends JSON-RPC batches. It is satisfied by *rpc.Client.
+type BatchCaller interface {
+	BatchCallContext(ctx context.Context, b []rpc.BatchElem) error
+}
+
+// Caller executes batches.
+type Caller struct {
+	backend bind.ContractCaller
+	rpc     BatchCaller
+
+	// Address is the Multicall3 deployment. The zero address disables
+	// Multicall3 and sends every batch as JSON-RPC.
+	Address common.Address
+	// MaxCalls is the number of calls per aggregate3 call.
+	MaxCalls int
+	// MaxBatch is the number of requests per JSON-RPC batch.
+	MaxBatch int
+}
+
+// NewCaller creates a Caller using Multicall3 through backend. Batches fall
+// back to JSON-RPC batches through batcher when Multicall3 is not available;
+// batcher may be nil to disable the fallback. With an *ethclient.Client the
+// arguments are the client and client.Client().
+func NewCaller(backend bind.ContractCaller, batcher BatchCaller) *Caller {
+	return &Caller{
+		backend:  backend,
+		rpc:      batcher,
+		Address:  Multicall3Address,
+		MaxCalls: DefaultMaxCalls,
+		MaxBatch: DefaultMaxBatch,
+	}
+}
+
+// Do executes the calls of b at block, or at the latest block when block is
+// nil, and fills in their Results. It returns the number of the block the
+// calls were executed at. A batch that does not fit in one aggregate3 call is
+// split, and every part is executed at the block of the first one.
+func (c *Caller) Do(ctx context.Context, block *big.Int, b *Batch) (uint64, error) {
+	if c.Address != (common.Address{}) {
+		number, err := c.aggregate(ctx, block, b)
+		if err == nil || c.rpc == nil {
+			return number, err
+		}
+	}
+	if c.rpc == nil {
+		return 0, errors.New("multicall: Multicall3 is disabled and there is no JSON-RPC fallback")
+	}
+	return c.batch(ctx, block, b)
+}
+
+// aggregate executes b with aggregate3. Results are only filled in once every
+// part succeeded, so a failure can be retried with JSON-RPC.
+func (c *Caller) aggregate(ctx context.Context, block *big.Int, b *Batch) (uint64, error) {
+	size := c.MaxCalls
+	if size <= 0 {
+		size = DefaultMaxCalls
+	}
+	returned := make([]result3, 0, len(b.calls))
+	var number uint64
+	for start := 0; ; start += size {
+		end := min(start+size, len(b.calls))
+		// The first entry reads the block number, which pins the block of
+		// later parts and is returned to the caller.
+		calls := []call3{{Target: c.Address, CallData: Multicall3ABI.Methods["getBlockNumber"].ID}}
+		for _, call := range b.calls[start:end] {
+			calls = append(calls, call3{Target: call.target, AllowFailure: true, CallData: call.data})
+		}
+		input, err := Multicall3ABI.Pack("aggregate3", calls)
+		if err != nil {
+			return 0, fmt.Errorf("multicall: pack aggregate3: %w", err)
+		}
+		output, err := c.backend.CallContract(ctx, ethereum.CallMsg{To: &c.Address, Data: input}, block)
+		if err != nil {
+			return 0, fmt.Errorf("multicall: aggregate3: %w", err)
+		}
+		unpacked, err := Multicall3ABI.Unpack("aggregate3", output)
+		if err != nil || len(unpacked) != 1 {
+			// No code at Address returns empty output.
+			return 0, fmt.Errorf("multicall: unexpected aggregate3 output %s", hexutil.Encode(output))
+		}
+		results := *abi.ConvertType(unpacked[0], new([]result3)).(*[]result3)
+		if len(results) != len(calls) {
+			return 0, fmt.Errorf("multicall: aggregate3 returned %d results for %d calls", len(results), len(calls))
+		}
+		blockNumber := new(big.Int).SetBytes(results[0].ReturnData)
+		if block == nil {
+			block = blockNumber
+		}
+		number = blockNumber.Uint64()
+		returned = append(returned, results[1:]...)
+		if end == len(b.calls) {
+			break
+		}
+	}
+	for i, call := range b.calls {
+		if returned[i].Success {
+			call.set(returned[i].ReturnData, nil)
+		} else {
+			call.set(nil, call.revertError(returned[i].ReturnData))
+		}
+	}
+	return number, nil
+}
+
+// batch executes b as JSON-RPC batches of eth_call requests.
+func (c *Caller) batch(ctx context.Context, block *big.Int, b *Batch) (uint64, error) {
+	if block == nil {
+		var latest hexutil.Uint64
+		if err := c.rpcCall(ctx, &latest, "eth_blockNumber"); err != nil {
+			return 0, fmt.Errorf("multicall: get latest block: %w", err)
+		}
+		block = new(big.Int).SetUint64(uint64(latest))
+	}
+	size := c.MaxBatch
+	if size <= 0 {
+		size = DefaultMaxBatch
+	}
+	blockArg := hexutil.EncodeBig(block)
+	for start := 0; start < len(b.calls); start += size {
+		calls := b.calls[start:min(start+size, len(b.calls))]
+		elems := make([]rpc.BatchElem, len(calls))
+		results := make([]hexutil.Bytes, len(calls))
+		for i, call := range calls {
+			elems[i] = rpc.BatchElem{
+				Method: "eth_call",
+				Args: []any{map[string]any{
+					"to":    call.target,
+					"input": hexutil.Bytes(call.data),
+				}, blockArg},
+				Result: &results[i],
+			}
+		}
+		if err := c.rpc.BatchCallContext(ctx, elems); err != nil {
+			return 0, fmt.Errorf("multicall: JSON-RPC batch: %w", err)
+		}
+		for i, call := range calls {
+			if err := elems[i].Error; err != nil {
+				call.set(nil, c.callError(call, err))
+				continue
+			}
+			call.set(results[i], nil)
+		}
+	}
+	return block.Uint64(), nil
+}
+
+func (c *Caller) rpcCall(ctx context.Context, result any, method string, args ...any) error {
+	elems := []rpc.BatchElem{{Method: method, Args: args, Result: result}}
+	if err := c.rpc.BatchCallContext(ctx, elems); err != nil {
+		return err
+	}
+	return elems[0].Error
+}
+
+// callError converts an eth_call error into a CallError when it carries
+// revert data.
+func (c *Caller) callError(call *call, err error) error {
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) {
+		if s, ok := dataErr.ErrorData().(string); ok {
+			if data, decodeErr := hexutil.Decode(s); decodeErr == nil {
+				return call.revertError(data)
+			}
+		}
+	}
+	return fmt.Errorf("multicall: %s on %s: %w", call.method.Name, call.target, err)
+}
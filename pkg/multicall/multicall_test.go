@@ -0,0 +1,183 @@
+package multicall
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/symbioticfi/network/pkg/timelock"
+)
+
+var (
+	network = common.HexToAddress("0x7e70000000000000000000000000000000000000")
+	// revertNope is the revert data of require(false, "nope").
+	revertNope = append(crypto.Keccak256([]byte("Error(string)"))[:4],
+		common.FromHex("0x000000000000000000000000000000000000000000000000000000000000002000000000000000000000000000000000000000000000000000000000000000046e6f706500000000000000000000000000000000000000000000000000000000")...)
+)
+
+// fakeChain executes getMinDelay() and hasRole() calls, the latter always
+// reverting, through aggregate3 or eth_call.
+type fakeChain struct {
+	head       uint64
+	multicall  bool
+	aggregates int
+	blocks     []*big.Int
+}
+
+func (f *fakeChain) execute(data []byte) ([]byte, bool) {
+	method, err := timelock.ABI.MethodById(data)
+	if err != nil || method.Name != "getMinDelay" {
+		return revertNope, false
+	}
+	out, _ := method.Outputs.Pack(big.NewInt(3600))
+	return out, true
+}
+
+func (f *fakeChain) CodeAt(context.Context, common.Address, *big.Int) ([]byte, error) {
+	return nil, nil
+}
+
+func (f *fakeChain) CallContract(_ context.Context, msg ethereum.CallMsg, block *big.Int) ([]byte, error) {
+	f.aggregates++
+	f.blocks = append(f.blocks, block)
+	if !f.multicall {
+		// Calls to an address without code return nothing.
+		return nil, nil
+	}
+	args, err := Multicall3ABI.Methods["aggregate3"].Inputs.Unpack(msg.Data[4:])
+	if err != nil {
+		return nil, err
+	}
+	calls := *abi.ConvertType(args[0], new([]call3)).(*[]call3)
+	results := make([]result3, len(calls))
+	for i, c := range calls {
+		if c.Target == Multicall3Address {
+			results[i] = result3{Success: true, ReturnData: common.LeftPadBytes(new(big.Int).SetUint64(f.head).Bytes(), 32)}
+			continue
+		}
+		results[i].ReturnData, results[i].Success = f.execute(c.CallData)
+	}
+	return Multicall3ABI.Methods["aggregate3"].Outputs.Pack(results)
+}
+
+func (f *fakeChain) BatchCallContext(_ context.Context, elems []rpc.BatchElem) error {
+	for i := range elems {
+		switch elems[i].Method {
+		case "eth_blockNumber":
+			*elems[i].Result.(*hexutil.Uint64) = hexutil.Uint64(f.head)
+		case "eth_call":
+			input := elems[i].Args[0].(map[string]any)["input"].(hexutil.Bytes)
+			out, ok := f.execute(input)
+			if !ok {
+				elems[i].Error = revertError{data: hexutil.Encode(out)}
+				continue
+			}
+			*elems[i].Result.(*hexutil.Bytes) = out
+		}
+	}
+	return nil
+}
+
+// revertError is an eth_call error carrying revert data, as returned by geth.
+type revertError struct{ data string }
+
+func (e revertError) Error() string          { return "execution reverted" }
+func (e revertError) ErrorCode() int         { return 3 }
+func (e revertError) ErrorData() interface{} { return e.data }
+
+func TestCallerDo(t *testing.T) {
+	tests := []struct {
+		name           string
+		multicall      bool
+		maxCalls       int
+		wantAggregates int
+	}{
+		{name: "aggregate3", multicall: true, maxCalls: 10, wantAggregates: 1},
+		{name: "split aggregate3", multicall: true, maxCalls: 2, wantAggregates: 3},
+		{name: "JSON-RPC fallback", multicall: false, maxCalls: 10, wantAggregates: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chain := &fakeChain{head: 42, multicall: tt.multicall}
+			caller := NewCaller(chain, chain)
+			caller.MaxCalls = tt.maxCalls
+
+			var b Batch
+			var delays []*Result[*big.Int]
+			for i := 0; i < 4; i++ {
+				delays = append(delays, Add[*big.Int](&b, network, timelock.ABI, "getMinDelay"))
+			}
+			role := Add[bool](&b, network, timelock.ABI, "hasRole", common.Hash{}, network)
+			missing := Add[bool](&b, network, timelock.ABI, "noSuchMethod")
+			if b.Len() != 5 {
+				t.Fatalf("Len() = %d, want 5", b.Len())
+			}
+
+			number, err := caller.Do(context.Background(), nil, &b)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if number != 42 {
+				t.Errorf("Do() = block %d, want 42", number)
+			}
+			if chain.aggregates != tt.wantAggregates {
+				t.Errorf("%d aggregate3 calls, want %d", chain.aggregates, tt.wantAggregates)
+			}
+			// Later parts are pinned to the block of the first one.
+			for i, block := range chain.blocks[1:] {
+				if tt.multicall && (block == nil || block.Uint64() != 42) {
+					t.Errorf("part %d executed at block %v, want 42", i+1, block)
+				}
+			}
+			for i, delay := range delays {
+				if value, err := delay.Get(); err != nil || value.Int64() != 3600 {
+					t.Errorf("delay %d = %v, %v, want 3600", i, value, err)
+				}
+			}
+			var callErr *CallError
+			if _, err := role.Get(); !errors.As(err, &callErr) || callErr.Reason != "nope" || callErr.Method != "hasRole" {
+				t.Errorf("hasRole error = %v, want a CallError with reason nope", err)
+			}
+			if _, err := missing.Get(); err == nil || errors.Is(err, ErrNotExecuted) {
+				t.Errorf("noSuchMethod error = %v, want an unknown method error", err)
+			}
+		})
+	}
+}
+
+func TestResultNotExecuted(t *testing.T) {
+	var b Batch
+	result := Add[*big.Int](&b, network, timelock.ABI, "getMinDelay")
+	if _, err := result.Get(); !errors.Is(err, ErrNotExecuted) {
+		t.Errorf("Get() error = %v, want ErrNotExecuted", err)
+	}
+}
+
+func TestDecodeTypeMismatch(t *testing.T) {
+	chain := &fakeChain{head: 1, multicall: true}
+	var b Batch
+	result := Add[bool](&b, network, timelock.ABI, "getMinDelay")
+	if _, err := NewCaller(chain, nil).Do(context.Background(), nil, &b); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := result.Get(); err == nil {
+		t.Error("Get() decoded a uint256 as a bool")
+	}
+}
+
+func TestCallerWithoutFallback(t *testing.T) {
+	chain := &fakeChain{head: 1}
+	var b Batch
+	Add[*big.Int](&b, network, timelock.ABI, "getMinDelay")
+	if _, err := NewCaller(chain, nil).Do(context.Background(), nil, &b); err == nil {
+		t.Error("Do() succeeded without Multicall3 and without a fallback")
+	}
+}
@@ -0,0 +1,210 @@
+package multicall
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/big"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	networkcontracts "github.com/symbioticfi/network/bindings/go-go-ethereum"
+	"github.com/symbioticfi/network/pkg/roles"
+	"github.com/symbioticfi/network/pkg/timelock"
+)
+
+// networkABI is the parsed INetwork ABI.
+var networkABI = mustGetABI(networkcontracts.INetworkMetaData)
+
+func mustGetABI(metadata *bind.MetaData) *abi.ABI {
+	parsed, err := metadata.GetAbi()
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
+
+// SnapshotQuery selects what Snapshot reads besides the name, metadata URI
+// and global delay.
+type SnapshotQuery struct {
+	// Calls are the calls getMinDelay(target, data) is evaluated for.
+	Calls []timelock.Call
+	// Accounts are checked with hasRole for every known role.
+	Accounts []common.Address
+	// Operations are read with getOperationState and getTimestamp.
+	Operations []common.Hash
+}
+
+// Delay is the value getMinDelay returned for a call.
+type Delay struct {
+	Target   common.Address `json:"target"`
+	Selector hexutil.Bytes  `json:"selector"`
+	Delay    *big.Int       `json:"delay,omitempty"`
+	// Error is set when getMinDelay reverts for the call.
+	Error string `json:"error,omitempty"`
+}
+
+// Holder is an account holding a role.
+type Holder struct {
+	Role    common.Hash    `json:"role"`
+	Account common.Address `json:"account"`
+}
+
+// Operation is the state of a timelock operation.
+type Operation struct {
+	ID    common.Hash             `json:"id"`
+	State timelock.OperationState `json:"state"`
+	// Timestamp is 0 for unset operations, 1 for done ones and the ready
+	// time otherwise.
+	Timestamp uint64 `json:"timestamp"`
+}
+
+// Snapshot is the state of a Network read in a single batch.
+type Snapshot struct {
+	Network     common.Address `json:"network"`
+	BlockNumber uint64         `json:"blockNumber"`
+
+	Name        string   `json:"name"`
+	MetadataURI string   `json:"metadataURI"`
+	MinDelay    *big.Int `json:"minDelay"`
+	Delays      []Delay  `json:"delays"`
+	// Holders lists the queried accounts that hold a known role.
+	Holders    []Holder    `json:"holders"`
+	Operations []Operation `json:"operations"`
+}
+
+// Snapshot reads the state of the Network at network with a single batch,
+// which is one aggregate3 call unless the query exceeds MaxCalls.
+func (c *Caller) Snapshot(ctx context.Context, network common.Address, block *big.Int, query SnapshotQuery) (*Snapshot, error) {
+	var b Batch
+	name := Add[string](&b, network, networkABI, "name")
+	metadataURI := Add[string](&b, network, networkABI, "metadataURI")
+	minDelay := Add[*big.Int](&b, network, timelock.ABI, "getMinDelay")
+	delays := make([]*Result[*big.Int], len(query.Calls))
+	for i, call := range query.Calls {
+		delays[i] = Add[*big.Int](&b, network, networkABI, "getMinDelay", call.Target, []byte(call.Data))
+	}
+	hasRole := make([][]*Result[bool], len(query.Accounts))
+	for i, account := range query.Accounts {
+		hasRole[i] = make([]*Result[bool], len(roles.Known))
+		for j, role := range roles.Known {
+			hasRole[i][j] = Add[bool](&b, network, timelock.ABI, "hasRole", [32]byte(role), account)
+		}
+	}
+	states := make([]*Result[uint8], len(query.Operations))
+	timestamps := make([]*Result[*big.Int], len(query.Operations))
+	for i, id := range query.Operations {
+		states[i] = Add[uint8](&b, network, timelock.ABI, "getOperationState", [32]byte(id))
+		timestamps[i] = Add[*big.Int](&b, network, timelock.ABI, "getTimestamp", [32]byte(id))
+	}
+
+	number, err := c.Do(ctx, block, &b)
+	if err != nil {
+		return nil, err
+	}
+	snapshot := &Snapshot{Network: network, BlockNumber: number}
+	if snapshot.Name, err = name.Get(); err != nil {
+		return nil, err
+	}
+	if snapshot.MetadataURI, err = metadataURI.Get(); err != nil {
+		return nil, err
+	}
+	if snapshot.MinDelay, err = minDelay.Get(); err != nil {
+		return nil, err
+	}
+	for i, call := range query.Calls {
+		selector := timelock.Selector(call.Data)
+		delay := Delay{Target: call.Target, Selector: selector[:]}
+		if delay.Delay, err = delays[i].Get(); err != nil {
+			delay.Error = err.Error()
+		}
+		snapshot.Delays = append(snapshot.Delays, delay)
+	}
+	for i, account := range query.Accounts {
+		for j, role := range roles.Known {
+			has, err := hasRole[i][j].Get()
+			if err != nil {
+				return nil, err
+			}
+			if has {
+				snapshot.Holders = append(snapshot.Holders, Holder{Role: role, Account: account})
+			}
+		}
+	}
+	for i, id := range query.Operations {
+		state, err := states[i].Get()
+		if err != nil {
+			return nil, err
+		}
+		timestamp, err := timestamps[i].Get()
+		if err != nil {
+			return nil, err
+		}
+		snapshot.Operations = append(snapshot.Operations, Operation{
+			ID:        id,
+			State:     timelock.OperationState(state),
+			Timestamp: timestamp.Uint64(),
+		})
+	}
+	return snapshot, nil
+}
+
+// WriteText renders the snapshot.
+func (s *Snapshot) WriteText(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(tw, "Network:\t%s\n", s.Network)
+	fmt.Fprintf(tw, "Block:\t%d\n", s.BlockNumber)
+	fmt.Fprintf(tw, "Name:\t%s\n", s.Name)
+	fmt.Fprintf(tw, "Metadata URI:\t%s\n", s.MetadataURI)
+	fmt.Fprintf(tw, "Global min delay:\t%ss\n", s.MinDelay)
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	if len(s.Delays) > 0 {
+		fmt.Fprintln(w)
+		tw = tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(tw, "TARGET\tSELECTOR\tMIN DELAY")
+		for _, d := range s.Delays {
+			value := d.Error
+			if d.Delay != nil {
+				value = d.Delay.String() + "s"
+			}
+			fmt.Fprintf(tw, "%s\t%s\t%s\n", d.Target, d.Selector, value)
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+	}
+	if len(s.Holders) > 0 {
+		fmt.Fprintln(w)
+		tw = tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(tw, "ROLE\tACCOUNT")
+		for _, h := range s.Holders {
+			fmt.Fprintf(tw, "%s\t%s\n", roles.Name(h.Role), h.Account)
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+	}
+	if len(s.Operations) > 0 {
+		fmt.Fprintln(w)
+		tw = tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(tw, "OPERATION\tSTATE\tREADY AT")
+		for _, op := range s.Operations {
+			readyAt := "-"
+			if op.Timestamp > 1 {
+				readyAt = time.Unix(int64(op.Timestamp), 0).UTC().Format(time.RFC3339)
+			}
+			fmt.Fprintf(tw, "%s\t%s\t%s\n", op.ID, op.State, readyAt)
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
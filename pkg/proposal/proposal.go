@@ -0,0 +1,245 @@
+// Package proposal implements a portable JSON document describing a Network
+// operation, which reviewers approve by signing its canonical hash.
+//
+// The canonical form of a proposal is its compact JSON encoding without the
+// signatures, with fields in the order of the Proposal type. Because decoding
+// rejects unknown fields, every byte that reviewers sign is also a byte the
+// scheduler reads.
+package proposal
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/symbioticfi/network/pkg/calldata"
+	"github.com/symbioticfi/network/pkg/timelock"
+)
+
+// Version is the proposal format version produced by this package.
+const Version = 1
+
+// Proposal describes a timelock operation on a Network.
+type Proposal struct {
+	Version     int              `json:"version"`
+	ChainID     uint64           `json:"chainId"`
+	Network     common.Address   `json:"network"`
+	Calls       []Call           `json:"calls"`
+	Batch       bool             `json:"batch"`
+	Predecessor common.Hash      `json:"predecessor"`
+	Salt        common.Hash      `json:"salt"`
+	Delay       *math.Decimal256 `json:"delay"`
+	OperationID common.Hash      `json:"operationId"`
+	Rationale   string           `json:"rationale"`
+	Signatures  []Signature      `json:"signatures"`
+}
+
+// Call is a call of the proposed operation with its decoded description.
+type Call struct {
+	Target      common.Address   `json:"target"`
+	Value       *math.Decimal256 `json:"value"`
+	Data        hexutil.Bytes    `json:"data"`
+	Description string           `json:"description"`
+}
+
+// New creates an unsigned proposal for op on the Network at network.
+func New(chainID uint64, network common.Address, op *timelock.Operation, rationale string) (*Proposal, error) {
+	id, err := op.ID()
+	if err != nil {
+		return nil, err
+	}
+	delay := op.Delay
+	if delay == nil {
+		delay = new(big.Int)
+	}
+	p := &Proposal{
+		Version:     Version,
+		ChainID:     chainID,
+		Network:     network,
+		Batch:       op.IsBatch(),
+		Predecessor: op.Predecessor,
+		Salt:        op.Salt,
+		Delay:       (*math.Decimal256)(new(big.Int).Set(delay)),
+		OperationID: id,
+		Rationale:   rationale,
+		Signatures:  []Signature{},
+	}
+	for _, call := range op.Calls {
+		value := new(big.Int)
+		if call.Value != nil {
+			value.Set(call.Value)
+		}
+		p.Calls = append(p.Calls, Call{
+			Target:      call.Target,
+			Value:       (*math.Decimal256)(value),
+			Data:        call.Data,
+			Description: calldata.Default.Describe(call.Data),
+		})
+	}
+	return p, p.Validate()
+}
+
+// Operation returns the timelock operation the proposal describes.
+func (p *Proposal) Operation() *timelock.Operation {
+	op := &timelock.Operation{
+		Predecessor: p.Predecessor,
+		Salt:        p.Salt,
+		Delay:       (*big.Int)(p.Delay),
+		Batch:       p.Batch,
+	}
+	for _, call := range p.Calls {
+		op.Calls = append(op.Calls, timelock.Call{Target: call.Target, Value: (*big.Int)(call.Value), Data: call.Data})
+	}
+	return op
+}
+
+// Validate checks that the proposal is well formed and that its operation ID
+// matches its calls. The call descriptions are signed as stored and are not
+// recomputed, so a proposal does not change meaning when the decoder learns
+// new ABIs; see Mismatches.
+func (p *Proposal) Validate() error {
+	switch {
+	case p.Version != Version:
+		return fmt.Errorf("proposal: unsupported version %d", p.Version)
+	case p.ChainID == 0:
+		return errors.New("proposal: missing chainId")
+	case p.Network == (common.Address{}):
+		return errors.New("proposal: missing network")
+	case len(p.Calls) == 0:
+		return errors.New("proposal: no calls")
+	case p.Delay == nil:
+		return errors.New("proposal: missing delay")
+	case len(p.Calls) > 1 && !p.Batch:
+		return errors.New("proposal: several calls must be scheduled as a batch")
+	}
+	for i, call := range p.Calls {
+		if call.Value == nil {
+			return fmt.Errorf("proposal: call %d: missing value", i)
+		}
+	}
+	id, err := p.Operation().ID()
+	if err != nil {
+		return err
+	}
+	if id != p.OperationID {
+		return fmt.Errorf("proposal: operationId %s does not match the calls, expected %s", p.OperationID, id)
+	}
+	return nil
+}
+
+// Mismatches returns the indexes of the calls whose stored description
+// differs from the one decoder gives them. Calls decoder does not know are
+// skipped.
+func (p *Proposal) Mismatches(decoder *calldata.Decoder) []int {
+	var mismatches []int
+	for i, call := range p.Calls {
+		expected := decoder.Describe(call.Data)
+		if call.Description != expected && !strings.HasPrefix(expected, "unknown ") {
+			mismatches = append(mismatches, i)
+		}
+	}
+	return mismatches
+}
+
+// Canonical returns the canonical encoding of the proposal, which excludes
+// the signatures.
+func (p *Proposal) Canonical() ([]byte, error) {
+	unsigned := *p
+	unsigned.Signatures = nil
+	return json.Marshal(&unsigned)
+}
+
+// Hash returns the keccak256 hash of the canonical encoding.
+func (p *Proposal) Hash() (common.Hash, error) {
+	canonical, err := p.Canonical()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return crypto.Keccak256Hash(canonical), nil
+}
+
+// Decode parses a proposal, rejecting unknown fields, and validates it.
+func Decode(data []byte) (*Proposal, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	var p Proposal
+	if err := decoder.Decode(&p); err != nil {
+		return nil, fmt.Errorf("proposal: %w", err)
+	}
+	if decoder.More() {
+		return nil, errors.New("proposal: trailing data after the proposal")
+	}
+	if p.Signatures == nil {
+		p.Signatures = []Signature{}
+	}
+	return &p, p.Validate()
+}
+
+// Encode returns the indented file representation of the proposal.
+func (p *Proposal) Encode() ([]byte, error) {
+	data, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+// Load reads and validates a proposal file.
+func Load(path string) (*Proposal, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Decode(data)
+}
+
+// Save writes the proposal to path in its file representation.
+func (p *Proposal) Save(path string) error {
+	data, err := p.Encode()
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o644)
+}
+
+// WriteText renders the proposal and the validity of each signature.
+func (p *Proposal) WriteText(w io.Writer) error {
+	hash, err := p.Hash()
+	if err != nil {
+		return err
+	}
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(tw, "chain ID:\t%d\n", p.ChainID)
+	fmt.Fprintf(tw, "network:\t%s\n", p.Network)
+	fmt.Fprintf(tw, "operation ID:\t%s\n", p.OperationID)
+	fmt.Fprintf(tw, "proposal hash:\t%s\n", hash)
+	fmt.Fprintf(tw, "batch:\t%t\n", p.Batch)
+	fmt.Fprintf(tw, "predecessor:\t%s\n", p.Predecessor)
+	fmt.Fprintf(tw, "salt:\t%s\n", p.Salt)
+	fmt.Fprintf(tw, "delay:\t%s\n", p.Delay)
+	fmt.Fprintf(tw, "rationale:\t%s\n", p.Rationale)
+	fmt.Fprintln(tw, "\n#\tTARGET\tVALUE\tCALL")
+	for i, call := range p.Calls {
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%s\n", i, call.Target, call.Value, call.Description)
+	}
+	fmt.Fprintln(tw, "\nSIGNER\tSCHEME\tVALID")
+	for _, signature := range p.Signatures {
+		valid := "yes"
+		if err := p.Verify(signature); err != nil {
+			valid = err.Error()
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", signature.Signer, signature.Scheme, valid)
+	}
+	return tw.Flush()
+}
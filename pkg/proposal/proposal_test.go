@@ -0,0 +1,220 @@
+package proposal
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/symbioticfi/network/pkg/calldata"
+	"github.com/symbioticfi/network/pkg/timelock"
+)
+
+var network = common.HexToAddress("0x7e70000000000000000000000000000000000000")
+
+func testProposal(t *testing.T) *Proposal {
+	t.Helper()
+	data, err := timelock.ABI.Pack("updateDelay", big.NewInt(86400))
+	if err != nil {
+		t.Fatal(err)
+	}
+	op := &timelock.Operation{
+		Calls: []timelock.Call{{Target: network, Data: data}},
+		Salt:  common.HexToHash("0x01"),
+		Delay: big.NewInt(86400),
+	}
+	p, err := New(1, network, op, "lower the delay")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func testKey(t *testing.T, seed byte) *ecdsa.PrivateKey {
+	t.Helper()
+	key, err := crypto.ToECDSA(bytes.Repeat([]byte{seed}, 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func TestCanonical(t *testing.T) {
+	p := testProposal(t)
+	unsigned, err := p.Canonical()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(unsigned, []byte(`{"version":1,"chainId":1,"network":"0x7e70`)) {
+		t.Errorf("canonical form does not follow the field order: %s", unsigned)
+	}
+	if _, err := p.Sign(testKey(t, 1), EIP191); err != nil {
+		t.Fatal(err)
+	}
+	signed, err := p.Canonical()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(unsigned, signed) {
+		t.Errorf("signing changed the canonical form:\n%s\n%s", unsigned, signed)
+	}
+	if len(p.Signatures) != 1 {
+		t.Errorf("Canonical dropped the signatures of the proposal")
+	}
+
+	encoded, err := p.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := Decode(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	roundTrip, err := decoded.Canonical()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(unsigned, roundTrip) {
+		t.Errorf("file round trip changed the canonical form:\n%s\n%s", unsigned, roundTrip)
+	}
+}
+
+func TestDecodeRejects(t *testing.T) {
+	encoded, err := testProposal(t).Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name    string
+		edit    func(string) string
+		wantErr string
+	}{
+		{"unknown field", func(s string) string { return strings.Replace(s, `"version"`, `"extra": 1, "version"`, 1) }, "unknown field"},
+		{"trailing data", func(s string) string { return s + "{}" }, "trailing data"},
+		{"operation id", func(s string) string { return strings.Replace(s, `"salt": "0x00`, `"salt": "0x10`, 1) }, "operationId"},
+		{"version", func(s string) string { return strings.Replace(s, `"version": 1`, `"version": 2`, 1) }, "version"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Decode([]byte(tt.edit(string(encoded))))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Decode() error = %v, want one containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSignVerify(t *testing.T) {
+	for _, scheme := range []Scheme{EIP191, EIP712} {
+		t.Run(string(scheme), func(t *testing.T) {
+			p := testProposal(t)
+			signature, err := p.Sign(testKey(t, 1), scheme)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := p.Verify(signature); err != nil {
+				t.Errorf("Verify() = %v", err)
+			}
+
+			forged := signature
+			forged.Signer = crypto.PubkeyToAddress(testKey(t, 2).PublicKey)
+			if err := p.Verify(forged); err == nil {
+				t.Error("Verify() accepted a signature under another signer")
+			}
+
+			other := map[Scheme]Scheme{EIP191: EIP712, EIP712: EIP191}[scheme]
+			wrongScheme := signature
+			wrongScheme.Scheme = other
+			if err := p.Verify(wrongScheme); err == nil {
+				t.Error("Verify() accepted a signature under another scheme")
+			}
+
+			p.Rationale = "changed"
+			if err := p.Verify(signature); err == nil {
+				t.Error("Verify() accepted a signature over a changed proposal")
+			}
+		})
+	}
+}
+
+func TestSignReplaces(t *testing.T) {
+	p := testProposal(t)
+	key := testKey(t, 1)
+	if _, err := p.Sign(key, EIP191); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.Sign(key, EIP712); err != nil {
+		t.Fatal(err)
+	}
+	if len(p.Signatures) != 1 || p.Signatures[0].Scheme != EIP712 {
+		t.Errorf("signatures = %+v, want the EIP712 one only", p.Signatures)
+	}
+}
+
+func TestRequireApprovals(t *testing.T) {
+	p := testProposal(t)
+	keys := []*ecdsa.PrivateKey{testKey(t, 1), testKey(t, 2), testKey(t, 3)}
+	var reviewers []common.Address
+	for _, key := range keys {
+		reviewers = append(reviewers, crypto.PubkeyToAddress(key.PublicKey))
+	}
+	for _, key := range keys[:2] {
+		if _, err := p.Sign(key, EIP712); err != nil {
+			t.Fatal(err)
+		}
+	}
+	outsider := testKey(t, 4)
+	if _, err := p.Sign(outsider, EIP712); err != nil {
+		t.Fatal(err)
+	}
+	// A stale signature from a reviewer does not count.
+	stale := *testProposal(t)
+	stale.Rationale = "older draft"
+	staleSignature, err := stale.Sign(keys[2], EIP712)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.Signatures = append(p.Signatures, staleSignature)
+
+	tests := []struct {
+		name      string
+		reviewers []common.Address
+		threshold int
+		wantErr   bool
+	}{
+		{"met", reviewers, 2, false},
+		{"not met", reviewers, 3, true},
+		{"outsider ignored", reviewers[1:], 2, true},
+		{"zero threshold", reviewers, 0, true},
+		{"threshold above reviewers", reviewers[:1], 2, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := p.RequireApprovals(tt.reviewers, tt.threshold)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RequireApprovals() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateKeepsStoredDescriptions(t *testing.T) {
+	p := testProposal(t)
+	if !strings.HasPrefix(p.Calls[0].Description, "updateDelay(") {
+		t.Fatalf("description = %q", p.Calls[0].Description)
+	}
+	p.Calls[0].Description = "updateDelay as decoded by an older release"
+	if err := p.Validate(); err != nil {
+		t.Errorf("Validate() = %v", err)
+	}
+	if got := p.Mismatches(calldata.Default); len(got) != 1 || got[0] != 0 {
+		t.Errorf("Mismatches() = %v, want [0]", got)
+	}
+	if got := p.Mismatches(calldata.NewDecoder()); len(got) != 0 {
+		t.Errorf("Mismatches() with an empty decoder = %v, want none", got)
+	}
+}
@@ -0,0 +1,186 @@
+package proposal
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// Scheme is the signing scheme of a review signature.
+type Scheme string
+
+const (
+	// EIP191 signs the proposal hash as a personal_sign message.
+	EIP191 Scheme = "eip191"
+	// EIP712 signs the typed data returned by Proposal.TypedData.
+	EIP712 Scheme = "eip712"
+)
+
+// Signature is a reviewer's approval of the exact proposal hash.
+type Signature struct {
+	Signer    common.Address `json:"signer"`
+	Scheme    Scheme         `json:"scheme"`
+	Signature hexutil.Bytes  `json:"signature"`
+}
+
+// TypedData returns the EIP-712 typed data reviewers sign with the EIP712
+// scheme, for example through eth_signTypedData_v4. The domain binds the
+// approval to the chain and the Network.
+func (p *Proposal) TypedData() (apitypes.TypedData, error) {
+	hash, err := p.Hash()
+	if err != nil {
+		return apitypes.TypedData{}, err
+	}
+	return apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {
+				{Name: "name", Type: "string"},
+				{Name: "version", Type: "string"},
+				{Name: "chainId", Type: "uint256"},
+				{Name: "verifyingContract", Type: "address"},
+			},
+			"NetworkProposal": {
+				{Name: "operationId", Type: "bytes32"},
+				{Name: "proposalHash", Type: "bytes32"},
+			},
+		},
+		PrimaryType: "NetworkProposal",
+		Domain: apitypes.TypedDataDomain{
+			Name:              "Symbiotic Network Proposal",
+			Version:           "1",
+			ChainId:           (*math.HexOrDecimal256)(new(big.Int).SetUint64(p.ChainID)),
+			VerifyingContract: p.Network.Hex(),
+		},
+		Message: apitypes.TypedDataMessage{
+			"operationId":  p.OperationID.Hex(),
+			"proposalHash": hash.Hex(),
+		},
+	}, nil
+}
+
+// Digest returns the 32-byte digest signed under the given scheme.
+func (p *Proposal) Digest(scheme Scheme) ([]byte, error) {
+	switch scheme {
+	case EIP191:
+		hash, err := p.Hash()
+		if err != nil {
+			return nil, err
+		}
+		return accounts.TextHash(hash[:]), nil
+	case EIP712:
+		typedData, err := p.TypedData()
+		if err != nil {
+			return nil, err
+		}
+		digest, _, err := apitypes.TypedDataAndHash(typedData)
+		return digest, err
+	default:
+		return nil, fmt.Errorf("proposal: unknown signature scheme %q", scheme)
+	}
+}
+
+// Sign signs the proposal with key and adds the signature, replacing an
+// earlier one of the same signer.
+func (p *Proposal) Sign(key *ecdsa.PrivateKey, scheme Scheme) (Signature, error) {
+	digest, err := p.Digest(scheme)
+	if err != nil {
+		return Signature{}, err
+	}
+	sig, err := crypto.Sign(digest, key)
+	if err != nil {
+		return Signature{}, err
+	}
+	sig[crypto.RecoveryIDOffset] += 27
+	signature := Signature{Signer: crypto.PubkeyToAddress(key.PublicKey), Scheme: scheme, Signature: sig}
+	return signature, p.Attach(signature)
+}
+
+// Attach verifies a signature produced elsewhere, for example by a hardware
+// wallet, and adds it, replacing an earlier one of the same signer.
+func (p *Proposal) Attach(signature Signature) error {
+	if err := p.Verify(signature); err != nil {
+		return err
+	}
+	for i, existing := range p.Signatures {
+		if existing.Signer == signature.Signer {
+			p.Signatures[i] = signature
+			return nil
+		}
+	}
+	p.Signatures = append(p.Signatures, signature)
+	return nil
+}
+
+// Verify checks that signature was produced by its signer over the current
+// proposal hash.
+func (p *Proposal) Verify(signature Signature) error {
+	recovered, err := p.Recover(signature.Scheme, signature.Signature)
+	if err != nil {
+		return err
+	}
+	if recovered != signature.Signer {
+		return fmt.Errorf("proposal: signature of %s was made by %s", signature.Signer, recovered)
+	}
+	return nil
+}
+
+// Recover returns the account that produced sig over the current proposal
+// hash under the given scheme.
+func (p *Proposal) Recover(scheme Scheme, sig []byte) (common.Address, error) {
+	if len(sig) != crypto.SignatureLength {
+		return common.Address{}, fmt.Errorf("proposal: signature has %d bytes, expected %d", len(sig), crypto.SignatureLength)
+	}
+	digest, err := p.Digest(scheme)
+	if err != nil {
+		return common.Address{}, err
+	}
+	sig = common.CopyBytes(sig)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+	pub, err := crypto.SigToPub(digest, sig)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("proposal: recover signer: %w", err)
+	}
+	return crypto.PubkeyToAddress(*pub), nil
+}
+
+// Approvals returns the reviewers with a valid signature on the proposal.
+func (p *Proposal) Approvals(reviewers []common.Address) []common.Address {
+	allowed := make(map[common.Address]bool, len(reviewers))
+	for _, reviewer := range reviewers {
+		allowed[reviewer] = true
+	}
+	var approvals []common.Address
+	for _, signature := range p.Signatures {
+		if !allowed[signature.Signer] || p.Verify(signature) != nil {
+			continue
+		}
+		allowed[signature.Signer] = false
+		approvals = append(approvals, signature.Signer)
+	}
+	return approvals
+}
+
+// RequireApprovals returns an error unless at least threshold of the
+// reviewers have a valid signature on the proposal.
+func (p *Proposal) RequireApprovals(reviewers []common.Address, threshold int) error {
+	if threshold <= 0 {
+		return errors.New("proposal: threshold must be positive")
+	}
+	if threshold > len(reviewers) {
+		return fmt.Errorf("proposal: threshold %d exceeds the %d reviewers", threshold, len(reviewers))
+	}
+	if approvals := p.Approvals(reviewers); len(approvals) < threshold {
+		return fmt.Errorf("proposal: %d of %d required reviewer signatures", len(approvals), threshold)
+	}
+	return nil
+}
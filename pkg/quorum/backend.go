@@ -0,0 +1,295 @@
+package quorum
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// CallContract executes call on every live endpoint and returns the result,
+// or the revert, that at least Quorum of them agree on. Without a block, the
+// call is made at the highest block all endpoints in sync have.
+func (b *Backend) CallContract(ctx context.Context, call ethereum.CallMsg, block *big.Int) ([]byte, error) {
+	if b.Critical != nil && !b.Critical(call) {
+		return failover(b, "eth_call", func(c Client) ([]byte, error) { return c.CallContract(ctx, call, block) })
+	}
+	live := b.live()
+	if block == nil {
+		var err error
+		if block, live, err = b.commonBlock(ctx, live); err != nil {
+			return nil, err
+		}
+	}
+	outputs, errs := each(live, func(c Client) ([]byte, error) { return c.CallContract(ctx, call, block) })
+
+	type tally struct {
+		count  int
+		output []byte
+		err    error
+	}
+	var (
+		tallies  = make(map[string]*tally)
+		order    []string
+		failures []error
+	)
+	for i, e := range live {
+		var key string
+		switch err := errs[i]; {
+		case err == nil:
+			key = "ok:" + string(outputs[i])
+		case reverted(err):
+			key = "revert:" + revertKey(err)
+		default:
+			b.fail(e, "eth_call", err)
+			failures = append(failures, fmt.Errorf("%s: %w", e.Name, err))
+			continue
+		}
+		t, ok := tallies[key]
+		if !ok {
+			t = &tally{output: outputs[i], err: errs[i]}
+			tallies[key] = t
+			order = append(order, key)
+		}
+		t.count++
+	}
+	for _, key := range order {
+		if t := tallies[key]; t.count >= b.quorum() {
+			if len(tallies) > 1 {
+				b.Logger.Warn("endpoints disagree on call", "to", call.To, "block", block, "results", len(tallies))
+			}
+			return t.output, t.err
+		}
+	}
+	err := fmt.Errorf("%w: eth_call to %s at block %s returned %d different results and %d failures, %d matching needed",
+		ErrNoQuorum, call.To, block, len(tallies), len(failures), b.quorum())
+	if len(failures) > 0 {
+		err = fmt.Errorf("%w: %w", err, errors.Join(failures...))
+	}
+	return nil, err
+}
+
+// revertKey identifies a revert by its data, or by its message when the
+// endpoint did not return the data.
+func revertKey(err error) string {
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) && dataErr.ErrorData() != nil {
+		return fmt.Sprint(dataErr.ErrorData())
+	}
+	return err.Error()
+}
+
+// logKey identifies a log by its position in the chain.
+type logKey struct {
+	block uint64
+	index uint
+}
+
+// FilterLogs runs query on every live endpoint and returns the logs that at
+// least Quorum of them returned. An endpoint missing logs that others returned
+// is reported as truncating its results; a log returned by fewer than Quorum
+// endpoints, or endpoints returning different logs at the same position, fail
+// the query. Without an upper bound, the query is capped at the highest block
+// all endpoints in sync have.
+func (b *Backend) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	live := b.live()
+	if query.BlockHash == nil && query.ToBlock == nil {
+		var (
+			block *big.Int
+			err   error
+		)
+		if block, live, err = b.commonBlock(ctx, live); err != nil {
+			return nil, err
+		}
+		query.ToBlock = block
+	}
+	results, errs := each(live, func(c Client) ([]types.Log, error) { return c.FilterLogs(ctx, query) })
+
+	type tally struct {
+		log   types.Log
+		count int
+	}
+	var (
+		merged    = make(map[logKey]*tally)
+		responded []int
+		failures  []error
+	)
+	for i, e := range live {
+		if errs[i] != nil {
+			b.fail(e, "eth_getLogs", errs[i])
+			failures = append(failures, fmt.Errorf("%s: %w", e.Name, errs[i]))
+			continue
+		}
+		responded = append(responded, i)
+		for _, log := range results[i] {
+			key := logKey{block: log.BlockNumber, index: log.Index}
+			if t, ok := merged[key]; ok {
+				if !sameLog(t.log, log) {
+					return nil, fmt.Errorf("%w: endpoints return different logs at block %d index %d", ErrNoQuorum, key.block, key.index)
+				}
+				t.count++
+				continue
+			}
+			merged[key] = &tally{log: log, count: 1}
+		}
+	}
+	if len(responded) < b.quorum() {
+		return nil, fmt.Errorf("%w: eth_getLogs answered by %d endpoints, %d needed: %w",
+			ErrNoQuorum, len(responded), b.quorum(), errors.Join(failures...))
+	}
+	for _, i := range responded {
+		if len(results[i]) < len(merged) {
+			b.Logger.Warn("endpoint returned truncated logs", "endpoint", live[i].Name,
+				"from", query.FromBlock, "to", query.ToBlock, "returned", len(results[i]), "expected", len(merged))
+		}
+	}
+	for key, t := range merged {
+		if t.count < b.quorum() {
+			return nil, fmt.Errorf("%w: log at block %d index %d returned by %d endpoints, %d needed",
+				ErrNoQuorum, key.block, key.index, t.count, b.quorum())
+		}
+	}
+
+	logs := make([]types.Log, 0, len(merged))
+	for _, t := range merged {
+		logs = append(logs, t.log)
+	}
+	sort.Slice(logs, func(a, b int) bool {
+		if logs[a].BlockNumber != logs[b].BlockNumber {
+			return logs[a].BlockNumber < logs[b].BlockNumber
+		}
+		return logs[a].Index < logs[b].Index
+	})
+	return logs, nil
+}
+
+func sameLog(a, b types.Log) bool {
+	if a.BlockHash != b.BlockHash || a.TxHash != b.TxHash || a.Address != b.Address ||
+		a.Removed != b.Removed || !bytes.Equal(a.Data, b.Data) || len(a.Topics) != len(b.Topics) {
+		return false
+	}
+	for i := range a.Topics {
+		if a.Topics[i] != b.Topics[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// SendTransaction broadcasts tx to every live endpoint and succeeds when at
+// least one of them accepts it.
+func (b *Backend) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	live := b.live()
+	_, errs := each(live, func(c Client) (struct{}, error) { return struct{}{}, c.SendTransaction(ctx, tx) })
+	var failures []error
+	for i, err := range errs {
+		if err == nil {
+			return nil
+		}
+		failures = append(failures, fmt.Errorf("%s: %w", live[i].Name, err))
+	}
+	return fmt.Errorf("quorum: no endpoint accepted transaction %s: %w", tx.Hash(), errors.Join(failures...))
+}
+
+// SubscribeFilterLogs subscribes through the first live endpoint that
+// supports subscriptions.
+func (b *Backend) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	return failover(b, "eth_subscribe", func(c Client) (ethereum.Subscription, error) {
+		return c.SubscribeFilterLogs(ctx, query, ch)
+	})
+}
+
+// CodeAt implements bind.ContractCaller with failover.
+func (b *Backend) CodeAt(ctx context.Context, account common.Address, block *big.Int) ([]byte, error) {
+	return failover(b, "eth_getCode", func(c Client) ([]byte, error) { return c.CodeAt(ctx, account, block) })
+}
+
+// Head returns the header of the highest block every endpoint in sync has,
+// the block CallContract and FilterLogs read at without one. Reading at it
+// from another client of the same endpoints can still hit an endpoint that
+// does not have it yet.
+func (b *Backend) Head(ctx context.Context) (*types.Header, error) {
+	block, kept, err := b.commonBlock(ctx, b.live())
+	if err != nil {
+		return nil, err
+	}
+	return failoverOn(b, kept, "eth_getBlockByNumber", func(c Client) (*types.Header, error) { return c.HeaderByNumber(ctx, block) })
+}
+
+// StorageAt returns a storage slot from the first live endpoint that answers.
+func (b *Backend) StorageAt(ctx context.Context, account common.Address, key common.Hash, block *big.Int) ([]byte, error) {
+	return failover(b, "eth_getStorageAt", func(c Client) ([]byte, error) { return c.StorageAt(ctx, account, key, block) })
+}
+
+// HeaderByNumber implements bind.ContractTransactor with failover.
+func (b *Backend) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return failover(b, "eth_getBlockByNumber", func(c Client) (*types.Header, error) { return c.HeaderByNumber(ctx, number) })
+}
+
+// PendingCodeAt implements bind.ContractTransactor with failover.
+func (b *Backend) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
+	return failover(b, "eth_getCode", func(c Client) ([]byte, error) { return c.PendingCodeAt(ctx, account) })
+}
+
+// PendingNonceAt implements bind.ContractTransactor with failover.
+func (b *Backend) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	return failover(b, "eth_getTransactionCount", func(c Client) (uint64, error) { return c.PendingNonceAt(ctx, account) })
+}
+
+// SuggestGasPrice implements bind.ContractTransactor with failover.
+func (b *Backend) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return failover(b, "eth_gasPrice", func(c Client) (*big.Int, error) { return c.SuggestGasPrice(ctx) })
+}
+
+// SuggestGasTipCap implements bind.ContractTransactor with failover.
+func (b *Backend) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	return failover(b, "eth_maxPriorityFeePerGas", func(c Client) (*big.Int, error) { return c.SuggestGasTipCap(ctx) })
+}
+
+// EstimateGas implements bind.ContractTransactor with failover.
+func (b *Backend) EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
+	return failover(b, "eth_estimateGas", func(c Client) (uint64, error) { return c.EstimateGas(ctx, call) })
+}
+
+// TransactionReceipt implements bind.DeployBackend with failover.
+func (b *Backend) TransactionReceipt(ctx context.Context, hash common.Hash) (*types.Receipt, error) {
+	return failover(b, "eth_getTransactionReceipt", func(c Client) (*types.Receipt, error) { return c.TransactionReceipt(ctx, hash) })
+}
+
+// BlockNumber returns the latest block number of the first live endpoint.
+func (b *Backend) BlockNumber(ctx context.Context) (uint64, error) {
+	return failover(b, "eth_blockNumber", func(c Client) (uint64, error) { return c.BlockNumber(ctx) })
+}
+
+// ChainID returns the chain ID of the first live endpoint.
+func (b *Backend) ChainID(ctx context.Context) (*big.Int, error) {
+	return failover(b, "eth_chainId", func(c Client) (*big.Int, error) { return c.ChainID(ctx) })
+}
+
+// TransactionByHash returns a transaction from the first live endpoint that
+// has it.
+func (b *Backend) TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error) {
+	type result struct {
+		tx      *types.Transaction
+		pending bool
+	}
+	r, err := failover(b, "eth_getTransactionByHash", func(c Client) (result, error) {
+		tx, pending, err := c.TransactionByHash(ctx, hash)
+		return result{tx, pending}, err
+	})
+	return r.tx, r.pending, err
+}
+
+// TransactionSender returns the sender of a mined transaction.
+func (b *Backend) TransactionSender(ctx context.Context, tx *types.Transaction, block common.Hash, index uint) (common.Address, error) {
+	return failover(b, "eth_getTransactionByBlockHashAndIndex", func(c Client) (common.Address, error) {
+		return c.TransactionSender(ctx, tx, block, index)
+	})
+}
@@ -0,0 +1,313 @@
+// Package quorum implements a bind.ContractBackend that spreads reads over
+// several RPC endpoints.
+//
+// Contract calls are executed on every available endpoint at a block they
+// all have, and a result is only returned once Quorum endpoints agree on it.
+// Log queries are sent to every endpoint and cross-checked: a log is only
+// returned once Quorum endpoints returned it, and an endpoint returning
+// truncated results is reported. Other methods fail over: they are
+// sent to the endpoints in order until one answers. Endpoints that fail or
+// rate-limit requests are skipped for Cooldown.
+//
+// A Backend can be passed wherever the bindings and the packages of this
+// module take an *ethclient.Client, for example networkcontracts.NewINetwork,
+// timelock.NewIndex or exporter.New.
+package quorum
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// ErrNoQuorum is returned when not enough endpoints agree on a result.
+var ErrNoQuorum = errors.New("quorum: endpoints do not agree")
+
+// Client is the chain access needed from each endpoint. It is satisfied by
+// *ethclient.Client.
+type Client interface {
+	bind.ContractBackend
+	bind.DeployBackend
+	BlockNumber(ctx context.Context) (uint64, error)
+	ChainID(ctx context.Context) (*big.Int, error)
+	StorageAt(ctx context.Context, account common.Address, key common.Hash, block *big.Int) ([]byte, error)
+	TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error)
+	TransactionSender(ctx context.Context, tx *types.Transaction, block common.Hash, index uint) (common.Address, error)
+}
+
+// Endpoint is a named RPC endpoint.
+type Endpoint struct {
+	// Name identifies the endpoint in errors and logs. It must not contain
+	// credentials.
+	Name   string
+	Client Client
+}
+
+type endpoint struct {
+	Endpoint
+
+	mu    sync.Mutex
+	until time.Time
+}
+
+// Backend spreads requests over several endpoints.
+type Backend struct {
+	endpoints []*endpoint
+	closers   []func()
+
+	// Quorum is the number of endpoints that must return the same call
+	// result or log set.
+	Quorum int
+	// MaxLag is how many blocks an endpoint may trail the highest head before
+	// it is left out of quorum reads.
+	MaxLag uint64
+	// Cooldown is how long an endpoint is skipped after failing.
+	Cooldown time.Duration
+	// Critical selects the calls that need a quorum. Other calls fail over.
+	// When nil, every call needs a quorum.
+	Critical func(call ethereum.CallMsg) bool
+	// Logger receives endpoint failures and disagreements.
+	Logger *slog.Logger
+}
+
+// New creates a Backend over endpoints, requiring a majority of them to agree.
+func New(endpoints ...Endpoint) (*Backend, error) {
+	if len(endpoints) == 0 {
+		return nil, errors.New("quorum: no endpoints")
+	}
+	b := &Backend{
+		Quorum:   len(endpoints)/2 + 1,
+		MaxLag:   8,
+		Cooldown: 30 * time.Second,
+		Logger:   slog.Default(),
+	}
+	for _, e := range endpoints {
+		b.endpoints = append(b.endpoints, &endpoint{Endpoint: e})
+	}
+	return b, nil
+}
+
+// Dial connects to every URL and creates a Backend over them, after checking
+// that they serve the same chain. Endpoints are named by host, so credentials
+// in paths or query strings are not logged.
+func Dial(ctx context.Context, urls []string) (*Backend, error) {
+	var endpoints []Endpoint
+	var closers []func()
+	for _, url := range urls {
+		client, err := ethclient.DialContext(ctx, url)
+		if err != nil {
+			for _, closeClient := range closers {
+				closeClient()
+			}
+			return nil, fmt.Errorf("quorum: dial %s: %w", host(url), err)
+		}
+		endpoints = append(endpoints, Endpoint{Name: host(url), Client: client})
+		closers = append(closers, client.Close)
+	}
+	b, err := New(endpoints...)
+	if err != nil {
+		return nil, err
+	}
+	b.closers = closers
+	if err := b.checkChainID(ctx); err != nil {
+		b.Close()
+		return nil, err
+	}
+	return b, nil
+}
+
+// checkChainID verifies that every endpoint serves the same chain.
+func (b *Backend) checkChainID(ctx context.Context) error {
+	ids, errs := each(b.endpoints, func(c Client) (*big.Int, error) { return c.ChainID(ctx) })
+	for i, e := range b.endpoints {
+		if errs[i] != nil {
+			return fmt.Errorf("quorum: get chain ID from %s: %w", e.Name, errs[i])
+		}
+		if ids[i].Cmp(ids[0]) != 0 {
+			return fmt.Errorf("quorum: %s serves chain %s, %s serves chain %s", e.Name, ids[i], b.endpoints[0].Name, ids[0])
+		}
+	}
+	return nil
+}
+
+// Close closes the clients created by Dial.
+func (b *Backend) Close() {
+	for _, closeClient := range b.closers {
+		closeClient()
+	}
+}
+
+func host(url string) string {
+	_, rest, found := strings.Cut(url, "://")
+	if !found {
+		return url
+	}
+	if i := strings.IndexAny(rest, "/?"); i >= 0 {
+		rest = rest[:i]
+	}
+	if _, after, found := strings.Cut(rest, "@"); found {
+		rest = after
+	}
+	return rest
+}
+
+// live returns the endpoints that are not cooling down, or every endpoint
+// when all of them are.
+func (b *Backend) live() []*endpoint {
+	now := time.Now()
+	var live []*endpoint
+	for _, e := range b.endpoints {
+		e.mu.Lock()
+		if now.After(e.until) {
+			live = append(live, e)
+		}
+		e.mu.Unlock()
+	}
+	if len(live) == 0 {
+		return b.endpoints
+	}
+	return live
+}
+
+// fail records a failed request and puts the endpoint on cooldown when the
+// failure is the endpoint's fault rather than the request's.
+func (b *Backend) fail(e *endpoint, method string, err error) {
+	if !endpointFailure(err) {
+		return
+	}
+	e.mu.Lock()
+	e.until = time.Now().Add(b.Cooldown)
+	e.mu.Unlock()
+	b.Logger.Warn("endpoint failed", "endpoint", e.Name, "method", method, "rateLimited", rateLimited(err), "err", err, "cooldown", b.Cooldown)
+}
+
+// endpointFailure reports whether err is a transport failure, server error or
+// rate limit, as opposed to a revert, a missing object or a cancellation.
+func endpointFailure(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, ethereum.NotFound) || reverted(err) {
+		return false
+	}
+	return true
+}
+
+// rateLimited reports whether err is an endpoint rejecting a request because
+// of its rate limit.
+func rateLimited(err error) bool {
+	var httpErr rpc.HTTPError
+	if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) && rpcErr.ErrorCode() == -32005 {
+		return true
+	}
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "rate limit") || strings.Contains(msg, "too many requests")
+}
+
+func reverted(err error) bool {
+	var dataErr rpc.DataError
+	if errors.As(err, &dataErr) && dataErr.ErrorData() != nil {
+		return true
+	}
+	return strings.Contains(err.Error(), "execution reverted")
+}
+
+// failover calls f on the live endpoints in order until one succeeds.
+func failover[T any](b *Backend, method string, f func(Client) (T, error)) (T, error) {
+	return failoverOn(b, b.live(), method, f)
+}
+
+// failoverOn calls f on endpoints in order until one succeeds.
+func failoverOn[T any](b *Backend, endpoints []*endpoint, method string, f func(Client) (T, error)) (T, error) {
+	var (
+		zero T
+		errs []error
+	)
+	for _, e := range endpoints {
+		value, err := f(e.Client)
+		if err == nil {
+			return value, nil
+		}
+		if !endpointFailure(err) && !errors.Is(err, ethereum.NotFound) {
+			// Reverts and cancellations are the same on every endpoint.
+			return zero, err
+		}
+		b.fail(e, method, err)
+		errs = append(errs, fmt.Errorf("%s: %w", e.Name, err))
+	}
+	if len(errs) == 1 {
+		return zero, errs[0]
+	}
+	return zero, fmt.Errorf("quorum: %s failed on every endpoint: %w", method, errors.Join(errs...))
+}
+
+// each calls f concurrently on every endpoint in endpoints.
+func each[T any](endpoints []*endpoint, f func(Client) (T, error)) ([]T, []error) {
+	values := make([]T, len(endpoints))
+	errs := make([]error, len(endpoints))
+	var wg sync.WaitGroup
+	for i, e := range endpoints {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			values[i], errs[i] = f(e.Client)
+		}()
+	}
+	wg.Wait()
+	return values, errs
+}
+
+// commonBlock returns the highest block every endpoint in live has, leaving
+// out endpoints more than MaxLag blocks behind, together with the endpoints
+// that were kept.
+func (b *Backend) commonBlock(ctx context.Context, live []*endpoint) (*big.Int, []*endpoint, error) {
+	heads, errs := each(live, func(c Client) (uint64, error) { return c.BlockNumber(ctx) })
+	var highest uint64
+	for i, err := range errs {
+		if err == nil && heads[i] > highest {
+			highest = heads[i]
+		}
+	}
+	var (
+		kept  []*endpoint
+		block uint64
+	)
+	for i, e := range live {
+		switch {
+		case errs[i] != nil:
+			b.fail(e, "eth_blockNumber", errs[i])
+		case heads[i]+b.MaxLag < highest:
+			b.Logger.Warn("endpoint lags", "endpoint", e.Name, "head", heads[i], "highest", highest)
+		default:
+			if len(kept) == 0 || heads[i] < block {
+				block = heads[i]
+			}
+			kept = append(kept, e)
+		}
+	}
+	if len(kept) < b.quorum() {
+		return nil, nil, fmt.Errorf("%w: only %d endpoints are in sync, %d needed", ErrNoQuorum, len(kept), b.quorum())
+	}
+	return new(big.Int).SetUint64(block), kept, nil
+}
+
+func (b *Backend) quorum() int {
+	if b.Quorum <= 0 {
+		return 1
+	}
+	return min(b.Quorum, len(b.endpoints))
+}
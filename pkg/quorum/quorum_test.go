@@ -0,0 +1,320 @@
+package quorum
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"math/big"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// fakeClient is an endpoint at a fixed head. Methods other than the ones
+// below panic.
+type fakeClient struct {
+	Client
+
+	head    uint64
+	headErr error
+	output  []byte
+	callErr error
+	logs    []types.Log
+	blocks  []*big.Int
+}
+
+func (f *fakeClient) BlockNumber(context.Context) (uint64, error) {
+	return f.head, f.headErr
+}
+
+func (f *fakeClient) CallContract(_ context.Context, _ ethereum.CallMsg, block *big.Int) ([]byte, error) {
+	f.blocks = append(f.blocks, block)
+	return f.output, f.callErr
+}
+
+func (f *fakeClient) FilterLogs(_ context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	f.blocks = append(f.blocks, query.ToBlock)
+	return f.logs, f.callErr
+}
+
+func (f *fakeClient) HeaderByNumber(_ context.Context, number *big.Int) (*types.Header, error) {
+	if number.Uint64() > f.head {
+		return nil, ethereum.NotFound
+	}
+	return &types.Header{Number: number}, nil
+}
+
+// revertError is a revert with data, as returned by go-ethereum's RPC client.
+type revertError struct{ data string }
+
+func (e revertError) Error() string  { return "execution reverted" }
+func (e revertError) ErrorData() any { return e.data }
+
+func newBackend(t *testing.T, clients ...*fakeClient) *Backend {
+	t.Helper()
+	var endpoints []Endpoint
+	for i, c := range clients {
+		endpoints = append(endpoints, Endpoint{Name: string(rune('a' + i)), Client: c})
+	}
+	b, err := New(endpoints...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.Logger = slog.New(slog.NewTextHandler(io.Discard, nil))
+	return b
+}
+
+func TestCommonBlock(t *testing.T) {
+	down := errors.New("connection refused")
+	tests := []struct {
+		name    string
+		clients []*fakeClient
+		maxLag  uint64
+		block   uint64
+		kept    int
+		wantErr error
+	}{
+		{
+			name:    "lowest head in sync",
+			clients: []*fakeClient{{head: 100}, {head: 98}, {head: 101}},
+			maxLag:  8,
+			block:   98,
+			kept:    3,
+		},
+		{
+			name:    "lagging endpoint left out",
+			clients: []*fakeClient{{head: 100}, {head: 80}, {head: 101}},
+			maxLag:  8,
+			block:   100,
+			kept:    2,
+		},
+		{
+			name:    "failed endpoint left out",
+			clients: []*fakeClient{{head: 100}, {headErr: down}, {head: 99}},
+			maxLag:  8,
+			block:   99,
+			kept:    2,
+		},
+		{
+			name:    "lag at the bound kept",
+			clients: []*fakeClient{{head: 100}, {head: 92}},
+			maxLag:  8,
+			block:   92,
+			kept:    2,
+		},
+		{
+			name:    "too few in sync",
+			clients: []*fakeClient{{head: 100}, {head: 50}, {headErr: down}},
+			maxLag:  8,
+			wantErr: ErrNoQuorum,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newBackend(t, tt.clients...)
+			b.MaxLag = tt.maxLag
+			block, kept, err := b.commonBlock(context.Background(), b.endpoints)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("commonBlock() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if block.Uint64() != tt.block || len(kept) != tt.kept {
+				t.Errorf("commonBlock() = %d with %d endpoints, want %d with %d", block, len(kept), tt.block, tt.kept)
+			}
+		})
+	}
+}
+
+func TestCallContractTally(t *testing.T) {
+	down := errors.New("503 service unavailable")
+	revert := revertError{data: "0x08c379a0"}
+	tests := []struct {
+		name       string
+		clients    []*fakeClient
+		quorum     int
+		want       string
+		wantRevert bool
+		wantErr    error
+	}{
+		{
+			name:    "unanimous",
+			clients: []*fakeClient{{output: []byte("a")}, {output: []byte("a")}, {output: []byte("a")}},
+			want:    "a",
+		},
+		{
+			name:    "majority",
+			clients: []*fakeClient{{output: []byte("b")}, {output: []byte("a")}, {output: []byte("a")}},
+			want:    "a",
+		},
+		{
+			name:       "majority revert",
+			clients:    []*fakeClient{{callErr: revert}, {output: []byte("a")}, {callErr: revert}},
+			wantRevert: true,
+		},
+		{
+			name:    "failure does not count",
+			clients: []*fakeClient{{output: []byte("a")}, {callErr: down}, {output: []byte("a")}},
+			want:    "a",
+		},
+		{
+			name:    "split",
+			clients: []*fakeClient{{output: []byte("a")}, {output: []byte("b")}, {callErr: down}},
+			wantErr: ErrNoQuorum,
+		},
+		{
+			name:    "quorum of one",
+			clients: []*fakeClient{{output: []byte("a")}, {output: []byte("b")}},
+			quorum:  1,
+			want:    "a",
+		},
+		{
+			name:    "quorum above endpoints",
+			clients: []*fakeClient{{output: []byte("a")}, {output: []byte("a")}},
+			quorum:  5,
+			want:    "a",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, c := range tt.clients {
+				c.head = 100
+			}
+			b := newBackend(t, tt.clients...)
+			if tt.quorum != 0 {
+				b.Quorum = tt.quorum
+			}
+			out, err := b.CallContract(context.Background(), ethereum.CallMsg{}, big.NewInt(100))
+			switch {
+			case tt.wantErr != nil:
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("CallContract() error = %v, want %v", err, tt.wantErr)
+				}
+			case tt.wantRevert:
+				if err == nil || !reverted(err) {
+					t.Fatalf("CallContract() error = %v, want revert", err)
+				}
+			case err != nil:
+				t.Fatalf("CallContract() error = %v", err)
+			case string(out) != tt.want:
+				t.Errorf("CallContract() = %q, want %q", out, tt.want)
+			}
+		})
+	}
+}
+
+func TestCallContractCommonBlock(t *testing.T) {
+	clients := []*fakeClient{{head: 100}, {head: 97}, {head: 60}}
+	b := newBackend(t, clients...)
+	if _, err := b.CallContract(context.Background(), ethereum.CallMsg{}, nil); err != nil {
+		t.Fatal(err)
+	}
+	for i, want := range []int64{97, 97} {
+		if len(clients[i].blocks) != 1 || clients[i].blocks[0].Int64() != want {
+			t.Errorf("endpoint %d called at %v, want %d", i, clients[i].blocks, want)
+		}
+	}
+	if len(clients[2].blocks) != 0 {
+		t.Errorf("lagging endpoint called at %v", clients[2].blocks)
+	}
+}
+
+func newLog(block uint64, index uint, data string) types.Log {
+	return types.Log{
+		BlockNumber: block,
+		Index:       index,
+		BlockHash:   common.BigToHash(new(big.Int).SetUint64(block)),
+		Data:        []byte(data),
+	}
+}
+
+func TestFilterLogs(t *testing.T) {
+	a, b, c := newLog(10, 0, "a"), newLog(10, 1, "b"), newLog(12, 0, "c")
+	down := errors.New("connection reset")
+	tests := []struct {
+		name    string
+		logs    [][]types.Log
+		errs    []error
+		want    []types.Log
+		wantErr error
+	}{
+		{
+			name: "agree",
+			logs: [][]types.Log{{a, b, c}, {a, b, c}, {a, b, c}},
+			want: []types.Log{a, b, c},
+		},
+		{
+			name: "truncated endpoint outvoted",
+			logs: [][]types.Log{{c, a, b}, {a}, {a, b, c}},
+			want: []types.Log{a, b, c},
+		},
+		{
+			name:    "log from a single endpoint",
+			logs:    [][]types.Log{{a, b}, {a}, {a}},
+			wantErr: ErrNoQuorum,
+		},
+		{
+			name:    "different logs at a position",
+			logs:    [][]types.Log{{a}, {newLog(10, 0, "x")}, {a}},
+			wantErr: ErrNoQuorum,
+		},
+		{
+			name: "failed endpoint",
+			logs: [][]types.Log{{a}, nil, {a}},
+			errs: []error{nil, down, nil},
+			want: []types.Log{a},
+		},
+		{
+			name:    "too few answers",
+			logs:    [][]types.Log{{a}, nil, nil},
+			errs:    []error{nil, down, down},
+			wantErr: ErrNoQuorum,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var clients []*fakeClient
+			for i, logs := range tt.logs {
+				client := &fakeClient{head: 100, logs: logs}
+				if tt.errs != nil {
+					client.callErr = tt.errs[i]
+				}
+				clients = append(clients, client)
+			}
+			backend := newBackend(t, clients...)
+			logs, err := backend.FilterLogs(context.Background(), ethereum.FilterQuery{})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("FilterLogs() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if len(logs) != len(tt.want) {
+				t.Fatalf("FilterLogs() returned %d logs, want %d", len(logs), len(tt.want))
+			}
+			for i := range logs {
+				if !sameLog(logs[i], tt.want[i]) || logs[i].Index != tt.want[i].Index {
+					t.Errorf("FilterLogs()[%d] = %+v, want %+v", i, logs[i], tt.want[i])
+				}
+			}
+			if got := clients[0].blocks[0]; got.Uint64() != 100 {
+				t.Errorf("query capped at %s, want 100", got)
+			}
+		})
+	}
+}
+
+func TestHead(t *testing.T) {
+	b := newBackend(t, &fakeClient{head: 105}, &fakeClient{head: 103}, &fakeClient{head: 104})
+	header, err := b.Head(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if header.Number.Uint64() != 103 {
+		t.Errorf("Head() = %s, want 103", header.Number)
+	}
+}
@@ -0,0 +1,210 @@
+package resolver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"text/tabwriter"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	networkcontracts "github.com/symbioticfi/network/bindings/go-go-ethereum"
+	"github.com/symbioticfi/network/pkg/core"
+	"github.com/symbioticfi/network/pkg/multicall"
+	"github.com/symbioticfi/network/pkg/timelock"
+)
+
+// Target is the wanted resolver of a subnetwork in a vault.
+type Target struct {
+	Vault        common.Address
+	SubnetworkID *big.Int
+	Resolver     common.Address
+}
+
+// Request is a set of wanted resolvers.
+type Request struct {
+	Targets []Target
+
+	// Predecessor, Salt and Delay are used for the operation. Delay overrides
+	// the required delay read from the Network and must not be shorter.
+	Predecessor common.Hash
+	Salt        common.Hash
+	Delay       *big.Int
+}
+
+// Plan is the timelock operation setting the resolvers that differ.
+type Plan struct {
+	Report
+
+	// Set when there is something to change.
+	*timelock.Prepared
+}
+
+// Changes returns the entries that need a setResolver call.
+func (p *Plan) Changes() []Entry {
+	var changes []Entry
+	for _, e := range p.Entries {
+		if e.Change {
+			changes = append(changes, e)
+		}
+	}
+	return changes
+}
+
+// PlanChanges reads the targeted subnetworks and builds the operation calling
+// setResolver on the slashers whose latest resolver is not the target, like
+// SetResolverBase. Every change is checked against the open slash requests of
+// its subnetwork, assuming the operation is executed as soon as its delay
+// allows.
+func PlanChanges(ctx context.Context, caller *multicall.Caller, backend Backend, network common.Address, req Request) (*Plan, error) {
+	if len(req.Targets) == 0 {
+		return nil, errors.New("resolver: no targets")
+	}
+	keys := make([]Key, len(req.Targets))
+	for i, t := range req.Targets {
+		keys[i] = Key{Vault: t.Vault, SubnetworkID: t.SubnetworkID}
+	}
+	report, err := Read(ctx, caller, backend, network, keys)
+	if err != nil {
+		return nil, err
+	}
+	plan := &Plan{Report: *report}
+
+	var calls []timelock.Call
+	for i, t := range req.Targets {
+		e := &plan.Entries[i]
+		if !e.IsVeto() {
+			return nil, fmt.Errorf("resolver: vault %s has no veto slasher", e.Vault)
+		}
+		target := t.Resolver
+		e.Target = &target
+		if e.Change = target != e.latest(); !e.Change {
+			continue
+		}
+		data, err := core.VetoSlasherABI.Pack("setResolver", e.SubnetworkID, target, []byte{})
+		if err != nil {
+			return nil, err
+		}
+		calls = append(calls, timelock.Call{Target: e.Slasher, Data: data})
+	}
+	if len(calls) == 0 {
+		return plan, nil
+	}
+
+	networkCaller, err := networkcontracts.NewINetworkCaller(network, backend)
+	if err != nil {
+		return nil, err
+	}
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(plan.BlockNumber)}
+	prepared, err := timelock.Prepare(opts, networkCaller, timelock.Operation{
+		Calls:       calls,
+		Predecessor: req.Predecessor,
+		Salt:        req.Salt,
+		Delay:       req.Delay,
+		Batch:       len(calls) > 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+	delay := prepared.Operation.Delay
+	if !delay.IsUint64() {
+		return nil, fmt.Errorf("resolver: delay %s is too long", delay)
+	}
+
+	executeAt := plan.Timestamp + delay.Uint64()
+	for i := range plan.Entries {
+		e := &plan.Entries[i]
+		if !e.Change {
+			continue
+		}
+		if e.Pending != nil {
+			e.Warnings = append(e.Warnings, fmt.Sprintf("replaces pending resolver %s, due at %s", e.Pending, formatTime(e.PendingAt)))
+		}
+		if *e.Target == e.Resolver {
+			// Setting the current resolver only drops the pending one.
+			e.ActivatesAt = executeAt
+			continue
+		}
+		e.ActivatesAt = e.activation(executeAt)
+		e.warnWindows(fmt.Sprintf("resolver %s", e.Target), e.ActivatesAt)
+	}
+
+	plan.Prepared = prepared
+	return plan, nil
+}
+
+// WriteText renders one row per subnetwork followed by the warnings.
+func (r *Report) WriteText(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(tw, "Resolvers of Network %s at block %d (%s)\n\n", r.Network, r.BlockNumber, formatTime(r.Timestamp))
+	fmt.Fprintln(tw, "VAULT\tSLASHER\tTYPE\tSUBNETWORK\tVETO DURATION\tRESOLVER\tPENDING\tOPEN REQUESTS")
+	for _, e := range r.Entries {
+		kind, vetoDuration, resolver, pending, open := "none", "-", "-", "-", "-"
+		if e.SlasherType != nil {
+			kind = e.SlasherType.String()
+		}
+		if e.IsVeto() {
+			vetoDuration = fmt.Sprintf("%ds", e.VetoDuration)
+			resolver = e.Resolver.Hex()
+			open = fmt.Sprint(len(e.OpenRequests))
+			if e.Pending != nil {
+				pending = fmt.Sprintf("%s at %s", e.Pending, formatTime(e.PendingAt))
+			}
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", e.Vault, e.Slasher, kind, e.SubnetworkID, vetoDuration, resolver, pending, open)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	return writeWarnings(w, r.Entries)
+}
+
+func writeWarnings(w io.Writer, entries []Entry) error {
+	first := true
+	for _, e := range entries {
+		for _, warning := range e.Warnings {
+			if first {
+				fmt.Fprintln(w)
+				first = false
+			}
+			if _, err := fmt.Fprintf(w, "! vault %s subnetwork %s: %s\n", e.Vault, e.SubnetworkID, warning); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// WriteText renders one row per target, marking the ones that change with
+// "*", followed by the warnings and the operation.
+func (p *Plan) WriteText(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(tw, "Resolvers of Network %s at block %d (%s)\n\n", p.Network, p.BlockNumber, formatTime(p.Timestamp))
+	fmt.Fprintln(tw, "\tVAULT\tSLASHER\tSUBNETWORK\tRESOLVER\tPENDING\tTARGET\tTAKES EFFECT")
+	for _, e := range p.Entries {
+		mark, pending, activates := "", "-", "-"
+		if e.Change {
+			mark, activates = "*", formatTime(e.ActivatesAt)
+		}
+		if e.Pending != nil {
+			pending = fmt.Sprintf("%s at %s", e.Pending, formatTime(e.PendingAt))
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", mark, e.Vault, e.Slasher, e.SubnetworkID, e.Resolver, pending, e.Target, activates)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	if err := writeWarnings(w, p.Entries); err != nil {
+		return err
+	}
+
+	if p.Prepared == nil {
+		_, err := fmt.Fprintln(w, "\nEvery target is already set.")
+		return err
+	}
+	fmt.Fprintln(w)
+	return p.Prepared.WriteText(w)
+}
@@ -0,0 +1,112 @@
+package resolver
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/symbioticfi/network/pkg/core"
+	"github.com/symbioticfi/network/pkg/multicall"
+)
+
+func TestPlanChanges(t *testing.T) {
+	// The operation can execute at now + 3600 = 13600, in the epoch starting
+	// at 13000, so replacing a resolver takes effect at 15000.
+	tests := []struct {
+		name     string
+		targets  []Target
+		changes  int
+		activate []uint64
+		// warnings are substrings of the expected warnings, per target.
+		warnings [][]string
+	}{
+		{
+			name:     "already set",
+			targets:  []Target{{Vault: vetoVault, SubnetworkID: big.NewInt(1), Resolver: current}},
+			warnings: [][]string{nil},
+		},
+		{
+			name:     "pending resolver is the target",
+			targets:  []Target{{Vault: vetoVault, SubnetworkID: big.NewInt(0), Resolver: next}},
+			warnings: [][]string{{"slash request 4"}},
+		},
+		{
+			name:     "during a veto window",
+			targets:  []Target{{Vault: vetoVault, SubnetworkID: big.NewInt(1), Resolver: other}},
+			changes:  1,
+			activate: []uint64{15_000},
+			warnings: [][]string{{"resolver " + other.Hex() + " takes effect at 1970-01-01T04:10:00Z, during the veto window of slash request 5"}},
+		},
+		{
+			name: "dropping the pending resolver",
+			targets: []Target{
+				{Vault: vetoVault, SubnetworkID: big.NewInt(0), Resolver: current},
+				{Vault: vetoVault, SubnetworkID: big.NewInt(1), Resolver: other},
+			},
+			changes:  2,
+			activate: []uint64{13_600, 15_000},
+			warnings: [][]string{{"slash request 4", "replaces pending resolver"}, {"slash request 5"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chain := newFakeChain(t)
+			plan, err := PlanChanges(context.Background(), multicall.NewCaller(chain, nil), chain, network, Request{Targets: tt.targets})
+			if err != nil {
+				t.Fatal(err)
+			}
+			changes := plan.Changes()
+			if len(changes) != tt.changes {
+				t.Fatalf("%d changes, want %d", len(changes), tt.changes)
+			}
+			for i, e := range plan.Entries {
+				if len(e.Warnings) != len(tt.warnings[i]) {
+					t.Errorf("entry %d warnings %q, want %q", i, e.Warnings, tt.warnings[i])
+					continue
+				}
+				for j, want := range tt.warnings[i] {
+					if !strings.Contains(e.Warnings[j], want) {
+						t.Errorf("entry %d warning %q, want %q", i, e.Warnings[j], want)
+					}
+				}
+			}
+			if tt.changes == 0 {
+				if plan.Prepared != nil {
+					t.Error("plan without changes has an operation")
+				}
+				return
+			}
+			calls := plan.Operation.Calls
+			if len(calls) != tt.changes || plan.Operation.IsBatch() != (tt.changes > 1) {
+				t.Fatalf("operation with %d calls, batch %v", len(calls), plan.Operation.IsBatch())
+			}
+			for i, e := range changes {
+				if e.ActivatesAt != tt.activate[i] {
+					t.Errorf("change %d activates at %d, want %d", i, e.ActivatesAt, tt.activate[i])
+				}
+				want, err := core.VetoSlasherABI.Pack("setResolver", e.SubnetworkID, *e.Target, []byte{})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if calls[i].Target != vetoSlasher || !bytes.Equal(calls[i].Data, want) {
+					t.Errorf("call %d = %s %x, want setResolver on the slasher", i, calls[i].Target, calls[i].Data)
+				}
+			}
+		})
+	}
+}
+
+func TestPlanChangesErrors(t *testing.T) {
+	for name, req := range map[string]Request{
+		"no targets":      {},
+		"no veto slasher": {Targets: []Target{{Vault: instantVault, SubnetworkID: big.NewInt(0), Resolver: other}}},
+		"delay too short": {Targets: []Target{{Vault: vetoVault, SubnetworkID: big.NewInt(1), Resolver: other}}, Delay: big.NewInt(60)},
+	} {
+		chain := newFakeChain(t)
+		if _, err := PlanChanges(context.Background(), multicall.NewCaller(chain, nil), chain, network, req); err == nil {
+			t.Errorf("%s: PlanChanges() succeeded", name)
+		}
+	}
+}
@@ -0,0 +1,375 @@
+// Package resolver reads and changes the veto slasher resolvers of a
+// Network's subnetworks.
+//
+// A VetoSlasher keeps the resolver of every subnetwork in checkpoints. The
+// first resolver of a subnetwork takes effect immediately; later ones take
+// effect resolverSetEpochsDelay vault epochs after the start of the epoch they
+// are set in and are pending until then. A resolver vetoes the slash requests
+// captured while it is in effect, until their veto deadline.
+package resolver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	networkcontracts "github.com/symbioticfi/network/bindings/go-go-ethereum"
+	"github.com/symbioticfi/network/pkg/core"
+	"github.com/symbioticfi/network/pkg/multicall"
+	"github.com/symbioticfi/network/pkg/subnetwork"
+)
+
+// maxTimestamp is type(uint48).max, which resolverAt resolves to the latest
+// checkpoint.
+var maxTimestamp = new(big.Int).SetUint64(1<<48 - 1)
+
+// Backend is the chain access needed besides the batched reads.
+type Backend interface {
+	bind.ContractCaller
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// Key is a subnetwork of the Network in a vault.
+type Key struct {
+	Vault common.Address
+	// SubnetworkID is the uint96 identifier of the subnetwork.
+	SubnetworkID *big.Int
+}
+
+// SlashRequest is a slash request whose veto deadline has not passed.
+type SlashRequest struct {
+	Index            uint64         `json:"index"`
+	Operator         common.Address `json:"operator"`
+	Amount           *big.Int       `json:"amount"`
+	CaptureTimestamp uint64         `json:"captureTimestamp"`
+	VetoDeadline     uint64         `json:"vetoDeadline"`
+}
+
+// Entry is the slasher and resolvers of one subnetwork in a vault.
+type Entry struct {
+	Vault        common.Address        `json:"vault"`
+	Slasher      common.Address        `json:"slasher"`
+	SlasherType  *core.SlasherType     `json:"slasherType,omitempty"`
+	SubnetworkID *big.Int              `json:"subnetworkId"`
+	Subnetwork   subnetwork.Subnetwork `json:"subnetwork"`
+
+	// Set for veto slashers.
+	VetoDuration           uint64          `json:"vetoDuration,omitempty"`
+	ResolverSetEpochsDelay *big.Int        `json:"resolverSetEpochsDelay,omitempty"`
+	Resolver               common.Address  `json:"resolver"`
+	Pending                *common.Address `json:"pending,omitempty"`
+	PendingAt              uint64          `json:"pendingAt,omitempty"`
+	OpenRequests           []SlashRequest  `json:"openRequests,omitempty"`
+
+	// Set by PlanChanges.
+	Target      *common.Address `json:"target,omitempty"`
+	Change      bool            `json:"change"`
+	ActivatesAt uint64          `json:"activatesAt,omitempty"`
+
+	Warnings []string `json:"warnings,omitempty"`
+
+	epochDurationInit, epochDuration uint64
+}
+
+// IsVeto reports whether the vault has a veto slasher.
+func (e *Entry) IsVeto() bool {
+	return e.SlasherType != nil && *e.SlasherType == core.VetoSlasher
+}
+
+// latest is the resolver once the pending one, if any, takes effect.
+func (e *Entry) latest() common.Address {
+	if e.Pending != nil {
+		return *e.Pending
+	}
+	return e.Resolver
+}
+
+// activation returns when a setResolver call made at timestamp takes effect.
+func (e *Entry) activation(timestamp uint64) uint64 {
+	// A subnetwork without a checkpoint gets its first resolver immediately.
+	// A resolver explicitly set to zero is indistinguishable from none.
+	if e.Pending == nil && e.Resolver == (common.Address{}) || e.epochDuration == 0 {
+		return timestamp
+	}
+	start := timestamp
+	if timestamp > e.epochDurationInit {
+		start = e.epochDurationInit + (timestamp-e.epochDurationInit)/e.epochDuration*e.epochDuration
+	}
+	return start + e.ResolverSetEpochsDelay.Uint64()*e.epochDuration
+}
+
+// warnWindows adds a warning for every open slash request whose veto window
+// is still running at activatesAt.
+func (e *Entry) warnWindows(what string, activatesAt uint64) {
+	for _, r := range e.OpenRequests {
+		if r.VetoDeadline > activatesAt {
+			e.Warnings = append(e.Warnings, fmt.Sprintf(
+				"%s takes effect at %s, during the veto window of slash request %d (deadline %s); that request stays with the resolver of its capture timestamp",
+				what, formatTime(activatesAt), r.Index, formatTime(r.VetoDeadline)))
+		}
+	}
+}
+
+// Report is the slasher and resolvers of a set of subnetworks.
+type Report struct {
+	Network     common.Address `json:"network"`
+	BlockNumber uint64         `json:"blockNumber"`
+	Timestamp   uint64         `json:"timestamp"`
+	Entries     []Entry        `json:"entries"`
+}
+
+// Read reads the slasher of every vault and, for veto slashers, the current
+// and pending resolver and the open slash requests of every subnetwork. The
+// reads are pinned to one block.
+func Read(ctx context.Context, caller *multicall.Caller, backend Backend, network common.Address, keys []Key) (*Report, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("resolver: no subnetworks")
+	}
+	type seenKey struct {
+		vault      common.Address
+		identifier string
+	}
+	seen := make(map[seenKey]bool)
+	for _, k := range keys {
+		if err := subnetwork.ValidateIdentifier(k.SubnetworkID); err != nil {
+			return nil, fmt.Errorf("resolver: vault %s: %w", k.Vault, err)
+		}
+		sk := seenKey{k.Vault, k.SubnetworkID.String()}
+		if seen[sk] {
+			return nil, fmt.Errorf("resolver: vault %s subnetwork %s is listed twice", k.Vault, k.SubnetworkID)
+		}
+		seen[sk] = true
+	}
+
+	// The slashers are needed to address the second batch, which is pinned
+	// to the block of the first.
+	type vaultReads struct {
+		slasher             *multicall.Result[common.Address]
+		epochInit, duration *multicall.Result[*big.Int]
+	}
+	var vaults multicall.Batch
+	vaultResults := make(map[common.Address]*vaultReads)
+	for _, k := range keys {
+		if vaultResults[k.Vault] == nil {
+			vaultResults[k.Vault] = &vaultReads{
+				slasher:   multicall.Add[common.Address](&vaults, k.Vault, core.VaultABI, "slasher"),
+				epochInit: multicall.Add[*big.Int](&vaults, k.Vault, core.VaultABI, "epochDurationInit"),
+				duration:  multicall.Add[*big.Int](&vaults, k.Vault, core.VaultABI, "epochDuration"),
+			}
+		}
+	}
+	number, err := caller.Do(ctx, nil, &vaults)
+	if err != nil {
+		return nil, err
+	}
+	block := new(big.Int).SetUint64(number)
+	header, err := backend.HeaderByNumber(ctx, block)
+	if err != nil {
+		return nil, fmt.Errorf("resolver: get block %d: %w", number, err)
+	}
+	report := &Report{Network: network, BlockNumber: number, Timestamp: header.Time}
+	now := new(big.Int).SetUint64(header.Time)
+
+	type slasherReads struct {
+		kind           *multicall.Result[uint64]
+		vetoDuration   *multicall.Result[*big.Int]
+		epochsDelay    *multicall.Result[*big.Int]
+		requestsLength *multicall.Result[*big.Int]
+	}
+	type entryReads struct {
+		current, latest *multicall.Result[common.Address]
+	}
+	var state multicall.Batch
+	slashers := make(map[common.Address]*slasherReads)
+	pending := make([]entryReads, len(keys))
+	for i, k := range keys {
+		v := vaultResults[k.Vault]
+		slasher, err := v.slasher.Get()
+		if err != nil {
+			return nil, fmt.Errorf("resolver: slasher of vault %s: %w", k.Vault, err)
+		}
+		s, err := subnetwork.FromNetwork(network, k.SubnetworkID)
+		if err != nil {
+			return nil, err
+		}
+		e := Entry{Vault: k.Vault, Slasher: slasher, SubnetworkID: new(big.Int).Set(k.SubnetworkID), Subnetwork: s}
+		if init, err := v.epochInit.Get(); err == nil {
+			e.epochDurationInit = init.Uint64()
+		}
+		if duration, err := v.duration.Get(); err == nil {
+			e.epochDuration = duration.Uint64()
+		}
+		report.Entries = append(report.Entries, e)
+		if slasher == (common.Address{}) {
+			continue
+		}
+		if slashers[slasher] == nil {
+			slashers[slasher] = &slasherReads{
+				kind:           multicall.Add[uint64](&state, slasher, core.VetoSlasherABI, "TYPE"),
+				vetoDuration:   multicall.Add[*big.Int](&state, slasher, core.VetoSlasherABI, "vetoDuration"),
+				epochsDelay:    multicall.Add[*big.Int](&state, slasher, core.VetoSlasherABI, "resolverSetEpochsDelay"),
+				requestsLength: multicall.Add[*big.Int](&state, slasher, core.VetoSlasherABI, "slashRequestsLength"),
+			}
+		}
+		pending[i] = entryReads{
+			current: multicall.Add[common.Address](&state, slasher, core.VetoSlasherABI, "resolverAt", s.Hash(), now, []byte{}),
+			latest:  multicall.Add[common.Address](&state, slasher, core.VetoSlasherABI, "resolverAt", s.Hash(), maxTimestamp, []byte{}),
+		}
+	}
+	if state.Len() > 0 {
+		if _, err := caller.Do(ctx, block, &state); err != nil {
+			return nil, err
+		}
+	}
+
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: block}
+	requests := make(map[common.Address][]openRequest)
+	for i := range report.Entries {
+		e := &report.Entries[i]
+		if e.Slasher == (common.Address{}) {
+			continue
+		}
+		s := slashers[e.Slasher]
+		kind, err := s.kind.Get()
+		if err != nil {
+			return nil, fmt.Errorf("resolver: TYPE of slasher %s: %w", e.Slasher, err)
+		}
+		slasherType := core.SlasherType(kind)
+		e.SlasherType = &slasherType
+		if !e.IsVeto() {
+			continue
+		}
+		vetoDuration, err := s.vetoDuration.Get()
+		if err != nil {
+			return nil, fmt.Errorf("resolver: vetoDuration of slasher %s: %w", e.Slasher, err)
+		}
+		e.VetoDuration = vetoDuration.Uint64()
+		if e.ResolverSetEpochsDelay, err = s.epochsDelay.Get(); err != nil {
+			return nil, fmt.Errorf("resolver: resolverSetEpochsDelay of slasher %s: %w", e.Slasher, err)
+		}
+		if e.Resolver, err = pending[i].current.Get(); err != nil {
+			return nil, fmt.Errorf("resolver: resolver of subnetwork %s on slasher %s: %w", e.Subnetwork, e.Slasher, err)
+		}
+		latest, err := pending[i].latest.Get()
+		if err != nil {
+			return nil, fmt.Errorf("resolver: latest resolver of subnetwork %s on slasher %s: %w", e.Subnetwork, e.Slasher, err)
+		}
+		if latest != e.Resolver {
+			e.Pending = &latest
+			if e.PendingAt, err = pendingAt(opts, backend, e, header.Time); err != nil {
+				return nil, err
+			}
+		}
+
+		open, ok := requests[e.Slasher]
+		if !ok {
+			length, err := s.requestsLength.Get()
+			if err != nil {
+				return nil, fmt.Errorf("resolver: slashRequestsLength of slasher %s: %w", e.Slasher, err)
+			}
+			if open, err = openRequests(opts, backend, e.Slasher, length.Uint64(), header.Time); err != nil {
+				return nil, err
+			}
+			requests[e.Slasher] = open
+		}
+		for _, r := range open {
+			if r.subnetwork == e.Subnetwork.Hash() {
+				e.OpenRequests = append(e.OpenRequests, r.SlashRequest)
+			}
+		}
+		if e.Pending != nil {
+			e.warnWindows(fmt.Sprintf("pending resolver %s", e.Pending), e.PendingAt)
+		}
+	}
+	return report, nil
+}
+
+// pendingAt bisects resolverAt for the timestamp the pending resolver takes
+// effect at, as the slasher exposes no getter for its checkpoints. The pending
+// checkpoint is at most resolverSetEpochsDelay epochs after the start of the
+// current epoch.
+func pendingAt(opts *bind.CallOpts, backend Backend, e *Entry, now uint64) (uint64, error) {
+	slasher, err := networkcontracts.NewIVetoSlasherCaller(e.Slasher, backend)
+	if err != nil {
+		return 0, err
+	}
+	resolverAt := func(timestamp uint64) (common.Address, error) {
+		resolver, err := slasher.ResolverAt(opts, e.Subnetwork.Hash(), new(big.Int).SetUint64(timestamp), []byte{})
+		if err != nil {
+			return common.Address{}, fmt.Errorf("resolver: resolverAt on slasher %s: %w", e.Slasher, err)
+		}
+		return resolver, nil
+	}
+	lo, hi := now, maxTimestamp.Uint64()
+	if bound := e.activation(now); bound > now {
+		if resolver, err := resolverAt(bound); err != nil {
+			return 0, err
+		} else if resolver == *e.Pending {
+			hi = bound
+		}
+	}
+	// resolverAt(lo) is the current resolver and resolverAt(hi) the pending one.
+	for hi-lo > 1 {
+		mid := lo + (hi-lo)/2
+		resolver, err := resolverAt(mid)
+		if err != nil {
+			return 0, err
+		}
+		if resolver == *e.Pending {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+	return hi, nil
+}
+
+type openRequest struct {
+	SlashRequest
+	subnetwork common.Hash
+}
+
+// openRequests reads the slash requests of slasher whose veto deadline is
+// after now and that are not completed. Requests are appended with
+// increasing deadlines, so the scan walks back from the last one.
+func openRequests(opts *bind.CallOpts, backend Backend, slasher common.Address, length, now uint64) ([]openRequest, error) {
+	contract, err := networkcontracts.NewIVetoSlasherCaller(slasher, backend)
+	if err != nil {
+		return nil, err
+	}
+	var open []openRequest
+	for index := length; index > 0; index-- {
+		request, err := contract.SlashRequests(opts, new(big.Int).SetUint64(index-1))
+		if err != nil {
+			return nil, fmt.Errorf("resolver: slash request %d on slasher %s: %w", index-1, slasher, err)
+		}
+		deadline := request.VetoDeadline.Uint64()
+		if deadline <= now {
+			break
+		}
+		if request.Completed {
+			continue
+		}
+		open = append(open, openRequest{
+			SlashRequest: SlashRequest{
+				Index:            index - 1,
+				Operator:         request.Operator,
+				Amount:           request.Amount,
+				CaptureTimestamp: request.CaptureTimestamp.Uint64(),
+				VetoDeadline:     deadline,
+			},
+			subnetwork: request.Subnetwork,
+		})
+	}
+	return open, nil
+}
+
+func formatTime(timestamp uint64) string {
+	return time.Unix(int64(timestamp), 0).UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,309 @@
+package resolver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	networkcontracts "github.com/symbioticfi/network/bindings/go-go-ethereum"
+	"github.com/symbioticfi/network/pkg/core"
+	"github.com/symbioticfi/network/pkg/multicall"
+	"github.com/symbioticfi/network/pkg/subnetwork"
+)
+
+func TestActivation(t *testing.T) {
+	resolver := common.HexToAddress("0x5e00000000000000000000000000000000000001")
+	pending := common.HexToAddress("0x5e00000000000000000000000000000000000002")
+	const init, epoch = 1000, 100
+	tests := []struct {
+		name      string
+		resolver  common.Address
+		pending   *common.Address
+		epochs    int64
+		epoch     uint64
+		timestamp uint64
+		want      uint64
+	}{
+		{name: "first resolver is immediate", epochs: 3, epoch: epoch, timestamp: 1250, want: 1250},
+		{name: "pending first resolver waits", pending: &pending, epochs: 3, epoch: epoch, timestamp: 1250, want: 1500},
+		{name: "replacement waits from the epoch start", resolver: resolver, epochs: 3, epoch: epoch, timestamp: 1250, want: 1500},
+		{name: "at an epoch start", resolver: resolver, epochs: 3, epoch: epoch, timestamp: 1300, want: 1600},
+		{name: "at the first epoch", resolver: resolver, epochs: 2, epoch: epoch, timestamp: init, want: 1200},
+		{name: "no epoch duration", resolver: resolver, epochs: 3, timestamp: 1250, want: 1250},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := &Entry{
+				Resolver:               tt.resolver,
+				Pending:                tt.pending,
+				ResolverSetEpochsDelay: big.NewInt(tt.epochs),
+				epochDurationInit:      init,
+				epochDuration:          tt.epoch,
+			}
+			if got := e.activation(tt.timestamp); got != tt.want {
+				t.Errorf("activation(%d) = %d, want %d", tt.timestamp, got, tt.want)
+			}
+		})
+	}
+}
+
+var (
+	network      = common.HexToAddress("0x7e70000000000000000000000000000000000000")
+	vetoVault    = common.HexToAddress("0x5a01700000000000000000000000000000000001")
+	instantVault = common.HexToAddress("0x5a01700000000000000000000000000000000002")
+	bareVault    = common.HexToAddress("0x5a01700000000000000000000000000000000003")
+	vetoSlasher  = common.HexToAddress("0x51a5000000000000000000000000000000000001")
+	instant      = common.HexToAddress("0x51a5000000000000000000000000000000000002")
+
+	current = common.HexToAddress("0x5e00000000000000000000000000000000000001")
+	next    = common.HexToAddress("0x5e00000000000000000000000000000000000002")
+	other   = common.HexToAddress("0x5e00000000000000000000000000000000000003")
+)
+
+// now is the timestamp of the head block of the fake chain, whose vaults have
+// epochs of 1000 seconds starting at 1000 and whose slasher waits two epochs
+// for new resolvers.
+const now = 10_000
+
+type checkpoint struct {
+	at       uint64
+	resolver common.Address
+}
+
+type fakeRequest struct {
+	subnetwork common.Hash
+	deadline   uint64
+	completed  bool
+}
+
+// fakeChain serves Multicall3, the Network, the vaults and the slashers.
+type fakeChain struct {
+	checkpoints map[common.Hash][]checkpoint
+	requests    []fakeRequest
+	// read lists the indexes of the slash requests read.
+	read []uint64
+}
+
+// newFakeChain returns a chain where subnetwork 0 of the veto vault has
+// resolver current and the pending resolver next from 11500, and subnetwork 1
+// has resolver current. Of its slash requests, 2 and 4 on subnetwork 0 and
+// 5 on subnetwork 1 are open.
+func newFakeChain(t *testing.T) *fakeChain {
+	t.Helper()
+	zero, one := subnetworkHash(t, 0), subnetworkHash(t, 1)
+	return &fakeChain{
+		checkpoints: map[common.Hash][]checkpoint{
+			zero: {{500, current}, {11_500, next}},
+			one:  {{500, current}},
+		},
+		requests: []fakeRequest{
+			{subnetwork: zero, deadline: 8000},
+			{subnetwork: one, deadline: 9500},
+			{subnetwork: zero, deadline: 11_000},
+			{subnetwork: one, deadline: 12_000, completed: true},
+			{subnetwork: zero, deadline: 13_000},
+			{subnetwork: one, deadline: 16_000},
+		},
+	}
+}
+
+func subnetworkHash(t *testing.T, id int64) common.Hash {
+	t.Helper()
+	s, err := subnetwork.FromNetwork(network, big.NewInt(id))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s.Hash()
+}
+
+func (f *fakeChain) resolverAt(s common.Hash, timestamp uint64) common.Address {
+	var resolver common.Address
+	for _, c := range f.checkpoints[s] {
+		if c.at <= timestamp {
+			resolver = c.resolver
+		}
+	}
+	return resolver
+}
+
+// execute runs a call and reports whether it succeeded.
+func (f *fakeChain) execute(to common.Address, data []byte) ([]byte, bool) {
+	var parsed *abi.ABI
+	switch to {
+	case multicall.Multicall3Address:
+		return common.LeftPadBytes(big.NewInt(42).Bytes(), 32), true
+	case network:
+		parsed, _ = networkcontracts.INetworkMetaData.GetAbi()
+	case vetoVault, instantVault, bareVault:
+		parsed = core.VaultABI
+	case vetoSlasher, instant:
+		parsed = core.VetoSlasherABI
+	default:
+		return nil, false
+	}
+	method, err := parsed.MethodById(data)
+	if err != nil {
+		return nil, false
+	}
+	args, err := method.Inputs.Unpack(data[4:])
+	if err != nil {
+		return nil, false
+	}
+	var out []byte
+	switch method.Name {
+	case "getMinDelay":
+		out, err = method.Outputs.Pack(big.NewInt(3600))
+	case "slasher":
+		slashers := map[common.Address]common.Address{vetoVault: vetoSlasher, instantVault: instant}
+		out, err = method.Outputs.Pack(slashers[to])
+	case "epochDurationInit":
+		out, err = method.Outputs.Pack(big.NewInt(1000))
+	case "epochDuration":
+		out, err = method.Outputs.Pack(big.NewInt(1000))
+	case "TYPE":
+		kind := core.VetoSlasher
+		if to == instant {
+			kind = core.InstantSlasher
+		}
+		out, err = method.Outputs.Pack(uint64(kind))
+	case "vetoDuration":
+		out, err = method.Outputs.Pack(big.NewInt(7 * 86400))
+	case "resolverSetEpochsDelay":
+		out, err = method.Outputs.Pack(big.NewInt(2))
+	case "slashRequestsLength":
+		out, err = method.Outputs.Pack(big.NewInt(int64(len(f.requests))))
+	case "resolverAt":
+		out, err = method.Outputs.Pack(f.resolverAt(args[0].([32]byte), args[1].(*big.Int).Uint64()))
+	case "slashRequests":
+		index := args[0].(*big.Int).Uint64()
+		f.read = append(f.read, index)
+		r := f.requests[index]
+		out, err = method.Outputs.Pack(r.subnetwork, common.Address{}, big.NewInt(1), big.NewInt(0), new(big.Int).SetUint64(r.deadline), r.completed)
+	default:
+		return nil, false
+	}
+	return out, err == nil
+}
+
+// call3 and result3 mirror the Multicall3 structs.
+type call3 struct {
+	Target       common.Address
+	AllowFailure bool
+	CallData     []byte
+}
+
+type result3 struct {
+	Success    bool
+	ReturnData []byte
+}
+
+func (f *fakeChain) CodeAt(context.Context, common.Address, *big.Int) ([]byte, error) {
+	return []byte{1}, nil
+}
+
+func (f *fakeChain) CallContract(_ context.Context, msg ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	if *msg.To != multicall.Multicall3Address {
+		out, ok := f.execute(*msg.To, msg.Data)
+		if !ok {
+			return nil, errors.New("execution reverted")
+		}
+		return out, nil
+	}
+	aggregate3 := multicall.Multicall3ABI.Methods["aggregate3"]
+	args, err := aggregate3.Inputs.Unpack(msg.Data[4:])
+	if err != nil {
+		return nil, err
+	}
+	calls := *abi.ConvertType(args[0], new([]call3)).(*[]call3)
+	results := make([]result3, len(calls))
+	for i, c := range calls {
+		results[i].ReturnData, results[i].Success = f.execute(c.Target, c.CallData)
+	}
+	return aggregate3.Outputs.Pack(results)
+}
+
+func (f *fakeChain) HeaderByNumber(_ context.Context, number *big.Int) (*types.Header, error) {
+	return &types.Header{Number: number, Time: now}, nil
+}
+
+func TestRead(t *testing.T) {
+	chain := newFakeChain(t)
+	report, err := Read(context.Background(), multicall.NewCaller(chain, nil), chain, network, []Key{
+		{Vault: vetoVault, SubnetworkID: big.NewInt(0)},
+		{Vault: vetoVault, SubnetworkID: big.NewInt(1)},
+		{Vault: instantVault, SubnetworkID: big.NewInt(0)},
+		{Vault: bareVault, SubnetworkID: big.NewInt(0)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.BlockNumber != 42 || report.Timestamp != now || len(report.Entries) != 4 {
+		t.Fatalf("report at block %d, time %d with %d entries", report.BlockNumber, report.Timestamp, len(report.Entries))
+	}
+
+	pending, settled, instantEntry, bare := report.Entries[0], report.Entries[1], report.Entries[2], report.Entries[3]
+	if pending.Resolver != current || pending.Pending == nil || *pending.Pending != next {
+		t.Errorf("subnetwork 0 resolver %s, pending %v, want %s and %s", pending.Resolver, pending.Pending, current, next)
+	}
+	// The checkpoint is between the bound of two epochs and now, so it is
+	// found by bisection.
+	if pending.PendingAt != 11_500 {
+		t.Errorf("subnetwork 0 pending at %d, want 11500", pending.PendingAt)
+	}
+	if settled.Resolver != current || settled.Pending != nil {
+		t.Errorf("subnetwork 1 resolver %s, pending %v, want %s without one", settled.Resolver, settled.Pending, current)
+	}
+	if got := requestIndexes(pending.OpenRequests); got != "4 2" {
+		t.Errorf("subnetwork 0 open requests %s, want 4 2", got)
+	}
+	if got := requestIndexes(settled.OpenRequests); got != "5" {
+		t.Errorf("subnetwork 1 open requests %s, want 5", got)
+	}
+	// Only request 4 is still open when the pending resolver takes effect.
+	if len(pending.Warnings) != 1 || !strings.Contains(pending.Warnings[0], "slash request 4") {
+		t.Errorf("subnetwork 0 warnings %q, want one about request 4", pending.Warnings)
+	}
+	// The scan stops at the first passed deadline and reads each slasher
+	// once.
+	if fmt.Sprint(chain.read) != "[5 4 3 2 1]" {
+		t.Errorf("read slash requests %v, want 5 down to 1", chain.read)
+	}
+	if instantEntry.IsVeto() || instantEntry.SlasherType == nil || *instantEntry.SlasherType != core.InstantSlasher {
+		t.Errorf("instant slasher entry = %+v", instantEntry)
+	}
+	if bare.Slasher != (common.Address{}) || bare.SlasherType != nil {
+		t.Errorf("vault without slasher entry = %+v", bare)
+	}
+}
+
+func requestIndexes(requests []SlashRequest) string {
+	var indexes []string
+	for _, r := range requests {
+		indexes = append(indexes, fmt.Sprint(r.Index))
+	}
+	return strings.Join(indexes, " ")
+}
+
+func TestReadErrors(t *testing.T) {
+	key := Key{Vault: vetoVault, SubnetworkID: big.NewInt(0)}
+	for name, keys := range map[string][]Key{
+		"no keys":   nil,
+		"duplicate": {key, key},
+		"above uint96": {
+			{Vault: vetoVault, SubnetworkID: new(big.Int).Lsh(common.Big1, 96)},
+		},
+	} {
+		chain := newFakeChain(t)
+		if _, err := Read(context.Background(), multicall.NewCaller(chain, nil), chain, network, keys); err == nil {
+			t.Errorf("%s: Read() succeeded", name)
+		}
+	}
+}
@@ -0,0 +1,258 @@
+package roles
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	networkcontracts "github.com/symbioticfi/network/bindings/go-go-ethereum"
+	"github.com/symbioticfi/network/pkg/blockrange"
+)
+
+// ChangeKind is the kind of a role change.
+type ChangeKind string
+
+const (
+	// Granted is emitted as RoleGranted.
+	Granted ChangeKind = "granted"
+	// Revoked is emitted as RoleRevoked.
+	Revoked ChangeKind = "revoked"
+	// AdminChanged is emitted as RoleAdminChanged.
+	AdminChanged ChangeKind = "adminChanged"
+)
+
+// Change is a single role change observed on a Network.
+type Change struct {
+	Kind ChangeKind  `json:"kind"`
+	Role common.Hash `json:"role"`
+	// Account and Sender are set for grants and revocations. Sender is the
+	// Network itself when the change was executed through the timelock.
+	Account common.Address `json:"account,omitempty"`
+	Sender  common.Address `json:"sender,omitempty"`
+	// PreviousAdminRole and NewAdminRole are set for admin changes.
+	PreviousAdminRole common.Hash `json:"previousAdminRole,omitempty"`
+	NewAdminRole      common.Hash `json:"newAdminRole,omitempty"`
+
+	BlockNumber uint64      `json:"blockNumber"`
+	BlockTime   uint64      `json:"blockTime"`
+	TxHash      common.Hash `json:"txHash"`
+	LogIndex    uint        `json:"logIndex"`
+
+	// Operation is set when the change was executed by a timelock operation.
+	Operation *Operation `json:"operation,omitempty"`
+}
+
+// Operation links a role change to the timelock operation that executed it.
+type Operation struct {
+	ID    common.Hash `json:"id"`
+	Index uint64      `json:"index"`
+	// ScheduledTx and ScheduledBlock are zero when the scheduling happened
+	// before the scanned block range.
+	ScheduledTx    common.Hash `json:"scheduledTx,omitempty"`
+	ScheduledBlock uint64      `json:"scheduledBlock,omitempty"`
+}
+
+// Indexer reads the role history of a single Network.
+type Indexer struct {
+	address  common.Address
+	backend  bind.ContractBackend
+	timelock *networkcontracts.TimelockControllerUpgradeable
+	abi      *abi.ABI
+
+	// BlockRange is the number of blocks requested per eth_getLogs call.
+	BlockRange uint64
+
+	headers map[uint64]uint64
+}
+
+// NewIndexer creates an Indexer for the Network deployed at address.
+func NewIndexer(address common.Address, backend bind.ContractBackend) (*Indexer, error) {
+	timelock, err := networkcontracts.NewTimelockControllerUpgradeable(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := networkcontracts.TimelockControllerUpgradeableMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return &Indexer{
+		address:    address,
+		backend:    backend,
+		timelock:   timelock,
+		abi:        parsed,
+		BlockRange: blockrange.DefaultSize,
+		headers:    make(map[uint64]uint64),
+	}, nil
+}
+
+// Address returns the address of the indexed Network.
+func (i *Indexer) Address() common.Address {
+	return i.address
+}
+
+// Changes returns every role change emitted in the inclusive block range
+// [from, to], ordered by block number and log index.
+func (i *Indexer) Changes(ctx context.Context, from, to uint64) ([]Change, error) {
+	events := i.abi.Events
+	topics := []common.Hash{
+		events["RoleGranted"].ID,
+		events["RoleRevoked"].ID,
+		events["RoleAdminChanged"].ID,
+		events["CallScheduled"].ID,
+		events["CallExecuted"].ID,
+	}
+
+	var (
+		changes   []Change
+		executed  = make(map[common.Hash][]*networkcontracts.TimelockControllerUpgradeableCallExecuted)
+		scheduled = make(map[common.Hash]types.Log)
+	)
+	for _, r := range blockrange.Split(from, to, i.BlockRange) {
+		logs, err := i.backend.FilterLogs(ctx, ethereum.FilterQuery{
+			FromBlock: new(big.Int).SetUint64(r.From),
+			ToBlock:   new(big.Int).SetUint64(r.To),
+			Addresses: []common.Address{i.address},
+			Topics:    [][]common.Hash{topics},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("filter logs in blocks %d-%d: %w", r.From, r.To, err)
+		}
+		for _, log := range logs {
+			if log.Removed || len(log.Topics) == 0 {
+				continue
+			}
+			change, err := i.parse(log, executed, scheduled)
+			if err != nil {
+				return nil, err
+			}
+			if change != nil {
+				changes = append(changes, *change)
+			}
+		}
+	}
+
+	for idx := range changes {
+		if changes[idx].Kind != AdminChanged {
+			changes[idx].Operation = i.operation(&changes[idx], executed[changes[idx].TxHash], scheduled)
+		}
+		timestamp, err := i.blockTime(ctx, changes[idx].BlockNumber)
+		if err != nil {
+			return nil, err
+		}
+		changes[idx].BlockTime = timestamp
+	}
+
+	sort.SliceStable(changes, func(a, b int) bool {
+		if changes[a].BlockNumber != changes[b].BlockNumber {
+			return changes[a].BlockNumber < changes[b].BlockNumber
+		}
+		return changes[a].LogIndex < changes[b].LogIndex
+	})
+	return changes, nil
+}
+
+func (i *Indexer) parse(
+	log types.Log,
+	executed map[common.Hash][]*networkcontracts.TimelockControllerUpgradeableCallExecuted,
+	scheduled map[common.Hash]types.Log,
+) (*Change, error) {
+	change := &Change{
+		BlockNumber: log.BlockNumber,
+		TxHash:      log.TxHash,
+		LogIndex:    log.Index,
+	}
+	switch log.Topics[0] {
+	case i.abi.Events["RoleGranted"].ID:
+		event, err := i.timelock.ParseRoleGranted(log)
+		if err != nil {
+			return nil, err
+		}
+		change.Kind, change.Role, change.Account, change.Sender = Granted, event.Role, event.Account, event.Sender
+	case i.abi.Events["RoleRevoked"].ID:
+		event, err := i.timelock.ParseRoleRevoked(log)
+		if err != nil {
+			return nil, err
+		}
+		change.Kind, change.Role, change.Account, change.Sender = Revoked, event.Role, event.Account, event.Sender
+	case i.abi.Events["RoleAdminChanged"].ID:
+		event, err := i.timelock.ParseRoleAdminChanged(log)
+		if err != nil {
+			return nil, err
+		}
+		change.Kind, change.Role = AdminChanged, event.Role
+		change.PreviousAdminRole, change.NewAdminRole = event.PreviousAdminRole, event.NewAdminRole
+	case i.abi.Events["CallExecuted"].ID:
+		event, err := i.timelock.ParseCallExecuted(log)
+		if err != nil {
+			return nil, err
+		}
+		executed[log.TxHash] = append(executed[log.TxHash], event)
+		return nil, nil
+	case i.abi.Events["CallScheduled"].ID:
+		id := common.Hash(log.Topics[1])
+		if _, ok := scheduled[id]; !ok {
+			scheduled[id] = log
+		}
+		return nil, nil
+	default:
+		return nil, nil
+	}
+	return change, nil
+}
+
+// operation finds the CallExecuted log that follows the role change in the
+// same transaction and carries the matching grantRole or revokeRole call.
+func (i *Indexer) operation(
+	change *Change,
+	executed []*networkcontracts.TimelockControllerUpgradeableCallExecuted,
+	scheduled map[common.Hash]types.Log,
+) *Operation {
+	if change.Sender != i.address {
+		return nil
+	}
+	method := i.abi.Methods["grantRole"]
+	if change.Kind == Revoked {
+		method = i.abi.Methods["revokeRole"]
+	}
+	for _, call := range executed {
+		if call.Raw.Index < change.LogIndex || call.Target != i.address {
+			continue
+		}
+		if len(call.Data) < 4 || !bytes.Equal(call.Data[:4], method.ID) {
+			continue
+		}
+		args, err := method.Inputs.Unpack(call.Data[4:])
+		if err != nil || len(args) != 2 {
+			continue
+		}
+		if common.Hash(args[0].([32]byte)) != change.Role || args[1].(common.Address) != change.Account {
+			continue
+		}
+		op := &Operation{ID: call.Id, Index: call.Index.Uint64()}
+		if log, ok := scheduled[op.ID]; ok {
+			op.ScheduledTx, op.ScheduledBlock = log.TxHash, log.BlockNumber
+		}
+		return op
+	}
+	return nil
+}
+
+func (i *Indexer) blockTime(ctx context.Context, number uint64) (uint64, error) {
+	if timestamp, ok := i.headers[number]; ok {
+		return timestamp, nil
+	}
+	header, err := i.backend.HeaderByNumber(ctx, new(big.Int).SetUint64(number))
+	if err != nil {
+		return 0, fmt.Errorf("get header %d: %w", number, err)
+	}
+	i.headers[number] = header.Time
+	return header.Time, nil
+}
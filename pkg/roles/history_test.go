@@ -0,0 +1,160 @@
+package roles
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/symbioticfi/network/pkg/timelock"
+)
+
+// fakeLogs serves logs by block and headers whose time is twelve seconds
+// per block. Methods other than the ones below panic.
+type fakeLogs struct {
+	bind.ContractBackend
+
+	logs    []types.Log
+	headers int
+}
+
+func (f *fakeLogs) FilterLogs(_ context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	var logs []types.Log
+	// Return each range latest first, as the order of eth_getLogs results
+	// is up to the node.
+	for i := len(f.logs) - 1; i >= 0; i-- {
+		log := f.logs[i]
+		if log.BlockNumber >= query.FromBlock.Uint64() && log.BlockNumber <= query.ToBlock.Uint64() {
+			logs = append(logs, log)
+		}
+	}
+	return logs, nil
+}
+
+func (f *fakeLogs) HeaderByNumber(_ context.Context, number *big.Int) (*types.Header, error) {
+	f.headers++
+	return &types.Header{Number: number, Time: 12 * number.Uint64()}, nil
+}
+
+// timelockLog returns the log of the timelock event name with args, in
+// order, at block:index of tx.
+func timelockLog(t *testing.T, block uint64, index uint, tx byte, name string, args ...any) types.Log {
+	t.Helper()
+	event := timelock.ABI.Events[name]
+	var (
+		indexed    [][]any
+		nonIndexed abi.Arguments
+		data       []any
+	)
+	for i, input := range event.Inputs {
+		if input.Indexed {
+			indexed = append(indexed, []any{args[i]})
+		} else {
+			nonIndexed = append(nonIndexed, input)
+			data = append(data, args[i])
+		}
+	}
+	topics, err := abi.MakeTopics(indexed...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	packed, err := nonIndexed.Pack(data...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	log := types.Log{
+		Address:     network,
+		Topics:      []common.Hash{event.ID},
+		Data:        packed,
+		BlockNumber: block,
+		TxHash:      common.Hash{tx},
+		Index:       index,
+	}
+	for _, topic := range topics {
+		log.Topics = append(log.Topics, topic[0])
+	}
+	return log
+}
+
+func roleCall(t *testing.T, method string, role common.Hash, account common.Address) []byte {
+	t.Helper()
+	data, err := timelock.ABI.Pack(method, role, account)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestChanges(t *testing.T) {
+	scheduledID, unscheduledID, otherID := common.Hash{0x01}, common.Hash{0x02}, common.Hash{0x03}
+	grantAlice := roleCall(t, "grantRole", ProposerRole, alice)
+	removed := timelockLog(t, 2, 0, 0x0b, "RoleGranted", ProposerRole, carol, network)
+	removed.Removed = true
+	backend := &fakeLogs{logs: []types.Log{
+		timelockLog(t, 1, 0, 0x0a, "CallScheduled", scheduledID, big.NewInt(0), network, big.NewInt(0), grantAlice, common.Hash{}, big.NewInt(3600)),
+		removed,
+		// Executed through the operation scheduled in block 1.
+		timelockLog(t, 3, 0, 0x0c, "RoleGranted", ProposerRole, alice, network),
+		timelockLog(t, 3, 1, 0x0c, "CallExecuted", scheduledID, big.NewInt(0), network, big.NewInt(0), grantAlice),
+		// Granted directly by an admin.
+		timelockLog(t, 4, 0, 0x0d, "RoleGranted", ExecutorRole, bob, carol),
+		// Executed through an operation scheduled before the range, as the
+		// second call of its batch.
+		timelockLog(t, 5, 0, 0x0e, "RoleRevoked", ProposerRole, alice, network),
+		timelockLog(t, 5, 1, 0x0e, "CallExecuted", unscheduledID, big.NewInt(1), network, big.NewInt(0), roleCall(t, "revokeRole", ProposerRole, alice)),
+		// The executed call grants the role to another account.
+		timelockLog(t, 6, 0, 0x0f, "RoleGranted", ProposerRole, bob, network),
+		timelockLog(t, 6, 1, 0x0f, "CallExecuted", otherID, big.NewInt(0), network, big.NewInt(0), roleCall(t, "grantRole", ProposerRole, carol)),
+		timelockLog(t, 6, 2, 0x0f, "RoleAdminChanged", CancellerRole, DefaultAdminRole, ProposerRole),
+	}}
+	indexer, err := NewIndexer(network, backend)
+	if err != nil {
+		t.Fatal(err)
+	}
+	indexer.BlockRange = 2
+
+	changes, err := indexer.Changes(context.Background(), 1, 6)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Change{
+		{
+			Kind: Granted, Role: ProposerRole, Account: alice, Sender: network,
+			BlockNumber: 3, BlockTime: 36, TxHash: common.Hash{0x0c},
+			Operation: &Operation{ID: scheduledID, ScheduledTx: common.Hash{0x0a}, ScheduledBlock: 1},
+		},
+		{Kind: Granted, Role: ExecutorRole, Account: bob, Sender: carol, BlockNumber: 4, BlockTime: 48, TxHash: common.Hash{0x0d}},
+		{
+			Kind: Revoked, Role: ProposerRole, Account: alice, Sender: network,
+			BlockNumber: 5, BlockTime: 60, TxHash: common.Hash{0x0e},
+			Operation: &Operation{ID: unscheduledID, Index: 1},
+		},
+		{Kind: Granted, Role: ProposerRole, Account: bob, Sender: network, BlockNumber: 6, BlockTime: 72, TxHash: common.Hash{0x0f}},
+		{
+			Kind: AdminChanged, Role: CancellerRole, PreviousAdminRole: DefaultAdminRole, NewAdminRole: ProposerRole,
+			BlockNumber: 6, BlockTime: 72, TxHash: common.Hash{0x0f}, LogIndex: 2,
+		},
+	}
+	if len(changes) != len(want) {
+		t.Fatalf("Changes() = %d changes, want %d: %+v", len(changes), len(want), changes)
+	}
+	for i := range want {
+		got, want := changes[i], want[i]
+		if (got.Operation == nil) != (want.Operation == nil) || got.Operation != nil && *got.Operation != *want.Operation {
+			t.Errorf("change %d operation = %+v, want %+v", i, got.Operation, want.Operation)
+		}
+		got.Operation, want.Operation = nil, nil
+		if got != want {
+			t.Errorf("change %d = %+v, want %+v", i, got, want)
+		}
+	}
+	// Block times are read once per block.
+	if backend.headers != 4 {
+		t.Errorf("read %d headers, want 4", backend.headers)
+	}
+}
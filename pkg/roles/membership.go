@@ -0,0 +1,179 @@
+package roles
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Member is an account holding a role, together with the change that granted it.
+type Member struct {
+	Account common.Address `json:"account"`
+	Grant   Change         `json:"grant"`
+}
+
+// Snapshot is the role membership of a Network as of a given block.
+type Snapshot struct {
+	BlockNumber uint64 `json:"blockNumber"`
+	// Members maps each role to its current holders, sorted by account.
+	Members map[common.Hash][]Member `json:"members"`
+	// Admins maps each role with an explicitly set admin role to that admin role.
+	// Roles missing from the map are administered by DEFAULT_ADMIN_ROLE.
+	Admins map[common.Hash]common.Hash `json:"admins"`
+	// Former lists accounts that held a role at some point but no longer do.
+	Former map[common.Hash][]common.Address `json:"former"`
+}
+
+// NewSnapshot returns the empty membership of a Network before any change.
+func NewSnapshot() *Snapshot {
+	return &Snapshot{
+		Members: make(map[common.Hash][]Member),
+		Admins:  make(map[common.Hash]common.Hash),
+		Former:  make(map[common.Hash][]common.Address),
+	}
+}
+
+// Replay applies the changes emitted up to and including block to an empty
+// membership. The changes must be ordered as returned by Indexer.Changes.
+func Replay(changes []Change, block uint64) *Snapshot {
+	snapshot := NewSnapshot()
+	snapshot.Apply(changes, block)
+	return snapshot
+}
+
+// Apply applies the changes emitted up to and including block and moves the
+// snapshot to block. The changes must follow the ones already applied, in the
+// order returned by Indexer.Changes, so that a snapshot can be kept up to date
+// by applying the changes of each newly scanned range.
+func (s *Snapshot) Apply(changes []Change, block uint64) {
+	for _, change := range changes {
+		if change.BlockNumber > block {
+			break
+		}
+		switch change.Kind {
+		case Granted:
+			members := s.Members[change.Role]
+			i := sort.Search(len(members), func(i int) bool {
+				return bytes.Compare(members[i].Account[:], change.Account[:]) >= 0
+			})
+			if i == len(members) || members[i].Account != change.Account {
+				members = slices.Insert(members, i, Member{Account: change.Account})
+			}
+			members[i].Grant = change
+			s.Members[change.Role] = members
+			s.setFormer(change.Role, change.Account, false)
+		case Revoked:
+			members := s.Members[change.Role]
+			i := slices.IndexFunc(members, func(m Member) bool { return m.Account == change.Account })
+			if i < 0 {
+				continue
+			}
+			if members = slices.Delete(members, i, i+1); len(members) == 0 {
+				delete(s.Members, change.Role)
+			} else {
+				s.Members[change.Role] = members
+			}
+			s.setFormer(change.Role, change.Account, true)
+		case AdminChanged:
+			s.Admins[change.Role] = change.NewAdminRole
+		}
+	}
+	s.BlockNumber = block
+}
+
+// setFormer adds account to or removes it from the sorted former holders of
+// role.
+func (s *Snapshot) setFormer(role common.Hash, account common.Address, former bool) {
+	accounts := s.Former[role]
+	i := sort.Search(len(accounts), func(i int) bool { return bytes.Compare(accounts[i][:], account[:]) >= 0 })
+	listed := i < len(accounts) && accounts[i] == account
+	switch {
+	case former && !listed:
+		s.Former[role] = slices.Insert(accounts, i, account)
+	case !former && listed:
+		if accounts = slices.Delete(accounts, i, i+1); len(accounts) == 0 {
+			delete(s.Former, role)
+		} else {
+			s.Former[role] = accounts
+		}
+	}
+}
+
+// Roles returns the roles present in the snapshot: the known Network roles
+// first, followed by any other role in hash order.
+func (s *Snapshot) Roles() []common.Hash {
+	seen := make(map[common.Hash]bool)
+	for _, role := range Known {
+		seen[role] = true
+	}
+	var extra []common.Hash
+	add := func(role common.Hash) {
+		if !seen[role] {
+			seen[role] = true
+			extra = append(extra, role)
+		}
+	}
+	for role := range s.Members {
+		add(role)
+	}
+	for role := range s.Former {
+		add(role)
+	}
+	sort.Slice(extra, func(a, b int) bool { return bytes.Compare(extra[a][:], extra[b][:]) < 0 })
+	return append(append([]common.Hash(nil), Known...), extra...)
+}
+
+// HasRole reports whether the snapshot lists account as a holder of role.
+func (s *Snapshot) HasRole(role common.Hash, account common.Address) bool {
+	for _, member := range s.Members[role] {
+		if member.Account == account {
+			return true
+		}
+	}
+	return false
+}
+
+// Mismatch is a disagreement between the replayed membership and hasRole.
+type Mismatch struct {
+	Role     common.Hash    `json:"role"`
+	Account  common.Address `json:"account"`
+	Replayed bool           `json:"replayed"`
+	OnChain  bool           `json:"onChain"`
+}
+
+// Verify cross-checks every current and former holder in the snapshot against
+// hasRole at the snapshot block and returns the disagreements.
+func (i *Indexer) Verify(ctx context.Context, snapshot *Snapshot) ([]Mismatch, error) {
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(snapshot.BlockNumber)}
+
+	var mismatches []Mismatch
+	check := func(role common.Hash, account common.Address, replayed bool) error {
+		onChain, err := i.timelock.HasRole(opts, role, account)
+		if err != nil {
+			return fmt.Errorf("hasRole(%s, %s): %w", Name(role), account, err)
+		}
+		if onChain != replayed {
+			mismatches = append(mismatches, Mismatch{Role: role, Account: account, Replayed: replayed, OnChain: onChain})
+		}
+		return nil
+	}
+	for _, role := range snapshot.Roles() {
+		for _, member := range snapshot.Members[role] {
+			if err := check(role, member.Account, true); err != nil {
+				return nil, err
+			}
+		}
+		for _, account := range snapshot.Former[role] {
+			if err := check(role, account, false); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return mismatches, nil
+}
@@ -0,0 +1,119 @@
+package roles
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	alice = common.HexToAddress("0xa11ce00000000000000000000000000000000000")
+	bob   = common.HexToAddress("0xb0b0000000000000000000000000000000000000")
+	carol = common.HexToAddress("0xca20100000000000000000000000000000000000")
+)
+
+func TestReplay(t *testing.T) {
+	changes := []Change{
+		{Kind: Granted, Role: ProposerRole, Account: alice, BlockNumber: 1},
+		{Kind: Granted, Role: ProposerRole, Account: bob, BlockNumber: 2},
+		{Kind: AdminChanged, Role: CancellerRole, NewAdminRole: ProposerRole, BlockNumber: 3},
+		{Kind: Revoked, Role: ProposerRole, Account: alice, BlockNumber: 4},
+		// Revoking an account without the role emits nothing on-chain; a
+		// stray change must not make it a former holder.
+		{Kind: Revoked, Role: ExecutorRole, Account: carol, BlockNumber: 5},
+		{Kind: Granted, Role: ProposerRole, Account: alice, BlockNumber: 6},
+	}
+	tests := []struct {
+		block   uint64
+		members []common.Address
+		former  []common.Address
+		admin   bool
+	}{
+		{block: 0},
+		{block: 1, members: []common.Address{alice}},
+		{block: 3, members: []common.Address{alice, bob}, admin: true},
+		{block: 4, members: []common.Address{bob}, former: []common.Address{alice}, admin: true},
+		{block: 6, members: []common.Address{alice, bob}, admin: true},
+	}
+	for _, tt := range tests {
+		snapshot := Replay(changes, tt.block)
+		var members []common.Address
+		for _, member := range snapshot.Members[ProposerRole] {
+			members = append(members, member.Account)
+		}
+		if !equalAddresses(members, tt.members) {
+			t.Errorf("block %d: proposers = %v, want %v", tt.block, members, tt.members)
+		}
+		if former := snapshot.Former[ProposerRole]; !equalAddresses(former, tt.former) {
+			t.Errorf("block %d: former proposers = %v, want %v", tt.block, former, tt.former)
+		}
+		if len(snapshot.Former[ExecutorRole]) != 0 {
+			t.Errorf("block %d: former executors = %v, want none", tt.block, snapshot.Former[ExecutorRole])
+		}
+		if _, ok := snapshot.Admins[CancellerRole]; ok != tt.admin {
+			t.Errorf("block %d: CANCELLER_ROLE admin set = %v, want %v", tt.block, ok, tt.admin)
+		}
+	}
+}
+
+func TestReplayKeepsLatestGrant(t *testing.T) {
+	changes := []Change{
+		{Kind: Granted, Role: ExecutorRole, Account: alice, BlockNumber: 1},
+		{Kind: Revoked, Role: ExecutorRole, Account: alice, BlockNumber: 2},
+		{Kind: Granted, Role: ExecutorRole, Account: alice, BlockNumber: 3},
+	}
+	members := Replay(changes, 3).Members[ExecutorRole]
+	if len(members) != 1 || members[0].Grant.BlockNumber != 3 {
+		t.Errorf("members = %+v, want alice granted at block 3", members)
+	}
+}
+
+func TestApply(t *testing.T) {
+	changes := []Change{
+		{Kind: Granted, Role: ProposerRole, Account: carol, BlockNumber: 1},
+		{Kind: Granted, Role: ProposerRole, Account: alice, BlockNumber: 1},
+		{Kind: Granted, Role: ExecutorRole, Account: bob, BlockNumber: 2},
+		{Kind: Revoked, Role: ExecutorRole, Account: bob, BlockNumber: 3},
+		{Kind: AdminChanged, Role: CancellerRole, NewAdminRole: ProposerRole, BlockNumber: 3},
+		{Kind: Revoked, Role: ProposerRole, Account: carol, BlockNumber: 4},
+		{Kind: Granted, Role: ProposerRole, Account: bob, BlockNumber: 5},
+		{Kind: Granted, Role: ExecutorRole, Account: bob, BlockNumber: 5},
+		{Kind: Granted, Role: ProposerRole, Account: alice, BlockNumber: 6},
+	}
+	// Applying the changes range by range, as the exporter does, ends in
+	// the same membership as replaying them at once.
+	for _, ranges := range [][]uint64{{6}, {1, 6}, {2, 3, 4, 6}, {1, 2, 3, 4, 5, 6}} {
+		snapshot, from := NewSnapshot(), 0
+		for _, to := range ranges {
+			end := from
+			for end < len(changes) && changes[end].BlockNumber <= to {
+				end++
+			}
+			snapshot.Apply(changes[from:end], to)
+			if want := Replay(changes, to); !reflect.DeepEqual(snapshot, want) {
+				t.Fatalf("ranges %v: snapshot at %d = %+v, want %+v", ranges, to, snapshot, want)
+			}
+			from = end
+		}
+	}
+	snapshot := Replay(changes, 6)
+	if _, ok := snapshot.Members[ExecutorRole]; !ok || len(snapshot.Former[ExecutorRole]) != 0 {
+		t.Errorf("executors = %v, former %v, want bob and no former holder", snapshot.Members[ExecutorRole], snapshot.Former[ExecutorRole])
+	}
+	if got := snapshot.Members[ProposerRole]; len(got) != 2 || got[0].Account != alice || got[0].Grant.BlockNumber != 6 || got[1].Account != bob {
+		t.Errorf("proposers = %+v, want alice granted at block 6 and bob", got)
+	}
+}
+
+func equalAddresses(a, b []common.Address) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,104 @@
+package roles
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Report is the role audit of a Network over a block range.
+type Report struct {
+	Network    common.Address `json:"network"`
+	FromBlock  uint64         `json:"fromBlock"`
+	ToBlock    uint64         `json:"toBlock"`
+	Snapshot   *Snapshot      `json:"snapshot"`
+	Changes    []Change       `json:"changes"`
+	Mismatches []Mismatch     `json:"mismatches"`
+}
+
+// Audit collects the role changes in [from, to], replays them into the
+// membership at block to and cross-checks that membership against hasRole.
+//
+// The from block should not be later than the Network deployment, otherwise
+// grants made before it are reported as mismatches.
+func (i *Indexer) Audit(ctx context.Context, from, to uint64) (*Report, error) {
+	changes, err := i.Changes(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+	snapshot := Replay(changes, to)
+	mismatches, err := i.Verify(ctx, snapshot)
+	if err != nil {
+		return nil, err
+	}
+	return &Report{
+		Network:    i.address,
+		FromBlock:  from,
+		ToBlock:    to,
+		Snapshot:   snapshot,
+		Changes:    changes,
+		Mismatches: mismatches,
+	}, nil
+}
+
+// WriteText renders the report as human-readable tables.
+func (r *Report) WriteText(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+
+	fmt.Fprintf(tw, "Network %s, blocks %d-%d\n\n", r.Network, r.FromBlock, r.ToBlock)
+
+	fmt.Fprintln(tw, "ROLE\tADMIN ROLE\tHOLDER\tGRANTED BY\tBLOCK\tTIME\tOPERATION")
+	for _, role := range r.Snapshot.Roles() {
+		admin := DefaultAdminRole
+		if set, ok := r.Snapshot.Admins[role]; ok {
+			admin = set
+		}
+		members := r.Snapshot.Members[role]
+		if len(members) == 0 {
+			fmt.Fprintf(tw, "%s\t%s\t-\t\t\t\t\n", Name(role), Name(admin))
+			continue
+		}
+		for _, member := range members {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%d\t%s\t%s\n",
+				Name(role), Name(admin), member.Account, member.Grant.Sender,
+				member.Grant.BlockNumber, formatTime(member.Grant.BlockTime), formatOperation(member.Grant.Operation))
+		}
+	}
+
+	fmt.Fprintln(tw, "\nBLOCK\tTIME\tCHANGE\tROLE\tACCOUNT\tSENDER\tOPERATION\tTX")
+	for _, change := range r.Changes {
+		account, sender := change.Account.Hex(), change.Sender.Hex()
+		if change.Kind == AdminChanged {
+			account, sender = Name(change.PreviousAdminRole)+" -> "+Name(change.NewAdminRole), "-"
+		}
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			change.BlockNumber, formatTime(change.BlockTime), change.Kind, Name(change.Role),
+			account, sender, formatOperation(change.Operation), change.TxHash)
+	}
+
+	if len(r.Mismatches) > 0 {
+		fmt.Fprintln(tw, "\nMISMATCH\tROLE\tACCOUNT\tREPLAYED\tHASROLE")
+		for _, m := range r.Mismatches {
+			fmt.Fprintf(tw, "!\t%s\t%s\t%t\t%t\n", Name(m.Role), m.Account, m.Replayed, m.OnChain)
+		}
+	}
+	return tw.Flush()
+}
+
+func formatTime(timestamp uint64) string {
+	return time.Unix(int64(timestamp), 0).UTC().Format(time.RFC3339)
+}
+
+func formatOperation(op *Operation) string {
+	if op == nil {
+		return "-"
+	}
+	if op.ScheduledTx == (common.Hash{}) {
+		return fmt.Sprintf("%s[%d]", op.ID, op.Index)
+	}
+	return fmt.Sprintf("%s[%d] scheduled in %s", op.ID, op.Index, op.ScheduledTx)
+}
@@ -0,0 +1,72 @@
+// Package roles reconstructs the AccessControl role membership of a Network.
+//
+// TimelockControllerUpgradeable is not AccessControlEnumerable, so the current
+// and historical role holders can only be derived from the RoleGranted,
+// RoleRevoked and RoleAdminChanged logs. The package replays those logs,
+// cross-checks the result against hasRole and links every change to the
+// timelock operation that executed it.
+package roles
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	// DefaultAdminRole is the AccessControl DEFAULT_ADMIN_ROLE.
+	DefaultAdminRole = common.Hash{}
+	// ProposerRole is the TimelockController PROPOSER_ROLE.
+	ProposerRole = crypto.Keccak256Hash([]byte("PROPOSER_ROLE"))
+	// ExecutorRole is the TimelockController EXECUTOR_ROLE.
+	ExecutorRole = crypto.Keccak256Hash([]byte("EXECUTOR_ROLE"))
+	// CancellerRole is the TimelockController CANCELLER_ROLE.
+	CancellerRole = crypto.Keccak256Hash([]byte("CANCELLER_ROLE"))
+	// NameUpdateRole is the Network NAME_UPDATE_ROLE.
+	NameUpdateRole = crypto.Keccak256Hash([]byte("NAME_UPDATE_ROLE"))
+	// MetadataURIUpdateRole is the Network METADATA_URI_UPDATE_ROLE.
+	MetadataURIUpdateRole = crypto.Keccak256Hash([]byte("METADATA_URI_UPDATE_ROLE"))
+)
+
+// Known lists the roles defined by the Network contract, in a stable order.
+var Known = []common.Hash{
+	DefaultAdminRole,
+	ProposerRole,
+	ExecutorRole,
+	CancellerRole,
+	NameUpdateRole,
+	MetadataURIUpdateRole,
+}
+
+var names = map[common.Hash]string{
+	DefaultAdminRole:      "DEFAULT_ADMIN_ROLE",
+	ProposerRole:          "PROPOSER_ROLE",
+	ExecutorRole:          "EXECUTOR_ROLE",
+	CancellerRole:         "CANCELLER_ROLE",
+	NameUpdateRole:        "NAME_UPDATE_ROLE",
+	MetadataURIUpdateRole: "METADATA_URI_UPDATE_ROLE",
+}
+
+// Name returns the Solidity constant name of a known role, or the role hash
+// for roles that are not defined by the Network contract.
+func Name(role common.Hash) string {
+	if name, ok := names[role]; ok {
+		return name
+	}
+	return role.Hex()
+}
+
+// Parse resolves a role given either by its constant name (for example
+// "PROPOSER_ROLE") or by its 32-byte hex value.
+func Parse(s string) (common.Hash, bool) {
+	for role, name := range names {
+		if name == s {
+			return role, true
+		}
+	}
+	b, err := hexutil.Decode(s)
+	if err != nil || len(b) != common.HashLength {
+		return common.Hash{}, false
+	}
+	return common.BytesToHash(b), true
+}
@@ -0,0 +1,174 @@
+package roles
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"text/tabwriter"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	networkcontracts "github.com/symbioticfi/network/bindings/go-go-ethereum"
+	"github.com/symbioticfi/network/pkg/timelock"
+)
+
+// Rotation replaces an account with another one in a set of roles.
+type Rotation struct {
+	Old common.Address
+	New common.Address
+	// Roles lists the roles to rotate. When empty, every known role held by
+	// Old is rotated.
+	Roles       []common.Hash
+	Predecessor common.Hash
+	Salt        common.Hash
+	// Delay overrides the required delay read from the Network. It must not be
+	// shorter than the required delay.
+	Delay *big.Int
+}
+
+// Step is a single grantRole or revokeRole call of a rotation.
+type Step struct {
+	Method  string         `json:"method"`
+	Role    common.Hash    `json:"role"`
+	Account common.Address `json:"account"`
+}
+
+// RotationPlan is the scheduleBatch operation that performs a rotation.
+type RotationPlan struct {
+	Network common.Address `json:"network"`
+	Old     common.Address `json:"old"`
+	New     common.Address `json:"new"`
+	Steps   []Step         `json:"steps"`
+
+	*timelock.Prepared
+}
+
+// PlanRotation builds the operation that grants every rotated role to the new
+// account and then revokes it from the old one. All grants precede all
+// revocations, and DEFAULT_ADMIN_ROLE is revoked last, so the Network never
+// ends up without a proposer, executor or admin while the batch executes.
+func PlanRotation(ctx context.Context, backend bind.ContractCaller, network common.Address, rotation Rotation) (*RotationPlan, error) {
+	if rotation.Old == rotation.New {
+		return nil, errors.New("roles: old and new accounts are the same")
+	}
+	if rotation.New == (common.Address{}) {
+		return nil, errors.New("roles: new account is the zero address")
+	}
+	caller, err := networkcontracts.NewTimelockControllerUpgradeableCaller(network, backend)
+	if err != nil {
+		return nil, err
+	}
+	networkCaller, err := networkcontracts.NewINetworkCaller(network, backend)
+	if err != nil {
+		return nil, err
+	}
+	opts := &bind.CallOpts{Context: ctx}
+
+	hasRole := func(role common.Hash, account common.Address) (bool, error) {
+		has, err := caller.HasRole(opts, role, account)
+		if err != nil {
+			return false, fmt.Errorf("roles: hasRole(%s, %s): %w", Name(role), account, err)
+		}
+		return has, nil
+	}
+
+	rotated := rotation.Roles
+	if len(rotated) == 0 {
+		for _, role := range Known {
+			has, err := hasRole(role, rotation.Old)
+			if err != nil {
+				return nil, err
+			}
+			if has {
+				rotated = append(rotated, role)
+			}
+		}
+		if len(rotated) == 0 {
+			return nil, fmt.Errorf("roles: %s holds none of the Network roles", rotation.Old)
+		}
+	}
+
+	var grants, revokes []Step
+	adminRevoked := false
+	for _, role := range rotated {
+		oldHas, err := hasRole(role, rotation.Old)
+		if err != nil {
+			return nil, err
+		}
+		if !oldHas {
+			return nil, fmt.Errorf("roles: %s does not hold %s", rotation.Old, Name(role))
+		}
+		admin, err := caller.GetRoleAdmin(opts, role)
+		if err != nil {
+			return nil, fmt.Errorf("roles: getRoleAdmin(%s): %w", Name(role), err)
+		}
+		networkIsAdmin, err := hasRole(admin, network)
+		if err != nil {
+			return nil, err
+		}
+		if !networkIsAdmin {
+			return nil, fmt.Errorf("roles: the Network does not hold %s, the admin role of %s", Name(admin), Name(role))
+		}
+		newHas, err := hasRole(role, rotation.New)
+		if err != nil {
+			return nil, err
+		}
+		if !newHas {
+			grants = append(grants, Step{Method: "grantRole", Role: role, Account: rotation.New})
+		}
+		if role == DefaultAdminRole {
+			adminRevoked = true
+			continue
+		}
+		revokes = append(revokes, Step{Method: "revokeRole", Role: role, Account: rotation.Old})
+	}
+	if adminRevoked {
+		revokes = append(revokes, Step{Method: "revokeRole", Role: DefaultAdminRole, Account: rotation.Old})
+	}
+	steps := append(grants, revokes...)
+
+	calls := make([]timelock.Call, len(steps))
+	for i, step := range steps {
+		data, err := timelock.ABI.Pack(step.Method, step.Role, step.Account)
+		if err != nil {
+			return nil, err
+		}
+		calls[i] = timelock.Call{Target: network, Value: new(big.Int), Data: data}
+	}
+
+	prepared, err := timelock.Prepare(opts, networkCaller, timelock.Operation{
+		Calls:       calls,
+		Predecessor: rotation.Predecessor,
+		Salt:        rotation.Salt,
+		Delay:       rotation.Delay,
+		Batch:       true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &RotationPlan{
+		Network:  network,
+		Old:      rotation.Old,
+		New:      rotation.New,
+		Steps:    steps,
+		Prepared: prepared,
+	}, nil
+}
+
+// WriteText renders the plan, ending with the calldata to send to the Network.
+func (p *RotationPlan) WriteText(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(tw, "Rotate %s -> %s on Network %s\n\n", p.Old, p.New, p.Network)
+	fmt.Fprintln(tw, "#\tCALL\tROLE\tACCOUNT")
+	for i, step := range p.Steps {
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%s\n", i, step.Method, Name(step.Role), step.Account)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	fmt.Fprintln(w)
+	return p.Prepared.WriteText(w)
+}
@@ -0,0 +1,148 @@
+package roles
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	networkcontracts "github.com/symbioticfi/network/bindings/go-go-ethereum"
+	"github.com/symbioticfi/network/pkg/timelock"
+)
+
+var network = common.HexToAddress("0x7e70000000000000000000000000000000000000")
+
+// fakeNetwork answers hasRole, getRoleAdmin and getMinDelay. Every role is
+// administered by DEFAULT_ADMIN_ROLE and every call requires minDelay.
+type fakeNetwork struct {
+	holders  map[common.Hash][]common.Address
+	minDelay int64
+}
+
+func (f *fakeNetwork) CodeAt(context.Context, common.Address, *big.Int) ([]byte, error) {
+	return []byte{1}, nil
+}
+
+func (f *fakeNetwork) CallContract(_ context.Context, call ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	networkABI, err := networkcontracts.INetworkMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	for _, parsed := range []*abi.ABI{timelock.ABI, networkABI} {
+		method, err := parsed.MethodById(call.Data[:4])
+		if err != nil {
+			continue
+		}
+		args, err := method.Inputs.Unpack(call.Data[4:])
+		if err != nil {
+			return nil, err
+		}
+		switch method.Name {
+		case "hasRole":
+			role, account := common.Hash(args[0].([32]byte)), args[1].(common.Address)
+			has := account == network && role == DefaultAdminRole
+			for _, holder := range f.holders[role] {
+				has = has || holder == account
+			}
+			return method.Outputs.Pack(has)
+		case "getRoleAdmin":
+			return method.Outputs.Pack(DefaultAdminRole)
+		case "getMinDelay":
+			return method.Outputs.Pack(big.NewInt(f.minDelay))
+		}
+	}
+	return nil, errors.New("unexpected call")
+}
+
+func TestPlanRotation(t *testing.T) {
+	tests := []struct {
+		name     string
+		holders  map[common.Hash][]common.Address
+		rotation Rotation
+		want     []Step
+		wantErr  bool
+	}{
+		{
+			name: "admin revoked last",
+			holders: map[common.Hash][]common.Address{
+				DefaultAdminRole: {alice},
+				ProposerRole:     {alice},
+				ExecutorRole:     {alice},
+			},
+			rotation: Rotation{Old: alice, New: bob},
+			want: []Step{
+				{Method: "grantRole", Role: DefaultAdminRole, Account: bob},
+				{Method: "grantRole", Role: ProposerRole, Account: bob},
+				{Method: "grantRole", Role: ExecutorRole, Account: bob},
+				{Method: "revokeRole", Role: ProposerRole, Account: alice},
+				{Method: "revokeRole", Role: ExecutorRole, Account: alice},
+				{Method: "revokeRole", Role: DefaultAdminRole, Account: alice},
+			},
+		},
+		{
+			name: "role already held by new account",
+			holders: map[common.Hash][]common.Address{
+				ProposerRole:  {alice, bob},
+				CancellerRole: {alice},
+			},
+			rotation: Rotation{Old: alice, New: bob, Roles: []common.Hash{CancellerRole, ProposerRole}},
+			want: []Step{
+				{Method: "grantRole", Role: CancellerRole, Account: bob},
+				{Method: "revokeRole", Role: CancellerRole, Account: alice},
+				{Method: "revokeRole", Role: ProposerRole, Account: alice},
+			},
+		},
+		{
+			name:     "role not held",
+			holders:  map[common.Hash][]common.Address{ProposerRole: {alice}},
+			rotation: Rotation{Old: alice, New: bob, Roles: []common.Hash{ExecutorRole}},
+			wantErr:  true,
+		},
+		{
+			name:     "no roles",
+			rotation: Rotation{Old: alice, New: bob},
+			wantErr:  true,
+		},
+		{
+			name:     "same account",
+			rotation: Rotation{Old: alice, New: alice},
+			wantErr:  true,
+		},
+		{
+			name:     "delay too short",
+			holders:  map[common.Hash][]common.Address{ProposerRole: {alice}},
+			rotation: Rotation{Old: alice, New: bob, Delay: big.NewInt(1)},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			backend := &fakeNetwork{holders: tt.holders, minDelay: 3600}
+			plan, err := PlanRotation(context.Background(), backend, network, tt.rotation)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("PlanRotation() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if len(plan.Steps) != len(tt.want) {
+				t.Fatalf("steps = %+v, want %+v", plan.Steps, tt.want)
+			}
+			for i := range tt.want {
+				if plan.Steps[i] != tt.want[i] {
+					t.Errorf("step %d = %+v, want %+v", i, plan.Steps[i], tt.want[i])
+				}
+			}
+			if len(plan.Operation.Calls) != len(tt.want) || !plan.Operation.IsBatch() {
+				t.Errorf("operation has %d calls, batch %v", len(plan.Operation.Calls), plan.Operation.IsBatch())
+			}
+			if plan.Operation.Delay.Int64() != 3600 {
+				t.Errorf("delay = %s, want the required 3600", plan.Operation.Delay)
+			}
+		})
+	}
+}
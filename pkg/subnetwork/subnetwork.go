@@ -0,0 +1,92 @@
+// Package subnetwork encodes Symbiotic subnetwork identifiers.
+//
+// A subnetwork is the bytes32 the core Subnetwork library derives from a
+// network address and a uint96 identifier: the 20-byte address followed by
+// the 12-byte identifier, bytes32(uint256(uint160(network)) << 96 | id).
+// Delegators and slashers key their per-network state by it, while
+// setMaxNetworkLimit and setResolver take the identifier alone.
+package subnetwork
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// MaxIdentifier is the largest identifier, type(uint96).max.
+var MaxIdentifier = new(big.Int).Sub(new(big.Int).Lsh(common.Big1, 96), common.Big1)
+
+// Subnetwork is a network address packed with a uint96 identifier.
+type Subnetwork [32]byte
+
+// ValidateIdentifier checks that id fits the uint96 subnetworkId argument of
+// setMaxNetworkLimit. The ABI encoder does not range-check it, so a larger
+// value would only surface as a reverted transaction.
+func ValidateIdentifier(id *big.Int) error {
+	if id == nil {
+		return errors.New("subnetwork: missing identifier")
+	}
+	if id.Sign() < 0 || id.Cmp(MaxIdentifier) > 0 {
+		return fmt.Errorf("subnetwork: identifier %s is not a uint96", id)
+	}
+	return nil
+}
+
+// FromNetwork returns the subnetwork of network with identifier id.
+func FromNetwork(network common.Address, id *big.Int) (Subnetwork, error) {
+	if err := ValidateIdentifier(id); err != nil {
+		return Subnetwork{}, err
+	}
+	var s Subnetwork
+	copy(s[:20], network[:])
+	id.FillBytes(s[20:])
+	return s, nil
+}
+
+// Network returns the network address.
+func (s Subnetwork) Network() common.Address {
+	return common.BytesToAddress(s[:20])
+}
+
+// Identifier returns the uint96 identifier.
+func (s Subnetwork) Identifier() *big.Int {
+	return new(big.Int).SetBytes(s[20:])
+}
+
+// Hash returns the subnetwork as the bytes32 taken by the core contracts.
+func (s Subnetwork) Hash() common.Hash {
+	return common.Hash(s)
+}
+
+// String returns the 0x-prefixed hex encoding.
+func (s Subnetwork) String() string {
+	return hexutil.Encode(s[:])
+}
+
+// MarshalText encodes the subnetwork as 0x-prefixed hex.
+func (s Subnetwork) MarshalText() ([]byte, error) {
+	return hexutil.Bytes(s[:]).MarshalText()
+}
+
+// UnmarshalText decodes 0x-prefixed hex.
+func (s *Subnetwork) UnmarshalText(input []byte) error {
+	var b hexutil.Bytes
+	if err := b.UnmarshalText(input); err != nil {
+		return fmt.Errorf("subnetwork: %w", err)
+	}
+	if len(b) != len(s) {
+		return fmt.Errorf("subnetwork: expected %d bytes, got %d", len(s), len(b))
+	}
+	copy(s[:], b)
+	return nil
+}
+
+// Parse decodes a 0x-prefixed hex subnetwork.
+func Parse(s string) (Subnetwork, error) {
+	var subnetwork Subnetwork
+	err := subnetwork.UnmarshalText([]byte(s))
+	return subnetwork, err
+}
@@ -0,0 +1,99 @@
+package subnetwork
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestFromNetwork(t *testing.T) {
+	network := common.HexToAddress("0x7e70000000000000000000000000000000000001")
+	tests := []struct {
+		name    string
+		id      *big.Int
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "zero",
+			id:   new(big.Int),
+			want: "0x7e70000000000000000000000000000000000001000000000000000000000000",
+		},
+		{
+			name: "one",
+			id:   big.NewInt(1),
+			want: "0x7e70000000000000000000000000000000000001000000000000000000000001",
+		},
+		{
+			name: "max",
+			id:   MaxIdentifier,
+			want: "0x7e70000000000000000000000000000000000001ffffffffffffffffffffffff",
+		},
+		{name: "above max", id: new(big.Int).Add(MaxIdentifier, common.Big1), wantErr: true},
+		{name: "negative", id: big.NewInt(-1), wantErr: true},
+		{name: "nil", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := FromNetwork(network, tt.id)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FromNetwork() error = %v, want error %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if s.String() != tt.want {
+				t.Errorf("FromNetwork() = %s, want %s", s, tt.want)
+			}
+			if s.Network() != network {
+				t.Errorf("Network() = %s, want %s", s.Network(), network)
+			}
+			if s.Identifier().Cmp(tt.id) != 0 {
+				t.Errorf("Identifier() = %s, want %s", s.Identifier(), tt.id)
+			}
+			if s.Hash() != common.HexToHash(tt.want) {
+				t.Errorf("Hash() = %s, want %s", s.Hash(), tt.want)
+			}
+		})
+	}
+}
+
+func TestText(t *testing.T) {
+	tests := []struct {
+		input   string
+		wantErr bool
+	}{
+		{input: "0x7e70000000000000000000000000000000000001000000000000000000000002"},
+		{input: "0x7e70000000000000000000000000000000000001", wantErr: true},
+		{input: "0x7e70000000000000000000000000000000000001000000000000000000000002ff", wantErr: true},
+		{input: "7e70000000000000000000000000000000000001000000000000000000000002", wantErr: true},
+		{input: "0xzz", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			s, err := Parse(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Parse() error = %v, want error %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			encoded, err := json.Marshal(s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(encoded) != `"`+tt.input+`"` {
+				t.Errorf("json.Marshal() = %s, want %q", encoded, tt.input)
+			}
+			var decoded Subnetwork
+			if err := json.Unmarshal(encoded, &decoded); err != nil {
+				t.Fatal(err)
+			}
+			if decoded != s {
+				t.Errorf("round trip = %s, want %s", decoded, s)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package timelock
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// CancelResult is the outcome of cancelling a single operation.
+type CancelResult struct {
+	ID     common.Hash `json:"id"`
+	TxHash common.Hash `json:"txHash,omitempty"`
+	// State is read after the cancellation is mined; Unset means cancelled.
+	State OperationState `json:"state"`
+	Error string         `json:"error,omitempty"`
+}
+
+// Cancelled reports whether the operation reads as Unset after cancellation.
+func (r CancelResult) Cancelled() bool {
+	return r.Error == "" && r.State == Unset
+}
+
+// Cancel submits cancel(id) for every operation from opts.From, which must
+// hold CANCELLER_ROLE. All transactions are sent before any is awaited so the
+// cancellations land as early as possible. Once they are mined, the state of
+// every operation is read back to verify it is Unset.
+func (x *Index) Cancel(ctx context.Context, opts *bind.TransactOpts, ids []common.Hash) ([]CancelResult, error) {
+	callOpts := &bind.CallOpts{Context: ctx}
+	role, err := x.timelock.CANCELLERROLE(callOpts)
+	if err != nil {
+		return nil, fmt.Errorf("timelock: CANCELLER_ROLE: %w", err)
+	}
+	canCancel, err := x.timelock.HasRole(callOpts, role, opts.From)
+	if err != nil {
+		return nil, fmt.Errorf("timelock: hasRole: %w", err)
+	}
+	if !canCancel {
+		return nil, fmt.Errorf("timelock: %s does not hold CANCELLER_ROLE", opts.From)
+	}
+
+	results := make([]CancelResult, len(ids))
+	txs := make([]*types.Transaction, len(ids))
+	var nonce *big.Int
+	if opts.Nonce != nil {
+		nonce = new(big.Int).Set(opts.Nonce)
+	} else {
+		pending, err := x.backend.PendingNonceAt(ctx, opts.From)
+		if err != nil {
+			return nil, fmt.Errorf("timelock: get nonce: %w", err)
+		}
+		nonce = new(big.Int).SetUint64(pending)
+	}
+	for i, id := range ids {
+		results[i].ID = id
+		send := *opts
+		send.Context = ctx
+		send.Nonce = new(big.Int).Set(nonce)
+		tx, err := x.timelock.Cancel(&send, id)
+		if err != nil {
+			results[i].Error = err.Error()
+			continue
+		}
+		nonce.Add(nonce, common.Big1)
+		txs[i] = tx
+		results[i].TxHash = tx.Hash()
+	}
+	for i, tx := range txs {
+		if tx == nil {
+			continue
+		}
+		receipt, err := bind.WaitMined(ctx, x.backend, tx)
+		if err != nil {
+			results[i].Error = err.Error()
+			continue
+		}
+		if receipt.Status != types.ReceiptStatusSuccessful {
+			results[i].Error = "cancel transaction reverted"
+		}
+	}
+	for i := range results {
+		state, err := x.timelock.GetOperationState(callOpts, results[i].ID)
+		if err != nil {
+			if results[i].Error == "" {
+				results[i].Error = err.Error()
+			}
+			continue
+		}
+		results[i].State = OperationState(state)
+	}
+	return results, nil
+}
@@ -0,0 +1,26 @@
+package timelock
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+
+	networkcontracts "github.com/symbioticfi/network/bindings/go-go-ethereum"
+)
+
+// RequiredDelay returns the smallest delay the Network accepts when
+// scheduling calls, which is the largest getMinDelay(target, data) among them.
+func RequiredDelay(opts *bind.CallOpts, network *networkcontracts.INetworkCaller, calls []Call) (*big.Int, error) {
+	required := new(big.Int)
+	for i, call := range calls {
+		delay, err := network.GetMinDelay(opts, call.Target, call.Data)
+		if err != nil {
+			return nil, fmt.Errorf("timelock: getMinDelay for call %d to %s: %w", i, call.Target, err)
+		}
+		if delay.Cmp(required) > 0 {
+			required = delay
+		}
+	}
+	return required, nil
+}
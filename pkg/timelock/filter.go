@@ -0,0 +1,89 @@
+package timelock
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// NativeTransferSelector is the selector the Network assigns to calls with
+// empty calldata.
+var NativeTransferSelector = [4]byte{0xee, 0xee, 0xee, 0xee}
+
+// Selector returns the selector of a call as the Network sees it: the first
+// four bytes of its calldata, or NativeTransferSelector for empty calldata.
+func Selector(data []byte) [4]byte {
+	var selector [4]byte
+	if len(data) == 0 {
+		return NativeTransferSelector
+	}
+	copy(selector[:], data)
+	return selector
+}
+
+// ParseSelector parses a selector given either as 4-byte hex (for example
+// "0x6773522c") or as a function signature (for example
+// "setMaxNetworkLimit(address,uint96,uint256)").
+func ParseSelector(s string) ([4]byte, error) {
+	var selector [4]byte
+	if strings.Contains(s, "(") {
+		signature := strings.ReplaceAll(s, " ", "")
+		if !strings.HasSuffix(signature, ")") {
+			return selector, fmt.Errorf("timelock: invalid function signature %q", s)
+		}
+		copy(selector[:], crypto.Keccak256([]byte(signature)))
+		return selector, nil
+	}
+	b, err := hexutil.Decode(s)
+	if err != nil || len(b) != len(selector) {
+		return selector, fmt.Errorf("timelock: invalid selector %q", s)
+	}
+	copy(selector[:], b)
+	return selector, nil
+}
+
+// Filter selects scheduled operations. Empty criteria match everything;
+// non-empty ones must all match.
+type Filter struct {
+	// Proposers matches operations scheduled by one of the accounts.
+	Proposers []common.Address
+	// Targets matches operations with at least one call to one of the targets.
+	Targets []common.Address
+	// Selectors matches operations with at least one call using one of the selectors.
+	Selectors [][4]byte
+}
+
+// Match reports whether op satisfies the filter.
+func (f Filter) Match(op *Scheduled) bool {
+	if len(f.Proposers) > 0 && !contains(f.Proposers, op.Proposer) {
+		return false
+	}
+	if len(f.Targets) > 0 && !op.anyCall(func(call Call) bool { return contains(f.Targets, call.Target) }) {
+		return false
+	}
+	if len(f.Selectors) > 0 && !op.anyCall(func(call Call) bool { return contains(f.Selectors, Selector(call.Data)) }) {
+		return false
+	}
+	return true
+}
+
+func (op *Scheduled) anyCall(match func(Call) bool) bool {
+	for _, call := range op.Calls {
+		if match(call) {
+			return true
+		}
+	}
+	return false
+}
+
+func contains[T comparable](values []T, value T) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
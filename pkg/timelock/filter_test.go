@@ -0,0 +1,38 @@
+package timelock
+
+import "testing"
+
+func TestParseSelector(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    [4]byte
+		wantErr bool
+	}{
+		{in: "0x6773522c", want: [4]byte{0x67, 0x73, 0x52, 0x2c}},
+		{in: "updateName(string)", want: [4]byte{0x84, 0xda, 0x92, 0xa7}},
+		{in: "setMaxNetworkLimit(uint96, uint256)", want: [4]byte{0x23, 0xf7, 0x52, 0xd5}},
+		{in: "setMaxNetworkLimit(uint96,uint256", wantErr: true},
+		{in: "0x6773", wantErr: true},
+		{in: "6773522c", wantErr: true},
+		{in: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseSelector(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseSelector(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseSelector(%q) = %x, want %x", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestSelector(t *testing.T) {
+	if got := Selector(nil); got != NativeTransferSelector {
+		t.Errorf("Selector(nil) = %x, want %x", got, NativeTransferSelector)
+	}
+	if got := Selector([]byte{1, 2, 3, 4, 5}); got != [4]byte{1, 2, 3, 4} {
+		t.Errorf("Selector = %x, want 01020304", got)
+	}
+}
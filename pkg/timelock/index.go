@@ -0,0 +1,219 @@
+package timelock
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	networkcontracts "github.com/symbioticfi/network/bindings/go-go-ethereum"
+	"github.com/symbioticfi/network/pkg/blockrange"
+)
+
+// Backend is the chain access needed to index the operations of a Network.
+// It is satisfied by *ethclient.Client.
+type Backend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+	TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error)
+	TransactionSender(ctx context.Context, tx *types.Transaction, block common.Hash, index uint) (common.Address, error)
+}
+
+// Scheduled is an operation reconstructed from its CallScheduled logs.
+type Scheduled struct {
+	Operation
+
+	ID    common.Hash    `json:"id"`
+	State OperationState `json:"state"`
+	// ReadyAt is the timestamp from which the operation can be executed.
+	ReadyAt uint64 `json:"readyAt"`
+
+	BlockNumber uint64      `json:"blockNumber"`
+	TxHash      common.Hash `json:"txHash"`
+	// Proposer is the sender of the scheduling transaction. It is the zero
+	// address until resolved, and the Safe or other contract account when the
+	// proposer is not an EOA.
+	Proposer common.Address `json:"proposer"`
+
+	blockHash common.Hash
+	txIndex   uint
+}
+
+// Index reads the operations scheduled on a single Network.
+type Index struct {
+	address  common.Address
+	backend  Backend
+	timelock *networkcontracts.TimelockControllerUpgradeable
+
+	// BlockRange is the number of blocks requested per eth_getLogs call.
+	BlockRange uint64
+}
+
+// NewIndex creates an Index for the Network deployed at address.
+func NewIndex(address common.Address, backend Backend) (*Index, error) {
+	timelock, err := networkcontracts.NewTimelockControllerUpgradeable(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Index{
+		address:    address,
+		backend:    backend,
+		timelock:   timelock,
+		BlockRange: blockrange.DefaultSize,
+	}, nil
+}
+
+// Scheduled returns the operations scheduled in the inclusive block range
+// [from, to] together with their state as of block to. An operation that was
+// cancelled and scheduled again is reported once, with its latest scheduling.
+func (x *Index) Scheduled(ctx context.Context, from, to uint64) ([]*Scheduled, error) {
+	topics := []common.Hash{ABI.Events["CallScheduled"].ID, ABI.Events["CallSalt"].ID}
+
+	operations := make(map[common.Hash]*Scheduled)
+	salts := make(map[common.Hash]common.Hash)
+	for _, r := range blockrange.Split(from, to, x.BlockRange) {
+		logs, err := x.backend.FilterLogs(ctx, ethereum.FilterQuery{
+			FromBlock: new(big.Int).SetUint64(r.From),
+			ToBlock:   new(big.Int).SetUint64(r.To),
+			Addresses: []common.Address{x.address},
+			Topics:    [][]common.Hash{topics},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("timelock: filter logs in blocks %d-%d: %w", r.From, r.To, err)
+		}
+		for _, log := range logs {
+			if log.Removed || len(log.Topics) == 0 {
+				continue
+			}
+			if log.Topics[0] == ABI.Events["CallSalt"].ID {
+				event, err := x.timelock.ParseCallSalt(log)
+				if err != nil {
+					return nil, err
+				}
+				salts[event.Id] = event.Salt
+				continue
+			}
+			event, err := x.timelock.ParseCallScheduled(log)
+			if err != nil {
+				return nil, err
+			}
+			op, ok := operations[event.Id]
+			if !ok || op.TxHash != log.TxHash {
+				op = &Scheduled{
+					Operation: Operation{
+						Predecessor: event.Predecessor,
+						Delay:       event.Delay,
+					},
+					ID:          event.Id,
+					BlockNumber: log.BlockNumber,
+					TxHash:      log.TxHash,
+					blockHash:   log.BlockHash,
+					txIndex:     log.TxIndex,
+				}
+				operations[event.Id] = op
+			}
+			op.Calls = append(op.Calls, Call{Target: event.Target, Value: event.Value, Data: event.Data})
+		}
+	}
+
+	scheduled := make([]*Scheduled, 0, len(operations))
+	for id, op := range operations {
+		op.Salt = salts[id]
+		// A batch with a single call emits the same logs as a plain schedule;
+		// the ID tells them apart.
+		if computed, err := op.Operation.ID(); err == nil && computed != id {
+			op.Batch = true
+		}
+		if err := x.Refresh(ctx, op, to); err != nil {
+			return nil, err
+		}
+		scheduled = append(scheduled, op)
+	}
+	sort.Slice(scheduled, func(a, b int) bool {
+		if scheduled[a].BlockNumber != scheduled[b].BlockNumber {
+			return scheduled[a].BlockNumber < scheduled[b].BlockNumber
+		}
+		return scheduled[a].txIndex < scheduled[b].txIndex
+	})
+	return scheduled, nil
+}
+
+// Refresh updates the State and ReadyAt of op as of the given block.
+func (x *Index) Refresh(ctx context.Context, op *Scheduled, block uint64) error {
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(block)}
+	state, err := x.timelock.GetOperationState(opts, op.ID)
+	if err != nil {
+		return fmt.Errorf("timelock: getOperationState(%s): %w", op.ID, err)
+	}
+	timestamp, err := x.timelock.GetTimestamp(opts, op.ID)
+	if err != nil {
+		return fmt.Errorf("timelock: getTimestamp(%s): %w", op.ID, err)
+	}
+	op.State = OperationState(state)
+	op.ReadyAt = timestamp.Uint64()
+	return nil
+}
+
+// Pending returns the Waiting and Ready operations scheduled in [from, to]
+// that match the filter, with their proposers resolved.
+func (x *Index) Pending(ctx context.Context, from, to uint64, filter Filter) (List, error) {
+	scheduled, err := x.Scheduled(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+	var pending List
+	for _, op := range scheduled {
+		if !op.State.Pending() {
+			continue
+		}
+		if err := x.ResolveProposer(ctx, op); err != nil {
+			return nil, err
+		}
+		if filter.Match(op) {
+			pending = append(pending, op)
+		}
+	}
+	return pending, nil
+}
+
+// ResolveProposer sets the Proposer of op to the sender of its scheduling
+// transaction.
+func (x *Index) ResolveProposer(ctx context.Context, op *Scheduled) error {
+	tx, _, err := x.backend.TransactionByHash(ctx, op.TxHash)
+	if err != nil {
+		return fmt.Errorf("timelock: get transaction %s: %w", op.TxHash, err)
+	}
+	sender, err := x.backend.TransactionSender(ctx, tx, op.blockHash, op.txIndex)
+	if err != nil {
+		return fmt.Errorf("timelock: get sender of %s: %w", op.TxHash, err)
+	}
+	op.Proposer = sender
+	return nil
+}
+
+// List is a list of scheduled operations with a tabular text rendering.
+type List []*Scheduled
+
+// WriteText renders one row per call of every operation.
+func (l List) WriteText(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tSTATE\tREADY AT\tPROPOSER\t#\tTARGET\tSELECTOR\tVALUE")
+	for _, op := range l {
+		for i, call := range op.Calls {
+			selector := Selector(call.Data)
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%d\t%s\t%s\t%s\n",
+				op.ID, op.State, time.Unix(int64(op.ReadyAt), 0).UTC().Format(time.RFC3339), op.Proposer,
+				i, call.Target, hexutil.Encode(selector[:]), value(call))
+		}
+	}
+	return tw.Flush()
+}
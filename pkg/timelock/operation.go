@@ -0,0 +1,156 @@
+// Package timelock builds and inspects TimelockController operations of a
+// Network.
+//
+// Operations are encoded the same way as the action scripts do: a single call
+// is scheduled with schedule/execute unless batching is requested, several
+// calls with scheduleBatch/executeBatch. Operation IDs are computed locally and
+// match hashOperation/hashOperationBatch.
+package timelock
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	networkcontracts "github.com/symbioticfi/network/bindings/go-go-ethereum"
+)
+
+// ErrNoCalls is returned when an operation without calls is encoded.
+var ErrNoCalls = errors.New("timelock: operation has no calls")
+
+// ABI is the parsed TimelockControllerUpgradeable ABI.
+var ABI = mustParseABI()
+
+func mustParseABI() *abi.ABI {
+	parsed, err := networkcontracts.TimelockControllerUpgradeableMetaData.GetAbi()
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
+
+// Call is a single call made by the timelock when an operation is executed.
+type Call struct {
+	Target common.Address `json:"target"`
+	Value  *big.Int       `json:"value"`
+	Data   hexutil.Bytes  `json:"data"`
+}
+
+// Operation is a set of calls scheduled and executed atomically by the timelock.
+type Operation struct {
+	Calls       []Call      `json:"calls"`
+	Predecessor common.Hash `json:"predecessor"`
+	Salt        common.Hash `json:"salt"`
+	Delay       *big.Int    `json:"delay"`
+	// Batch forces scheduleBatch/executeBatch for an operation with one call.
+	Batch bool `json:"batch,omitempty"`
+}
+
+// IsBatch reports whether the operation is scheduled with scheduleBatch.
+func (op *Operation) IsBatch() bool {
+	return op.Batch || len(op.Calls) != 1
+}
+
+// ID returns the operation ID, as computed by hashOperation or
+// hashOperationBatch.
+func (op *Operation) ID() (common.Hash, error) {
+	if len(op.Calls) == 0 {
+		return common.Hash{}, ErrNoCalls
+	}
+	var (
+		packed []byte
+		err    error
+	)
+	if op.IsBatch() {
+		targets, values, payloads := op.split()
+		packed, err = ABI.Methods["hashOperationBatch"].Inputs.Pack(targets, values, payloads, op.Predecessor, op.Salt)
+	} else {
+		call := op.Calls[0]
+		packed, err = ABI.Methods["hashOperation"].Inputs.Pack(call.Target, value(call), []byte(call.Data), op.Predecessor, op.Salt)
+	}
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("timelock: encode operation: %w", err)
+	}
+	return crypto.Keccak256Hash(packed), nil
+}
+
+// ScheduleData returns the calldata of the schedule or scheduleBatch call
+// that schedules the operation on the Network.
+func (op *Operation) ScheduleData() ([]byte, error) {
+	if len(op.Calls) == 0 {
+		return nil, ErrNoCalls
+	}
+	delay := op.Delay
+	if delay == nil {
+		delay = new(big.Int)
+	}
+	if op.IsBatch() {
+		targets, values, payloads := op.split()
+		return ABI.Pack("scheduleBatch", targets, values, payloads, op.Predecessor, op.Salt, delay)
+	}
+	call := op.Calls[0]
+	return ABI.Pack("schedule", call.Target, value(call), []byte(call.Data), op.Predecessor, op.Salt, delay)
+}
+
+// ExecuteData returns the calldata of the execute or executeBatch call that
+// executes the operation once it is ready.
+func (op *Operation) ExecuteData() ([]byte, error) {
+	if len(op.Calls) == 0 {
+		return nil, ErrNoCalls
+	}
+	if op.IsBatch() {
+		targets, values, payloads := op.split()
+		return ABI.Pack("executeBatch", targets, values, payloads, op.Predecessor, op.Salt)
+	}
+	call := op.Calls[0]
+	return ABI.Pack("execute", call.Target, value(call), []byte(call.Data), op.Predecessor, op.Salt)
+}
+
+// Value returns the total native value carried by the operation.
+func (op *Operation) Value() *big.Int {
+	total := new(big.Int)
+	for _, call := range op.Calls {
+		total.Add(total, value(call))
+	}
+	return total
+}
+
+func (op *Operation) split() ([]common.Address, []*big.Int, [][]byte) {
+	targets := make([]common.Address, len(op.Calls))
+	values := make([]*big.Int, len(op.Calls))
+	payloads := make([][]byte, len(op.Calls))
+	for i, call := range op.Calls {
+		targets[i], values[i], payloads[i] = call.Target, value(call), call.Data
+	}
+	return targets, values, payloads
+}
+
+func value(call Call) *big.Int {
+	if call.Value == nil {
+		return new(big.Int)
+	}
+	return call.Value
+}
+
+// ParseSalt parses a salt given either as a 32-byte hex value or, like the
+// bytes32 string literals of the action scripts, as a string of at most 32
+// bytes that is right-padded with zeros.
+func ParseSalt(s string) (common.Hash, error) {
+	if b, err := hexutil.Decode(s); err == nil {
+		if len(b) != common.HashLength {
+			return common.Hash{}, fmt.Errorf("timelock: hex salt must be %d bytes, got %d", common.HashLength, len(b))
+		}
+		return common.BytesToHash(b), nil
+	}
+	if len(s) > common.HashLength {
+		return common.Hash{}, fmt.Errorf("timelock: salt %q is longer than %d bytes", s, common.HashLength)
+	}
+	var salt common.Hash
+	copy(salt[:], s)
+	return salt, nil
+}
@@ -0,0 +1,263 @@
+package timelock
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	networkcontracts "github.com/symbioticfi/network/bindings/go-go-ethereum"
+)
+
+var (
+	target      = common.HexToAddress("0x1111111111111111111111111111111111111111")
+	otherTarget = common.HexToAddress("0x2222222222222222222222222222222222222222")
+	salt        = common.HexToHash("0x53796d4e6574776f726b00000000000000000000000000000000000000000000")
+)
+
+// The IDs were computed by encoding the hashOperation and hashOperationBatch
+// arguments by hand.
+func TestOperationID(t *testing.T) {
+	tests := []struct {
+		name string
+		op   Operation
+		want common.Hash
+	}{
+		{
+			name: "single",
+			op: Operation{
+				Calls: []Call{{Target: target, Value: big.NewInt(1), Data: common.FromHex("0x12345678")}},
+				Salt:  salt,
+			},
+			want: common.HexToHash("0x93d262acc7dee6b3f3f79c9b183268df8045b528705ea052d9a6cb4aaf42656c"),
+		},
+		{
+			name: "batch",
+			op: Operation{
+				Calls: []Call{
+					{Target: target, Data: common.FromHex("0x12345678")},
+					{Target: otherTarget},
+				},
+				Predecessor: common.HexToHash("0x01"),
+				Salt:        salt,
+			},
+			want: common.HexToHash("0xe987fc8b6ae733853012c5bc3e2c3673d49524cc0e5afee2c74249e99909d67a"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := tt.op.ID()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if id != tt.want {
+				t.Errorf("ID() = %s, want %s", id, tt.want)
+			}
+		})
+	}
+}
+
+func TestOperationBatchOfOneCall(t *testing.T) {
+	op := Operation{Calls: []Call{{Target: target, Data: common.FromHex("0x12345678")}}, Salt: salt}
+	single, err := op.ID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	op.Batch = true
+	batch, err := op.ID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if single == batch {
+		t.Errorf("forcing a batch did not change the ID %s", single)
+	}
+}
+
+func TestOperationScheduleData(t *testing.T) {
+	tests := []struct {
+		name     string
+		op       Operation
+		method   string
+		selector string
+	}{
+		{
+			name:     "single",
+			op:       Operation{Calls: []Call{{Target: target, Data: common.FromHex("0x12345678")}}, Salt: salt, Delay: big.NewInt(86400)},
+			method:   "schedule",
+			selector: "0x01d5062a",
+		},
+		{
+			name:     "forced batch",
+			op:       Operation{Calls: []Call{{Target: target}}, Salt: salt, Delay: big.NewInt(86400), Batch: true},
+			method:   "scheduleBatch",
+			selector: "0x8f2a0bb0",
+		},
+		{
+			name:     "batch",
+			op:       Operation{Calls: []Call{{Target: target}, {Target: otherTarget}}, Salt: salt, Delay: big.NewInt(86400)},
+			method:   "scheduleBatch",
+			selector: "0x8f2a0bb0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := tt.op.ScheduleData()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := common.Bytes2Hex(data[:4]); "0x"+got != tt.selector {
+				t.Fatalf("selector = 0x%s, want %s", got, tt.selector)
+			}
+			args, err := ABI.Methods[tt.method].Inputs.Unpack(data[4:])
+			if err != nil {
+				t.Fatal(err)
+			}
+			if delay := args[len(args)-1].(*big.Int); delay.Cmp(tt.op.Delay) != 0 {
+				t.Errorf("delay = %s, want %s", delay, tt.op.Delay)
+			}
+			if got := common.Hash(args[len(args)-2].([32]byte)); got != tt.op.Salt {
+				t.Errorf("salt = %s, want %s", got, tt.op.Salt)
+			}
+		})
+	}
+}
+
+// readmeCallData is the schedule calldata of the SetMaxNetworkLimit action
+// shown in the README.
+const readmeCallData = "0x01d5062a00000000000000000000000025ed2ee6e295880326bdeca245ee4d8b72c8f103000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000c000000000000000000000000000000000000000000000000000000000000000005365744d61784e6574776f726b4c696d697400000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000004423f752d500000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000002b6700000000000000000000000000000000000000000000000000000000"
+
+func TestOperationScheduleDataMatchesScript(t *testing.T) {
+	data := common.FromHex(readmeCallData)
+	args, err := ABI.Methods["schedule"].Inputs.Unpack(data[4:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	op := Operation{
+		Calls:       []Call{{Target: args[0].(common.Address), Value: args[1].(*big.Int), Data: args[2].([]byte)}},
+		Predecessor: args[3].([32]byte),
+		Salt:        args[4].([32]byte),
+		Delay:       args[5].(*big.Int),
+	}
+	salt, err := ParseSalt("SetMaxNetworkLimit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if op.Salt != salt {
+		t.Fatalf("salt = %s, want %s", op.Salt, salt)
+	}
+	got, err := op.ScheduleData()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Errorf("ScheduleData() = %x, want %s", got, readmeCallData)
+	}
+}
+
+func TestOperationWithoutCalls(t *testing.T) {
+	op := Operation{}
+	if _, err := op.ID(); !errors.Is(err, ErrNoCalls) {
+		t.Errorf("ID() error = %v, want ErrNoCalls", err)
+	}
+	if _, err := op.ScheduleData(); !errors.Is(err, ErrNoCalls) {
+		t.Errorf("ScheduleData() error = %v, want ErrNoCalls", err)
+	}
+	if _, err := op.ExecuteData(); !errors.Is(err, ErrNoCalls) {
+		t.Errorf("ExecuteData() error = %v, want ErrNoCalls", err)
+	}
+}
+
+func TestParseSalt(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    common.Hash
+		wantErr bool
+	}{
+		{in: "SymNetwork", want: salt},
+		{in: "", want: common.Hash{}},
+		{in: salt.Hex(), want: salt},
+		{in: "0x1234", wantErr: true},
+		{in: strings.Repeat("a", 33), wantErr: true},
+		{in: strings.Repeat("a", 32), want: common.BytesToHash([]byte(strings.Repeat("a", 32)))},
+	}
+	for _, tt := range tests {
+		got, err := ParseSalt(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseSalt(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseSalt(%q) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+}
+
+// minDelays answers getMinDelay with the delay of the call's selector.
+type minDelays map[[4]byte]int64
+
+func (m minDelays) CodeAt(context.Context, common.Address, *big.Int) ([]byte, error) {
+	return []byte{1}, nil
+}
+
+func (m minDelays) CallContract(_ context.Context, call ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	parsed, err := networkcontracts.INetworkMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	method := parsed.Methods["getMinDelay"]
+	if !bytes.Equal(call.Data[:4], method.ID) {
+		return nil, errors.New("unexpected call")
+	}
+	args, err := method.Inputs.Unpack(call.Data[4:])
+	if err != nil {
+		return nil, err
+	}
+	return method.Outputs.Pack(big.NewInt(m[Selector(args[1].([]byte))]))
+}
+
+func TestPrepare(t *testing.T) {
+	network, err := networkcontracts.NewINetworkCaller(common.Address{}, minDelays{{0x12, 0x34, 0x56, 0x78}: 100, NativeTransferSelector: 50})
+	if err != nil {
+		t.Fatal(err)
+	}
+	calls := []Call{{Target: target, Data: common.FromHex("0x12345678")}, {Target: otherTarget}}
+	tests := []struct {
+		name      string
+		delay     *big.Int
+		wantDelay int64
+		wantErr   bool
+	}{
+		{name: "required", wantDelay: 100},
+		{name: "longer", delay: big.NewInt(200), wantDelay: 200},
+		{name: "equal", delay: big.NewInt(100), wantDelay: 100},
+		{name: "shorter", delay: big.NewInt(99), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prepared, err := Prepare(&bind.CallOpts{}, network, Operation{Calls: calls, Salt: salt, Delay: tt.delay})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Prepare() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if prepared.RequiredDelay.Int64() != 100 {
+				t.Errorf("RequiredDelay = %s, want 100", prepared.RequiredDelay)
+			}
+			if prepared.Operation.Delay.Int64() != tt.wantDelay {
+				t.Errorf("Delay = %s, want %d", prepared.Operation.Delay, tt.wantDelay)
+			}
+			id, _ := prepared.Operation.ID()
+			schedule, _ := prepared.Operation.ScheduleData()
+			if prepared.ID != id || !bytes.Equal(prepared.ScheduleData, schedule) {
+				t.Error("ID or ScheduleData do not match the operation")
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package timelock
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	networkcontracts "github.com/symbioticfi/network/bindings/go-go-ethereum"
+)
+
+// Prepared is an operation with the delay the Network requires for it and
+// the calldata that schedules and executes it.
+type Prepared struct {
+	RequiredDelay *big.Int      `json:"requiredDelay"`
+	Operation     *Operation    `json:"operation"`
+	ID            common.Hash   `json:"id"`
+	ScheduleData  hexutil.Bytes `json:"scheduleData"`
+	ExecuteData   hexutil.Bytes `json:"executeData"`
+}
+
+// Prepare reads the delay the Network requires for the calls of op and
+// encodes op. op.Delay overrides the required delay and must not be shorter;
+// when nil, the required delay is used.
+func Prepare(opts *bind.CallOpts, network *networkcontracts.INetworkCaller, op Operation) (*Prepared, error) {
+	if len(op.Calls) == 0 {
+		return nil, ErrNoCalls
+	}
+	required, err := RequiredDelay(opts, network, op.Calls)
+	if err != nil {
+		return nil, err
+	}
+	if op.Delay == nil {
+		op.Delay = required
+	} else if op.Delay.Cmp(required) < 0 {
+		return nil, fmt.Errorf("timelock: delay %s is shorter than the required delay %s", op.Delay, required)
+	}
+
+	p := &Prepared{RequiredDelay: required, Operation: &op}
+	if p.ID, err = op.ID(); err != nil {
+		return nil, err
+	}
+	if p.ScheduleData, err = op.ScheduleData(); err != nil {
+		return nil, err
+	}
+	if p.ExecuteData, err = op.ExecuteData(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// WriteText renders the delays, the operation ID and the calldata to send to
+// the Network.
+func (p *Prepared) WriteText(w io.Writer) error {
+	schedule, execute := "schedule", "execute"
+	if p.Operation.IsBatch() {
+		schedule, execute = "scheduleBatch", "executeBatch"
+	}
+	_, err := fmt.Fprintf(w, "required delay: %s\ndelay: %s\noperation id: %s\n%s callData: %s\n%s callData: %s\n",
+		p.RequiredDelay, p.Operation.Delay, p.ID, schedule, p.ScheduleData, execute, p.ExecuteData)
+	return err
+}
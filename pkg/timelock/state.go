@@ -0,0 +1,44 @@
+package timelock
+
+import "fmt"
+
+// OperationState mirrors TimelockControllerUpgradeable.OperationState.
+type OperationState uint8
+
+const (
+	// Unset is the state of an operation that was never scheduled, or was
+	// cancelled.
+	Unset OperationState = iota
+	// Waiting is the state of a scheduled operation whose delay has not passed.
+	Waiting
+	// Ready is the state of a scheduled operation that can be executed.
+	Ready
+	// Done is the state of an executed operation.
+	Done
+)
+
+// String returns the Solidity name of the state.
+func (s OperationState) String() string {
+	switch s {
+	case Unset:
+		return "Unset"
+	case Waiting:
+		return "Waiting"
+	case Ready:
+		return "Ready"
+	case Done:
+		return "Done"
+	default:
+		return fmt.Sprintf("OperationState(%d)", uint8(s))
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s OperationState) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// Pending reports whether the operation is scheduled but not yet executed.
+func (s OperationState) Pending() bool {
+	return s == Waiting || s == Ready
+}
@@ -0,0 +1,88 @@
+package upgrade
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Artifact is the runtime bytecode of a contract from a Foundry build
+// artifact, such as out/Network.sol/Network.json.
+type Artifact struct {
+	Path string
+	Code []byte
+	// Immutables are the byte ranges of Code holding immutable values, which
+	// are only known once the contract is deployed.
+	Immutables []Range
+}
+
+// Range is a byte range of runtime bytecode.
+type Range struct {
+	Start  int `json:"start"`
+	Length int `json:"length"`
+}
+
+// LoadArtifact reads the deployedBytecode of a Foundry artifact.
+func LoadArtifact(path string) (*Artifact, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var artifact struct {
+		DeployedBytecode struct {
+			Object              string                     `json:"object"`
+			LinkReferences      map[string]json.RawMessage `json:"linkReferences"`
+			ImmutableReferences map[string][]Range         `json:"immutableReferences"`
+		} `json:"deployedBytecode"`
+	}
+	if err := json.Unmarshal(data, &artifact); err != nil {
+		return nil, fmt.Errorf("upgrade: %s: %w", path, err)
+	}
+	bytecode := artifact.DeployedBytecode
+	if bytecode.Object == "" || bytecode.Object == "0x" {
+		return nil, fmt.Errorf("upgrade: %s has no deployedBytecode", path)
+	}
+	if len(bytecode.LinkReferences) > 0 {
+		return nil, fmt.Errorf("upgrade: %s links libraries, which is not supported", path)
+	}
+	code, err := hexutil.Decode(bytecode.Object)
+	if err != nil {
+		return nil, fmt.Errorf("upgrade: %s: deployedBytecode: %w", path, err)
+	}
+	a := &Artifact{Path: path, Code: code}
+	for _, ranges := range bytecode.ImmutableReferences {
+		for _, r := range ranges {
+			if r.Start < 0 || r.Length <= 0 || r.Start+r.Length > len(code) {
+				return nil, fmt.Errorf("upgrade: %s: immutable reference %d+%d is out of range", path, r.Start, r.Length)
+			}
+			a.Immutables = append(a.Immutables, r)
+		}
+	}
+	return a, nil
+}
+
+// Match checks that code is the artifact's runtime bytecode with its
+// immutables filled in.
+func (a *Artifact) Match(code []byte) error {
+	if len(code) == 0 {
+		return errors.New("no code deployed")
+	}
+	if len(code) != len(a.Code) {
+		return fmt.Errorf("deployed code is %d bytes, %s is %d bytes", len(code), a.Path, len(a.Code))
+	}
+	masked := make([]bool, len(code))
+	for _, r := range a.Immutables {
+		for i := r.Start; i < r.Start+r.Length; i++ {
+			masked[i] = true
+		}
+	}
+	for i := range code {
+		if !masked[i] && code[i] != a.Code[i] {
+			return fmt.Errorf("deployed code differs from %s at byte %d", a.Path, i)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,501 @@
+// Package upgrade prepares the timelock operation that upgrades the
+// implementation behind a Network proxy, the Go counterpart of the
+// UpgradeProxy action script.
+//
+// Like the script, the operation calls upgradeAndCall on the ProxyAdmin read
+// from the ERC-1967 admin slot. Before building it, Prepare checks that the
+// new implementation is deployed with the runtime bytecode of a local build
+// artifact, that its bytecode still contains the ERC-7201 namespace slots of
+// the Network and of the OpenZeppelin TimelockController, and that, running
+// on the proxy's storage after upgradeAndCall, it returns the same
+// configuration as the current implementation.
+package upgrade
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"text/tabwriter"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/gethclient"
+
+	networkcontracts "github.com/symbioticfi/network/bindings/go-go-ethereum"
+	"github.com/symbioticfi/network/pkg/roles"
+	"github.com/symbioticfi/network/pkg/timelock"
+)
+
+var (
+	// ImplementationSlot is the ERC-1967 implementation slot,
+	// bytes32(uint256(keccak256("eip1967.proxy.implementation")) - 1).
+	ImplementationSlot = common.HexToHash("0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc")
+	// AdminSlot is the ERC-1967 admin slot.
+	AdminSlot = common.HexToHash("0xb53127684a568b3173ae13b9f8a6016e243e63b6e8ee1178d6a717850b5d6103")
+
+	// NetworkStorageLocation is the ERC-7201 location of the
+	// symbiotic.storage.Network namespace.
+	NetworkStorageLocation = ERC7201Slot("symbiotic.storage.Network")
+	// TimelockControllerStorageLocation is the ERC-7201 location of the
+	// openzeppelin.storage.TimelockController namespace.
+	TimelockControllerStorageLocation = ERC7201Slot("openzeppelin.storage.TimelockController")
+)
+
+// ERC7201Slot returns the storage location of an ERC-7201 namespace,
+// keccak256(abi.encode(uint256(keccak256(namespace)) - 1)) & ~bytes32(uint256(0xff)).
+func ERC7201Slot(namespace string) common.Hash {
+	n := new(big.Int).SetBytes(crypto.Keccak256([]byte(namespace)))
+	n.Sub(n, big.NewInt(1))
+	slot := crypto.Keccak256Hash(common.LeftPadBytes(n.Bytes(), 32))
+	slot[31] = 0
+	return slot
+}
+
+// The ABIs of the Network and of the OpenZeppelin ProxyAdmin, parsed from
+// the generated bindings.
+var (
+	networkABI    = mustParseABI(networkcontracts.INetworkMetaData)
+	proxyAdminABI = mustParseABI(networkcontracts.ProxyAdminMetaData)
+)
+
+func mustParseABI(metaData *bind.MetaData) *abi.ABI {
+	parsed, err := metaData.GetAbi()
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
+
+// Backend is the chain access needed to prepare an upgrade. It is satisfied
+// by *ethclient.Client.
+type Backend interface {
+	bind.ContractCaller
+	BlockNumber(ctx context.Context) (uint64, error)
+	StorageAt(ctx context.Context, account common.Address, key common.Hash, block *big.Int) ([]byte, error)
+}
+
+// OverrideCaller executes calls with state overrides. It is satisfied by
+// *gethclient.Client.
+type OverrideCaller interface {
+	CallContract(ctx context.Context, msg ethereum.CallMsg, block *big.Int, overrides *map[common.Address]gethclient.OverrideAccount) ([]byte, error)
+}
+
+// Simulator sends raw JSON-RPC requests. It is satisfied by *rpc.Client and
+// used for eth_simulateV1, which runs upgradeAndCall and then the reads in
+// one simulated block.
+type Simulator interface {
+	CallContext(ctx context.Context, result any, method string, args ...any) error
+}
+
+// Proxy is the ERC-1967 configuration of a proxy.
+type Proxy struct {
+	Implementation common.Address `json:"implementation"`
+	Admin          common.Address `json:"admin"`
+}
+
+// ReadProxy reads the implementation and admin slots of proxy at block.
+func ReadProxy(ctx context.Context, backend Backend, proxy common.Address, block *big.Int) (Proxy, error) {
+	implementation, err := backend.StorageAt(ctx, proxy, ImplementationSlot, block)
+	if err != nil {
+		return Proxy{}, fmt.Errorf("upgrade: read implementation slot of %s: %w", proxy, err)
+	}
+	admin, err := backend.StorageAt(ctx, proxy, AdminSlot, block)
+	if err != nil {
+		return Proxy{}, fmt.Errorf("upgrade: read admin slot of %s: %w", proxy, err)
+	}
+	return Proxy{Implementation: common.BytesToAddress(implementation), Admin: common.BytesToAddress(admin)}, nil
+}
+
+// Request describes an upgrade.
+type Request struct {
+	Network           common.Address
+	NewImplementation common.Address
+	// UpgradeData is called on the new implementation by upgradeAndCall.
+	UpgradeData []byte
+	// Artifact is the local build of the new implementation.
+	Artifact *Artifact
+	// Calls and Accounts extend the reads compared between the current and
+	// new implementation: getMinDelay(target, data) for each call and
+	// hasRole of every known role for each account.
+	Calls    []timelock.Call
+	Accounts []common.Address
+
+	Predecessor common.Hash
+	Salt        common.Hash
+	// Delay overrides the required delay read from the Network. It must not
+	// be shorter than the required delay.
+	Delay *big.Int
+}
+
+// Check is the outcome of a pre-upgrade check.
+type Check struct {
+	Name   string `json:"name"`
+	OK     bool   `json:"ok"`
+	Detail string `json:"detail,omitempty"`
+}
+
+// Read is a view call compared between the current and new implementation.
+type Read struct {
+	Call    string `json:"call"`
+	Current string `json:"current"`
+	New     string `json:"new"`
+}
+
+// Plan is a checked upgrade. The prepared operation is only set when every
+// check passed.
+type Plan struct {
+	Network               common.Address `json:"network"`
+	ProxyAdmin            common.Address `json:"proxyAdmin"`
+	CurrentImplementation common.Address `json:"currentImplementation"`
+	NewImplementation     common.Address `json:"newImplementation"`
+	UpgradeData           hexutil.Bytes  `json:"upgradeData"`
+	BlockNumber           uint64         `json:"blockNumber"`
+	Checks                []Check        `json:"checks"`
+	// Mismatches are the reads that differ between the implementations.
+	Mismatches []Read `json:"mismatches,omitempty"`
+
+	*timelock.Prepared
+}
+
+// Passed reports whether every check passed.
+func (p *Plan) Passed() bool {
+	for _, c := range p.Checks {
+		if !c.OK {
+			return false
+		}
+	}
+	return true
+}
+
+func (p *Plan) check(name string, err error) {
+	c := Check{Name: name, OK: err == nil}
+	if err != nil {
+		c.Detail = err.Error()
+	}
+	p.Checks = append(p.Checks, c)
+}
+
+// Prepare reads the proxy configuration, runs the checks and, when they pass,
+// builds the operation scheduling upgradeAndCall on the ProxyAdmin. The reads
+// are compared with a code override through overrides when req.UpgradeData is
+// empty, and after a simulated upgradeAndCall through simulator otherwise.
+func Prepare(ctx context.Context, backend Backend, overrides OverrideCaller, simulator Simulator, req Request) (*Plan, error) {
+	if req.Artifact == nil {
+		return nil, errors.New("upgrade: no build artifact for the new implementation")
+	}
+	number, err := backend.BlockNumber(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("upgrade: get latest block: %w", err)
+	}
+	block := new(big.Int).SetUint64(number)
+	proxy, err := ReadProxy(ctx, backend, req.Network, block)
+	if err != nil {
+		return nil, err
+	}
+	if proxy.Admin == (common.Address{}) {
+		return nil, fmt.Errorf("upgrade: %s has no ERC-1967 admin", req.Network)
+	}
+	plan := &Plan{
+		Network:               req.Network,
+		ProxyAdmin:            proxy.Admin,
+		CurrentImplementation: proxy.Implementation,
+		NewImplementation:     req.NewImplementation,
+		UpgradeData:           req.UpgradeData,
+		BlockNumber:           number,
+	}
+
+	plan.check("new implementation differs from the current one", func() error {
+		if req.NewImplementation == proxy.Implementation {
+			return fmt.Errorf("%s is already the implementation", proxy.Implementation)
+		}
+		return nil
+	}())
+	plan.check("proxy admin is owned by the Network", checkOwner(ctx, backend, proxy.Admin, req.Network, block))
+
+	code, err := backend.CodeAt(ctx, req.NewImplementation, block)
+	if err != nil {
+		return nil, fmt.Errorf("upgrade: get code of %s: %w", req.NewImplementation, err)
+	}
+	plan.check("runtime bytecode matches "+req.Artifact.Path, req.Artifact.Match(code))
+	plan.check("bytecode contains the symbiotic.storage.Network slot (heuristic)", usesSlot(code, NetworkStorageLocation))
+	plan.check("bytecode contains the openzeppelin.storage.TimelockController slot (heuristic)", usesSlot(code, TimelockControllerStorageLocation))
+
+	if err := plan.compareReads(ctx, backend, overrides, simulator, req, code, block); err != nil {
+		return nil, err
+	}
+
+	if !plan.Passed() {
+		return plan, nil
+	}
+	if err := plan.build(ctx, backend, req, block); err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+// checkOwner verifies that the timelock can call the ProxyAdmin.
+func checkOwner(ctx context.Context, backend Backend, admin, network common.Address, block *big.Int) error {
+	proxyAdmin, err := networkcontracts.NewProxyAdminCaller(admin, backend)
+	if err != nil {
+		return err
+	}
+	owner, err := proxyAdmin.Owner(&bind.CallOpts{Context: ctx, BlockNumber: block})
+	if err != nil {
+		return fmt.Errorf("owner(): %w", err)
+	}
+	if owner != network {
+		return fmt.Errorf("owner is %s", owner)
+	}
+	return nil
+}
+
+// usesSlot reports whether code contains a PUSH32 of slot, as solc emits for
+// the constant ERC-7201 location of a namespace. It is a heuristic: it does
+// not follow control flow, so a slot pushed by dead code passes, and a
+// compiler that computes the slot differently fails. The compared reads are
+// the check that the namespaces are actually used.
+func usesSlot(code []byte, slot common.Hash) error {
+	const push32 = 0x7f
+	if !bytes.Contains(code, append([]byte{push32}, slot[:]...)) {
+		return fmt.Errorf("the bytecode never pushes %s", slot)
+	}
+	return nil
+}
+
+// compareReads makes the same view calls to the proxy with its current
+// implementation and with the new one, sets the differing ones as Mismatches
+// and adds the checks. Without upgrade data, the new implementation runs on
+// the proxy's storage through a code override. With upgrade data, the
+// upgradeAndCall of the operation is simulated first, so the reads see the
+// storage it leaves and a reverting upgrade fails its own check.
+func (p *Plan) compareReads(ctx context.Context, backend Backend, overrides OverrideCaller, simulator Simulator, req Request, code []byte, block *big.Int) error {
+	reads, err := readCalls(req)
+	if err != nil {
+		return err
+	}
+
+	var next []result
+	if len(req.UpgradeData) == 0 {
+		next = overrideReads(ctx, overrides, req.Network, code, reads, block)
+	} else {
+		var upgrade result
+		upgrade, next, err = simulateReads(ctx, simulator, p.ProxyAdmin, req, reads, block)
+		if err != nil {
+			return err
+		}
+		p.check("upgradeAndCall with the upgrade data succeeds", upgrade.err)
+		if upgrade.err != nil {
+			return nil
+		}
+	}
+
+	for i, r := range reads {
+		current, err := backend.CallContract(ctx, ethereum.CallMsg{To: &req.Network, Data: r.data}, block)
+		if err != nil {
+			return fmt.Errorf("upgrade: %s on the current implementation: %w", r.label, err)
+		}
+		switch {
+		case next[i].err != nil:
+			p.Mismatches = append(p.Mismatches, Read{Call: r.label, Current: hexutil.Encode(current), New: "error: " + next[i].err.Error()})
+		case !bytes.Equal(current, next[i].output):
+			p.Mismatches = append(p.Mismatches, Read{Call: r.label, Current: hexutil.Encode(current), New: hexutil.Encode(next[i].output)})
+		}
+	}
+	p.check("reads the proxy storage like the current implementation", func() error {
+		if len(p.Mismatches) > 0 {
+			return fmt.Errorf("%d reads differ", len(p.Mismatches))
+		}
+		return nil
+	}())
+	return nil
+}
+
+// read is a view call to the proxy.
+type read struct {
+	label string
+	data  []byte
+}
+
+// result is the outcome of a read on the new implementation.
+type result struct {
+	output []byte
+	err    error
+}
+
+// readCalls lists the reads compared between the implementations.
+func readCalls(req Request) ([]read, error) {
+	var reads []read
+	add := func(label string, contract *abi.ABI, method string, args ...any) error {
+		data, err := contract.Pack(method, args...)
+		if err != nil {
+			return fmt.Errorf("upgrade: pack %s: %w", label, err)
+		}
+		reads = append(reads, read{label, data})
+		return nil
+	}
+	if err := add("name()", networkABI, "name"); err != nil {
+		return nil, err
+	}
+	if err := add("metadataURI()", networkABI, "metadataURI"); err != nil {
+		return nil, err
+	}
+	if err := add("getMinDelay()", timelock.ABI, "getMinDelay"); err != nil {
+		return nil, err
+	}
+	for _, c := range req.Calls {
+		selector := timelock.Selector(c.Data)
+		label := fmt.Sprintf("getMinDelay(%s, %s)", c.Target, hexutil.Encode(selector[:]))
+		if err := add(label, networkABI, "getMinDelay", c.Target, []byte(c.Data)); err != nil {
+			return nil, err
+		}
+	}
+	for _, account := range req.Accounts {
+		for _, role := range roles.Known {
+			label := fmt.Sprintf("hasRole(%s, %s)", roles.Name(role), account)
+			if err := add(label, timelock.ABI, "hasRole", role, account); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return reads, nil
+}
+
+// overrideReads makes the reads with the code of the proxy replaced by code.
+func overrideReads(ctx context.Context, overrides OverrideCaller, proxy common.Address, code []byte, reads []read, block *big.Int) []result {
+	override := map[common.Address]gethclient.OverrideAccount{proxy: {Code: code}}
+	results := make([]result, len(reads))
+	for i, r := range reads {
+		results[i].output, results[i].err = overrides.CallContract(ctx, ethereum.CallMsg{To: &proxy, Data: r.data}, block, &override)
+	}
+	return results
+}
+
+// simulatedCall is a call of an eth_simulateV1 block.
+type simulatedCall struct {
+	From  common.Address `json:"from"`
+	To    common.Address `json:"to"`
+	Input hexutil.Bytes  `json:"input"`
+}
+
+// simulatedBlock is the part of an eth_simulateV1 block result used here.
+type simulatedBlock struct {
+	Calls []struct {
+		ReturnData hexutil.Bytes  `json:"returnData"`
+		Status     hexutil.Uint64 `json:"status"`
+		Error      *struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	} `json:"calls"`
+}
+
+// simulateReads simulates, in a block on top of block, the Network calling
+// upgradeAndCall on admin and then the reads. It returns the error of the
+// upgrade call first; the reads are only meaningful when it succeeded.
+func simulateReads(ctx context.Context, simulator Simulator, admin common.Address, req Request, reads []read, block *big.Int) (result, []result, error) {
+	if simulator == nil {
+		return result{}, nil, errors.New("upgrade: simulating upgrade data needs eth_simulateV1")
+	}
+	data, err := proxyAdminABI.Pack("upgradeAndCall", req.Network, req.NewImplementation, req.UpgradeData)
+	if err != nil {
+		return result{}, nil, err
+	}
+	calls := []simulatedCall{{From: req.Network, To: admin, Input: data}}
+	for _, r := range reads {
+		calls = append(calls, simulatedCall{To: req.Network, Input: r.data})
+	}
+	payload := map[string]any{
+		"blockStateCalls": []map[string]any{{"calls": calls}},
+		"validation":      false,
+	}
+	var blocks []simulatedBlock
+	if err := simulator.CallContext(ctx, &blocks, "eth_simulateV1", payload, hexutil.EncodeBig(block)); err != nil {
+		return result{}, nil, fmt.Errorf("upgrade: simulate upgradeAndCall: %w", err)
+	}
+	if len(blocks) != 1 || len(blocks[0].Calls) != len(calls) {
+		return result{}, nil, errors.New("upgrade: simulate upgradeAndCall: unexpected result")
+	}
+
+	results := make([]result, len(calls))
+	for i, call := range blocks[0].Calls {
+		results[i].output = call.ReturnData
+		if call.Status != 1 {
+			results[i].err = errors.New("execution reverted")
+			if call.Error != nil {
+				results[i].err = errors.New(call.Error.Message)
+			}
+		}
+	}
+	return results[0], results[1:], nil
+}
+
+// build sets the operation calling upgradeAndCall on the ProxyAdmin.
+func (p *Plan) build(ctx context.Context, backend Backend, req Request, block *big.Int) error {
+	data, err := proxyAdminABI.Pack("upgradeAndCall", req.Network, req.NewImplementation, req.UpgradeData)
+	if err != nil {
+		return err
+	}
+	network, err := networkcontracts.NewINetworkCaller(req.Network, backend)
+	if err != nil {
+		return err
+	}
+	p.Prepared, err = timelock.Prepare(&bind.CallOpts{Context: ctx, BlockNumber: block}, network, timelock.Operation{
+		Calls:       []timelock.Call{{Target: p.ProxyAdmin, Data: data}},
+		Predecessor: req.Predecessor,
+		Salt:        req.Salt,
+		Delay:       req.Delay,
+	})
+	return err
+}
+
+// WriteText renders the checks and, when they passed, the operation.
+func (p *Plan) WriteText(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(tw, "Network:\t%s\n", p.Network)
+	fmt.Fprintf(tw, "Proxy admin:\t%s\n", p.ProxyAdmin)
+	fmt.Fprintf(tw, "Current implementation:\t%s\n", p.CurrentImplementation)
+	fmt.Fprintf(tw, "New implementation:\t%s\n", p.NewImplementation)
+	fmt.Fprintf(tw, "Upgrade data:\t%s\n", p.UpgradeData)
+	fmt.Fprintf(tw, "Block:\t%d\n", p.BlockNumber)
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(w)
+	tw = tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "CHECK\tRESULT")
+	for _, c := range p.Checks {
+		result := "ok"
+		if !c.OK {
+			result = "FAILED: " + c.Detail
+		}
+		fmt.Fprintf(tw, "%s\t%s\n", c.Name, result)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	if len(p.Mismatches) > 0 {
+		fmt.Fprintln(w)
+		tw = tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(tw, "CALL\tCURRENT\tNEW")
+		for _, m := range p.Mismatches {
+			fmt.Fprintf(tw, "%s\t%s\t%s\n", m.Call, m.Current, m.New)
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+	}
+
+	if p.Prepared == nil {
+		return nil
+	}
+	fmt.Fprintln(w)
+	return p.Prepared.WriteText(w)
+}
@@ -0,0 +1,155 @@
+package upgrade
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+func TestERC7201Slot(t *testing.T) {
+	// The constants of src/Network.sol.
+	tests := []struct {
+		namespace string
+		want      string
+	}{
+		{"symbiotic.storage.Network", "0x2affd7691de6b6d2a998e6b135d73a3c906ea64896dff9dcb273e98dd44a6100"},
+		{"openzeppelin.storage.TimelockController", "0x9a37c2aa9d186a0969ff8a8267bf4e07e864c2f2768f5040949e28a624fb3600"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.namespace, func(t *testing.T) {
+			if got := ERC7201Slot(tt.namespace); got != common.HexToHash(tt.want) {
+				t.Errorf("ERC7201Slot(%q) = %s, want %s", tt.namespace, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUsesSlot(t *testing.T) {
+	slot := NetworkStorageLocation
+	tests := []struct {
+		name string
+		code []byte
+		ok   bool
+	}{
+		{"push32", append(append([]byte{0x60, 0x80, 0x7f}, slot[:]...), 0x54), true},
+		{"no push", append([]byte{0x60, 0x80}, slot[:]...), false},
+		{"truncated", append([]byte{0x7f}, slot[:31]...), false},
+		{"other slot", append([]byte{0x7f}, TimelockControllerStorageLocation[:]...), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := usesSlot(tt.code, slot); (err == nil) != tt.ok {
+				t.Errorf("usesSlot() = %v, want ok %v", err, tt.ok)
+			}
+		})
+	}
+}
+
+// fakeSimulator answers eth_simulateV1 with blocks and records the request.
+type fakeSimulator struct {
+	blocks  string
+	err     error
+	method  string
+	payload map[string]any
+	block   string
+}
+
+func (f *fakeSimulator) CallContext(_ context.Context, result any, method string, args ...any) error {
+	f.method = method
+	f.payload = args[0].(map[string]any)
+	f.block = args[1].(string)
+	if f.err != nil {
+		return f.err
+	}
+	return json.Unmarshal([]byte(f.blocks), result)
+}
+
+func TestSimulateReads(t *testing.T) {
+	network := common.HexToAddress("0x7e70000000000000000000000000000000000000")
+	admin := common.HexToAddress("0xad00000000000000000000000000000000000000")
+	req := Request{
+		Network:           network,
+		NewImplementation: common.HexToAddress("0x1e00000000000000000000000000000000000000"),
+		UpgradeData:       hexutil.MustDecode("0x8129fc1c"),
+	}
+	reads := []read{{"name()", hexutil.MustDecode("0x06fdde03")}, {"getMinDelay()", hexutil.MustDecode("0xf27a0c92")}}
+	tests := []struct {
+		name       string
+		blocks     string
+		rpcErr     error
+		upgradeErr string
+		outputs    []string
+		readErrs   []string
+		wantErr    bool
+	}{
+		{
+			name:     "upgrade and reads succeed",
+			blocks:   `[{"calls":[{"returnData":"0x","status":"0x1"},{"returnData":"0x01","status":"0x1"},{"returnData":"0x02","status":"0x1"}]}]`,
+			outputs:  []string{"0x01", "0x02"},
+			readErrs: []string{"", ""},
+		},
+		{
+			name:       "upgrade reverts",
+			blocks:     `[{"calls":[{"returnData":"0x","status":"0x0","error":{"message":"execution reverted: InvalidInitialization()"}},{"returnData":"0x","status":"0x0"},{"returnData":"0x","status":"0x0"}]}]`,
+			upgradeErr: "execution reverted: InvalidInitialization()",
+		},
+		{
+			name:     "read reverts",
+			blocks:   `[{"calls":[{"returnData":"0x","status":"0x1"},{"returnData":"0x01","status":"0x1"},{"returnData":"0x","status":"0x0"}]}]`,
+			outputs:  []string{"0x01", "0x"},
+			readErrs: []string{"", "execution reverted"},
+		},
+		{
+			name:    "missing calls",
+			blocks:  `[{"calls":[{"returnData":"0x","status":"0x1"}]}]`,
+			wantErr: true,
+		},
+		{
+			name:    "method not supported",
+			rpcErr:  errors.New("the method eth_simulateV1 does not exist"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			simulator := &fakeSimulator{blocks: tt.blocks, err: tt.rpcErr}
+			upgrade, results, err := simulateReads(context.Background(), simulator, admin, req, reads, big.NewInt(100))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("simulateReads() error = %v, want error %v", err, tt.wantErr)
+			}
+			if simulator.method != "eth_simulateV1" || simulator.block != "0x64" {
+				t.Errorf("called %s at %s, want eth_simulateV1 at 0x64", simulator.method, simulator.block)
+			}
+			calls := simulator.payload["blockStateCalls"].([]map[string]any)[0]["calls"].([]simulatedCall)
+			if len(calls) != 3 || calls[0].From != network || calls[0].To != admin || calls[1].To != network {
+				t.Errorf("simulated calls = %+v, want upgradeAndCall from the Network then the reads", calls)
+			}
+			if tt.wantErr {
+				return
+			}
+			if errString(upgrade.err) != tt.upgradeErr {
+				t.Fatalf("upgrade error = %v, want %q", upgrade.err, tt.upgradeErr)
+			}
+			if tt.upgradeErr != "" {
+				return
+			}
+			for i, r := range results {
+				if hexutil.Encode(r.output) != tt.outputs[i] || errString(r.err) != tt.readErrs[i] {
+					t.Errorf("read %d = %x, %v, want %s, %q", i, r.output, r.err, tt.outputs[i], tt.readErrs[i])
+				}
+			}
+		})
+	}
+}
+
+func errString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
@@ -0,0 +1,319 @@
+// Package vaults discovers the Symbiotic vaults that allocate to a Network.
+//
+// Delegators emit SetMaxNetworkLimit and SetNetworkLimit with the subnetwork
+// as their only indexed topic, and a subnetwork starts with the address of its
+// Network. Discover scans those events, keeps the delegators that set a limit
+// for one of the Network's subnetworks, and reads the current state of their
+// vaults, so the inventory needs no hand-maintained vault list.
+package vaults
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"text/tabwriter"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+
+	networkcontracts "github.com/symbioticfi/network/bindings/go-go-ethereum"
+	"github.com/symbioticfi/network/pkg/blockrange"
+	"github.com/symbioticfi/network/pkg/core"
+	"github.com/symbioticfi/network/pkg/multicall"
+	"github.com/symbioticfi/network/pkg/subnetwork"
+)
+
+// Backend is the chain access needed besides the batched reads.
+type Backend interface {
+	ethereum.LogFilterer
+	BlockNumber(ctx context.Context) (uint64, error)
+}
+
+// Request selects the Network and the blocks to scan.
+type Request struct {
+	Network common.Address
+	// FromBlock is the first block to scan, normally the deployment block of
+	// the Network.
+	FromBlock uint64
+	// BlockRange is the number of blocks requested per eth_getLogs call.
+	BlockRange uint64
+	// SubnetworkIDs restricts the scan to these subnetworks. When empty,
+	// every event is fetched and filtered by the Network address.
+	SubnetworkIDs []*big.Int
+}
+
+// Limit is the allocation of a vault to one subnetwork.
+type Limit struct {
+	SubnetworkID    *big.Int              `json:"subnetworkId"`
+	Subnetwork      subnetwork.Subnetwork `json:"subnetwork"`
+	MaxNetworkLimit *big.Int              `json:"maxNetworkLimit"`
+	// NetworkLimit is nil for delegators without one.
+	NetworkLimit *big.Int `json:"networkLimit,omitempty"`
+}
+
+// Vault is a vault with a non-zero limit for at least one subnetwork.
+type Vault struct {
+	Address          common.Address     `json:"address"`
+	Collateral       common.Address     `json:"collateral"`
+	CollateralSymbol string             `json:"collateralSymbol,omitempty"`
+	Delegator        common.Address     `json:"delegator"`
+	DelegatorType    core.DelegatorType `json:"delegatorType"`
+	Slasher          common.Address     `json:"slasher"`
+	SlasherType      *core.SlasherType  `json:"slasherType,omitempty"`
+	Limits           []Limit            `json:"limits"`
+}
+
+// Ignored is a contract that emitted a limit event for the Network but is not
+// the delegator of a vault.
+type Ignored struct {
+	Delegator common.Address `json:"delegator"`
+	Reason    string         `json:"reason"`
+}
+
+// Inventory is the result of a discovery.
+type Inventory struct {
+	Network     common.Address `json:"network"`
+	FromBlock   uint64         `json:"fromBlock"`
+	BlockNumber uint64         `json:"blockNumber"`
+	Vaults      []Vault        `json:"vaults"`
+	Ignored     []Ignored      `json:"ignored,omitempty"`
+}
+
+// Discover scans the limit events of the Network's subnetworks up to the
+// latest block and reads every vault found at that block.
+func Discover(ctx context.Context, caller *multicall.Caller, backend Backend, req Request) (*Inventory, error) {
+	number, err := backend.BlockNumber(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("vaults: get latest block: %w", err)
+	}
+	found, err := scan(ctx, backend, req, number)
+	if err != nil {
+		return nil, err
+	}
+	inventory := &Inventory{Network: req.Network, FromBlock: req.FromBlock, BlockNumber: number}
+	if len(found) == 0 {
+		return inventory, nil
+	}
+	delegators := make([]common.Address, 0, len(found))
+	for delegator := range found {
+		delegators = append(delegators, delegator)
+	}
+	sort.Slice(delegators, func(i, j int) bool {
+		return delegators[i].Cmp(delegators[j]) < 0
+	})
+	block := new(big.Int).SetUint64(number)
+
+	// Each batch addresses the contracts read by the previous one.
+	type delegatorReads struct {
+		vault *multicall.Result[common.Address]
+		kind  *multicall.Result[uint64]
+	}
+	var first multicall.Batch
+	dr := make([]delegatorReads, len(delegators))
+	for i, delegator := range delegators {
+		dr[i] = delegatorReads{
+			vault: multicall.Add[common.Address](&first, delegator, core.BaseDelegatorABI, "vault"),
+			kind:  multicall.Add[uint64](&first, delegator, core.BaseDelegatorABI, "TYPE"),
+		}
+	}
+	if _, err := caller.Do(ctx, block, &first); err != nil {
+		return nil, err
+	}
+
+	type vaultReads struct {
+		vault                          Vault
+		delegator, collateral, slasher *multicall.Result[common.Address]
+		max, current                   []*multicall.Result[*big.Int]
+	}
+	var second multicall.Batch
+	var vr []*vaultReads
+	for i, delegator := range delegators {
+		vault, err := dr[i].vault.Get()
+		if err != nil {
+			inventory.Ignored = append(inventory.Ignored, Ignored{Delegator: delegator, Reason: "vault(): " + err.Error()})
+			continue
+		}
+		kind, err := dr[i].kind.Get()
+		if err != nil {
+			inventory.Ignored = append(inventory.Ignored, Ignored{Delegator: delegator, Reason: "TYPE(): " + err.Error()})
+			continue
+		}
+		r := &vaultReads{
+			vault:      Vault{Address: vault, Delegator: delegator, DelegatorType: core.DelegatorType(kind)},
+			delegator:  multicall.Add[common.Address](&second, vault, core.VaultABI, "delegator"),
+			collateral: multicall.Add[common.Address](&second, vault, core.VaultABI, "collateral"),
+			slasher:    multicall.Add[common.Address](&second, vault, core.VaultABI, "slasher"),
+		}
+		for _, s := range found[delegator] {
+			r.vault.Limits = append(r.vault.Limits, Limit{SubnetworkID: s.Identifier(), Subnetwork: s})
+			r.max = append(r.max, multicall.Add[*big.Int](&second, delegator, core.BaseDelegatorABI, "maxNetworkLimit", s.Hash()))
+			r.current = append(r.current, multicall.Add[*big.Int](&second, delegator, core.NetworkRestakeDelegatorABI, "networkLimit", s.Hash()))
+		}
+		vr = append(vr, r)
+	}
+	if second.Len() > 0 {
+		if _, err := caller.Do(ctx, block, &second); err != nil {
+			return nil, err
+		}
+	}
+
+	erc20ABI, err := networkcontracts.IERC20MetadataMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	var third multicall.Batch
+	slasherTypes := make(map[common.Address]*multicall.Result[uint64])
+	symbols := make(map[common.Address]*multicall.Result[string])
+	var kept []*vaultReads
+	for _, r := range vr {
+		v := &r.vault
+		if delegator, err := r.delegator.Get(); err != nil || delegator != v.Delegator {
+			inventory.Ignored = append(inventory.Ignored, Ignored{Delegator: v.Delegator, Reason: fmt.Sprintf("not the delegator of %s", v.Address)})
+			continue
+		}
+		if v.Collateral, err = r.collateral.Get(); err != nil {
+			return nil, fmt.Errorf("vaults: collateral of vault %s: %w", v.Address, err)
+		}
+		if v.Slasher, err = r.slasher.Get(); err != nil {
+			return nil, fmt.Errorf("vaults: slasher of vault %s: %w", v.Address, err)
+		}
+		var allocated []Limit
+		for i, l := range v.Limits {
+			if l.MaxNetworkLimit, err = r.max[i].Get(); err != nil {
+				return nil, fmt.Errorf("vaults: maxNetworkLimit on delegator %s: %w", v.Delegator, err)
+			}
+			// OperatorNetworkSpecific delegators have no network limit.
+			if limit, err := r.current[i].Get(); err == nil {
+				l.NetworkLimit = limit
+			}
+			if l.MaxNetworkLimit.Sign() != 0 || l.NetworkLimit != nil && l.NetworkLimit.Sign() != 0 {
+				allocated = append(allocated, l)
+			}
+		}
+		if len(allocated) == 0 {
+			continue
+		}
+		v.Limits = allocated
+		if v.Slasher != (common.Address{}) && slasherTypes[v.Slasher] == nil {
+			slasherTypes[v.Slasher] = multicall.Add[uint64](&third, v.Slasher, core.VetoSlasherABI, "TYPE")
+		}
+		if symbols[v.Collateral] == nil {
+			symbols[v.Collateral] = multicall.Add[string](&third, v.Collateral, erc20ABI, "symbol")
+		}
+		kept = append(kept, r)
+	}
+	if third.Len() > 0 {
+		if _, err := caller.Do(ctx, block, &third); err != nil {
+			return nil, err
+		}
+	}
+	for _, r := range kept {
+		v := r.vault
+		if result := slasherTypes[v.Slasher]; result != nil {
+			kind, err := result.Get()
+			if err != nil {
+				return nil, fmt.Errorf("vaults: TYPE of slasher %s: %w", v.Slasher, err)
+			}
+			slasherType := core.SlasherType(kind)
+			v.SlasherType = &slasherType
+		}
+		// Some tokens return bytes32 or nothing from symbol().
+		v.CollateralSymbol, _ = symbols[v.Collateral].Get()
+		inventory.Vaults = append(inventory.Vaults, v)
+	}
+	sort.Slice(inventory.Vaults, func(i, j int) bool {
+		return inventory.Vaults[i].Address.Cmp(inventory.Vaults[j].Address) < 0
+	})
+	return inventory, nil
+}
+
+// scan returns the subnetworks of the Network every delegator emitted a limit
+// event for, in the order first seen.
+func scan(ctx context.Context, backend Backend, req Request, to uint64) (map[common.Address][]subnetwork.Subnetwork, error) {
+	topics := [][]common.Hash{{
+		core.BaseDelegatorABI.Events["SetMaxNetworkLimit"].ID,
+		core.NetworkRestakeDelegatorABI.Events["SetNetworkLimit"].ID,
+	}}
+	if len(req.SubnetworkIDs) > 0 {
+		var subnetworks []common.Hash
+		for _, id := range req.SubnetworkIDs {
+			s, err := subnetwork.FromNetwork(req.Network, id)
+			if err != nil {
+				return nil, fmt.Errorf("vaults: %w", err)
+			}
+			subnetworks = append(subnetworks, s.Hash())
+		}
+		topics = append(topics, subnetworks)
+	}
+
+	found := make(map[common.Address][]subnetwork.Subnetwork)
+	seen := make(map[common.Address]map[subnetwork.Subnetwork]bool)
+	for _, r := range blockrange.Split(req.FromBlock, to, req.BlockRange) {
+		logs, err := backend.FilterLogs(ctx, ethereum.FilterQuery{
+			FromBlock: new(big.Int).SetUint64(r.From),
+			ToBlock:   new(big.Int).SetUint64(r.To),
+			Topics:    topics,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("vaults: filter logs in blocks %d-%d: %w", r.From, r.To, err)
+		}
+		for _, log := range logs {
+			if log.Removed || len(log.Topics) != 2 {
+				continue
+			}
+			s := subnetwork.Subnetwork(log.Topics[1])
+			if s.Network() != req.Network {
+				continue
+			}
+			if seen[log.Address] == nil {
+				seen[log.Address] = make(map[subnetwork.Subnetwork]bool)
+			}
+			if !seen[log.Address][s] {
+				seen[log.Address][s] = true
+				found[log.Address] = append(found[log.Address], s)
+			}
+		}
+	}
+	return found, nil
+}
+
+// WriteText renders one row per vault and subnetwork, followed by the ignored
+// contracts.
+func (inv *Inventory) WriteText(w io.Writer) error {
+	fmt.Fprintf(w, "Vaults of Network %s from block %d to %d\n\n", inv.Network, inv.FromBlock, inv.BlockNumber)
+	if len(inv.Vaults) == 0 {
+		fmt.Fprintln(w, "No vault has a limit for the Network.")
+	} else {
+		tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(tw, "VAULT\tCOLLATERAL\tDELEGATOR\tDELEGATOR TYPE\tSLASHER TYPE\tSUBNETWORK\tMAX NETWORK LIMIT\tNETWORK LIMIT")
+		for _, v := range inv.Vaults {
+			collateral, slasherType := v.Collateral.Hex(), "none"
+			if v.CollateralSymbol != "" {
+				collateral = fmt.Sprintf("%s (%s)", v.CollateralSymbol, v.Collateral)
+			}
+			if v.SlasherType != nil {
+				slasherType = v.SlasherType.String()
+			}
+			for _, l := range v.Limits {
+				limit := "-"
+				if l.NetworkLimit != nil {
+					limit = l.NetworkLimit.String()
+				}
+				fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+					v.Address, collateral, v.Delegator, v.DelegatorType, slasherType, l.SubnetworkID, l.MaxNetworkLimit, limit)
+			}
+		}
+		if err := tw.Flush(); err != nil {
+			return err
+		}
+	}
+	if len(inv.Ignored) > 0 {
+		fmt.Fprintln(w)
+		for _, ignored := range inv.Ignored {
+			fmt.Fprintf(w, "! ignored %s: %s\n", ignored.Delegator, ignored.Reason)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,354 @@
+package vaults
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	networkcontracts "github.com/symbioticfi/network/bindings/go-go-ethereum"
+	"github.com/symbioticfi/network/pkg/core"
+	"github.com/symbioticfi/network/pkg/multicall"
+	"github.com/symbioticfi/network/pkg/subnetwork"
+)
+
+var (
+	network = common.HexToAddress("0x7e70000000000000000000000000000000000001")
+	other   = common.HexToAddress("0x7e70000000000000000000000000000000000002")
+
+	restakeVault     = common.HexToAddress("0x5a01700000000000000000000000000000000001")
+	specificVault    = common.HexToAddress("0x5a01700000000000000000000000000000000002")
+	unallocatedVault = common.HexToAddress("0x5a01700000000000000000000000000000000003")
+
+	restakeDelegator     = common.HexToAddress("0xde1e000000000000000000000000000000000001")
+	specificDelegator    = common.HexToAddress("0xde1e000000000000000000000000000000000002")
+	unallocatedDelegator = common.HexToAddress("0xde1e000000000000000000000000000000000003")
+	otherDelegator       = common.HexToAddress("0xde1e000000000000000000000000000000000004")
+	// impostor claims restakeVault, which has another delegator.
+	impostor = common.HexToAddress("0xde1e000000000000000000000000000000000005")
+	// token emits limit events but is no delegator.
+	token = common.HexToAddress("0xde1e000000000000000000000000000000000006")
+
+	wstETH  = common.HexToAddress("0xc011000000000000000000000000000000000001")
+	noName  = common.HexToAddress("0xc011000000000000000000000000000000000002")
+	slasher = common.HexToAddress("0x51a5000000000000000000000000000000000001")
+)
+
+type fakeDelegator struct {
+	vault         common.Address
+	kind          core.DelegatorType
+	maxLimits     map[common.Hash]*big.Int
+	networkLimits map[common.Hash]*big.Int
+}
+
+type fakeVault struct {
+	delegator, collateral, slasher common.Address
+}
+
+// fakeChain serves the limit events of its delegators, Multicall3 and the
+// contracts read by Discover. Methods other than the ones below panic.
+type fakeChain struct {
+	Backend
+
+	head       uint64
+	logs       []types.Log
+	delegators map[common.Address]*fakeDelegator
+	vaults     map[common.Address]fakeVault
+	// queries are the topics of the FilterLogs calls.
+	queries [][][]common.Hash
+}
+
+func subnetworkHash(t *testing.T, network common.Address, id int64) common.Hash {
+	t.Helper()
+	s, err := subnetwork.FromNetwork(network, big.NewInt(id))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s.Hash()
+}
+
+// newFakeChain returns a chain at block 12 with:
+//   - a NetworkRestake vault with limits for subnetwork 0 of the Network, set
+//     by several events, and no limits for subnetwork 1;
+//   - an OperatorNetworkSpecific vault whose collateral has no symbol();
+//   - a vault with no limits for subnetwork 1;
+//   - a delegator with limits for another Network only;
+//   - an impostor and a token emitting limit events.
+func newFakeChain(t *testing.T) *fakeChain {
+	t.Helper()
+	zero, one := subnetworkHash(t, network, 0), subnetworkHash(t, network, 1)
+	setMax := core.BaseDelegatorABI.Events["SetMaxNetworkLimit"].ID
+	setLimit := core.NetworkRestakeDelegatorABI.Events["SetNetworkLimit"].ID
+	event := func(block uint64, emitter common.Address, id common.Hash, s common.Hash) types.Log {
+		return types.Log{Address: emitter, Topics: []common.Hash{id, s}, BlockNumber: block}
+	}
+	removed := event(9, unallocatedDelegator, setMax, zero)
+	removed.Removed = true
+	return &fakeChain{
+		head: 12,
+		logs: []types.Log{
+			// Before the scanned blocks.
+			event(1, unallocatedDelegator, setMax, zero),
+			event(3, restakeDelegator, setMax, zero),
+			event(4, restakeDelegator, setLimit, zero),
+			event(5, restakeDelegator, setMax, one),
+			event(6, specificDelegator, setMax, zero),
+			event(7, otherDelegator, setMax, subnetworkHash(t, other, 0)),
+			event(8, unallocatedDelegator, setMax, one),
+			removed,
+			event(10, impostor, setMax, zero),
+			event(11, token, setMax, zero),
+			event(12, restakeDelegator, setMax, zero),
+		},
+		delegators: map[common.Address]*fakeDelegator{
+			restakeDelegator: {
+				vault:         restakeVault,
+				kind:          core.NetworkRestake,
+				maxLimits:     map[common.Hash]*big.Int{zero: big.NewInt(100)},
+				networkLimits: map[common.Hash]*big.Int{zero: big.NewInt(50)},
+			},
+			specificDelegator: {
+				vault:     specificVault,
+				kind:      core.OperatorNetworkSpecific,
+				maxLimits: map[common.Hash]*big.Int{zero: big.NewInt(10)},
+			},
+			unallocatedDelegator: {
+				vault:         unallocatedVault,
+				kind:          core.NetworkRestake,
+				maxLimits:     map[common.Hash]*big.Int{},
+				networkLimits: map[common.Hash]*big.Int{},
+			},
+			otherDelegator: {vault: restakeVault},
+			impostor:       {vault: restakeVault, maxLimits: map[common.Hash]*big.Int{}},
+		},
+		vaults: map[common.Address]fakeVault{
+			restakeVault:     {delegator: restakeDelegator, collateral: wstETH, slasher: slasher},
+			specificVault:    {delegator: specificDelegator, collateral: noName},
+			unallocatedVault: {delegator: unallocatedDelegator, collateral: wstETH},
+		},
+	}
+}
+
+func (f *fakeChain) BlockNumber(context.Context) (uint64, error) {
+	return f.head, nil
+}
+
+func (f *fakeChain) FilterLogs(_ context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	f.queries = append(f.queries, query.Topics)
+	var logs []types.Log
+	for _, log := range f.logs {
+		if log.BlockNumber < query.FromBlock.Uint64() || log.BlockNumber > query.ToBlock.Uint64() {
+			continue
+		}
+		match := true
+		for i, alternatives := range query.Topics {
+			found := len(alternatives) == 0
+			for _, topic := range alternatives {
+				found = found || log.Topics[i] == topic
+			}
+			match = match && found
+		}
+		if match {
+			logs = append(logs, log)
+		}
+	}
+	return logs, nil
+}
+
+// call3 and result3 mirror the Multicall3 structs.
+type call3 struct {
+	Target       common.Address
+	AllowFailure bool
+	CallData     []byte
+}
+
+type result3 struct {
+	Success    bool
+	ReturnData []byte
+}
+
+// execute runs a call and reports whether it succeeded.
+func (f *fakeChain) execute(to common.Address, data []byte) ([]byte, bool) {
+	erc20ABI, _ := networkcontracts.IERC20MetadataMetaData.GetAbi()
+	var parsed []*abi.ABI
+	switch {
+	case to == multicall.Multicall3Address:
+		return common.LeftPadBytes(new(big.Int).SetUint64(f.head).Bytes(), 32), true
+	case to == slasher:
+		parsed = []*abi.ABI{core.VetoSlasherABI}
+	case to == wstETH:
+		parsed = []*abi.ABI{erc20ABI}
+	case f.delegators[to] != nil:
+		parsed = []*abi.ABI{core.BaseDelegatorABI, core.NetworkRestakeDelegatorABI}
+	case f.vaults[to] != (fakeVault{}):
+		parsed = []*abi.ABI{core.VaultABI}
+	}
+	for _, p := range parsed {
+		method, err := p.MethodById(data)
+		if err != nil {
+			continue
+		}
+		args, err := method.Inputs.Unpack(data[4:])
+		if err != nil {
+			return nil, false
+		}
+		var value any
+		switch method.Name {
+		case "TYPE":
+			if to == slasher {
+				value = uint64(core.VetoSlasher)
+			} else {
+				value = uint64(f.delegators[to].kind)
+			}
+		case "vault":
+			value = f.delegators[to].vault
+		case "maxNetworkLimit":
+			value = orZero(f.delegators[to].maxLimits[args[0].([32]byte)])
+		case "networkLimit":
+			if f.delegators[to].networkLimits == nil {
+				return nil, false
+			}
+			value = orZero(f.delegators[to].networkLimits[args[0].([32]byte)])
+		case "delegator":
+			value = f.vaults[to].delegator
+		case "collateral":
+			value = f.vaults[to].collateral
+		case "slasher":
+			value = f.vaults[to].slasher
+		case "symbol":
+			value = "wstETH"
+		default:
+			return nil, false
+		}
+		out, err := method.Outputs.Pack(value)
+		return out, err == nil
+	}
+	return nil, false
+}
+
+func orZero(x *big.Int) *big.Int {
+	if x == nil {
+		return new(big.Int)
+	}
+	return x
+}
+
+func (f *fakeChain) CodeAt(context.Context, common.Address, *big.Int) ([]byte, error) {
+	return []byte{1}, nil
+}
+
+func (f *fakeChain) CallContract(_ context.Context, msg ethereum.CallMsg, _ *big.Int) ([]byte, error) {
+	if *msg.To != multicall.Multicall3Address {
+		return nil, errors.New("unexpected call outside of aggregate3")
+	}
+	aggregate3 := multicall.Multicall3ABI.Methods["aggregate3"]
+	args, err := aggregate3.Inputs.Unpack(msg.Data[4:])
+	if err != nil {
+		return nil, err
+	}
+	calls := *abi.ConvertType(args[0], new([]call3)).(*[]call3)
+	results := make([]result3, len(calls))
+	for i, c := range calls {
+		results[i].ReturnData, results[i].Success = f.execute(c.Target, c.CallData)
+	}
+	return aggregate3.Outputs.Pack(results)
+}
+
+func TestDiscover(t *testing.T) {
+	tests := []struct {
+		name          string
+		subnetworkIDs []*big.Int
+		// ignored is whether the token, which has no vault(), and the
+		// impostor, which is not its vault's delegator, are reported.
+		ignored bool
+	}{
+		{name: "every subnetwork", ignored: true},
+		{name: "subnetwork 0", subnetworkIDs: []*big.Int{big.NewInt(0)}, ignored: true},
+		{name: "subnetwork 1", subnetworkIDs: []*big.Int{big.NewInt(1)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chain := newFakeChain(t)
+			inventory, err := Discover(context.Background(), multicall.NewCaller(chain, nil), chain, Request{
+				Network:       network,
+				FromBlock:     2,
+				BlockRange:    5,
+				SubnetworkIDs: tt.subnetworkIDs,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(chain.queries) != 3 {
+				t.Errorf("%d eth_getLogs calls for blocks 2-12 by 5, want 3", len(chain.queries))
+			}
+			for _, topics := range chain.queries {
+				if len(topics) != 1+min(len(tt.subnetworkIDs), 1) {
+					t.Errorf("queried topics %v, want the subnetworks only with subnetwork IDs", topics)
+				}
+			}
+			if inventory.BlockNumber != 12 || inventory.FromBlock != 2 {
+				t.Errorf("inventory of blocks %d-%d", inventory.FromBlock, inventory.BlockNumber)
+			}
+
+			var ignored []common.Address
+			for _, i := range inventory.Ignored {
+				ignored = append(ignored, i.Delegator)
+			}
+			var want []common.Address
+			if tt.ignored {
+				want = []common.Address{token, impostor}
+			}
+			if fmt.Sprint(ignored) != fmt.Sprint(want) {
+				t.Errorf("ignored %+v, want %v", inventory.Ignored, want)
+			}
+			if !tt.ignored {
+				// Vaults whose limits are all zero are left out.
+				if len(inventory.Vaults) != 0 {
+					t.Errorf("vaults %+v, want none", inventory.Vaults)
+				}
+				return
+			}
+
+			if len(inventory.Vaults) != 2 {
+				t.Fatalf("vaults %+v, want the NetworkRestake and OperatorNetworkSpecific ones", inventory.Vaults)
+			}
+			restake, specific := inventory.Vaults[0], inventory.Vaults[1]
+			if restake.Address != restakeVault || restake.Delegator != restakeDelegator || restake.CollateralSymbol != "wstETH" ||
+				restake.SlasherType == nil || *restake.SlasherType != core.VetoSlasher {
+				t.Errorf("NetworkRestake vault = %+v", restake)
+			}
+			// Subnetwork 0 is found once despite three events, and subnetwork 1
+			// is dropped as it has no limits.
+			if len(restake.Limits) != 1 || restake.Limits[0].SubnetworkID.Sign() != 0 ||
+				restake.Limits[0].MaxNetworkLimit.Int64() != 100 || restake.Limits[0].NetworkLimit.Int64() != 50 {
+				t.Errorf("NetworkRestake limits = %+v", restake.Limits)
+			}
+			// A collateral without symbol() is shown by address only.
+			if specific.Address != specificVault || specific.CollateralSymbol != "" || specific.SlasherType != nil ||
+				specific.DelegatorType != core.OperatorNetworkSpecific {
+				t.Errorf("OperatorNetworkSpecific vault = %+v", specific)
+			}
+			if len(specific.Limits) != 1 || specific.Limits[0].MaxNetworkLimit.Int64() != 10 || specific.Limits[0].NetworkLimit != nil {
+				t.Errorf("OperatorNetworkSpecific limits = %+v", specific.Limits)
+			}
+		})
+	}
+}
+
+func TestDiscoverInvalidSubnetwork(t *testing.T) {
+	chain := newFakeChain(t)
+	_, err := Discover(context.Background(), multicall.NewCaller(chain, nil), chain, Request{
+		Network:       network,
+		BlockRange:    5,
+		SubnetworkIDs: []*big.Int{new(big.Int).Lsh(common.Big1, 96)},
+	})
+	if err == nil {
+		t.Error("Discover() succeeded with a subnetwork identifier above uint96")
+	}
+}
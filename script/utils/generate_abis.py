@@ -14,10 +14,25 @@ OUT_DIR = ROOT / "out"
 ABIS_DIR = ROOT / "abis"
 SRC_ROOT = Path("src")
 INTERFACES_ROOT = SRC_ROOT / "interfaces"
+# Dependency contracts whose ABIs are needed by the off-chain tooling.
+DEPENDENCY_ARTIFACTS = {
+    "lib/openzeppelin-contracts/contracts/proxy/transparent/ProxyAdmin.sol",
+    "lib/openzeppelin-contracts/contracts/token/ERC20/extensions/IERC20Metadata.sol",
+    "lib/openzeppelin-contracts-upgradeable/contracts/governance/TimelockControllerUpgradeable.sol",
+    "lib/core/src/interfaces/INetworkRegistry.sol",
+    "lib/core/src/interfaces/delegator/IBaseDelegator.sol",
+    "lib/core/src/interfaces/delegator/INetworkRestakeDelegator.sol",
+    "lib/core/src/interfaces/service/INetworkMiddlewareService.sol",
+    "lib/core/src/interfaces/slasher/IVetoSlasher.sol",
+    "lib/core/src/interfaces/vault/IVault.sol",
+}
 
 
 def is_abi_artifact(source_path: str) -> bool:
     """Return True if the source path should produce an ABI artifact."""
+    if source_path in DEPENDENCY_ARTIFACTS:
+        return True
+
     if not source_path.startswith(f"{SRC_ROOT}/"):
         return False
 